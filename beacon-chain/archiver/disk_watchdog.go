@@ -0,0 +1,87 @@
+package archiver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// diskCheckInterval is the time between two checks of the free disk space of the data directory.
+var diskCheckInterval = time.Minute
+
+// freeDiskSpace is replaced in tests to simulate disk pressure.
+var freeDiskSpace = diskFreeSpace
+
+// runDiskWatchdog checks the free disk space of the data directory every disk check interval,
+// pausing archival writes while it is below the minimum free disk space. The watchdog stops if
+// the free disk space can't be checked at startup, as on unsupported platforms.
+func (s *Service) runDiskWatchdog(ctx context.Context) {
+	if err := s.checkDiskSpace(); err != nil {
+		log.WithError(err).Warn("Could not check free disk space, archival writes won't be paused on low disk space")
+		return
+	}
+	ticker := time.NewTicker(diskCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.checkDiskSpace(); err != nil {
+				log.WithError(err).Error("Could not check free disk space")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting disk space watchdog")
+			return
+		}
+	}
+}
+
+// checkDiskSpace pauses archival writes when the free disk space of the data directory drops
+// below the minimum free disk space, and resumes them once it is back above it.
+func (s *Service) checkDiskSpace() error {
+	free, err := freeDiskSpace(s.dataDir)
+	if err != nil {
+		return err
+	}
+	archiverFreeDiskSpace.Set(float64(free))
+
+	s.pauseLock.Lock()
+	defer s.pauseLock.Unlock()
+	s.freeDiskSpace = free
+	fields := logrus.Fields{
+		"freeDiskSpaceMB":    free / (1024 * 1024),
+		"minFreeDiskSpaceMB": s.minFreeDiskSpace / (1024 * 1024),
+	}
+	if free < s.minFreeDiskSpace && !s.writesPaused {
+		s.writesPaused = true
+		archiverWritesPaused.Set(1)
+		log.WithFields(fields).Error("Free disk space is low, pausing archival writes until space is freed")
+	} else if free >= s.minFreeDiskSpace && s.writesPaused {
+		s.writesPaused = false
+		archiverWritesPaused.Set(0)
+		log.WithFields(fields).Info("Free disk space recovered, resuming archival writes")
+	}
+	return nil
+}
+
+// archivalPaused returns true while archival writes are paused because of low free disk space.
+func (s *Service) archivalPaused() bool {
+	s.pauseLock.RLock()
+	defer s.pauseLock.RUnlock()
+	return s.writesPaused
+}
+
+// pausedStatus returns the status error reported while archival writes are paused.
+func (s *Service) pausedStatus() error {
+	s.pauseLock.RLock()
+	defer s.pauseLock.RUnlock()
+	if !s.writesPaused {
+		return nil
+	}
+	return fmt.Errorf(
+		"archival writes paused, free disk space %d MB is below the minimum of %d MB",
+		s.freeDiskSpace/(1024*1024),
+		s.minFreeDiskSpace/(1024*1024),
+	)
+}
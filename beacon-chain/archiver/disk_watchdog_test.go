@@ -0,0 +1,68 @@
+package archiver
+
+import (
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestArchiverService_PausesWritesOnLowDiskSpace(t *testing.T) {
+	hook := logTest.NewGlobal()
+	free := uint64(10)
+	defer func(original func(string) (uint64, error)) {
+		freeDiskSpace = original
+	}(freeDiskSpace)
+	freeDiskSpace = func(_ string) (uint64, error) {
+		return free, nil
+	}
+
+	headState, err := setupState(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc, beaconDB := setupService(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc.headFetcher = &mock.ChainService{
+		State: headState,
+	}
+	svc.minFreeDiskSpace = 100
+
+	if err := svc.checkDiskSpace(); err != nil {
+		t.Fatal(err)
+	}
+	if svc.Status() == nil {
+		t.Error("Wanted an unhealthy status while archival writes are paused")
+	}
+	event := &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{
+			BlockRoot: [32]byte{1, 2, 3},
+			Verified:  true,
+		},
+	}
+	triggerStateEvent(t, svc, event)
+	testutil.AssertLogsContain(t, hook, "Archival writes are paused")
+	testutil.AssertLogsDoNotContain(t, hook, "Successfully archived")
+	info, err := beaconDB.ArchivedCommitteeInfo(svc.ctx, helpers.CurrentEpoch(headState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info != nil {
+		t.Error("Wanted no committee info archived while archival writes are paused")
+	}
+
+	free = 200
+	if err := svc.checkDiskSpace(); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Status(); err != nil {
+		t.Errorf("Wanted a healthy status once disk space recovered, received %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "resuming archival writes")
+}
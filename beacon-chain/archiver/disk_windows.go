@@ -0,0 +1,10 @@
+package archiver
+
+import (
+	"errors"
+)
+
+// diskFreeSpace isn't supported on windows, the disk space watchdog is disabled.
+func diskFreeSpace(_ string) (uint64, error) {
+	return 0, errors.New("checking free disk space is not supported on windows")
+}
@@ -0,0 +1,21 @@
+package archiver
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	archiverFreeDiskSpace = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "archiver_free_disk_space_bytes",
+		Help: "Free disk space of the data directory, as last checked by the archiver disk space watchdog",
+	})
+	archiverWritesPaused = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "archiver_writes_paused",
+		Help: "1 while archival writes are paused because of low free disk space, 0 otherwise",
+	})
+	archiverEpochsSkipped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "archiver_epochs_skipped_total",
+		Help: "The # of epochs not archived because archival writes were paused",
+	})
+)
@@ -3,7 +3,9 @@ package archiver
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -30,6 +32,13 @@ type Service struct {
 	participationFetcher blockchain.ParticipationFetcher
 	stateNotifier        statefeed.Notifier
 	lastArchivedEpoch    uint64
+	dataDir              string
+	minFreeDiskSpace     uint64
+	pauseLock            sync.RWMutex
+	writesPaused         bool
+	freeDiskSpace        uint64
+	lastJustified        *ethpb.Checkpoint
+	lastFinalized        *ethpb.Checkpoint
 }
 
 // Config options for the archiver service.
@@ -38,6 +47,11 @@ type Config struct {
 	HeadFetcher          blockchain.HeadFetcher
 	ParticipationFetcher blockchain.ParticipationFetcher
 	StateNotifier        statefeed.Notifier
+	// DataDir is the directory whose volume free disk space is watched.
+	DataDir string
+	// MinFreeDiskSpace is the free disk space, in bytes, below which archival writes are
+	// paused. 0 disables the disk space watchdog.
+	MinFreeDiskSpace uint64
 }
 
 // NewArchiverService initializes the service from configuration options.
@@ -50,11 +64,16 @@ func NewArchiverService(ctx context.Context, cfg *Config) *Service {
 		headFetcher:          cfg.HeadFetcher,
 		participationFetcher: cfg.ParticipationFetcher,
 		stateNotifier:        cfg.StateNotifier,
+		dataDir:              cfg.DataDir,
+		minFreeDiskSpace:     cfg.MinFreeDiskSpace,
 	}
 }
 
 // Start the archiver service event loop.
 func (s *Service) Start() {
+	if s.minFreeDiskSpace > 0 && s.dataDir != "" {
+		go s.runDiskWatchdog(s.ctx)
+	}
 	go s.run(s.ctx)
 }
 
@@ -65,9 +84,10 @@ func (s *Service) Stop() error {
 }
 
 // Status reports the healthy status of the archiver. Returning nil means service
-// is correctly running without error.
+// is correctly running without error, an error is reported while archival writes are
+// paused because of low free disk space.
 func (s *Service) Status() error {
-	return nil
+	return s.pausedStatus()
 }
 
 // We archive committee information pertaining to the head state's epoch.
@@ -139,6 +159,53 @@ func (s *Service) archiveBalances(ctx context.Context, balances []uint64, epoch
 	return nil
 }
 
+// We archive the slot at which the checkpoints of the head state were first seen justified and
+// finalized, building the justification and finalization timeline of the chain.
+func (s *Service) archiveCheckpoints(ctx context.Context, headState *state.BeaconState) error {
+	justified := headState.CurrentJustifiedCheckpoint()
+	if justified != nil && !proto.Equal(justified, s.lastJustified) {
+		if err := s.archiveCheckpoint(ctx, justified, headState.Slot(), false); err != nil {
+			return errors.Wrap(err, "could not archive justified checkpoint")
+		}
+		s.lastJustified = justified
+	}
+	finalized := headState.FinalizedCheckpoint()
+	if finalized != nil && !proto.Equal(finalized, s.lastFinalized) {
+		if err := s.archiveCheckpoint(ctx, finalized, headState.Slot(), true); err != nil {
+			return errors.Wrap(err, "could not archive finalized checkpoint")
+		}
+		s.lastFinalized = finalized
+	}
+	return nil
+}
+
+// This marks the checkpoint justified, or finalized, at the slot unless it already was.
+func (s *Service) archiveCheckpoint(ctx context.Context, cp *ethpb.Checkpoint, slot uint64, finalized bool) error {
+	archived, err := s.beaconDB.ArchivedCheckpoints(ctx, cp.Epoch, cp.Epoch)
+	if err != nil {
+		return err
+	}
+	record := &pb.ArchivedCheckpoint{Epoch: cp.Epoch}
+	if len(archived) > 0 {
+		record = archived[0]
+	}
+	if record.Finalized || (record.Justified && !finalized) {
+		return nil
+	}
+	// A finalized checkpoint was justified first, if the archiver never saw the justification it is
+	// recorded at the finalization slot.
+	if !record.Justified {
+		record.Justified = true
+		record.JustifiedSlot = slot
+	}
+	if finalized {
+		record.Finalized = true
+		record.FinalizedSlot = slot
+	}
+	record.Root = cp.Root
+	return s.beaconDB.SaveArchivedCheckpoint(ctx, record)
+}
+
 func (s *Service) run(ctx context.Context) {
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
@@ -154,6 +221,12 @@ func (s *Service) run(ctx context.Context) {
 					log.WithError(err).Error("Head state is not available")
 					continue
 				}
+				// Checkpoints are archived on every block to record the slot they changed at.
+				if !s.archivalPaused() {
+					if err := s.archiveCheckpoints(ctx, headState); err != nil {
+						log.WithError(err).Error("Could not archive checkpoints")
+					}
+				}
 				slot := headState.Slot()
 				currentEpoch := helpers.SlotToEpoch(slot)
 				if !helpers.IsEpochEnd(slot) && currentEpoch <= s.lastArchivedEpoch {
@@ -163,6 +236,12 @@ func (s *Service) run(ctx context.Context) {
 				if !helpers.IsEpochEnd(slot) {
 					epochToArchive--
 				}
+				if s.archivalPaused() {
+					archiverEpochsSkipped.Inc()
+					log.WithField("epoch", epochToArchive).Warn("Archival writes are paused, not archiving epoch")
+					s.lastArchivedEpoch = epochToArchive
+					continue
+				}
 				if err := s.archiveCommitteeInfo(ctx, headState, epochToArchive); err != nil {
 					log.WithError(err).Error("Could not archive committee info")
 					continue
@@ -19,6 +19,7 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -447,6 +448,55 @@ func setupState(validatorCount uint64) (*stateTrie.BeaconState, error) {
 	})
 }
 
+func TestArchiverService_ArchivesCheckpointTimeline(t *testing.T) {
+	svc, beaconDB := setupService(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	root1 := bytesutil.PadTo([]byte{'a'}, 32)
+	root2 := bytesutil.PadTo([]byte{'b'}, 32)
+
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{
+		Slot:                       70,
+		CurrentJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: root1},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.archiveCheckpoints(ctx, st); err != nil {
+		t.Fatal(err)
+	}
+	st, err = stateTrie.InitializeFromProto(&pb.BeaconState{
+		Slot:                       100,
+		CurrentJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 2, Root: root2},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Epoch: 1, Root: root1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.archiveCheckpoints(ctx, st); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoints, err := beaconDB.ArchivedCheckpoints(ctx, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.ArchivedCheckpoint{
+		{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:], Justified: true, JustifiedSlot: 70, Finalized: true, FinalizedSlot: 70},
+		{Epoch: 1, Root: root1, Justified: true, JustifiedSlot: 70, Finalized: true, FinalizedSlot: 100},
+		{Epoch: 2, Root: root2, Justified: true, JustifiedSlot: 100},
+	}
+	if len(checkpoints) != len(want) {
+		t.Fatalf("Wanted %d checkpoints, received %d", len(want), len(checkpoints))
+	}
+	for i := range want {
+		if !proto.Equal(want[i], checkpoints[i]) {
+			t.Errorf("Wanted %v, received %v", want[i], checkpoints[i])
+		}
+	}
+}
+
 func setupService(t *testing.T) (*Service, db.Database) {
 	beaconDB := dbutil.SetupDB(t)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,199 @@
+// Package bench drives synthetic block and attestation load through the beacon chain state
+// transition and state generation pipeline, without any networking, in order to measure
+// throughput on real hardware.
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "bench")
+
+// benchDBName is the directory, inside the data directory, of the throwaway benchmark DB.
+const benchDBName = "benchdata"
+
+// Config options for a benchmark run.
+type Config struct {
+	// DataDir is where the temporary benchmark database is created.
+	DataDir string
+	// NumValidators is the size of the genesis validator registry.
+	NumValidators uint64
+	// NumBlocks is the number of blocks, one per slot, to process.
+	NumBlocks uint64
+	// MaxAttestationsPerBlock caps the number of aggregated attestations included in each block.
+	MaxAttestationsPerBlock uint64
+	// HotStateCacheSize is the number of hot states stategen keeps in memory, the most recent
+	// states loaded back are served from them. Zero uses the default of --hot-state-cache-size.
+	HotStateCacheSize int
+}
+
+// Report summarizes the results of a benchmark run.
+type Report struct {
+	Blocks         uint64
+	Attestations   uint64
+	ProcessingTime time.Duration
+	SaveTime       time.Duration
+	CachedLoads    uint64
+	CachedLoadTime time.Duration
+	ReplayLoads    uint64
+	ReplayLoadTime time.Duration
+}
+
+// Run generates the genesis state and blocks for the configured validator count and feeds the
+// blocks through the state transition and stategen, then loads every produced state back.
+func Run(ctx context.Context, cfg *Config) (*Report, error) {
+	dbPath := filepath.Join(cfg.DataDir, benchDBName)
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create benchmark DB")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close benchmark DB")
+		}
+		if err := os.RemoveAll(dbPath); err != nil {
+			log.WithError(err).Error("Could not remove benchmark DB")
+		}
+	}()
+
+	privKeys, _, err := interop.DeterministicallyGenerateKeys(0, cfg.NumValidators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate keys")
+	}
+	genesis, _, err := interop.GenerateGenesisState(0, cfg.NumValidators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate genesis state")
+	}
+	st, err := stateTrie.InitializeFromProto(genesis)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := ssz.HashTreeRoot(genesisBlk.Block)
+	if err != nil {
+		return nil, err
+	}
+	if err := beaconDB.SaveBlock(ctx, genesisBlk); err != nil {
+		return nil, err
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		return nil, err
+	}
+	if err := beaconDB.SaveState(ctx, st, genesisRoot); err != nil {
+		return nil, err
+	}
+
+	// Stategen sizes its hot state cache from the global flags.
+	cachedStates := cfg.HotStateCacheSize
+	if cachedStates <= 0 {
+		cachedStates = flags.HotStateCacheSize.Value
+	}
+	globalFlags := *flags.Get()
+	globalFlags.HotStateCacheSize = cachedStates
+	flags.Init(&globalFlags)
+	stateGen := stategen.New(beaconDB)
+	report := &Report{}
+	roots := make([][32]byte, 0, cfg.NumBlocks)
+	for slot := uint64(1); slot <= cfg.NumBlocks; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blk, err := generateBlock(ctx, st, privKeys, slot, cfg.MaxAttestationsPerBlock)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate block at slot %d", slot)
+		}
+
+		start := time.Now()
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block at slot %d", slot)
+		}
+		report.ProcessingTime += time.Since(start)
+
+		start = time.Now()
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			return nil, err
+		}
+		if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+			return nil, err
+		}
+		// The hot state cache keeps a reference to the saved state, so hand it a copy that the
+		// next state transition does not mutate.
+		if err := stateGen.SaveState(ctx, root, st.Copy()); err != nil {
+			return nil, errors.Wrapf(err, "could not save state at slot %d", slot)
+		}
+		report.SaveTime += time.Since(start)
+
+		roots = append(roots, root)
+		report.Blocks++
+		report.Attestations += uint64(len(blk.Block.Body.Attestations))
+		if slot%32 == 0 {
+			log.WithField("slot", slot).Info("Processed benchmark blocks")
+		}
+	}
+
+	// Load the states back, oldest first. Only the most recent states are still held by the hot
+	// state cache, the rest have to be regenerated by replaying blocks.
+	for i, root := range roots {
+		start := time.Now()
+		if _, err := stateGen.StateByRoot(ctx, root); err != nil {
+			return nil, errors.Wrap(err, "could not load state")
+		}
+		elapsed := time.Since(start)
+		if len(roots)-i <= cachedStates {
+			report.CachedLoads++
+			report.CachedLoadTime += elapsed
+		} else {
+			report.ReplayLoads++
+			report.ReplayLoadTime += elapsed
+		}
+	}
+
+	return report, nil
+}
+
+// Print writes a human readable summary of the report.
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "Blocks processed:        %d\n", r.Blocks)
+	fmt.Fprintf(w, "Attestations processed:  %d\n", r.Attestations)
+	fmt.Fprintf(w, "Processing time:         %v (%.2f blocks/s, %.2f attestations/s)\n",
+		r.ProcessingTime, perSecond(r.Blocks, r.ProcessingTime), perSecond(r.Attestations, r.ProcessingTime))
+	fmt.Fprintf(w, "State save time:         %v (%v per block)\n", r.SaveTime, average(r.SaveTime, r.Blocks))
+	fmt.Fprintf(w, "Cached state loads:      %d (%v average)\n", r.CachedLoads, average(r.CachedLoadTime, r.CachedLoads))
+	fmt.Fprintf(w, "Replayed state loads:    %d (%v average)\n", r.ReplayLoads, average(r.ReplayLoadTime, r.ReplayLoads))
+}
+
+func perSecond(n uint64, d time.Duration) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / d.Seconds()
+}
+
+func average(d time.Duration, n uint64) time.Duration {
+	if n == 0 {
+		return 0
+	}
+	return d / time.Duration(n)
+}
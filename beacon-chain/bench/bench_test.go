@@ -0,0 +1,66 @@
+package bench
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestRun_ProcessesBlocks(t *testing.T) {
+	dataDir := testutil.TempDir() + "/bench"
+	defer func() {
+		if err := os.RemoveAll(dataDir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	report, err := Run(context.Background(), &Config{
+		DataDir:                 dataDir,
+		NumValidators:           64,
+		NumBlocks:               3,
+		MaxAttestationsPerBlock: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Blocks != 3 {
+		t.Errorf("Wanted 3 blocks processed, got %d", report.Blocks)
+	}
+	if report.Attestations != 3 {
+		t.Errorf("Wanted 3 attestations processed, got %d", report.Attestations)
+	}
+	if report.CachedLoads+report.ReplayLoads != 3 {
+		t.Errorf("Wanted 3 states loaded, got %d", report.CachedLoads+report.ReplayLoads)
+	}
+}
+
+func TestRun_UsesHotStateCacheSize(t *testing.T) {
+	dataDir := testutil.TempDir() + "/bench"
+	defer func() {
+		if err := os.RemoveAll(dataDir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	resetCfg := flags.Get()
+	defer flags.Init(resetCfg)
+
+	report, err := Run(context.Background(), &Config{
+		DataDir:                 dataDir,
+		NumValidators:           64,
+		NumBlocks:               3,
+		MaxAttestationsPerBlock: 1,
+		HotStateCacheSize:       1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flags.Get().HotStateCacheSize != 1 {
+		t.Errorf("Wanted a hot state cache of 1 state, got %d", flags.Get().HotStateCacheSize)
+	}
+	if report.CachedLoads != 1 || report.ReplayLoads != 2 {
+		t.Errorf("Wanted 1 cached and 2 replayed loads, got %d and %d", report.CachedLoads, report.ReplayLoads)
+	}
+}
@@ -0,0 +1,161 @@
+package bench
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// generateBlock builds a fully signed block at the given slot on top of the input state. The block
+// carries one fully aggregated attestation per committee of the previous slot, capped at maxAtts.
+func generateBlock(
+	ctx context.Context,
+	st *stateTrie.BeaconState,
+	privKeys []*bls.SecretKey,
+	slot uint64,
+	maxAtts uint64,
+) (*ethpb.SignedBeaconBlock, error) {
+	parentHeader := st.LatestBlockHeader()
+	prevStateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parentHeader.StateRoot = prevStateRoot[:]
+	parentRoot, err := ssz.HashTreeRoot(parentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	preState, err := state.ProcessSlots(ctx, st.Copy(), slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slots")
+	}
+	epoch := helpers.SlotToEpoch(slot)
+	proposerIdx, err := helpers.BeaconProposerIndex(preState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer index")
+	}
+
+	randaoDomain, err := helpers.Domain(preState.Fork(), epoch, params.BeaconConfig().DomainRandao)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	reveal := privKeys[proposerIdx].Sign(buf, randaoDomain)
+
+	atts, err := generateAttestations(preState, privKeys, slot, maxAtts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate attestations")
+	}
+
+	block := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data:     st.Eth1Data(),
+			RandaoReveal: reveal.Marshal(),
+			Attestations: atts,
+		},
+	}
+	stateRoot, err := state.CalculateStateRoot(ctx, st, &ethpb.SignedBeaconBlock{Block: block})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate state root")
+	}
+	block.StateRoot = stateRoot[:]
+
+	blockRoot, err := ssz.HashTreeRoot(block)
+	if err != nil {
+		return nil, err
+	}
+	proposerDomain, err := helpers.Domain(preState.Fork(), epoch, params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return nil, err
+	}
+	sig := privKeys[proposerIdx].Sign(blockRoot[:], proposerDomain)
+
+	return &ethpb.SignedBeaconBlock{Block: block, Signature: sig.Marshal()}, nil
+}
+
+// generateAttestations creates attestations for the slot preceding the input slot, where every
+// member of the committee attests to the canonical head.
+func generateAttestations(
+	preState *stateTrie.BeaconState,
+	privKeys []*bls.SecretKey,
+	slot uint64,
+	maxAtts uint64,
+) ([]*ethpb.Attestation, error) {
+	if slot == 0 || maxAtts == 0 {
+		return nil, nil
+	}
+	attSlot := slot - 1
+	attEpoch := helpers.SlotToEpoch(attSlot)
+
+	headRoot, err := helpers.BlockRootAtSlot(preState, attSlot)
+	if err != nil {
+		return nil, err
+	}
+	targetRoot, err := helpers.BlockRoot(preState, attEpoch)
+	if err != nil {
+		return nil, err
+	}
+	source := preState.CurrentJustifiedCheckpoint()
+	if attEpoch < helpers.CurrentEpoch(preState) {
+		source = preState.PreviousJustifiedCheckpoint()
+	}
+	activeCount, err := helpers.ActiveValidatorCount(preState, attEpoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := helpers.Domain(preState.Fork(), attEpoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, err
+	}
+
+	committeeCount := helpers.SlotCommitteeCount(activeCount)
+	atts := make([]*ethpb.Attestation, 0, committeeCount)
+	for c := uint64(0); c < committeeCount && c < maxAtts; c++ {
+		committee, err := helpers.BeaconCommitteeFromState(preState, attSlot, c)
+		if err != nil {
+			return nil, err
+		}
+		if len(committee) == 0 {
+			continue
+		}
+		data := &ethpb.AttestationData{
+			Slot:            attSlot,
+			CommitteeIndex:  c,
+			BeaconBlockRoot: headRoot,
+			Source:          source,
+			Target: &ethpb.Checkpoint{
+				Epoch: attEpoch,
+				Root:  targetRoot,
+			},
+		}
+		dataRoot, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			return nil, err
+		}
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		sigs := make([]*bls.Signature, len(committee))
+		for i, idx := range committee {
+			bits.SetBitAt(uint64(i), true)
+			sigs[i] = privKeys[idx].Sign(dataRoot[:], domain)
+		}
+		atts = append(atts, &ethpb.Attestation{
+			Data:            data,
+			AggregationBits: bits,
+			Signature:       bls.AggregateSignatures(sigs).Marshal(),
+		})
+	}
+	return atts, nil
+}
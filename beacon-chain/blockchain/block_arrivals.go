@@ -0,0 +1,198 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// blockArrivalHistorySize is the number of most recent block arrivals kept in memory.
+const blockArrivalHistorySize = 1024
+
+// BlockArrivalFetcher retrieves the arrival timing of the most recently received blocks.
+type BlockArrivalFetcher interface {
+	BlockArrivals() []*BlockArrival
+}
+
+// BlockReceiptRecorder records when blocks are received from the network, before they are
+// validated and processed, so their arrival delay doesn't include the time spent on them.
+type BlockReceiptRecorder interface {
+	RecordBlockReceipt(root [32]byte, received time.Time)
+}
+
+// BlockArrival records when a block was received relative to the start of its slot and whether
+// it ended up in the canonical chain.
+type BlockArrival struct {
+	Slot      uint64
+	Root      [32]byte
+	Delay     time.Duration
+	Finalized bool
+	Orphaned  bool
+}
+
+// blockArrivals keeps the arrivals of the most recently received blocks, oldest first. The
+// arrivals are resolved as canonical or orphaned once their slot is finalized.
+type blockArrivals struct {
+	lock           sync.RWMutex
+	arrivals       []*BlockArrival
+	resolvedEpoch  uint64
+	unresolvedFrom int
+	receipts       map[[32]byte]time.Time
+	receiptOrder   [][32]byte
+}
+
+func newBlockArrivals() *blockArrivals {
+	return &blockArrivals{
+		arrivals: make([]*BlockArrival, 0, blockArrivalHistorySize),
+		receipts: make(map[[32]byte]time.Time),
+	}
+}
+
+// addReceipt records the time a block was received from the network, the receipts of the
+// oldest blocks which were never processed are dropped.
+func (a *blockArrivals) addReceipt(root [32]byte, received time.Time) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if _, ok := a.receipts[root]; ok {
+		return
+	}
+	if len(a.receiptOrder) == blockArrivalHistorySize {
+		delete(a.receipts, a.receiptOrder[0])
+		a.receiptOrder = append(a.receiptOrder[:0], a.receiptOrder[1:]...)
+	}
+	a.receipts[root] = received
+	a.receiptOrder = append(a.receiptOrder, root)
+}
+
+// takeReceipt returns and forgets the time a block was received from the network, if recorded.
+func (a *blockArrivals) takeReceipt(root [32]byte) (time.Time, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	received, ok := a.receipts[root]
+	if ok {
+		delete(a.receipts, root)
+	}
+	return received, ok
+}
+
+// add records the arrival of a block received at the input time.
+func (a *blockArrivals) add(slot uint64, root [32]byte, genesisTime time.Time, received time.Time) {
+	slotStart := genesisTime.Add(time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	delay := received.Sub(slotStart)
+	blockArrivalDelay.Observe(delay.Seconds())
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if len(a.arrivals) == blockArrivalHistorySize {
+		a.arrivals = append(a.arrivals[:0], a.arrivals[1:]...)
+		if a.unresolvedFrom > 0 {
+			a.unresolvedFrom--
+		}
+	}
+	a.arrivals = append(a.arrivals, &BlockArrival{Slot: slot, Root: root, Delay: delay})
+}
+
+// BlockArrivals returns the arrivals of the most recently received blocks, oldest first.
+func (s *Service) BlockArrivals() []*BlockArrival {
+	if s.blockArrivals == nil {
+		return nil
+	}
+	s.blockArrivals.lock.RLock()
+	defer s.blockArrivals.lock.RUnlock()
+	arrivals := make([]*BlockArrival, len(s.blockArrivals.arrivals))
+	for i, arrival := range s.blockArrivals.arrivals {
+		copied := *arrival
+		arrivals[i] = &copied
+	}
+	return arrivals
+}
+
+// RecordBlockReceipt records the time a block was received from the network. It is used as the
+// block's arrival time once the block is processed.
+func (s *Service) RecordBlockReceipt(root [32]byte, received time.Time) {
+	if s.blockArrivals == nil {
+		return
+	}
+	s.blockArrivals.addReceipt(root, received)
+}
+
+// This records the arrival of a processed block. The time the block was received from the
+// network is used when recorded, the input time otherwise.
+func (s *Service) recordBlockArrival(slot uint64, root [32]byte, received time.Time) {
+	if s.blockArrivals == nil || s.genesisTime.IsZero() {
+		return
+	}
+	if receipt, ok := s.blockArrivals.takeReceipt(root); ok {
+		received = receipt
+	}
+	s.blockArrivals.add(slot, root, s.genesisTime, received)
+}
+
+// This resolves the recorded arrivals whose slot got finalized since the last call: blocks
+// which are ancestors of the finalized checkpoint root are canonical, others are orphaned.
+func (s *Service) resolveBlockArrivals(ctx context.Context) error {
+	if s.blockArrivals == nil {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "blockchain.resolveBlockArrivals")
+	defer span.End()
+
+	finalized := s.FinalizedCheckpt()
+	a := s.blockArrivals
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if finalized.Epoch <= a.resolvedEpoch || a.unresolvedFrom == len(a.arrivals) {
+		return nil
+	}
+	finalizedSlot := helpers.StartSlot(finalized.Epoch)
+	minSlot := a.arrivals[a.unresolvedFrom].Slot
+	for _, arrival := range a.arrivals[a.unresolvedFrom:] {
+		if arrival.Slot < minSlot {
+			minSlot = arrival.Slot
+		}
+	}
+
+	// Walk the canonical chain back from the finalized root to the oldest unresolved arrival.
+	canonical := make(map[[32]byte]bool)
+	root := bytesutil.ToBytes32(finalized.Root)
+	for {
+		signed, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return err
+		}
+		if signed == nil || signed.Block == nil || signed.Block.Slot < minSlot {
+			break
+		}
+		canonical[root] = true
+		root = bytesutil.ToBytes32(signed.Block.ParentRoot)
+	}
+
+	// Arrivals are kept in receive order, which can be out of slot order, so every arrival
+	// after the first unresolved one is checked.
+	firstUnresolved := len(a.arrivals)
+	for i := a.unresolvedFrom; i < len(a.arrivals); i++ {
+		arrival := a.arrivals[i]
+		if arrival.Finalized {
+			continue
+		}
+		if arrival.Slot > finalizedSlot {
+			if i < firstUnresolved {
+				firstUnresolved = i
+			}
+			continue
+		}
+		arrival.Finalized = true
+		if !canonical[arrival.Root] {
+			arrival.Orphaned = true
+			orphanedBlocks.Inc()
+		}
+	}
+	a.unresolvedFrom = firstUnresolved
+	a.resolvedEpoch = finalized.Epoch
+	return nil
+}
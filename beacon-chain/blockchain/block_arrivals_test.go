@@ -0,0 +1,116 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	ssz "github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestBlockArrivals_RecordsDelay(t *testing.T) {
+	genesisTime := time.Now().Add(-time.Hour)
+	c := &Service{genesisTime: genesisTime, blockArrivals: newBlockArrivals()}
+	slotStart := genesisTime.Add(time.Duration(3*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	c.recordBlockArrival(3, [32]byte{'a'}, slotStart.Add(1500*time.Millisecond))
+
+	arrivals := c.BlockArrivals()
+	if len(arrivals) != 1 {
+		t.Fatalf("Wanted 1 arrival, received %d", len(arrivals))
+	}
+	if arrivals[0].Delay != 1500*time.Millisecond {
+		t.Errorf("Wanted delay %v, received %v", 1500*time.Millisecond, arrivals[0].Delay)
+	}
+	if arrivals[0].Finalized || arrivals[0].Orphaned {
+		t.Error("Did not want an unfinalized arrival to be resolved")
+	}
+}
+
+func TestBlockArrivals_UsesReceiptTime(t *testing.T) {
+	genesisTime := time.Now().Add(-time.Hour)
+	c := &Service{genesisTime: genesisTime, blockArrivals: newBlockArrivals()}
+	slotStart := genesisTime.Add(time.Duration(3*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	c.RecordBlockReceipt([32]byte{'a'}, slotStart.Add(500*time.Millisecond))
+	// The block is processed well after it was received.
+	c.recordBlockArrival(3, [32]byte{'a'}, slotStart.Add(3*time.Second))
+	c.recordBlockArrival(3, [32]byte{'b'}, slotStart.Add(3*time.Second))
+
+	arrivals := c.BlockArrivals()
+	if len(arrivals) != 2 {
+		t.Fatalf("Wanted 2 arrivals, received %d", len(arrivals))
+	}
+	if arrivals[0].Delay != 500*time.Millisecond {
+		t.Errorf("Wanted delay %v from the receipt time, received %v", 500*time.Millisecond, arrivals[0].Delay)
+	}
+	if arrivals[1].Delay != 3*time.Second {
+		t.Errorf("Wanted delay %v without a receipt time, received %v", 3*time.Second, arrivals[1].Delay)
+	}
+	if len(c.blockArrivals.receipts) != 0 {
+		t.Errorf("Wanted the receipt to be consumed, %d left", len(c.blockArrivals.receipts))
+	}
+}
+
+func TestBlockArrivals_KeepsMostRecent(t *testing.T) {
+	c := &Service{genesisTime: time.Now(), blockArrivals: newBlockArrivals()}
+	for i := uint64(0); i < blockArrivalHistorySize+10; i++ {
+		c.recordBlockArrival(i, [32]byte{}, time.Now())
+	}
+	arrivals := c.BlockArrivals()
+	if len(arrivals) != blockArrivalHistorySize {
+		t.Fatalf("Wanted %d arrivals, received %d", blockArrivalHistorySize, len(arrivals))
+	}
+	if arrivals[0].Slot != 10 {
+		t.Errorf("Wanted oldest arrival at slot 10, received %d", arrivals[0].Slot)
+	}
+}
+
+func TestBlockArrivals_ResolvesOrphans(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// Block 2 is canonical and finalized, the fork block at slot 2 is orphaned.
+	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: make([]byte, 32)}}
+	r1, err := ssz.HashTreeRoot(b1.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: r1[:]}}
+	r2, err := ssz.HashTreeRoot(b2.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: r1[:], StateRoot: []byte("fork")}}
+	forkRoot, err := ssz.HashTreeRoot(fork.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{b1, b2, fork}); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Service{beaconDB: db, genesisTime: time.Now(), blockArrivals: newBlockArrivals()}
+	c.recordBlockArrival(1, r1, time.Now())
+	c.recordBlockArrival(2, forkRoot, time.Now())
+	c.recordBlockArrival(2, r2, time.Now())
+	c.recordBlockArrival(params.BeaconConfig().SlotsPerEpoch+1, [32]byte{'b'}, time.Now())
+
+	c.finalizedCheckpt = &ethpb.Checkpoint{Epoch: 1, Root: r2[:]}
+	if err := c.resolveBlockArrivals(ctx); err != nil {
+		t.Fatal(err)
+	}
+	arrivals := c.BlockArrivals()
+	wantOrphaned := []bool{false, true, false, false}
+	wantFinalized := []bool{true, true, true, false}
+	for i, arrival := range arrivals {
+		if arrival.Orphaned != wantOrphaned[i] || arrival.Finalized != wantFinalized[i] {
+			t.Errorf(
+				"Arrival %d: wanted finalized %v orphaned %v, received finalized %v orphaned %v",
+				i, wantFinalized[i], wantOrphaned[i], arrival.Finalized, arrival.Orphaned,
+			)
+		}
+	}
+}
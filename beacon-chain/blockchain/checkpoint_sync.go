@@ -0,0 +1,159 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+)
+
+// CheckpointSyncConfig defines the trusted finalized state an empty DB is initialized from,
+// instead of syncing from genesis.
+type CheckpointSyncConfig struct {
+	// StatePath is the SSZ encoded state file, at the start slot of an epoch.
+	StatePath string
+	// BlockPath is the SSZ encoded signed block file of the latest block applied to the state.
+	BlockPath string
+	// BlockRoot is the root of the checkpoint block, obtained from a trusted source.
+	BlockRoot [32]byte
+}
+
+// This initializes an empty DB from the checkpoint state and block. The checkpoint is saved as
+// the head and the justified and finalized checkpoints of the node, which then syncs forward
+// from it. The genesis block root is left unset, the checkpoint block is not the genesis block.
+// It does nothing when the DB was already initialized.
+func (s *Service) initializeFromCheckpoint(ctx context.Context) error {
+	genesisBlock, err := s.beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block")
+	}
+	headBlock, err := s.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if genesisBlock != nil || headBlock != nil {
+		log.Info("Database already initialized, ignoring checkpoint state")
+		return nil
+	}
+
+	st, blk, err := loadCheckpoint(ctx, s.checkpointSync)
+	if err != nil {
+		return err
+	}
+	root := s.checkpointSync.BlockRoot
+
+	// The checkpoint is saved in one batch, a node stopped halfway would otherwise find a
+	// checkpoint block without its state.
+	checkpoint := &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(st.Slot()), Root: root[:]}
+	if err := s.beaconDB.Batch(ctx, func(b db.WriteBatch) error {
+		if err := b.SaveBlock(blk); err != nil {
+			return errors.Wrap(err, "could not save checkpoint block")
+		}
+		if featureconfig.Get().NewStateMgmt {
+			if err := s.stateGen.InitializeFromCheckpoint(ctx, b, st, root); err != nil {
+				return errors.Wrap(err, "could not initialize state generation from checkpoint")
+			}
+		} else {
+			if err := b.SaveState(st, root); err != nil {
+				return errors.Wrap(err, "could not save checkpoint state")
+			}
+			if err := b.SaveStateSummary(&pb.StateSummary{Slot: st.Slot(), Root: root[:]}); err != nil {
+				return errors.Wrap(err, "could not save checkpoint state summary")
+			}
+		}
+		if err := b.SaveJustifiedCheckpoint(checkpoint); err != nil {
+			return errors.Wrap(err, "could not save justified checkpoint")
+		}
+		if err := b.SaveFinalizedCheckpoint(checkpoint); err != nil {
+			return errors.Wrap(err, "could not save finalized checkpoint")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := s.beaconDB.SaveHeadBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":  st.Slot(),
+		"epoch": checkpoint.Epoch,
+		"root":  hex.EncodeToString(bytesutil.Trunc(root[:])),
+	}).Info("Initialized database from checkpoint state")
+	return nil
+}
+
+// This reads the checkpoint state and block files and verifies the block has the trusted root and
+// is the latest block applied to the state.
+func loadCheckpoint(
+	ctx context.Context,
+	cfg *CheckpointSyncConfig,
+) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock, error) {
+	stateData, err := ioutil.ReadFile(cfg.StatePath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint state file")
+	}
+	pbState := &pb.BeaconState{}
+	if err := ssz.Unmarshal(stateData, pbState); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	st, err := stateTrie.InitializeFromProto(pbState)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize checkpoint state")
+	}
+	if !helpers.IsEpochStart(st.Slot()) {
+		return nil, nil, fmt.Errorf("checkpoint state slot %d is not the start slot of an epoch", st.Slot())
+	}
+
+	blockData, err := ioutil.ReadFile(cfg.BlockPath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint block file")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(blockData, blk); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	if blk.Block == nil {
+		return nil, nil, errors.New("nil checkpoint block")
+	}
+	blockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not hash checkpoint block")
+	}
+	if blockRoot != cfg.BlockRoot {
+		return nil, nil, fmt.Errorf(
+			"checkpoint block root %#x does not match the trusted root %#x",
+			blockRoot,
+			cfg.BlockRoot,
+		)
+	}
+
+	// The latest block header of the state has its state root filled by the next slot processing.
+	header := stateTrie.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	if bytesutil.ToBytes32(header.StateRoot) == [32]byte{} {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not hash checkpoint state")
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := ssz.HashTreeRoot(header)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not hash latest block header")
+	}
+	if headerRoot != blockRoot {
+		return nil, nil, errors.New("checkpoint block is not the latest block of the checkpoint state")
+	}
+	return st, blk, nil
+}
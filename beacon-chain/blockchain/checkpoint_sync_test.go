@@ -0,0 +1,105 @@
+package blockchain
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func writeCheckpointFiles(t *testing.T, dir string) *CheckpointSyncConfig {
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := blocks.NewGenesisBlock(stateRoot[:])
+	blockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateData, err := ssz.Marshal(st.InnerStateUnsafe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockData, err := ssz.Marshal(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &CheckpointSyncConfig{
+		StatePath: filepath.Join(dir, "state.ssz"),
+		BlockPath: filepath.Join(dir, "block.ssz"),
+		BlockRoot: blockRoot,
+	}
+	if err := ioutil.WriteFile(cfg.StatePath, stateData, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(cfg.BlockPath, blockData, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestLoadCheckpoint_VerifiesBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := writeCheckpointFiles(t, dir)
+
+	st, blk, err := loadCheckpoint(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Slot() != 0 || blk.Block.Slot != 0 {
+		t.Error("Did not load the checkpoint state and block")
+	}
+
+	cfg.BlockRoot = [32]byte{'a'}
+	if _, _, err := loadCheckpoint(context.Background(), cfg); err == nil || !strings.Contains(err.Error(), "does not match the trusted root") {
+		t.Errorf("Expected trusted root mismatch, received %v", err)
+	}
+}
+
+func TestInitializeFromCheckpoint_KeepsGenesisBlockRootUnset(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := &Service{beaconDB: db, checkpointSync: writeCheckpointFiles(t, dir)}
+	if err := s.initializeFromCheckpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock, err := db.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesisBlock != nil {
+		t.Error("Did not want the checkpoint block saved as the genesis block")
+	}
+	headBlock, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headBlock == nil {
+		t.Fatal("Wanted the checkpoint block saved as the head block")
+	}
+
+	// A restarted node finds the DB initialized from the head block.
+	if err := s.initializeFromCheckpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
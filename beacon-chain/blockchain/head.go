@@ -103,7 +103,14 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	// Cache the new head info.
 	s.setHead(headRoot, newHeadBlock, newHeadState)
 
+	if featureconfig.Get().NewStateMgmt && featureconfig.Get().PregenerateBoundaryStates {
+		s.stateGen.RequestBoundaryPregeneration(headRoot, newHeadBlock.Block.Slot)
+	}
+
 	// Save the new head root to DB.
+	if err := s.saveStateSummaries(ctx); err != nil {
+		return err
+	}
 	if err := s.beaconDB.SaveHeadBlockRoot(ctx, headRoot); err != nil {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
@@ -111,6 +118,18 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	return nil
 }
 
+// This writes the state summaries cached by state generation to the DB. The DB requires the
+// head root and the checkpoint roots it saves to have their state summary in the DB already.
+func (s *Service) saveStateSummaries(ctx context.Context) error {
+	if !featureconfig.Get().NewStateMgmt {
+		return nil
+	}
+	if err := s.stateGen.SaveStateSummariesToDB(ctx); err != nil {
+		return errors.Wrap(err, "could not save state summaries in DB")
+	}
+	return nil
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of inital-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -0,0 +1,127 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// This verifies the head block and head state persisted in DB are consistent with each other:
+// both decode, the state is the post state of the block and its slot isn't ahead of the wall
+// clock. A missing head state is not an inconsistency, the node then starts from the finalized
+// checkpoint.
+func (s *Service) verifyHead(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockchain.verifyHead")
+	defer span.End()
+
+	headBlock, err := s.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head block")
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+	if headState == nil {
+		return nil
+	}
+	if headBlock == nil || headBlock.Block == nil {
+		return errors.New("head state has no head block")
+	}
+	if headState.Slot() != headBlock.Block.Slot {
+		return errors.Errorf("head state slot %d does not match head block slot %d", headState.Slot(), headBlock.Block.Slot)
+	}
+	currentSlot := helpers.SlotsSince(time.Unix(int64(headState.GenesisTime()), 0))
+	if headState.Slot() > currentSlot+1 {
+		return errors.Errorf("head slot %d is ahead of the current slot %d", headState.Slot(), currentSlot)
+	}
+	stateRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash head state")
+	}
+	if stateRoot != bytesutil.ToBytes32(headBlock.Block.StateRoot) {
+		return errors.Errorf("head state root %#x does not match head block state root %#x",
+			stateRoot, headBlock.Block.StateRoot)
+	}
+	return nil
+}
+
+// This resets the head to the last finalized checkpoint, regenerating its state if needed, so the
+// node can sync forward from it again. The inconsistent head state is deleted.
+func (s *Service) recoverHeadFromFinalized(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockchain.recoverHeadFromFinalized")
+	defer span.End()
+
+	finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	if finalized == nil {
+		return errors.New("no finalized checkpoint in the database")
+	}
+	finalizedRoot := bytesutil.ToBytes32(finalized.Root)
+	finalizedBlock, err := s.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return errors.New("no finalized block in the database")
+	}
+
+	var finalizedState *stateTrie.BeaconState
+	if featureconfig.Get().NewStateMgmt {
+		finalizedState, err = s.stateGen.StateByRoot(ctx, finalizedRoot)
+	} else {
+		finalizedState, err = s.beaconDB.State(ctx, finalizedRoot)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	if finalizedState == nil {
+		return errors.New("no finalized state in the database")
+	}
+	if featureconfig.Get().NewStateMgmt {
+		if !s.beaconDB.HasStateSummary(ctx, finalizedRoot) {
+			if err := s.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: finalizedBlock.Block.Slot, Root: finalizedRoot[:]}); err != nil {
+				return errors.Wrap(err, "could not save finalized state summary")
+			}
+		}
+	} else if !s.beaconDB.HasState(ctx, finalizedRoot) {
+		if err := s.beaconDB.SaveState(ctx, finalizedState, finalizedRoot); err != nil {
+			return errors.Wrap(err, "could not save finalized state")
+		}
+	}
+
+	// The root of the inconsistent head can't be known if its block doesn't decode.
+	var headRoot [32]byte
+	if headBlock, err := s.beaconDB.HeadBlock(ctx); err == nil && headBlock != nil && headBlock.Block != nil {
+		if r, err := ssz.HashTreeRoot(headBlock.Block); err == nil {
+			headRoot = r
+		}
+	}
+	if err := s.beaconDB.SaveHeadBlockRoot(ctx, finalizedRoot); err != nil {
+		return errors.Wrap(err, "could not save finalized root as head")
+	}
+	headStateRecoveries.Inc()
+	log.WithFields(logrus.Fields{
+		"slot": finalizedBlock.Block.Slot,
+		"root": hex.EncodeToString(bytesutil.Trunc(finalizedRoot[:])),
+	}).Warn("Reset head to the finalized checkpoint")
+
+	if headRoot != [32]byte{} && headRoot != finalizedRoot && s.beaconDB.HasState(ctx, headRoot) {
+		if err := s.beaconDB.DeleteState(ctx, headRoot); err != nil {
+			log.WithError(err).Warn("Could not delete inconsistent head state")
+		}
+	}
+	return nil
+}
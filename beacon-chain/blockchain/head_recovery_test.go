@@ -0,0 +1,89 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	ssz "github.com/prysmaticlabs/go-ssz"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestVerifyHead_RecoversFromFinalized(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 8)
+	genesisStateRoot, err := genesisState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(genesisStateRoot[:])
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: genesisRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Service{beaconDB: db}
+	if err := c.verifyHead(ctx); err != nil {
+		t.Fatalf("Wanted a consistent head, received %v", err)
+	}
+
+	// A head state which isn't the post state of the head block.
+	headBlock := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:], StateRoot: make([]byte, 32)}}
+	headRoot, err := ssz.HashTreeRoot(headBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState := genesisState.Copy()
+	if err := headState.SetSlot(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, headBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, headState, headRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, headRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.verifyHead(ctx); err == nil {
+		t.Fatal("Expected the inconsistent head to be detected")
+	}
+
+	if err := c.recoverHeadFromFinalized(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.verifyHead(ctx); err != nil {
+		t.Errorf("Wanted a consistent head after recovery, received %v", err)
+	}
+	blk, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk.Block.Slot != 0 {
+		t.Errorf("Wanted head reset to the genesis block, received slot %d", blk.Block.Slot)
+	}
+	if db.HasState(ctx, headRoot) {
+		t.Error("Expected the inconsistent head state to be deleted")
+	}
+}
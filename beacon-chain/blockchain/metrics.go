@@ -19,6 +19,19 @@ var (
 		Name: "beacon_head_slot",
 		Help: "Slot of the head block of the beacon chain",
 	})
+	headStateRecoveries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "head_state_recoveries_total",
+		Help: "The number of times an inconsistent head was reset to the finalized checkpoint on startup",
+	})
+	blockArrivalDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "block_arrival_delay_seconds",
+		Help:    "The time between the start of the slot of a received block and its arrival",
+		Buckets: []float64{0.25, 0.5, 1, 2, 3, 4, 6, 8, 12},
+	})
+	orphanedBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orphaned_blocks_total",
+		Help: "The number of received blocks which did not end up in the finalized chain",
+	})
 	competingBlks = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "competing_blocks",
 		Help: "The # of blocks received and processed from a competing chain",
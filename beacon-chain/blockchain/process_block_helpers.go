@@ -293,6 +293,9 @@ func (s *Service) updateJustified(ctx context.Context, state *stateTrie.BeaconSt
 		}
 	}
 
+	if err := s.saveStateSummaries(ctx); err != nil {
+		return err
+	}
 	return s.beaconDB.SaveJustifiedCheckpoint(ctx, cpt)
 }
 
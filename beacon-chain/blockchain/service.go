@@ -75,6 +75,8 @@ type Service struct {
 	checkpointStateLock    sync.Mutex
 	stateGen               *stategen.State
 	opsService             *attestations.Service
+	blockArrivals          *blockArrivals
+	checkpointSync         *CheckpointSyncConfig
 }
 
 // Config options for the service.
@@ -92,6 +94,9 @@ type Config struct {
 	ForkChoiceStore   f.ForkChoicer
 	OpsService        *attestations.Service
 	StateGen          *stategen.State
+	// CheckpointSync defines the trusted state an empty DB is initialized from, nil to start
+	// from genesis.
+	CheckpointSync *CheckpointSyncConfig
 }
 
 // NewService instantiates a new block service instance that will
@@ -117,12 +122,27 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		checkpointState:    cache.NewCheckpointStateCache(),
 		opsService:         cfg.OpsService,
 		stateGen:           cfg.StateGen,
+		blockArrivals:      newBlockArrivals(),
+		checkpointSync:     cfg.CheckpointSync,
 	}, nil
 }
 
 // Start a blockchain service's main event loop.
 func (s *Service) Start() {
 	ctx := context.TODO()
+	// A head left inconsistent by an unclean shutdown would make the node fail on every restart,
+	// it's reset to the finalized checkpoint and synced forward from there instead.
+	if err := s.verifyHead(ctx); err != nil {
+		log.WithError(err).Error("Head in DB is inconsistent, recovering from the finalized checkpoint")
+		if err := s.recoverHeadFromFinalized(ctx); err != nil {
+			log.Fatalf("Could not recover head from the finalized checkpoint: %v", err)
+		}
+	}
+	if s.checkpointSync != nil {
+		if err := s.initializeFromCheckpoint(ctx); err != nil {
+			log.Fatalf("Could not initialize from checkpoint state: %v", err)
+		}
+	}
 	beaconState, err := s.beaconDB.HeadState(ctx)
 	if err != nil {
 		log.Fatalf("Could not fetch beacon state: %v", err)
@@ -223,6 +243,17 @@ func (s *Service) Start() {
 		}()
 	}
 
+	if featureconfig.Get().NewStateMgmt {
+		go s.stateGen.RunStateMigration(s.ctx)
+		go s.stateGen.RunStateSummaryFlush(s.ctx)
+	}
+	if featureconfig.Get().NewStateMgmt && featureconfig.Get().PregenerateColdStates {
+		go s.stateGen.RunColdStatePregeneration(s.ctx)
+	}
+	if featureconfig.Get().NewStateMgmt && featureconfig.Get().PregenerateBoundaryStates {
+		go s.stateGen.RunBoundaryStatePregeneration(s.ctx)
+	}
+
 	go s.processAttestation(attestationProcessorSubscribed)
 }
 
@@ -284,6 +315,13 @@ func (s *Service) initializeBeaconChain(
 // Stop the blockchain service's main event loop and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
+
+	// Write the state summaries still cached before the DB is closed.
+	if featureconfig.Get().NewStateMgmt && s.stateGen != nil {
+		if err := s.stateGen.SaveStateSummariesToDB(s.ctx); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -383,14 +421,15 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil {
-		return errors.New("no genesis block in db")
-	}
-	genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
+	// Nodes started from a checkpoint state have no genesis block, the chain info is then
+	// initialized from the finalized checkpoint alone.
+	if genesisBlock != nil {
+		genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
 	}
-	s.genesisRoot = genesisBlkRoot
 
 	if flags.Get().UnsafeSync {
 		headBlock, err := s.beaconDB.HeadBlock(ctx)
@@ -113,6 +113,10 @@ func (ms *ChainService) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.S
 	return nil
 }
 
+// RecordBlockReceipt mocks RecordBlockReceipt method in chain service.
+func (ms *ChainService) RecordBlockReceipt(root [32]byte, received time.Time) {
+}
+
 // ReceiveBlockNoPubsub mocks ReceiveBlockNoPubsub method in chain service.
 func (ms *ChainService) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedBeaconBlock) error {
 	return nil
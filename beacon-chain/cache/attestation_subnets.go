@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+// subnetRateWindow is the window over which subnet message rates are computed.
+const subnetRateWindow = time.Minute
+
+// SubnetOverride is an operator override of the subscription to an attestation subnet.
+type SubnetOverride int
+
+const (
+	// NoOverride leaves the subscription to the subnet up to validator duties.
+	NoOverride SubnetOverride = iota
+	// ForceSubscribe keeps the node subscribed to the subnet.
+	ForceSubscribe
+	// ForceUnsubscribe keeps the node unsubscribed from the subnet.
+	ForceUnsubscribe
+)
+
+// AttestationSubnets tracks the attestation subnets the node is subscribed to, operator
+// overrides of those subscriptions and the rate of messages received on each subnet.
+var AttestationSubnets = newAttestationSubnets()
+
+type subnetMessages struct {
+	total       uint64
+	current     uint64
+	previous    uint64
+	windowStart time.Time
+}
+
+type attestationSubnets struct {
+	lock       sync.RWMutex
+	subscribed map[uint64]bool
+	overrides  map[uint64]SubnetOverride
+	messages   map[uint64]*subnetMessages
+	now        func() time.Time
+}
+
+func newAttestationSubnets() *attestationSubnets {
+	return &attestationSubnets{
+		subscribed: make(map[uint64]bool),
+		overrides:  make(map[uint64]SubnetOverride),
+		messages:   make(map[uint64]*subnetMessages),
+		now:        roughtime.Now,
+	}
+}
+
+// SetSubscribed records whether the node is subscribed to the subnet.
+func (s *attestationSubnets) SetSubscribed(subnet uint64, subscribed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if subscribed {
+		s.subscribed[subnet] = true
+	} else {
+		delete(s.subscribed, subnet)
+	}
+}
+
+// Subscribed returns the sorted subnets the node is subscribed to.
+func (s *attestationSubnets) Subscribed() []uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	subnets := make([]uint64, 0, len(s.subscribed))
+	for subnet := range s.subscribed {
+		subnets = append(subnets, subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+	return subnets
+}
+
+// SetOverride sets the operator override of the subnet, NoOverride clears it.
+func (s *attestationSubnets) SetOverride(subnet uint64, override SubnetOverride) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if override == NoOverride {
+		delete(s.overrides, subnet)
+		return
+	}
+	s.overrides[subnet] = override
+}
+
+// Override returns the operator override of the subnet.
+func (s *attestationSubnets) Override(subnet uint64) SubnetOverride {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.overrides[subnet]
+}
+
+// Overridden returns the sorted subnets which have an operator override.
+func (s *attestationSubnets) Overridden() []uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	subnets := make([]uint64, 0, len(s.overrides))
+	for subnet := range s.overrides {
+		subnets = append(subnets, subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+	return subnets
+}
+
+// ApplyOverrides returns the subnets to subscribe to given the subnets wanted by validator
+// duties, adding force subscribed subnets and removing force unsubscribed ones.
+func (s *attestationSubnets) ApplyOverrides(wanted []uint64) []uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	subnets := make([]uint64, 0, len(wanted)+len(s.overrides))
+	seen := make(map[uint64]bool, len(wanted)+len(s.overrides))
+	for _, subnet := range wanted {
+		if seen[subnet] || s.overrides[subnet] == ForceUnsubscribe {
+			continue
+		}
+		seen[subnet] = true
+		subnets = append(subnets, subnet)
+	}
+	for subnet, override := range s.overrides {
+		if override == ForceSubscribe && !seen[subnet] {
+			seen[subnet] = true
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets
+}
+
+// RecordMessage counts a message received on the subnet.
+func (s *attestationSubnets) RecordMessage(subnet uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m, ok := s.messages[subnet]
+	if !ok {
+		m = &subnetMessages{windowStart: s.now()}
+		s.messages[subnet] = m
+	}
+	s.rollWindow(m)
+	m.total++
+	m.current++
+}
+
+// MessageRate returns the total number of messages received on the subnet and the rate of
+// messages per second over the last full window, or over the current window if there is no
+// full window yet.
+func (s *attestationSubnets) MessageRate(subnet uint64) (uint64, float64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m, ok := s.messages[subnet]
+	if !ok {
+		return 0, 0
+	}
+	s.rollWindow(m)
+	if m.total > m.current {
+		return m.total, float64(m.previous) / subnetRateWindow.Seconds()
+	}
+	elapsed := s.now().Sub(m.windowStart).Seconds()
+	if elapsed <= 0 {
+		return m.total, 0
+	}
+	return m.total, float64(m.current) / elapsed
+}
+
+// rollWindow moves the message counts of the subnet to the window the current time falls in.
+func (s *attestationSubnets) rollWindow(m *subnetMessages) {
+	elapsed := s.now().Sub(m.windowStart)
+	if elapsed < subnetRateWindow {
+		return
+	}
+	if elapsed < 2*subnetRateWindow {
+		m.previous = m.current
+	} else {
+		// No message was received in the last full window.
+		m.previous = 0
+	}
+	m.current = 0
+	m.windowStart = m.windowStart.Add(elapsed.Truncate(subnetRateWindow))
+}
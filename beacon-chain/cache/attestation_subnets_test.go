@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAttestationSubnets_Subscribed(t *testing.T) {
+	s := newAttestationSubnets()
+	s.SetSubscribed(5, true)
+	s.SetSubscribed(1, true)
+	s.SetSubscribed(3, true)
+	s.SetSubscribed(3, false)
+	if got := s.Subscribed(); !reflect.DeepEqual(got, []uint64{1, 5}) {
+		t.Errorf("Wanted subscribed subnets [1 5], got %v", got)
+	}
+}
+
+func TestAttestationSubnets_ApplyOverrides(t *testing.T) {
+	s := newAttestationSubnets()
+	s.SetOverride(2, ForceUnsubscribe)
+	s.SetOverride(7, ForceSubscribe)
+	s.SetOverride(4, ForceSubscribe)
+	s.SetOverride(4, NoOverride)
+
+	got := s.ApplyOverrides([]uint64{1, 2, 3})
+	if !reflect.DeepEqual(got, []uint64{1, 3, 7}) {
+		t.Errorf("Wanted subnets [1 3 7], got %v", got)
+	}
+	if got := s.Overridden(); !reflect.DeepEqual(got, []uint64{2, 7}) {
+		t.Errorf("Wanted overridden subnets [2 7], got %v", got)
+	}
+	if s.Override(7) != ForceSubscribe {
+		t.Errorf("Wanted subnet 7 to be force subscribed, got %v", s.Override(7))
+	}
+}
+
+func TestAttestationSubnets_MessageRate(t *testing.T) {
+	s := newAttestationSubnets()
+	now := time.Unix(1000, 0)
+	s.now = func() time.Time { return now }
+
+	if total, rate := s.MessageRate(1); total != 0 || rate != 0 {
+		t.Errorf("Wanted no messages on an unknown subnet, got %d at %f/s", total, rate)
+	}
+
+	for i := 0; i < 30; i++ {
+		s.RecordMessage(1)
+	}
+	now = now.Add(subnetRateWindow / 2)
+	total, rate := s.MessageRate(1)
+	if total != 30 {
+		t.Errorf("Wanted 30 messages, got %d", total)
+	}
+	if want := 30 / (subnetRateWindow / 2).Seconds(); rate != want {
+		t.Errorf("Wanted rate %f over the partial window, got %f", want, rate)
+	}
+
+	// Once the window is over, the rate is the one of the full window.
+	now = now.Add(subnetRateWindow / 2)
+	s.RecordMessage(1)
+	total, rate = s.MessageRate(1)
+	if total != 31 {
+		t.Errorf("Wanted 31 messages, got %d", total)
+	}
+	if want := 30 / subnetRateWindow.Seconds(); rate != want {
+		t.Errorf("Wanted rate %f over the last full window, got %f", want, rate)
+	}
+
+	// After a window without messages, the rate drops to zero.
+	now = now.Add(3 * subnetRateWindow)
+	if _, rate := s.MessageRate(1); rate != 0 {
+		t.Errorf("Wanted rate 0 after an idle window, got %f", rate)
+	}
+}
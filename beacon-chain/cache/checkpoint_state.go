@@ -22,6 +22,9 @@ var (
 	// window to accept attestation based on latest spec.
 	maxCheckpointStateSize = 10
 
+	// checkpointStateNamespace separates the checkpoint state cache keys from other cache keys.
+	checkpointStateNamespace = "checkpoint-state"
+
 	// Metrics.
 	checkpointStateMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "check_point_statecache_miss",
@@ -52,7 +55,7 @@ func checkpointState(obj interface{}) (string, error) {
 		return "", ErrNotCheckpointState
 	}
 
-	h, err := hashutil.HashProto(info.Checkpoint)
+	h, err := hashutil.CacheKeyProto(checkpointStateNamespace, info.Checkpoint)
 	if err != nil {
 		return "", err
 	}
@@ -71,7 +74,7 @@ func NewCheckpointStateCache() *CheckpointStateCache {
 func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (*stateTrie.BeaconState, error) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
-	h, err := hashutil.HashProto(cp)
+	h, err := hashutil.CacheKeyProto(checkpointStateNamespace, cp)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ func TestCheckpointStateCacheKeyFn_OK(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantedKey, err := hashutil.HashProto(cp)
+	wantedKey, err := hashutil.CacheKeyProto(checkpointStateNamespace, cp)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -8,7 +8,7 @@ import (
 )
 
 var (
-	// hotStateCacheSize defines the max number of hot state this can cache.
+	// hotStateCacheSize defines the default max number of hot state this can cache.
 	hotStateCacheSize = 16
 	// Metrics
 	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
@@ -19,6 +19,14 @@ var (
 		Name: "hot_state_cache_miss",
 		Help: "The total number of cache misses on the hot state cache.",
 	})
+	hotStateCacheEviction = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_cache_eviction",
+		Help: "The total number of states evicted from the hot state cache.",
+	})
+	hotStateCacheItems = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_state_cache_items",
+		Help: "The number of states in the hot state cache.",
+	})
 )
 
 // HotStateCache is used to store the processed beacon state after finalized check point..
@@ -26,9 +34,15 @@ type HotStateCache struct {
 	cache *lru.Cache
 }
 
-// NewHotStateCache initializes the map and underlying cache.
-func NewHotStateCache() *HotStateCache {
-	cache, err := lru.New(hotStateCacheSize)
+// NewHotStateCache initializes the map and underlying cache. The cache holds at most size
+// states, evicting the least recently used state first. A size of 0 uses the default size.
+func NewHotStateCache(size int) *HotStateCache {
+	if size <= 0 {
+		size = hotStateCacheSize
+	}
+	cache, err := lru.NewWithEvict(size, func(_ interface{}, _ interface{}) {
+		hotStateCacheEviction.Inc()
+	})
 	if err != nil {
 		panic(err)
 	}
@@ -50,9 +64,23 @@ func (c *HotStateCache) Get(root [32]byte) *stateTrie.BeaconState {
 	return nil
 }
 
+// GetWithoutCopy returns the cached state of the input block root, if any, without copying it.
+// The state is shared with the cache and must not be mutated.
+func (c *HotStateCache) GetWithoutCopy(root [32]byte) *stateTrie.BeaconState {
+	item, exists := c.cache.Get(root)
+
+	if exists && item != nil {
+		hotStateCacheHit.Inc()
+		return item.(*stateTrie.BeaconState)
+	}
+	hotStateCacheMiss.Inc()
+	return nil
+}
+
 // Put the response in the cache.
 func (c *HotStateCache) Put(root [32]byte, state *stateTrie.BeaconState) {
 	c.cache.Add(root, state)
+	hotStateCacheItems.Set(float64(c.cache.Len()))
 }
 
 // Has returns true if the key exists in the cache.
@@ -10,7 +10,7 @@ import (
 )
 
 func TestHotStateCache_RoundTrip(t *testing.T) {
-	c := cache.NewHotStateCache()
+	c := cache.NewHotStateCache(0)
 	root := [32]byte{'A'}
 	state := c.Get(root)
 	if state != nil {
@@ -39,3 +39,46 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 		t.Error("Expected equal protos to return from cache")
 	}
 }
+
+func TestHotStateCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := cache.NewHotStateCache(2)
+	for i := byte(0); i < 3; i++ {
+		state, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: uint64(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Put([32]byte{i}, state)
+		if i == 1 {
+			// Use the first state so the second one is evicted instead.
+			if c.Get([32]byte{0}) == nil {
+				t.Fatal("Expected first state in cache")
+			}
+		}
+	}
+	if !c.Has([32]byte{0}) || !c.Has([32]byte{2}) {
+		t.Error("Expected most recently used states in cache")
+	}
+	if c.Has([32]byte{1}) {
+		t.Error("Expected least recently used state to be evicted")
+	}
+}
+
+func TestHotStateCache_GetWithoutCopy(t *testing.T) {
+	c := cache.NewHotStateCache(0)
+	root := [32]byte{'A'}
+	if c.GetWithoutCopy(root) != nil {
+		t.Error("Empty cache returned an object")
+	}
+
+	state, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Put(root, state)
+	if c.GetWithoutCopy(root) != state {
+		t.Error("Expected the cached state to be returned without a copy")
+	}
+	if c.Get(root) == state {
+		t.Error("Expected a copy of the cached state")
+	}
+}
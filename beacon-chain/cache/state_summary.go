@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"sync"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// StateSummaryCache holds the state summaries saved by the state management which weren't
+// written to the DB yet. The summaries are written in batches, and reads are served from the
+// cache until then.
+type StateSummaryCache struct {
+	summaries map[[32]byte]*pb.StateSummary
+	lock      sync.RWMutex
+}
+
+// NewStateSummaryCache creates a new state summary cache.
+func NewStateSummaryCache() *StateSummaryCache {
+	return &StateSummaryCache{
+		summaries: make(map[[32]byte]*pb.StateSummary),
+	}
+}
+
+// Put saves a state summary to the cache.
+func (c *StateSummaryCache) Put(root [32]byte, summary *pb.StateSummary) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.summaries[root] = summary
+}
+
+// Has returns true if the state summary of the block root is in the cache.
+func (c *StateSummaryCache) Has(root [32]byte) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	_, ok := c.summaries[root]
+	return ok
+}
+
+// Get returns the state summary of the block root, or nil if it isn't in the cache.
+func (c *StateSummaryCache) Get(root [32]byte) *pb.StateSummary {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.summaries[root]
+}
+
+// GetAll returns all the state summaries in the cache.
+func (c *StateSummaryCache) GetAll() []*pb.StateSummary {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	summaries := make([]*pb.StateSummary, 0, len(c.summaries))
+	for _, s := range c.summaries {
+		summaries = append(summaries, s)
+	}
+	return summaries
+}
+
+// Delete removes the state summaries of the block roots from the cache.
+func (c *StateSummaryCache) Delete(roots [][32]byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, r := range roots {
+		delete(c.summaries, r)
+	}
+}
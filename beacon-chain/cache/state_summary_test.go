@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestStateSummaryCache_PutGetDelete(t *testing.T) {
+	c := NewStateSummaryCache()
+	r1, r2 := [32]byte{'a'}, [32]byte{'b'}
+	if c.Has(r1) || c.Get(r1) != nil {
+		t.Fatal("Wanted empty cache")
+	}
+	c.Put(r1, &pb.StateSummary{Slot: 1, Root: r1[:]})
+	c.Put(r2, &pb.StateSummary{Slot: 2, Root: r2[:]})
+	if !c.Has(r1) || c.Get(r1).Slot != 1 {
+		t.Error("Wanted summary of the first root cached")
+	}
+	if len(c.GetAll()) != 2 {
+		t.Errorf("Wanted 2 summaries, got %d", len(c.GetAll()))
+	}
+	c.Delete([][32]byte{r1})
+	if c.Has(r1) || !c.Has(r2) {
+		t.Error("Wanted only the summary of the first root deleted")
+	}
+}
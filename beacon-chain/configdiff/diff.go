@@ -0,0 +1,112 @@
+// Package configdiff compares the effective configuration of two running beacon nodes, as
+// exported by their debug RPC service.
+package configdiff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "configdiff")
+
+// dialTimeout bounds the time spent connecting to and querying a node.
+const dialTimeout = 10 * time.Second
+
+// Difference is a flag whose value differs between two nodes. A flag unknown to a node, as when
+// the nodes run different versions, has an empty value and is marked missing for that node.
+type Difference struct {
+	Flag     string
+	A        string
+	B        string
+	MissingA bool
+	MissingB bool
+}
+
+// Diff returns the flags whose values differ between the two configurations, sorted by name.
+func Diff(a *pb.EffectiveConfig, b *pb.EffectiveConfig) []*Difference {
+	var diffs []*Difference
+	for name, valueA := range a.Flags {
+		valueB, ok := b.Flags[name]
+		if !ok || valueA != valueB {
+			diffs = append(diffs, &Difference{Flag: name, A: valueA, B: valueB, MissingB: !ok})
+		}
+	}
+	for name, valueB := range b.Flags {
+		if _, ok := a.Flags[name]; !ok {
+			diffs = append(diffs, &Difference{Flag: name, B: valueB, MissingA: true})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Flag < diffs[j].Flag
+	})
+	return diffs
+}
+
+// Run fetches the effective configuration of the nodes at the two RPC endpoints and prints the
+// flags whose values differ.
+func Run(ctx context.Context, endpoints []string, out io.Writer) error {
+	if len(endpoints) != 2 {
+		return fmt.Errorf("expected 2 RPC endpoints to compare, received %d", len(endpoints))
+	}
+	configs := make([]*pb.EffectiveConfig, len(endpoints))
+	for i, endpoint := range endpoints {
+		cfg, err := fetchConfig(ctx, endpoint)
+		if err != nil {
+			return errors.Wrapf(err, "could not fetch config of %s", endpoint)
+		}
+		configs[i] = cfg
+	}
+	return Print(out, endpoints, configs[0], configs[1])
+}
+
+// Print writes the versions of the two nodes and the differences between their configurations.
+func Print(out io.Writer, endpoints []string, a *pb.EffectiveConfig, b *pb.EffectiveConfig) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "\t%s\t%s\n", endpoints[0], endpoints[1])
+	fmt.Fprintf(w, "version\t%s\t%s\n", a.Version, b.Version)
+	diffs := Diff(a, b)
+	for _, d := range diffs {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", d.Flag, displayValue(d.A, d.MissingA), displayValue(d.B, d.MissingB))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(diffs) == 0 {
+		fmt.Fprintln(out, "No flag differences")
+	}
+	return nil
+}
+
+func displayValue(value string, missing bool) string {
+	if missing {
+		return "<missing>"
+	}
+	if value == "" {
+		return `""`
+	}
+	return value
+}
+
+func fetchConfig(ctx context.Context, endpoint string) (*pb.EffectiveConfig, error) {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection")
+		}
+	}()
+	return pb.NewDebugClient(conn).GetEffectiveConfig(ctx, &pb.EffectiveConfigRequest{})
+}
@@ -0,0 +1,47 @@
+package configdiff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestDiff(t *testing.T) {
+	a := &pb.EffectiveConfig{Flags: map[string]string{
+		"rpc-port":      "4000",
+		"p2p-max-peers": "30",
+		"only-in-a":     "true",
+	}}
+	b := &pb.EffectiveConfig{Flags: map[string]string{
+		"rpc-port":      "4000",
+		"p2p-max-peers": "50",
+		"only-in-b":     "",
+	}}
+
+	diffs := Diff(a, b)
+	if len(diffs) != 3 {
+		t.Fatalf("Wanted %d differences, got %d", 3, len(diffs))
+	}
+	if d := diffs[0]; d.Flag != "only-in-a" || !d.MissingB || d.MissingA {
+		t.Errorf("Unexpected difference %+v", d)
+	}
+	if d := diffs[1]; d.Flag != "only-in-b" || !d.MissingA || d.MissingB {
+		t.Errorf("Unexpected difference %+v", d)
+	}
+	if d := diffs[2]; d.Flag != "p2p-max-peers" || d.A != "30" || d.B != "50" {
+		t.Errorf("Unexpected difference %+v", d)
+	}
+}
+
+func TestPrint_NoDifferences(t *testing.T) {
+	cfg := &pb.EffectiveConfig{Version: "v1", Flags: map[string]string{"rpc-port": "4000"}}
+	out := &bytes.Buffer{}
+	if err := Print(out, []string{"a:4000", "b:4000"}, cfg, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "No flag differences") {
+		t.Errorf("Unexpected output %q", out.String())
+	}
+}
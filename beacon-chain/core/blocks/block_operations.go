@@ -227,26 +227,34 @@ func ProcessBlockHeader(
 		return nil, err
 	}
 
+	if err := VerifyBlockHeaderSignature(beaconState, block); err != nil {
+		return nil, err
+	}
+
+	return beaconState, nil
+}
+
+// VerifyBlockHeaderSignature verifies the proposer signature of the block against the
+// proposer of the input state's slot.
+func VerifyBlockHeaderSignature(beaconState *stateTrie.BeaconState, block *ethpb.SignedBeaconBlock) error {
 	idx, err := helpers.BeaconProposerIndex(beaconState)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	proposer, err := beaconState.ValidatorAtIndex(idx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Verify proposer signature.
 	currentEpoch := helpers.SlotToEpoch(beaconState.Slot())
 	domain, err := helpers.Domain(beaconState.Fork(), currentEpoch, params.BeaconConfig().DomainBeaconProposer)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if err := verifyBlockRoot(block.Block, proposer.PublicKey, block.Signature, domain); err != nil {
-		return nil, ErrSigFailedToVerify
+		return ErrSigFailedToVerify
 	}
-
-	return beaconState, nil
+	return nil
 }
 
 // ProcessBlockHeaderNoVerify validates a block by its header but skips proposer
@@ -343,9 +351,23 @@ func ProcessRandao(
 	beaconState *stateTrie.BeaconState,
 	body *ethpb.BeaconBlockBody,
 ) (*stateTrie.BeaconState, error) {
+	if err := VerifyRandao(beaconState, body); err != nil {
+		return nil, err
+	}
+
+	beaconState, err := ProcessRandaoNoVerify(beaconState, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process randao")
+	}
+	return beaconState, nil
+}
+
+// VerifyRandao verifies the randao reveal of the block body against the proposer of the input
+// state's slot.
+func VerifyRandao(beaconState *stateTrie.BeaconState, body *ethpb.BeaconBlockBody) error {
 	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get beacon proposer index")
+		return errors.Wrap(err, "could not get beacon proposer index")
 	}
 	proposerPub := beaconState.PubkeyAtIndex(proposerIdx)
 
@@ -355,17 +377,12 @@ func ProcessRandao(
 
 	domain, err := helpers.Domain(beaconState.Fork(), currentEpoch, params.BeaconConfig().DomainRandao)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if err := verifySignature(buf, proposerPub[:], body.RandaoReveal, domain); err != nil {
-		return nil, errors.Wrap(err, "could not verify block randao")
-	}
-
-	beaconState, err = ProcessRandaoNoVerify(beaconState, body)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process randao")
+		return errors.Wrap(err, "could not verify block randao")
 	}
-	return beaconState, nil
+	return nil
 }
 
 // ProcessRandaoNoVerify generates a new randao mix to update
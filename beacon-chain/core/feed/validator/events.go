@@ -0,0 +1,92 @@
+package validator
+
+const (
+	// StatusChanged is sent when the lifecycle status of a tracked validator changes.
+	StatusChanged = iota + 1
+	// BalanceAlert is sent when the balance of a tracked validator is penalized or leaking.
+	BalanceAlert
+)
+
+// Lifecycle is a coarse validator lifecycle stage that status change events are emitted for.
+type Lifecycle int
+
+const (
+	// Unknown means the validator is not yet present in the beacon state.
+	Unknown Lifecycle = iota
+	// ActivationQueued means the validator is eligible and waiting in the activation queue.
+	ActivationQueued
+	// Activated means the validator is active.
+	Activated
+	// Exited means the validator has reached its exit epoch.
+	Exited
+	// Slashed means the validator has been slashed.
+	Slashed
+)
+
+// String returns the human readable name of the lifecycle stage.
+func (l Lifecycle) String() string {
+	switch l {
+	case ActivationQueued:
+		return "ACTIVATION_QUEUED"
+	case Activated:
+		return "ACTIVATED"
+	case Exited:
+		return "EXITED"
+	case Slashed:
+		return "SLASHED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// StatusChangedData is the data sent with StatusChanged events.
+type StatusChangedData struct {
+	// PublicKey is the public key of the validator.
+	PublicKey [48]byte
+	// ValidatorIndex is the index of the validator in the registry.
+	ValidatorIndex uint64
+	// Epoch is the epoch at which the change was observed.
+	Epoch uint64
+	// Previous is the lifecycle stage before the change.
+	Previous Lifecycle
+	// Current is the lifecycle stage after the change.
+	Current Lifecycle
+}
+
+// BalanceAlertReason is why the balance of a tracked validator is alerted on.
+type BalanceAlertReason int
+
+const (
+	// Penalized means the validator lost more than the alert threshold per epoch.
+	Penalized BalanceAlertReason = iota
+	// Leaking means the validator balance decreased for several epochs in a row.
+	Leaking
+)
+
+// String returns the human readable name of the balance alert reason.
+func (r BalanceAlertReason) String() string {
+	switch r {
+	case Penalized:
+		return "PENALIZED"
+	case Leaking:
+		return "LEAKING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// BalanceAlertData is the data sent with BalanceAlert events.
+type BalanceAlertData struct {
+	// PublicKey is the public key of the validator.
+	PublicKey [48]byte
+	// ValidatorIndex is the index of the validator in the registry.
+	ValidatorIndex uint64
+	// Epoch is the epoch at which the balance was observed.
+	Epoch uint64
+	// Reason is why the balance is alerted on.
+	Reason BalanceAlertReason
+	// PreviousBalance is the balance in Gwei observed at the previous epoch.
+	PreviousBalance uint64
+	// CurrentBalance is the balance in Gwei observed at the epoch.
+	CurrentBalance uint64
+}
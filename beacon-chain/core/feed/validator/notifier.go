@@ -0,0 +1,8 @@
+package validator
+
+import "github.com/prysmaticlabs/prysm/shared/event"
+
+// Notifier interface defines the methods of the service that provides validator status updates to consumers.
+type Notifier interface {
+	ValidatorStatusFeed() *event.Feed
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -319,6 +320,10 @@ func ProcessSlots(ctx context.Context, state *stateTrie.BeaconState, slot uint64
 			}
 		}
 		state.SetSlot(state.Slot() + 1)
+		if err := processForkSchedule(state); err != nil {
+			traceutil.AnnotateError(span, err)
+			return nil, errors.Wrap(err, "could not process fork schedule")
+		}
 	}
 
 	if highestSlot < state.Slot() {
@@ -328,6 +333,28 @@ func ProcessSlots(ctx context.Context, state *stateTrie.BeaconState, slot uint64
 	return state, nil
 }
 
+// processForkSchedule switches the fork of the state to the version scheduled for the epoch in
+// the fork version schedule of the beacon config, at the first slot of the epoch.
+func processForkSchedule(state *stateTrie.BeaconState) error {
+	if state.Slot()%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return nil
+	}
+	epoch := helpers.CurrentEpoch(state)
+	version, ok := params.BeaconConfig().ForkVersionSchedule[epoch]
+	if !ok {
+		return nil
+	}
+	fork := state.Fork()
+	if bytes.Equal(fork.CurrentVersion, version) {
+		return nil
+	}
+	return state.SetFork(&pb.Fork{
+		PreviousVersion: fork.CurrentVersion,
+		CurrentVersion:  version,
+		Epoch:           epoch,
+	})
+}
+
 // ProcessBlock creates a new, modified beacon state by applying block operation
 // transformations as defined in the Ethereum Serenity specification, including processing proposer slashings,
 // processing block attestations, and more.
@@ -933,3 +933,28 @@ func TestProcessOperations_IncorrectDeposits(t *testing.T) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 }
+
+func TestProcessSlots_ForkSchedule(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	cfg := *params.BeaconConfig()
+	cfg.ForkVersionSchedule = map[uint64][]byte{1: {1, 0, 0, 0}}
+	params.OverrideBeaconConfig(&cfg)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisVersion := beaconState.Fork().CurrentVersion
+	beaconState, err := state.ProcessSlots(context.Background(), beaconState, cfg.SlotsPerEpoch-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(beaconState.Fork().CurrentVersion, genesisVersion) {
+		t.Errorf("Did not want the fork switched before its epoch, got version %#x", beaconState.Fork().CurrentVersion)
+	}
+	beaconState, err = state.ProcessSlots(context.Background(), beaconState, cfg.SlotsPerEpoch+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork := beaconState.Fork()
+	if !bytes.Equal(fork.CurrentVersion, []byte{1, 0, 0, 0}) || !bytes.Equal(fork.PreviousVersion, genesisVersion) || fork.Epoch != 1 {
+		t.Errorf("Wanted the fork scheduled at epoch 1, got %v", fork)
+	}
+}
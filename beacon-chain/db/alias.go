@@ -2,6 +2,10 @@ package db
 
 import "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 
+// ErrStateNotFound is returned by the highest slot state lookups when no saved state matches, as
+// opposed to a failure reading the database.
+var ErrStateNotFound = iface.ErrStateNotFound
+
 // ReadOnlyDatabase exposes Prysm's eth2 data backend for read access only, no information about
 // head info. For head info, use github.com/prysmaticlabs/prysm/blockchain.HeadFetcher.
 type ReadOnlyDatabase = iface.ReadOnlyDatabase
@@ -22,3 +26,13 @@ type HeadAccessDatabase = iface.HeadAccessDatabase
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.Database
+
+// DatabaseStats reports the disk usage of the database, as returned by Database.DatabaseStats.
+type DatabaseStats = iface.DatabaseStats
+
+// BucketStats reports the number of keys and the disk usage of a bucket of the database.
+type BucketStats = iface.BucketStats
+
+// WriteBatch groups writes to the database in a single transaction, see NoHeadAccessDatabase.Batch.
+// The writes are only visible to readers once the batch is committed.
+type WriteBatch = iface.WriteBatch
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
+
+// Restore replaces the database in the directory path with a snapshot written by a backup, and
+// the cold state database in the cold directory path, if any, with the cold snapshot written
+// along with it. The database must not be in use.
+func Restore(ctx context.Context, snapshotPath string, dirPath string, coldDirPath string) error {
+	return kv.Restore(ctx, snapshotPath, dirPath, coldDirPath)
+}
+
+// ColdSnapshotPath returns the path of the cold state database snapshot written along with the
+// snapshot at the input path.
+func ColdSnapshotPath(snapshotPath string) string {
+	return kv.ColdSnapshotPath(snapshotPath)
+}
@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
+
+// Compact rewrites the database in the directory path, and the cold state database in the cold
+// directory path if any, without the free pages left by deleted data, returning the size of the
+// database files before and after. Compaction is offline only, the database must not be in use.
+func Compact(ctx context.Context, dirPath string, coldDirPath string) (int64, int64, error) {
+	return kv.Compact(ctx, dirPath, coldDirPath)
+}
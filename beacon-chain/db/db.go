@@ -6,3 +6,9 @@ import "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 func NewDB(dirPath string) (Database, error) {
 	return kv.NewKVStore(dirPath)
 }
+
+// NewDBWithColdStorage initializes a new DB storing the cold states in a separate database
+// in the cold directory path.
+func NewDBWithColdStorage(dirPath string, coldDirPath string) (Database, error) {
+	return kv.NewKVStoreWithColdStorage(dirPath, coldDirPath)
+}
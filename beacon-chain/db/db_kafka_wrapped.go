@@ -14,3 +14,14 @@ func NewDB(dirPath string) (Database, error) {
 
 	return kafka.Wrap(db)
 }
+
+// NewDBWithColdStorage initializes a new DB with kafka wrapper, storing the cold states in a
+// separate database in the cold directory path.
+func NewDBWithColdStorage(dirPath string, coldDirPath string) (Database, error) {
+	db, err := kv.NewKVStoreWithColdStorage(dirPath, coldDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return kafka.Wrap(db)
+}
@@ -8,14 +8,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// BackupHandler for accepting requests to initiate a new database backup.
-func BackupHandler(db Database) func(http.ResponseWriter, *http.Request) {
+// BackupHandler for accepting requests to initiate a new database backup in the output directory.
+func BackupHandler(db Database, outputDir string) func(http.ResponseWriter, *http.Request) {
 	log := logrus.WithField("prefix", "db")
 
 	return func(w http.ResponseWriter, _ *http.Request) {
 		log.Debug("Creating database backup from HTTP webhook.")
 
-		if err := db.Backup(context.Background()); err != nil {
+		if _, err := db.Backup(context.Background(), outputDir); err != nil {
 			log.WithError(err).Error("Failed to create backup")
 			w.WriteHeader(http.StatusInternalServerError)
 			return
@@ -3,7 +3,9 @@ package iface
 
 import (
 	"context"
+	"errors"
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -14,6 +16,9 @@ import (
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
+// ErrStateNotFound -- See github.com/prysmaticlabs/prysm/beacon-chain/db.ErrStateNotFound
+var ErrStateNotFound = errors.New("could not get one state")
+
 // ReadOnlyDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.ReadOnlyDatabase
 type ReadOnlyDatabase interface {
 	// Attestation related methods.
@@ -56,9 +61,14 @@ type ReadOnlyDatabase interface {
 	ArchivedCommitteeInfo(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedCommitteeInfo, error)
 	ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error)
 	ArchivedValidatorParticipation(ctx context.Context, epoch uint64) (*eth.ValidatorParticipation, error)
+	ArchivedCheckpoints(ctx context.Context, startEpoch uint64, endEpoch uint64) ([]*ethereum_beacon_p2p_v1.ArchivedCheckpoint, error)
 	ArchivedPointRoot(ctx context.Context, index uint64) [32]byte
 	HasArchivedPoint(ctx context.Context, index uint64) bool
 	LastArchivedIndexRoot(ctx context.Context) [32]byte
+	SplitInfo(ctx context.Context) (uint64, [32]byte, error)
+	LastPrunedSlot(ctx context.Context) (uint64, error)
+	HasSplitInfo(ctx context.Context) bool
+	StateDiff(ctx context.Context, slot uint64) (*ethereum_beacon_p2p_v1.StateDiff, error)
 	// Deposit contract related handlers.
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
@@ -87,9 +97,17 @@ type NoHeadAccessDatabase interface {
 	// State related methods.
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error
+	SaveColdState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
+	MoveStateToCold(ctx context.Context, blockRoot [32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
+	SoftDeleteState(ctx context.Context, blockRoot [32]byte) error
+	RestoreSoftDeletedState(ctx context.Context, blockRoot [32]byte) error
+	PurgeSoftDeletedStates(ctx context.Context, deletedBefore time.Time) (int, error)
 	SaveStateSummary(ctx context.Context, summary *ethereum_beacon_p2p_v1.StateSummary) error
+	SaveStateSummaries(ctx context.Context, summaries []*ethereum_beacon_p2p_v1.StateSummary) error
+	DeleteStateSummaries(ctx context.Context, blockRoots [][32]byte) error
+	SaveStateAndSummary(ctx context.Context, state *state.BeaconState, summary *ethereum_beacon_p2p_v1.StateSummary) error
 	// Slashing operations.
 	SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
@@ -106,12 +124,31 @@ type NoHeadAccessDatabase interface {
 	SaveArchivedCommitteeInfo(ctx context.Context, epoch uint64, info *ethereum_beacon_p2p_v1.ArchivedCommitteeInfo) error
 	SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error
 	SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error
+	SaveArchivedCheckpoint(ctx context.Context, checkpoint *ethereum_beacon_p2p_v1.ArchivedCheckpoint) error
 	SaveArchivedPointRoot(ctx context.Context, blockRoot [32]byte, index uint64) error
 	SaveLastArchivedIndex(ctx context.Context, index uint64) error
+	SaveSplitInfo(ctx context.Context, slot uint64, blockRoot [32]byte) error
+	SaveLastPrunedSlot(ctx context.Context, slot uint64) error
+	SaveStateDiff(ctx context.Context, slot uint64, diff *ethereum_beacon_p2p_v1.StateDiff) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Batch runs f in a single write transaction, discarding all of its writes if f returns an error.
+	Batch(ctx context.Context, f func(WriteBatch) error) error
+}
+
+// WriteBatch -- See github.com/prysmaticlabs/prysm/beacon-chain/db.WriteBatch
+type WriteBatch interface {
+	SaveBlock(block *eth.SignedBeaconBlock) error
+	SaveGenesisBlockRoot(blockRoot [32]byte) error
+	SaveState(state *state.BeaconState, blockRoot [32]byte) error
+	SaveStateSummary(summary *ethereum_beacon_p2p_v1.StateSummary) error
+	SaveJustifiedCheckpoint(checkpoint *eth.Checkpoint) error
+	SaveFinalizedCheckpoint(checkpoint *eth.Checkpoint) error
+	SaveArchivedPointRoot(blockRoot [32]byte, index uint64) error
+	SaveLastArchivedIndex(index uint64) error
+	SaveSplitInfo(slot uint64, blockRoot [32]byte) error
 }
 
 // HeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.HeadAccessDatabase
@@ -134,5 +171,30 @@ type Database interface {
 	ClearDB() error
 
 	// Backup and restore methods
-	Backup(ctx context.Context) error
+	Backup(ctx context.Context, outputDir string) (string, error)
+
+	// DatabaseStats reports the disk usage of the database and of each of its buckets.
+	DatabaseStats(ctx context.Context) (*DatabaseStats, error)
+}
+
+// DatabaseStats -- See github.com/prysmaticlabs/prysm/beacon-chain/db.DatabaseStats
+type DatabaseStats struct {
+	// FileSize is the size of the database file in bytes.
+	FileSize int64
+	// FreeSize is the size of the free pages in bytes, it's reclaimed by compacting the database.
+	FreeSize int64
+	// SchemaVersion is the version of the database schema, raised by the migrations run on startup.
+	SchemaVersion uint64
+	// Buckets are sorted by decreasing size.
+	Buckets []*BucketStats
+}
+
+// BucketStats -- See github.com/prysmaticlabs/prysm/beacon-chain/db.BucketStats
+type BucketStats struct {
+	Name string
+	Keys int
+	// Size is the size in bytes of the pages allocated to the bucket.
+	Size int64
+	// InUse is the size in bytes of the allocated pages used by the keys and values of the bucket.
+	InUse int64
 }
@@ -2,11 +2,13 @@ package kafka
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -24,8 +26,13 @@ func (e Exporter) ClearDB() error {
 }
 
 // Backup -- passthrough.
-func (e Exporter) Backup(ctx context.Context) error {
-	return e.db.Backup(ctx)
+func (e Exporter) Backup(ctx context.Context, outputDir string) (string, error) {
+	return e.db.Backup(ctx, outputDir)
+}
+
+// DatabaseStats -- passthrough.
+func (e Exporter) DatabaseStats(ctx context.Context) (*iface.DatabaseStats, error) {
+	return e.db.DatabaseStats(ctx)
 }
 
 // AttestationsByDataRoot -- passthrough.
@@ -198,6 +205,11 @@ func (e Exporter) ArchivedValidatorParticipation(ctx context.Context, epoch uint
 	return e.db.ArchivedValidatorParticipation(ctx, epoch)
 }
 
+// ArchivedCheckpoints -- passthrough.
+func (e Exporter) ArchivedCheckpoints(ctx context.Context, startEpoch uint64, endEpoch uint64) ([]*ethereum_beacon_p2p_v1.ArchivedCheckpoint, error) {
+	return e.db.ArchivedCheckpoints(ctx, startEpoch, endEpoch)
+}
+
 // DepositContractAddress -- passthrough.
 func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
@@ -238,6 +250,16 @@ func (e Exporter) SaveStateSummary(ctx context.Context, summary *pb.StateSummary
 	return e.db.SaveStateSummary(ctx, summary)
 }
 
+// SaveStateSummaries -- passthrough.
+func (e Exporter) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSummary) error {
+	return e.db.SaveStateSummaries(ctx, summaries)
+}
+
+// SaveStateAndSummary -- passthrough.
+func (e Exporter) SaveStateAndSummary(ctx context.Context, state *state.BeaconState, summary *pb.StateSummary) error {
+	return e.db.SaveStateAndSummary(ctx, state, summary)
+}
+
 // SaveStates -- passthrough.
 func (e Exporter) SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error {
 	return e.db.SaveStates(ctx, states, blockRoots)
@@ -288,6 +310,11 @@ func (e Exporter) SaveArchivedValidatorParticipation(ctx context.Context, epoch
 	return e.db.SaveArchivedValidatorParticipation(ctx, epoch, part)
 }
 
+// SaveArchivedCheckpoint -- passthrough.
+func (e Exporter) SaveArchivedCheckpoint(ctx context.Context, checkpoint *ethereum_beacon_p2p_v1.ArchivedCheckpoint) error {
+	return e.db.SaveArchivedCheckpoint(ctx, checkpoint)
+}
+
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
@@ -298,16 +325,46 @@ func (e Exporter) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	return e.db.DeleteState(ctx, blockRoot)
 }
 
+// SaveColdState -- passthrough.
+func (e Exporter) SaveColdState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error {
+	return e.db.SaveColdState(ctx, state, blockRoot)
+}
+
+// MoveStateToCold -- passthrough.
+func (e Exporter) MoveStateToCold(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.MoveStateToCold(ctx, blockRoot)
+}
+
 // DeleteStates -- passthrough.
 func (e Exporter) DeleteStates(ctx context.Context, blockRoots [][32]byte) error {
 	return e.db.DeleteStates(ctx, blockRoots)
 }
 
+// SoftDeleteState -- passthrough.
+func (e Exporter) SoftDeleteState(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SoftDeleteState(ctx, blockRoot)
+}
+
+// RestoreSoftDeletedState -- passthrough.
+func (e Exporter) RestoreSoftDeletedState(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.RestoreSoftDeletedState(ctx, blockRoot)
+}
+
+// PurgeSoftDeletedStates -- passthrough.
+func (e Exporter) PurgeSoftDeletedStates(ctx context.Context, deletedBefore time.Time) (int, error) {
+	return e.db.PurgeSoftDeletedStates(ctx, deletedBefore)
+}
+
 // HasState -- passthrough.
 func (e Exporter) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	return e.db.HasState(ctx, blockRoot)
 }
 
+// DeleteStateSummaries -- passthrough.
+func (e Exporter) DeleteStateSummaries(ctx context.Context, blockRoots [][32]byte) error {
+	return e.db.DeleteStateSummaries(ctx, blockRoots)
+}
+
 // HasStateSummary -- passthrough.
 func (e Exporter) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
 	return e.db.HasStateSummary(ctx, blockRoot)
@@ -328,6 +385,11 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// Batch -- passthrough
+func (e Exporter) Batch(ctx context.Context, f func(iface.WriteBatch) error) error {
+	return e.db.Batch(ctx, f)
+}
+
 // SaveArchivedPointRoot -- passthrough
 func (e Exporter) SaveArchivedPointRoot(ctx context.Context, blockRoot [32]byte, index uint64) error {
 	return e.db.SaveArchivedPointRoot(ctx, blockRoot, index)
@@ -348,6 +410,31 @@ func (e Exporter) LastArchivedIndexRoot(ctx context.Context) [32]byte {
 	return e.db.LastArchivedIndexRoot(ctx)
 }
 
+// SplitInfo -- passthrough
+func (e Exporter) SplitInfo(ctx context.Context) (uint64, [32]byte, error) {
+	return e.db.SplitInfo(ctx)
+}
+
+// LastPrunedSlot -- passthrough
+func (e Exporter) LastPrunedSlot(ctx context.Context) (uint64, error) {
+	return e.db.LastPrunedSlot(ctx)
+}
+
+// HasSplitInfo -- passthrough
+func (e Exporter) HasSplitInfo(ctx context.Context) bool {
+	return e.db.HasSplitInfo(ctx)
+}
+
+// StateDiff -- passthrough
+func (e Exporter) StateDiff(ctx context.Context, slot uint64) (*pb.StateDiff, error) {
+	return e.db.StateDiff(ctx, slot)
+}
+
+// SaveStateDiff -- passthrough
+func (e Exporter) SaveStateDiff(ctx context.Context, slot uint64, diff *pb.StateDiff) error {
+	return e.db.SaveStateDiff(ctx, slot, diff)
+}
+
 // HighestSlotBlocks -- passthrough
 func (e Exporter) HighestSlotBlocks(ctx context.Context) ([]*ethpb.SignedBeaconBlock, error) {
 	return e.db.HighestSlotBlocks(ctx)
@@ -372,3 +459,13 @@ func (e Exporter) HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*s
 func (e Exporter) SaveLastArchivedIndex(ctx context.Context, index uint64) error {
 	return e.db.SaveLastArchivedIndex(ctx, index)
 }
+
+// SaveLastPrunedSlot -- passthrough
+func (e Exporter) SaveLastPrunedSlot(ctx context.Context, slot uint64) error {
+	return e.db.SaveLastPrunedSlot(ctx, slot)
+}
+
+// SaveSplitInfo -- passthrough
+func (e Exporter) SaveSplitInfo(ctx context.Context, slot uint64, blockRoot [32]byte) error {
+	return e.db.SaveSplitInfo(ctx, slot, blockRoot)
+}
@@ -143,6 +143,49 @@ func (k *Store) SaveArchivedValidatorParticipation(ctx context.Context, epoch ui
 	})
 }
 
+// ArchivedCheckpoints retrieval for the epochs between the start and end epochs, inclusive. Epochs
+// without an archived checkpoint are skipped.
+func (k *Store) ArchivedCheckpoints(ctx context.Context, startEpoch uint64, endEpoch uint64) ([]*pb.ArchivedCheckpoint, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedCheckpoints")
+	defer span.End()
+
+	checkpoints := make([]*pb.ArchivedCheckpoint, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(archivedCheckpointsBucket)
+		for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+			enc := bkt.Get(uint64ToBytes(epoch))
+			if enc != nil {
+				cp := &pb.ArchivedCheckpoint{}
+				if err := decode(enc, cp); err != nil {
+					return err
+				}
+				checkpoints = append(checkpoints, cp)
+			}
+			// Guards against the overflow of an end epoch at the max uint64.
+			if epoch == endEpoch {
+				break
+			}
+		}
+		return nil
+	})
+	return checkpoints, err
+}
+
+// SaveArchivedCheckpoint by its epoch.
+func (k *Store) SaveArchivedCheckpoint(ctx context.Context, checkpoint *pb.ArchivedCheckpoint) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedCheckpoint")
+	defer span.End()
+	buf := uint64ToBytes(checkpoint.Epoch)
+	enc, err := encode(checkpoint)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedCheckpointsBucket)
+		return bucket.Put(buf, enc)
+	})
+}
+
 func marshalBalances(bals []uint64) []byte {
 	res := make([]byte, len(bals)*8)
 	offset := 0
@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
 func TestStore_ArchivedActiveValidatorChanges(t *testing.T) {
@@ -164,3 +165,40 @@ func TestStore_ArchivedValidatorParticipation(t *testing.T) {
 		t.Errorf("Wanted %v, received %v", part, retrieved)
 	}
 }
+
+func TestStore_ArchivedCheckpoints(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	checkpoints := []*pbp2p.ArchivedCheckpoint{
+		{Epoch: 2, Root: bytesutil.PadTo([]byte{'a'}, 32), Justified: true, JustifiedSlot: 96, Finalized: true, FinalizedSlot: 128},
+		{Epoch: 3, Root: bytesutil.PadTo([]byte{'b'}, 32), Justified: true, JustifiedSlot: 128},
+		{Epoch: 5, Root: bytesutil.PadTo([]byte{'c'}, 32), Justified: true, JustifiedSlot: 192},
+	}
+	for _, cp := range checkpoints {
+		if err := db.SaveArchivedCheckpoint(ctx, cp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	retrieved, err := db.ArchivedCheckpoints(ctx, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved) != 2 {
+		t.Fatalf("Wanted 2 checkpoints, received %d", len(retrieved))
+	}
+	for i, cp := range retrieved {
+		if !proto.Equal(checkpoints[i], cp) {
+			t.Errorf("Wanted %v, received %v", checkpoints[i], cp)
+		}
+	}
+
+	retrieved, err = db.ArchivedCheckpoints(ctx, 6, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved) != 0 {
+		t.Errorf("Wanted no checkpoints, received %d", len(retrieved))
+	}
+}
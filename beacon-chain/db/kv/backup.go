@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -14,45 +15,133 @@ import (
 
 const backupsDirectoryName = "backups"
 
-// Backup the database to the datadir backup directory.
+// coldSnapshotSuffix is appended to the path of a snapshot for the snapshot of the separate cold
+// state database taken alongside it.
+const coldSnapshotSuffix = ".cold"
+
+// ColdSnapshotPath returns the path of the cold state database snapshot written along with the
+// snapshot at the input path, when the cold states are stored in a separate database.
+func ColdSnapshotPath(snapshotPath string) string {
+	return snapshotPath + coldSnapshotSuffix
+}
+
+// Backup the database to a snapshot file in the output directory, or in the datadir backup
+// directory if it is empty, and returns the path of the snapshot. The snapshot is taken from a
+// read transaction, so the node keeps running and writing to the database meanwhile. When the
+// cold states are stored apart, their database is written to ColdSnapshotPath of the snapshot.
 // Example for backup at slot 345: $DATADIR/backups/prysm_beacondb_at_slot_0000345.backup
-func (k *Store) Backup(ctx context.Context) error {
+func (k *Store) Backup(ctx context.Context, outputDir string) (string, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Backup")
 	defer span.End()
 
-	backupsDir := path.Join(k.databasePath, backupsDirectoryName)
+	backupsDir := outputDir
+	if backupsDir == "" {
+		backupsDir = path.Join(k.databasePath, backupsDirectoryName)
+	}
 	head, err := k.HeadBlock(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
 	if head == nil {
-		return errors.New("no head block")
+		return "", errors.New("no head block")
 	}
 	// Ensure the backups directory exists.
 	if err := os.MkdirAll(backupsDir, os.ModePerm); err != nil {
-		return err
+		return "", err
 	}
 	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block.Slot))
 	logrus.WithField("prefix", "db").WithField("backup", backupPath).Info("Writing backup database.")
 
-	copyDB, err := bolt.Open(backupPath, 0666, nil)
+	if err := writeSnapshot(k.db, backupPath); err != nil {
+		return "", err
+	}
+	// States only move from the main database to the cold one, the cold snapshot being taken
+	// last holds every cold state the main snapshot refers to.
+	if k.coldDB != nil {
+		if err := writeSnapshot(k.coldDB, ColdSnapshotPath(backupPath)); err != nil {
+			return "", errors.Wrap(err, "could not back up cold state database")
+		}
+	}
+	return backupPath, nil
+}
+
+// writeSnapshot copies the database to the snapshot path from a read transaction. The snapshot is
+// written to a temporary file first, so an interrupted backup never leaves a truncated snapshot
+// behind.
+func writeSnapshot(db *bolt.DB, snapshotPath string) error {
+	tmpPath := snapshotPath + ".tmp"
+	if err := db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(tmpPath, 0600)
+	}); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil && !os.IsNotExist(rmErr) {
+			logrus.WithField("prefix", "db").WithError(rmErr).Error("Could not remove incomplete backup")
+		}
+		return errors.Wrap(err, "could not write backup")
+	}
+	return os.Rename(tmpPath, snapshotPath)
+}
+
+// Restore replaces the database in the directory path with the snapshot file written by Backup.
+// The snapshot is checked to be a readable database before the existing database is replaced.
+// When the cold directory path isn't empty, the cold state database in it is replaced with the
+// cold snapshot written along with the snapshot. The database must not be in use.
+func Restore(ctx context.Context, snapshotPath string, dirPath string, coldDirPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Restore")
+	defer span.End()
+
+	if err := restoreSnapshot(snapshotPath, path.Join(dirPath, databaseFileName), blocksBucket); err != nil {
+		return err
+	}
+	if coldDirPath == "" {
+		return nil
+	}
+	coldSnapshot := ColdSnapshotPath(snapshotPath)
+	if _, err := os.Stat(coldSnapshot); os.IsNotExist(err) {
+		logrus.WithField("prefix", "db").WithField("snapshot", coldSnapshot).Warn(
+			"No cold state database snapshot, keeping the existing cold state database")
+		return nil
+	}
+	return restoreSnapshot(coldSnapshot, path.Join(coldDirPath, coldDatabaseFileName), stateBucket)
+}
+
+// restoreSnapshot replaces the database file at the input path with the snapshot, once checked to
+// be a database holding the required bucket.
+func restoreSnapshot(snapshotPath string, dbPath string, requiredBucket []byte) error {
+	log := logrus.WithField("prefix", "db")
+	snapshot, err := bolt.Open(snapshotPath, 0600, &bolt.Options{ReadOnly: true, Timeout: 1 * time.Second})
 	if err != nil {
-		panic(err)
-	}
-	defer copyDB.Close()
-
-	return k.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			logrus.Debugf("Copying bucket %s\n", name)
-			return copyDB.Update(func(tx2 *bolt.Tx) error {
-				b2, err := tx2.CreateBucketIfNotExists(name)
-				if err != nil {
-					return err
-				}
-				return b.ForEach(func(k []byte, v []byte) error {
-					return b2.Put(k, v)
-				})
-			})
-		})
-	})
+		return errors.Wrapf(err, "could not open snapshot %s", snapshotPath)
+	}
+	defer func() {
+		if err := snapshot.Close(); err != nil {
+			log.WithError(err).Error("Could not close snapshot")
+		}
+	}()
+	if err := os.MkdirAll(path.Dir(dbPath), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmpPath := dbPath + ".restore"
+	if err := snapshot.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(requiredBucket) == nil {
+			return errors.New("snapshot is not a beacon chain database")
+		}
+		return tx.CopyFile(tmpPath, 0600)
+	}); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil && !os.IsNotExist(rmErr) {
+			log.WithError(rmErr).Error("Could not remove incomplete restore")
+		}
+		return errors.Wrap(err, "could not copy snapshot")
+	}
+	if _, err := os.Stat(dbPath); err == nil {
+		log.WithField("path", dbPath).Warn("Replacing the existing database with the snapshot")
+	}
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"snapshot": snapshotPath,
+		"path":     dbPath,
+	}).Info("Restored database from snapshot")
+	return nil
 }
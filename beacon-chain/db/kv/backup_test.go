@@ -34,9 +34,13 @@ func TestStore_Backup(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := db.Backup(ctx); err != nil {
+	backupPath, err := db.Backup(ctx, "")
+	if err != nil {
 		t.Fatal(err)
 	}
+	if path.Dir(backupPath) != path.Join(db.databasePath, backupsDirectoryName) {
+		t.Errorf("Wanted backup in the datadir backup directory, got %s", backupPath)
+	}
 
 	files, err := ioutil.ReadDir(path.Join(db.databasePath, backupsDirectoryName))
 	if err != nil {
@@ -46,3 +50,69 @@ func TestStore_Backup(t *testing.T) {
 		t.Fatal("No backups created.")
 	}
 }
+
+func TestStore_Restore(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	head := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 5000}}
+	if err := db.SaveBlock(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(head.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.InitializeFromProto(&pb.BeaconState{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	backupPath, err := db.Backup(ctx, path.Join(db.databasePath, "snapshots"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restoreDir := path.Join(db.databasePath, "restored")
+	if err := Restore(ctx, backupPath, restoreDir, ""); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := NewKVStore(restoreDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := restored.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if !restored.HasBlock(ctx, root) {
+		t.Error("Expected restored database to have the head block")
+	}
+	restoredHead, err := restored.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoredHead == nil || restoredHead.Block.Slot != 5000 {
+		t.Errorf("Wanted restored head block at slot 5000, got %v", restoredHead)
+	}
+}
+
+func TestRestore_RejectsInvalidSnapshot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	snapshotPath := path.Join(db.databasePath, "snapshot.backup")
+	if err := ioutil.WriteFile(snapshotPath, []byte("not a database"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := Restore(context.Background(), snapshotPath, path.Join(db.databasePath, "restored"), ""); err == nil {
+		t.Error("Expected restoring an invalid snapshot to fail")
+	}
+}
@@ -0,0 +1,130 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Batch runs f in a single bolt write transaction. All the writes of the batch are committed
+// together once f returns, or none of them are if f returns an error. This is used to save data
+// spanning several buckets, such as a state and the checkpoints pointing to it, without leaving
+// the DB inconsistent when the node is stopped halfway.
+//
+// f must only write through the given batch. Calling the write methods of the store from f
+// deadlocks, as bolt allows a single write transaction at a time.
+func (k *Store) Batch(ctx context.Context, f func(iface.WriteBatch) error) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Batch")
+	defer span.End()
+
+	b := &writeBatch{ctx: ctx, k: k}
+	if err := k.db.Update(func(tx *bolt.Tx) error {
+		b.tx = tx
+		return f(b)
+	}); err != nil {
+		return err
+	}
+	// Blocks are only cached once the transaction is committed, so a rolled back batch never
+	// leaves blocks in the cache that are missing from the DB.
+	for i, blk := range b.blocks {
+		k.blockCache.Set(string(b.blockRoots[i][:]), blk, int64(b.blockSizes[i]))
+	}
+	return nil
+}
+
+// writeBatch implements iface.WriteBatch over a bolt write transaction.
+type writeBatch struct {
+	ctx        context.Context
+	k          *Store
+	tx         *bolt.Tx
+	blocks     []*ethpb.SignedBeaconBlock
+	blockRoots [][32]byte
+	blockSizes []int
+}
+
+// SaveBlock saves the block and its indices in the batch.
+func (b *writeBatch) SaveBlock(signed *ethpb.SignedBeaconBlock) error {
+	blockRoot, err := stateutil.BlockRoot(signed.Block)
+	if err != nil {
+		return err
+	}
+	enc, err := b.k.putBlock(b.ctx, b.tx, signed, blockRoot)
+	if err != nil {
+		return err
+	}
+	if enc != nil {
+		b.blocks = append(b.blocks, signed)
+		b.blockRoots = append(b.blockRoots, blockRoot)
+		b.blockSizes = append(b.blockSizes, len(enc))
+	}
+	return nil
+}
+
+// SaveGenesisBlockRoot saves the genesis block root in the batch.
+func (b *writeBatch) SaveGenesisBlockRoot(blockRoot [32]byte) error {
+	return b.tx.Bucket(blocksBucket).Put(genesisBlockRootKey, blockRoot[:])
+}
+
+// SaveState saves the state in the batch.
+func (b *writeBatch) SaveState(state *state.BeaconState, blockRoot [32]byte) error {
+	if state == nil {
+		return errors.New("nil state")
+	}
+	enc, err := encodeState(state.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	return b.k.putState(b.ctx, b.tx, state.Slot(), blockRoot, enc)
+}
+
+// SaveStateSummary saves the state summary in the batch.
+func (b *writeBatch) SaveStateSummary(summary *pb.StateSummary) error {
+	enc, err := encode(summary)
+	if err != nil {
+		return err
+	}
+	return b.tx.Bucket(stateSummaryBucket).Put(summary.Root, enc)
+}
+
+// SaveJustifiedCheckpoint saves the justified checkpoint in the batch. As with the store, the
+// state or state summary of the checkpoint root must have been saved first.
+func (b *writeBatch) SaveJustifiedCheckpoint(checkpoint *ethpb.Checkpoint) error {
+	enc, err := encode(checkpoint)
+	if err != nil {
+		return err
+	}
+	return putJustifiedCheckpoint(b.ctx, b.tx, checkpoint.Root, enc)
+}
+
+// SaveFinalizedCheckpoint saves the finalized checkpoint in the batch and updates the finalized
+// block roots index.
+func (b *writeBatch) SaveFinalizedCheckpoint(checkpoint *ethpb.Checkpoint) error {
+	enc, err := encode(checkpoint)
+	if err != nil {
+		return err
+	}
+	return b.k.putFinalizedCheckpoint(b.ctx, b.tx, checkpoint, enc)
+}
+
+// SaveArchivedPointRoot saves the block root of the archived point index in the batch.
+func (b *writeBatch) SaveArchivedPointRoot(blockRoot [32]byte, index uint64) error {
+	return b.tx.Bucket(archivedIndexRootBucket).Put(uint64ToBytes(index), blockRoot[:])
+}
+
+// SaveLastArchivedIndex saves the last archived point index in the batch.
+func (b *writeBatch) SaveLastArchivedIndex(index uint64) error {
+	return b.tx.Bucket(archivedIndexRootBucket).Put(lastArchivedIndexKey, uint64ToBytes(index))
+}
+
+// SaveSplitInfo saves the slot and block root splitting the hot and cold sections in the batch.
+func (b *writeBatch) SaveSplitInfo(slot uint64, blockRoot [32]byte) error {
+	enc := append(uint64ToBytes(slot), blockRoot[:]...)
+	return b.tx.Bucket(chainMetadataBucket).Put(splitInfoKey, enc)
+}
@@ -0,0 +1,105 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestStore_Batch_CommitsWrites(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	root, err := stateutil.BlockRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: 2, Root: root[:]}
+	if err := db.Batch(ctx, func(b iface.WriteBatch) error {
+		if err := b.SaveBlock(blk); err != nil {
+			return err
+		}
+		if err := b.SaveState(st, root); err != nil {
+			return err
+		}
+		if err := b.SaveStateSummary(&pb.StateSummary{Slot: 64, Root: root[:]}); err != nil {
+			return err
+		}
+		if err := b.SaveFinalizedCheckpoint(checkpoint); err != nil {
+			return err
+		}
+		return b.SaveSplitInfo(64, root)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !db.HasBlock(ctx, root) {
+		t.Error("Expected block to be saved")
+	}
+	if !db.HasState(ctx, root) {
+		t.Error("Expected state to be saved")
+	}
+	if !db.HasStateSummary(ctx, root) {
+		t.Error("Expected state summary to be saved")
+	}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(finalized, checkpoint) {
+		t.Errorf("Wanted finalized checkpoint %v, received %v", checkpoint, finalized)
+	}
+	if slot, splitRoot, err := db.SplitInfo(ctx); err != nil || slot != 64 || splitRoot != root {
+		t.Errorf("Wanted split info slot 64 root %#x, received slot %d root %#x: %v", root, slot, splitRoot, err)
+	}
+}
+
+func TestStore_Batch_RollsBackOnError(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	root, err := stateutil.BlockRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedErr := errors.New("failed")
+	if err := db.Batch(ctx, func(b iface.WriteBatch) error {
+		if err := b.SaveBlock(blk); err != nil {
+			return err
+		}
+		if err := b.SaveStateSummary(&pb.StateSummary{Slot: 64, Root: root[:]}); err != nil {
+			return err
+		}
+		if err := b.SaveArchivedPointRoot(root, 1); err != nil {
+			return err
+		}
+		return wantedErr
+	}); err != wantedErr {
+		t.Fatalf("Wanted error %v, received %v", wantedErr, err)
+	}
+
+	if db.HasBlock(ctx, root) {
+		t.Error("Expected block of the failed batch to not be saved")
+	}
+	if db.HasStateSummary(ctx, root) {
+		t.Error("Expected state summary of the failed batch to not be saved")
+	}
+	if db.HasArchivedPoint(ctx, 1) {
+		t.Error("Expected archived point of the failed batch to not be saved")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 
@@ -200,27 +201,39 @@ func (k *Store) SaveBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock)
 		return nil
 	}
 	return k.db.Update(func(tx *bolt.Tx) error {
-		if err := k.setBlockSlotBitField(ctx, tx, signed.Block.Slot); err != nil {
-			return err
-		}
-
-		bkt := tx.Bucket(blocksBucket)
-		if existingBlock := bkt.Get(blockRoot[:]); existingBlock != nil {
-			return nil
-		}
-		enc, err := encode(signed)
+		enc, err := k.putBlock(ctx, tx, signed, blockRoot)
 		if err != nil {
 			return err
 		}
-		indicesByBucket := createBlockIndicesFromBlock(signed.Block)
-		if err := updateValueForIndices(indicesByBucket, blockRoot[:], tx); err != nil {
-			return errors.Wrap(err, "could not update DB indices")
+		if enc != nil {
+			k.blockCache.Set(string(blockRoot[:]), signed, int64(len(enc)))
 		}
-		k.blockCache.Set(string(blockRoot[:]), signed, int64(len(enc)))
-		return bkt.Put(blockRoot[:], enc)
+		return nil
 	})
 }
 
+// putBlock writes the block and its indices in the transaction. It returns the encoded block, or
+// nil if the block was already saved.
+func (k *Store) putBlock(ctx context.Context, tx *bolt.Tx, signed *ethpb.SignedBeaconBlock, blockRoot [32]byte) ([]byte, error) {
+	if err := k.setBlockSlotBitField(ctx, tx, signed.Block.Slot); err != nil {
+		return nil, err
+	}
+
+	bkt := tx.Bucket(blocksBucket)
+	if existingBlock := bkt.Get(blockRoot[:]); existingBlock != nil {
+		return nil, nil
+	}
+	enc, err := encode(signed)
+	if err != nil {
+		return nil, err
+	}
+	indicesByBucket := createBlockIndicesFromBlock(signed.Block)
+	if err := updateValueForIndices(indicesByBucket, blockRoot[:], tx); err != nil {
+		return nil, errors.Wrap(err, "could not update DB indices")
+	}
+	return enc, bkt.Put(blockRoot[:], enc)
+}
+
 // SaveBlocks via bulk updates to the db.
 func (k *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocks")
@@ -330,26 +343,90 @@ func (k *Store) HighestSlotBlocks(ctx context.Context) ([]*ethpb.SignedBeaconBlo
 }
 
 // HighestSlotBlocksBelow returns the block with the highest slot below the input slot from the db.
+// The slot is looked up in the block slot indices, so it costs the same however many skip slots
+// there are below the input slot.
 func (k *Store) HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*ethpb.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotBlocksBelow")
 	defer span.End()
 
-	blocks := make([]*ethpb.SignedBeaconBlock, 0)
+	var blocks []*ethpb.SignedBeaconBlock
 	err := k.db.View(func(tx *bolt.Tx) error {
-		sBkt := tx.Bucket(slotsHasObjectBucket)
-		savedSlots := sBkt.Get(savedBlockSlotsKey)
-		highestIndex, err := bytesutil.HighestBitIndexAt(savedSlots, int(slot))
-		if err != nil {
-			return err
-		}
-		blocks, err = k.blocksAtSlotBitfieldIndex(ctx, tx, highestIndex)
+		bBkt := tx.Bucket(blocksBucket)
+		return descendBlockSlotIndices(tx, slot, func(_ uint64, roots [][]byte) (bool, error) {
+			for _, r := range roots {
+				block := &ethpb.SignedBeaconBlock{}
+				if err := decode(bBkt.Get(r), block); err != nil {
+					return false, err
+				}
+				blocks = append(blocks, block)
+			}
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The genesis block is returned when no block was indexed below the input slot.
+	if len(blocks) == 0 {
+		gBlock, err := k.GenesisBlock(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		return nil
-	})
+		return []*ethpb.SignedBeaconBlock{gBlock}, nil
+	}
+	return blocks, nil
+}
 
-	return blocks, err
+// descendBlockSlotIndices calls f with the block roots of the indexed slots below the input slot,
+// from the highest slot down, until f returns true or an error. Seeking the input slot in the
+// block slot indices skips the slots without blocks at once.
+//
+// The indices are keyed by the slot in decimal padded to 7 digits, so keys only sort as their
+// slots do among keys of the same length: from slot 10^7 on, longer keys are interleaved with the
+// shorter ones. The keys are walked one length at a time, from the length of the input slot
+// down, each length from its highest key below the input slot down to its lowest possible key.
+func descendBlockSlotIndices(tx *bolt.Tx, slot uint64, f func(slot uint64, roots [][]byte) (bool, error)) error {
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	upper := fmt.Sprintf("%07d", slot)
+	for length := len(upper); length >= 7; length-- {
+		var k, v []byte
+		if length == len(upper) {
+			k, v = c.Seek([]byte(upper))
+		} else {
+			// Every key of a shorter length is below the input slot, ':' sorts right after '9'.
+			k, v = c.Seek([]byte(strings.Repeat("9", length) + ":"))
+		}
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		// Keys lexically below the lowest key of the length, past the 7 digits padding, are all shorter.
+		lowest := ""
+		if length > 7 {
+			lowest = "1" + strings.Repeat("0", length-1)
+		}
+		for ; k != nil && string(k) >= lowest; k, v = c.Prev() {
+			// Deleted blocks leave their slot in the indices without roots.
+			if len(k) != length || len(v) == 0 {
+				continue
+			}
+			indexedSlot, err := strconv.ParseUint(string(k), 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "could not parse block slot index %q", k)
+			}
+			roots := make([][]byte, 0, len(v)/32)
+			for i := 0; i+32 <= len(v); i += 32 {
+				roots = append(roots, v[i:i+32])
+			}
+			done, err := f(indexedSlot, roots)
+			if err != nil || done {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // blocksAtSlotBitfieldIndex retrieves the blocks in DB given the input index. The index represents
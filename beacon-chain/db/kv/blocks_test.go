@@ -508,6 +508,42 @@ func TestStore_SaveBlock_CanGetHighestAt(t *testing.T) {
 	}
 }
 
+func TestStore_HighestSlotBlocksBelow_PastSevenDigitSlots(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Slot 2000000 sorts after slot 10000001 in the indices, which are decimal strings.
+	slots := []uint64{9999998, 2000000, 10000001}
+	blocks := make(map[uint64]*ethpb.SignedBeaconBlock, len(slots))
+	for _, slot := range slots {
+		blocks[slot] = &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}
+		if err := db.SaveBlock(ctx, blocks[slot]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		below uint64
+		want  uint64
+	}{
+		{below: 9999998, want: 2000000},
+		{below: 10000000, want: 9999998},
+		{below: 10000001, want: 9999998},
+		{below: 10000005, want: 10000001},
+		{below: 200000000, want: 10000001},
+	}
+	for _, tt := range tests {
+		highestAt, err := db.HighestSlotBlocksBelow(ctx, tt.below)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(highestAt) != 1 || !proto.Equal(blocks[tt.want], highestAt[0]) {
+			t.Errorf("Below slot %d: wanted %v, received %v", tt.below, blocks[tt.want], highestAt)
+		}
+	}
+}
+
 func TestStore_GenesisBlock_CanGetHighestAt(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
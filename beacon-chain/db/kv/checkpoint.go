@@ -63,24 +63,29 @@ func (k *Store) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 		return err
 	}
 	return k.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(checkpointBucket)
-		if featureconfig.Get().NewStateMgmt {
-			if tx.Bucket(stateSummaryBucket).Get(checkpoint.Root) == nil {
-				return errors.New("missing state summary for finalized root")
-			}
-		} else {
-			// The corresponding state must exist or there is a risk that the beacondb enters a state
-			// where the justified beaconState is missing. This may be a fatal condition requiring
-			// a new sync from genesis.
-			if tx.Bucket(stateBucket).Get(checkpoint.Root) == nil {
-				traceutil.AnnotateError(span, errMissingStateForCheckpoint)
-				return errMissingStateForCheckpoint
-			}
-		}
-		return bucket.Put(justifiedCheckpointKey, enc)
+		return putJustifiedCheckpoint(ctx, tx, checkpoint.Root, enc)
 	})
 }
 
+// putJustifiedCheckpoint writes the encoded justified checkpoint in the transaction.
+func putJustifiedCheckpoint(ctx context.Context, tx *bolt.Tx, root []byte, enc []byte) error {
+	bucket := tx.Bucket(checkpointBucket)
+	if featureconfig.Get().NewStateMgmt {
+		if tx.Bucket(stateSummaryBucket).Get(root) == nil {
+			return errors.New("missing state summary for finalized root")
+		}
+	} else {
+		// The corresponding state must exist or there is a risk that the beacondb enters a state
+		// where the justified beaconState is missing. This may be a fatal condition requiring
+		// a new sync from genesis.
+		if tx.Bucket(stateBucket).Get(root) == nil {
+			traceutil.AnnotateError(trace.FromContext(ctx), errMissingStateForCheckpoint)
+			return errMissingStateForCheckpoint
+		}
+	}
+	return bucket.Put(justifiedCheckpointKey, enc)
+}
+
 // SaveFinalizedCheckpoint saves finalized checkpoint in beacon chain.
 func (k *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveFinalizedCheckpoint")
@@ -91,24 +96,30 @@ func (k *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 		return err
 	}
 	return k.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(checkpointBucket)
-		if featureconfig.Get().NewStateMgmt {
-			if tx.Bucket(stateSummaryBucket).Get(checkpoint.Root) == nil {
-				return errors.New("missing state summary for finalized root")
-			}
-		} else {
-			// The corresponding state must exist or there is a risk that the beacondb enters a state
-			// where the finalized beaconState is missing. This would be a fatal condition requiring
-			// a new sync from genesis.
-			if tx.Bucket(stateBucket).Get(checkpoint.Root) == nil {
-				traceutil.AnnotateError(span, errMissingStateForCheckpoint)
-				return errMissingStateForCheckpoint
-			}
-		}
+		return k.putFinalizedCheckpoint(ctx, tx, checkpoint, enc)
+	})
+}
 
-		if err := bucket.Put(finalizedCheckpointKey, enc); err != nil {
-			return err
+// putFinalizedCheckpoint writes the encoded finalized checkpoint in the transaction, and updates
+// the finalized block roots index.
+func (k *Store) putFinalizedCheckpoint(ctx context.Context, tx *bolt.Tx, checkpoint *ethpb.Checkpoint, enc []byte) error {
+	bucket := tx.Bucket(checkpointBucket)
+	if featureconfig.Get().NewStateMgmt {
+		if tx.Bucket(stateSummaryBucket).Get(checkpoint.Root) == nil {
+			return errors.New("missing state summary for finalized root")
 		}
-		return k.updateFinalizedBlockRoots(ctx, tx, checkpoint)
-	})
+	} else {
+		// The corresponding state must exist or there is a risk that the beacondb enters a state
+		// where the finalized beaconState is missing. This would be a fatal condition requiring
+		// a new sync from genesis.
+		if tx.Bucket(stateBucket).Get(checkpoint.Root) == nil {
+			traceutil.AnnotateError(trace.FromContext(ctx), errMissingStateForCheckpoint)
+			return errMissingStateForCheckpoint
+		}
+	}
+
+	if err := bucket.Put(finalizedCheckpointKey, enc); err != nil {
+		return err
+	}
+	return k.updateFinalizedBlockRoots(ctx, tx, checkpoint)
 }
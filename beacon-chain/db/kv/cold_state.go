@@ -0,0 +1,85 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveColdState saves an archived point state in the cold state database, or in the main
+// database when the cold states are not stored apart.
+func (k *Store) SaveColdState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveColdState")
+	defer span.End()
+
+	if k.coldDB == nil {
+		return k.SaveState(ctx, st, blockRoot)
+	}
+	if st == nil {
+		return errors.New("nil state")
+	}
+	enc, err := encodeState(st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	return k.coldDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateBucket).Put(blockRoot[:], enc)
+	})
+}
+
+// MoveStateToCold moves the state of the block root from the main database to the cold state
+// database. It's a no-op when the cold states are not stored apart, or the state is not in the
+// main database.
+func (k *Store) MoveStateToCold(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.MoveStateToCold")
+	defer span.End()
+
+	if k.coldDB == nil {
+		return nil
+	}
+	var enc []byte
+	if err := k.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(stateBucket).Get(blockRoot[:]); v != nil {
+			enc = make([]byte, len(v))
+			copy(enc, v)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if enc == nil {
+		return nil
+	}
+	// The state is only deleted from the main database once it's saved in the cold one, a stop in
+	// between leaves a copy in both which is harmless.
+	if err := k.coldDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateBucket).Put(blockRoot[:], enc)
+	}); err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		return k.deleteState(ctx, tx, blockRoot)
+	})
+}
+
+// viewColdStates runs f with the state bucket of the cold state database in a read-only
+// transaction, or with a nil bucket when the cold states are not stored apart.
+func (k *Store) viewColdStates(f func(coldBkt *bolt.Bucket) error) error {
+	if k.coldDB == nil {
+		return f(nil)
+	}
+	return k.coldDB.View(func(tx *bolt.Tx) error {
+		return f(tx.Bucket(stateBucket))
+	})
+}
+
+// stateDiffDB returns the database holding the cold state diffs.
+func (k *Store) stateDiffDB() *bolt.DB {
+	if k.coldDB != nil {
+		return k.coldDB
+	}
+	return k.db
+}
@@ -0,0 +1,232 @@
+package kv
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupColdStorageDB(t *testing.T) (*Store, string) {
+	dir, err := ioutil.TempDir("", "beacondb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	coldDir := path.Join(dir, "cold")
+	db, err := NewKVStoreWithColdStorage(path.Join(dir, "hot"), coldDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, dir
+}
+
+func TestStore_SaveColdState_StoredApart(t *testing.T) {
+	db, dir := setupColdStorageDB(t)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	ctx := context.Background()
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	r, err := stateutil.BlockRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveColdState(ctx, st, r); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path.Join(dir, "cold", coldDatabaseFileName)); err != nil {
+		t.Fatalf("Expected cold state database file: %v", err)
+	}
+	if !db.HasState(ctx, r) {
+		t.Fatal("Expected cold state to be found")
+	}
+	saved, err := db.State(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved == nil || saved.Slot() != 64 {
+		t.Fatalf("Wanted cold state at slot 64, received %v", saved)
+	}
+	states, err := db.HighestSlotStatesBelow(ctx, 65)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(states) == 0 || states[0].Slot() != 64 {
+		t.Errorf("Wanted highest state below slot 65 at slot 64, received %v", states)
+	}
+}
+
+func TestStore_MoveStateToCold(t *testing.T) {
+	db, dir := setupColdStorageDB(t)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	ctx := context.Background()
+
+	r := [32]byte{'A'}
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, r); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.MoveStateToCold(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	var inHot bool
+	if err := db.db.View(func(tx *bolt.Tx) error {
+		inHot = tx.Bucket(stateBucket).Get(r[:]) != nil
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if inHot {
+		t.Error("Expected state to be deleted from the main database")
+	}
+	if !db.HasState(ctx, r) {
+		t.Error("Expected moved state to be found in the cold database")
+	}
+}
+
+func TestStore_StateDiff_StoredApart(t *testing.T) {
+	db, dir := setupColdStorageDB(t)
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	ctx := context.Background()
+
+	if err := db.SaveStateDiff(ctx, 64, &pb.StateDiff{BaseSlot: 32}); err != nil {
+		t.Fatal(err)
+	}
+	var inCold bool
+	if err := db.coldDB.View(func(tx *bolt.Tx) error {
+		inCold = tx.Bucket(stateDiffsBucket).Get(uint64ToBytes(64)) != nil
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !inCold {
+		t.Error("Expected state diff to be saved in the cold database")
+	}
+	diff, err := db.StateDiff(ctx, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.BaseSlot != 32 {
+		t.Errorf("Wanted diff with base slot 32, received %v", diff)
+	}
+}
+
+func TestStore_BackupRestore_CoversColdStates(t *testing.T) {
+	db, dir := setupColdStorageDB(t)
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	ctx := context.Background()
+
+	head := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	if err := db.SaveBlock(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	r, err := stateutil.BlockRoot(head.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, r); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	coldRoot := [32]byte{'C'}
+	coldState, err := state.InitializeFromProto(&pb.BeaconState{Slot: 32})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveColdState(ctx, coldState, coldRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := db.DatabaseStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, b := range stats.Buckets {
+		if b.Name == "cold/"+string(stateBucket) {
+			found = b.Keys == 1
+		}
+	}
+	if !found {
+		t.Errorf("Wanted the cold state bucket with 1 key in the stats, received %v", stats.Buckets)
+	}
+
+	backupPath, err := db.Backup(ctx, path.Join(dir, "backups"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	restoredDir, restoredColdDir := path.Join(dir, "restored"), path.Join(dir, "restored-cold")
+	if err := Restore(ctx, backupPath, restoredDir, restoredColdDir); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Compact(ctx, restoredDir, restoredColdDir); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := NewKVStoreWithColdStorage(restoredDir, restoredColdDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := restored.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	saved, err := restored.State(ctx, coldRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved == nil || saved.Slot() != 32 {
+		t.Errorf("Wanted restored cold state at slot 32, received %v", saved)
+	}
+}
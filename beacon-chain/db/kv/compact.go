@@ -0,0 +1,166 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// compactTxSize is the number of bytes of keys and values copied per transaction when compacting
+// the database, so large buckets such as the states one aren't copied in a single transaction.
+const compactTxSize = 64 * 1024 * 1024
+
+// Compact rewrites the database in the directory path into a new file holding only the pages in
+// use, then replaces the database file with it. Bolt never shrinks its file, the pages freed by
+// pruning are only reused by later writes, so compacting after pruning is how the disk space is
+// reclaimed. The cold state database in the cold directory path is compacted too when the path
+// isn't empty. It returns the size of the database files before and after compaction.
+//
+// Compaction is offline only: the database can't be in use, compacting the database of a running
+// beacon node fails to obtain its lock.
+func Compact(ctx context.Context, dirPath string, coldDirPath string) (int64, int64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Compact")
+	defer span.End()
+
+	before, after, err := compactFile(ctx, path.Join(dirPath, databaseFileName))
+	if err != nil || coldDirPath == "" {
+		return before, after, err
+	}
+	coldBefore, coldAfter, err := compactFile(ctx, path.Join(coldDirPath, coldDatabaseFileName))
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not compact cold state database")
+	}
+	return before + coldBefore, after + coldAfter, nil
+}
+
+// compactFile compacts the database file, see Compact.
+func compactFile(ctx context.Context, datafile string) (int64, int64, error) {
+	info, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	src, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return 0, 0, errors.New("cannot obtain database lock, stop the beacon node before compacting its database")
+		}
+		return 0, 0, err
+	}
+	compactedFile := datafile + ".compact"
+	if err := os.Remove(compactedFile); err != nil && !os.IsNotExist(err) {
+		return 0, 0, errors.Wrap(err, "could not remove previous compaction file")
+	}
+	dst, err := bolt.Open(compactedFile, 0600, nil)
+	if err != nil {
+		if closeErr := src.Close(); closeErr != nil {
+			logrus.WithField("prefix", "db").WithError(closeErr).Error("Could not close database")
+		}
+		return 0, 0, err
+	}
+
+	err = src.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return compactBucket(ctx, dst, name, b)
+		})
+	})
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := src.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := os.Remove(compactedFile); rmErr != nil {
+			logrus.WithField("prefix", "db").WithError(rmErr).Error("Could not remove compaction file")
+		}
+		return 0, 0, errors.Wrap(err, "could not compact database")
+	}
+
+	if err := os.Rename(compactedFile, datafile); err != nil {
+		return 0, 0, errors.Wrap(err, "could not replace database with compacted database")
+	}
+	compactedInfo, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	return info.Size(), compactedInfo.Size(), nil
+}
+
+// compactBucket copies the top level bucket to the destination database, committing every
+// compactTxSize bytes. Pages are filled completely as keys are inserted in order.
+func compactBucket(ctx context.Context, dst *bolt.DB, name []byte, src *bolt.Bucket) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Rolling back the committed transaction is a no-op.
+		_ = tx.Rollback()
+	}()
+	b, err := tx.CreateBucket(name)
+	if err != nil {
+		return err
+	}
+	b.FillPercent = 1.0
+	if err := b.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+
+	size := 0
+	c := src.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if size > compactTxSize {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+			if tx, err = dst.Begin(true); err != nil {
+				return err
+			}
+			b = tx.Bucket(name)
+			b.FillPercent = 1.0
+			size = 0
+		}
+		// Values of nested buckets are nil, they are copied whole.
+		if v == nil {
+			nested, err := b.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			if err := copyNestedBucket(nested, src.Bucket(k)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := b.Put(k, v); err != nil {
+			return err
+		}
+		size += len(k) + len(v)
+	}
+	return tx.Commit()
+}
+
+func copyNestedBucket(dst *bolt.Bucket, src *bolt.Bucket) error {
+	dst.FillPercent = 1.0
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+	return src.ForEach(func(k []byte, v []byte) error {
+		if v == nil {
+			nested, err := dst.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return copyNestedBucket(nested, src.Bucket(k))
+		}
+		return dst.Put(k, v)
+	})
+}
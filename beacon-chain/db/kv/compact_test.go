@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+)
+
+func TestCompact_ReclaimsDeletedBlocks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blocks := make([]*eth.SignedBeaconBlock, 2000)
+	roots := make([][32]byte, len(blocks))
+	for i := range blocks {
+		blocks[i] = &eth.SignedBeaconBlock{
+			Block:     &eth.BeaconBlock{Slot: uint64(i)},
+			Signature: make([]byte, 96),
+		}
+		r, err := ssz.HashTreeRoot(blocks[i].Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[i] = r
+	}
+	if err := db.SaveBlocks(ctx, blocks); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteBlocks(ctx, roots[1:]); err != nil {
+		t.Fatal(err)
+	}
+
+	// Compacting fails while the database is in use.
+	dirPath := db.DatabasePath()
+	if _, _, err := Compact(ctx, dirPath, ""); err == nil || !strings.Contains(err.Error(), "cannot obtain database lock") {
+		t.Errorf("Expected a lock error compacting an open database, received %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	before, after, err := Compact(ctx, dirPath, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after >= before {
+		t.Errorf("Wanted the compacted database to be smaller than %d bytes, received %d", before, after)
+	}
+
+	db, err = NewKVStore(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, db)
+	received, err := db.Block(ctx, roots[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(received, blocks[0]) {
+		t.Errorf("Wanted %v, received %v", blocks[0], received)
+	}
+	if db.HasBlock(ctx, roots[1]) {
+		t.Error("Deleted block should not be in the compacted database")
+	}
+}
@@ -1,6 +1,7 @@
 package kv
 
 import (
+	"context"
 	"os"
 	"path"
 	"sync"
@@ -22,7 +23,9 @@ const (
 	// NumOfVotes specifies the vote cache size.
 	NumOfVotes       = 1 << 20
 	databaseFileName = "beaconchain.db"
-	boltAllocSize    = 8 * 1024 * 1024
+	// coldDatabaseFileName is the file holding the cold states when they are stored apart.
+	coldDatabaseFileName = "beaconchain-cold.db"
+	boltAllocSize        = 8 * 1024 * 1024
 )
 
 // BlockCacheSize specifies 1000 slots worth of blocks cached, which
@@ -33,7 +36,9 @@ var BlockCacheSize = int64(1 << 21)
 // using BoltDB as the underlying persistent kv-store for eth2.
 type Store struct {
 	db                  *bolt.DB
+	coldDB              *bolt.DB // Nil unless the cold states are stored in a separate database.
 	databasePath        string
+	coldDatabasePath    string
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSlotBitLock    sync.Mutex
@@ -44,18 +49,19 @@ type Store struct {
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(dirPath string) (*Store, error) {
-	if err := os.MkdirAll(dirPath, 0700); err != nil {
-		return nil, err
-	}
-	datafile := path.Join(dirPath, databaseFileName)
-	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
+	return NewKVStoreWithColdStorage(dirPath, "")
+}
+
+// NewKVStoreWithColdStorage initializes a new boltDB key-value store like NewKVStore, except the
+// cold states, which are the archived point states and the state diffs, are stored in a separate
+// database at the cold directory path. This lets the bulk of the historical data live on a
+// different volume than the hot data. The cold states are kept in the main database when the cold
+// directory path is empty.
+func NewKVStoreWithColdStorage(dirPath string, coldDirPath string) (*Store, error) {
+	boltDB, err := openBoltDB(dirPath, databaseFileName)
 	if err != nil {
-		if err == bolt.ErrTimeout {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -97,10 +103,13 @@ func NewKVStore(dirPath string) (*Store, error) {
 			archivedCommitteeInfoBucket,
 			archivedBalancesBucket,
 			archivedValidatorParticipationBucket,
+			archivedCheckpointsBucket,
 			powchainBucket,
 			stateSummaryBucket,
 			archivedIndexRootBucket,
 			slotsHasObjectBucket,
+			stateDiffsBucket,
+			softDeletedStatesBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -116,25 +125,71 @@ func NewKVStore(dirPath string) (*Store, error) {
 	}); err != nil {
 		return nil, err
 	}
+	if err := kv.runMigrations(context.Background()); err != nil {
+		return nil, err
+	}
+
+	if coldDirPath != "" {
+		kv.coldDatabasePath = coldDirPath
+		if kv.coldDB, err = openBoltDB(coldDirPath, coldDatabaseFileName); err != nil {
+			return nil, errors.Wrap(err, "could not open cold state database")
+		}
+		if err := kv.coldDB.Update(func(tx *bolt.Tx) error {
+			return createBuckets(tx, stateBucket, stateDiffsBucket)
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	err = prometheus.Register(createBoltCollector(kv.db))
 
 	return kv, err
 }
 
+// This opens the bolt database file in the directory path, creating the directory if needed.
+func openBoltDB(dirPath string, fileName string) (*bolt.DB, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, fileName)
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	boltDB.AllocSize = boltAllocSize
+	return boltDB, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (k *Store) ClearDB() error {
 	if _, err := os.Stat(k.databasePath); os.IsNotExist(err) {
 		return nil
 	}
 	prometheus.Unregister(createBoltCollector(k.db))
+	if k.coldDB != nil {
+		if err := os.Remove(path.Join(k.coldDatabasePath, coldDatabaseFileName)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
 	return os.Remove(path.Join(k.databasePath, databaseFileName))
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying BoltDB databases. Both databases are closed even if closing the
+// cold one fails, the first error is returned.
 func (k *Store) Close() error {
 	prometheus.Unregister(createBoltCollector(k.db))
-	return k.db.Close()
+	var coldErr error
+	if k.coldDB != nil {
+		coldErr = k.coldDB.Close()
+	}
+	err := k.db.Close()
+	if coldErr != nil {
+		return errors.Wrap(coldErr, "could not close cold database")
+	}
+	return err
 }
 
 // DatabasePath at which this database writes files.
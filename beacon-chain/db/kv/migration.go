@@ -0,0 +1,125 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// migration upgrades the data of the DB from the schema version before it to its own, such as
+// re-encoding the values of a bucket after the encoding of its keys or values changed.
+type migration struct {
+	name    string
+	migrate func(ctx context.Context, tx *bolt.Tx) error
+}
+
+// migrations upgrade the DB schema in order, migrations[i] upgrades the schema from version i to
+// version i+1. A migration is appended whenever a change to the stored data would otherwise
+// require resyncing with --clear-db, migrations must never be removed or reordered.
+var migrations = []migration{}
+
+// latestSchemaVersion is the schema version of the data written by this version of the node.
+func latestSchemaVersion() uint64 {
+	return uint64(len(migrations))
+}
+
+// runMigrations brings the DB up to the latest schema version on startup. Each migration runs in
+// its own transaction along with the update of the schema version, so a node stopped in the
+// middle of the migrations resumes with the first migration not applied. A new DB is written with
+// the latest schema and starts at the latest version, while an existing DB without a schema
+// version starts at version 0.
+func (k *Store) runMigrations(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.runMigrations")
+	defer span.End()
+
+	version, err := k.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version > latestSchemaVersion() {
+		return fmt.Errorf(
+			"database schema version %d is newer than the version %d supported by this node",
+			version,
+			latestSchemaVersion(),
+		)
+	}
+	if !k.hasSchemaVersion() {
+		empty, err := k.isEmpty()
+		if err != nil {
+			return err
+		}
+		if empty {
+			return k.saveSchemaVersion(latestSchemaVersion())
+		}
+	}
+
+	log := logrus.WithField("prefix", "db")
+	for ; version < latestSchemaVersion(); version++ {
+		m := migrations[version]
+		log.WithFields(logrus.Fields{
+			"migration": m.name,
+			"version":   version + 1,
+		}).Info("Running database migration")
+		if err := k.db.Update(func(tx *bolt.Tx) error {
+			if err := m.migrate(ctx, tx); err != nil {
+				return err
+			}
+			return tx.Bucket(migrationBucket).Put(schemaVersionKey, uint64ToBytes(version+1))
+		}); err != nil {
+			return errors.Wrapf(err, "could not run database migration %s", m.name)
+		}
+	}
+	return nil
+}
+
+// SchemaVersion returns the schema version of the DB, 0 if it was never set.
+func (k *Store) SchemaVersion(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SchemaVersion")
+	defer span.End()
+
+	var version uint64
+	err := k.db.View(func(tx *bolt.Tx) error {
+		if enc := tx.Bucket(migrationBucket).Get(schemaVersionKey); enc != nil {
+			version = binary.LittleEndian.Uint64(enc)
+		}
+		return nil
+	})
+	return version, err
+}
+
+// This returns true if the schema version of the DB was set.
+func (k *Store) hasSchemaVersion() bool {
+	var has bool
+	// #nosec G104. Always returns nil.
+	k.db.View(func(tx *bolt.Tx) error {
+		has = tx.Bucket(migrationBucket).Get(schemaVersionKey) != nil
+		return nil
+	})
+	return has
+}
+
+// This saves the schema version of the DB.
+func (k *Store) saveSchemaVersion(version uint64) error {
+	return k.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationBucket).Put(schemaVersionKey, uint64ToBytes(version))
+	})
+}
+
+// This returns true if no block or state was ever saved in the DB.
+func (k *Store) isEmpty() (bool, error) {
+	empty := true
+	err := k.db.View(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{blocksBucket, stateBucket} {
+			if key, _ := tx.Bucket(bucket).Cursor().First(); key != nil {
+				empty = false
+			}
+		}
+		return nil
+	})
+	return empty, err
+}
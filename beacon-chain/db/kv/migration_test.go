@@ -0,0 +1,98 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_RunMigrations_NewDBAtLatestVersion(t *testing.T) {
+	saved := migrations
+	defer func() { migrations = saved }()
+	migrations = []migration{{
+		name: "fail",
+		migrate: func(_ context.Context, _ *bolt.Tx) error {
+			return errors.New("migration should not run on a new database")
+		},
+	}}
+
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	version, err := db.SchemaVersion(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 1 {
+		t.Errorf("Wanted schema version 1, received %d", version)
+	}
+}
+
+func TestStore_RunMigrations_InOrder(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// An existing database written before the schema version was tracked.
+	if err := db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationBucket).Delete(schemaVersionKey)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	saved := migrations
+	defer func() { migrations = saved }()
+	var ran []string
+	wantedErr := errors.New("failed")
+	fail := true
+	migrations = []migration{
+		{name: "first", migrate: func(_ context.Context, _ *bolt.Tx) error {
+			ran = append(ran, "first")
+			return nil
+		}},
+		{name: "second", migrate: func(_ context.Context, _ *bolt.Tx) error {
+			if fail {
+				return wantedErr
+			}
+			ran = append(ran, "second")
+			return nil
+		}},
+	}
+
+	if err := db.runMigrations(ctx); err == nil {
+		t.Fatal("Expected failed migration to return an error")
+	}
+	if version, err := db.SchemaVersion(ctx); err != nil || version != 1 {
+		t.Fatalf("Wanted schema version 1 after the failed migration, received %d: %v", version, err)
+	}
+
+	// The first migration is not run again once the failed one is fixed.
+	fail = false
+	if err := db.runMigrations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(ran) != 2 || ran[0] != "first" || ran[1] != "second" {
+		t.Errorf("Wanted migrations run once in order, received %v", ran)
+	}
+	if version, err := db.SchemaVersion(ctx); err != nil || version != 2 {
+		t.Errorf("Wanted schema version 2, received %d: %v", version, err)
+	}
+}
+
+func TestStore_RunMigrations_RejectsNewerVersion(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.saveSchemaVersion(latestSchemaVersion() + 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.runMigrations(context.Background()); err == nil {
+		t.Error("Expected a database with a newer schema version to be rejected")
+	}
+}
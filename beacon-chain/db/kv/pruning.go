@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLastPrunedSlot saves the slot up to which non-canonical data behind finality was pruned, so
+// pruning resumes from it on restart.
+func (k *Store) SaveLastPrunedSlot(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLastPrunedSlot")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		return bucket.Put(lastPrunedSlotKey, uint64ToBytes(slot))
+	})
+}
+
+// LastPrunedSlot returns the slot up to which non-canonical data behind finality was pruned, or 0
+// if nothing was pruned yet.
+func (k *Store) LastPrunedSlot(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastPrunedSlot")
+	defer span.End()
+
+	var slot uint64
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		if enc := bucket.Get(lastPrunedSlotKey); enc != nil {
+			slot = binary.LittleEndian.Uint64(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
@@ -0,0 +1,30 @@
+package kv
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStore_LastPrunedSlot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slot, err := db.LastPrunedSlot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 0 {
+		t.Errorf("Wanted last pruned slot 0, received %d", slot)
+	}
+	if err := db.SaveLastPrunedSlot(ctx, 640); err != nil {
+		t.Fatal(err)
+	}
+	slot, err = db.LastPrunedSlot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 640 {
+		t.Errorf("Wanted last pruned slot 640, received %d", slot)
+	}
+}
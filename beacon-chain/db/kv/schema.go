@@ -21,9 +21,12 @@ var (
 	archivedCommitteeInfoBucket          = []byte("archived-committee-info")
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
+	archivedCheckpointsBucket            = []byte("archived-checkpoints")
 	powchainBucket                       = []byte("powchain")
 	archivedIndexRootBucket              = []byte("archived-index-root")
 	slotsHasObjectBucket                 = []byte("slots-has-objects")
+	stateDiffsBucket                     = []byte("state-diffs")
+	softDeletedStatesBucket              = []byte("soft-deleted-states")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -45,7 +48,10 @@ var (
 	lastArchivedIndexKey      = []byte("last-archived")
 	savedBlockSlotsKey        = []byte("saved-block-slots")
 	savedStateSlotsKey        = []byte("saved-state-slots")
+	splitInfoKey              = []byte("split-info")
+	lastPrunedSlotKey         = []byte("last-pruned-slot")
+	schemaVersionKey          = []byte("schema-version")
 
-	// Migration bucket.
+	// Migration bucket, holding the schema version of the DB.
 	migrationBucket = []byte("migrations")
 )
@@ -0,0 +1,90 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SoftDeleteState moves the state of the block root out of the state bucket into the soft deleted
+// states bucket, along with the time of the deletion. The state is no longer returned by State and
+// HasState, but it can be restored with RestoreSoftDeletedState until it's purged by
+// PurgeSoftDeletedStates.
+func (k *Store) SoftDeleteState(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SoftDeleteState")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		// The encoded state is only valid for the life of the transaction, it's copied before the
+		// state is deleted.
+		value := make([]byte, 8, 8+len(enc))
+		binary.LittleEndian.PutUint64(value, uint64(time.Now().Unix()))
+		value = append(value, enc...)
+		if err := tx.Bucket(softDeletedStatesBucket).Put(blockRoot[:], value); err != nil {
+			return err
+		}
+		return k.deleteState(ctx, tx, blockRoot)
+	})
+}
+
+// RestoreSoftDeletedState moves the soft deleted state of the block root back to the state bucket.
+func (k *Store) RestoreSoftDeletedState(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RestoreSoftDeletedState")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(softDeletedStatesBucket)
+		value := bkt.Get(blockRoot[:])
+		if value == nil {
+			return errors.New("no soft deleted state for block root")
+		}
+		enc := make([]byte, len(value)-8)
+		copy(enc, value[8:])
+		s, err := createState(enc)
+		if err != nil {
+			return err
+		}
+		if err := k.putState(ctx, tx, s.Slot, blockRoot, enc); err != nil {
+			return err
+		}
+		return bkt.Delete(blockRoot[:])
+	})
+}
+
+// PurgeSoftDeletedStates permanently deletes the states soft deleted before the input time, and
+// returns the number of states purged.
+func (k *Store) PurgeSoftDeletedStates(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PurgeSoftDeletedStates")
+	defer span.End()
+
+	purged := 0
+	err := k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(softDeletedStatesBucket)
+		// Deleting with the cursor while iterating skips the key following each deleted one, the
+		// expired keys are collected first and deleted afterwards.
+		var expired [][]byte
+		c := bkt.Cursor()
+		for key, v := c.First(); key != nil; key, v = c.Next() {
+			if len(v) < 8 || int64(binary.LittleEndian.Uint64(v[:8])) >= deletedBefore.Unix() {
+				continue
+			}
+			expired = append(expired, append([]byte{}, key...))
+		}
+		for _, key := range expired {
+			if err := bkt.Delete(key); err != nil {
+				return err
+			}
+			purged++
+		}
+		return nil
+	})
+	return purged, err
+}
@@ -0,0 +1,115 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestStore_SoftDeleteState_CanRestore(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	r := [32]byte{'A'}
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, r); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.SoftDeleteState(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	if db.HasState(ctx, r) {
+		t.Fatal("Expected soft deleted state to not be returned")
+	}
+
+	if err := db.RestoreSoftDeletedState(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := db.State(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored == nil || restored.Slot() != 100 {
+		t.Fatalf("Wanted restored state at slot 100, received %v", restored)
+	}
+	if err := db.RestoreSoftDeletedState(ctx, r); err == nil {
+		t.Error("Expected restoring a state twice to fail")
+	}
+}
+
+func TestStore_PurgeSoftDeletedStates(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	r := [32]byte{'A'}
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, r); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SoftDeleteState(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	purged, err := db.PurgeSoftDeletedStates(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 0 {
+		t.Fatalf("Wanted no state purged within the window, received %d", purged)
+	}
+	purged, err = db.PurgeSoftDeletedStates(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Fatalf("Wanted 1 state purged, received %d", purged)
+	}
+	if err := db.RestoreSoftDeletedState(ctx, r); err == nil {
+		t.Error("Expected restoring a purged state to fail")
+	}
+}
+
+func TestStore_PurgeSoftDeletedStates_PurgesConsecutiveKeys(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	roots := [][32]byte{{'A'}, {'B'}, {'C'}, {'D'}}
+	for i, r := range roots {
+		st, err := state.InitializeFromProto(&pb.BeaconState{Slot: uint64(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, st, r); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SoftDeleteState(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	purged, err := db.PurgeSoftDeletedStates(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != len(roots) {
+		t.Fatalf("Wanted %d states purged, received %d", len(roots), purged)
+	}
+	for _, r := range roots {
+		if err := db.RestoreSoftDeletedState(ctx, r); err == nil {
+			t.Errorf("Expected state of root %#x to be purged", r)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveSplitInfo saves the slot and block root splitting the hot and cold sections of the DB.
+// This is used for cold state management, so the split point survives restarts.
+func (k *Store) SaveSplitInfo(ctx context.Context, slot uint64, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveSplitInfo")
+	defer span.End()
+
+	enc := append(uint64ToBytes(slot), blockRoot[:]...)
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		return bucket.Put(splitInfoKey, enc)
+	})
+}
+
+// SplitInfo returns the saved slot and block root splitting the hot and cold sections of the DB.
+// It returns a zero slot and root if no split info has been saved.
+func (k *Store) SplitInfo(ctx context.Context) (uint64, [32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SplitInfo")
+	defer span.End()
+
+	var enc []byte
+	// #nosec G104. Always returns nil.
+	k.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		enc = bytesutil.SafeCopyBytes(bucket.Get(splitInfoKey))
+		return nil
+	})
+	if enc == nil {
+		return 0, [32]byte{}, nil
+	}
+	if len(enc) != 8+32 {
+		return 0, [32]byte{}, errors.Errorf("invalid split info length %d", len(enc))
+	}
+	return binary.LittleEndian.Uint64(enc[:8]), bytesutil.ToBytes32(enc[8:]), nil
+}
+
+// HasSplitInfo returns true if the split info exists in DB.
+func (k *Store) HasSplitInfo(ctx context.Context) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasSplitInfo")
+	defer span.End()
+	var exists bool
+	// #nosec G104. Always returns nil.
+	k.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		exists = bucket.Get(splitInfoKey) != nil
+		return nil
+	})
+	return exists
+}
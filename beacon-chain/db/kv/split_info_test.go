@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSplitInfo_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	if db.HasSplitInfo(ctx) {
+		t.Fatal("Should not have split info")
+	}
+	slot, root, err := db.SplitInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 0 || root != [32]byte{} {
+		t.Errorf("Wanted zero split info, received slot %d root %#x", slot, root)
+	}
+
+	wantedRoot := [32]byte{'A'}
+	if err := db.SaveSplitInfo(ctx, 100, wantedRoot); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasSplitInfo(ctx) {
+		t.Fatal("Should have split info")
+	}
+	slot, root, err = db.SplitInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 100 || root != wantedRoot {
+		t.Errorf("Wanted slot 100 root %#x, received slot %d root %#x", wantedRoot, slot, root)
+	}
+}
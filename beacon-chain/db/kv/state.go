@@ -22,16 +22,21 @@ func (k *Store) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconSta
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.State")
 	defer span.End()
 	var s *pb.BeaconState
-	err := k.db.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(stateBucket)
-		enc := bucket.Get(blockRoot[:])
-		if enc == nil {
-			return nil
-		}
+	err := k.viewColdStates(func(coldBkt *bolt.Bucket) error {
+		return k.db.View(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(stateBucket)
+			enc := bucket.Get(blockRoot[:])
+			if enc == nil && coldBkt != nil {
+				enc = coldBkt.Get(blockRoot[:])
+			}
+			if enc == nil {
+				return nil
+			}
 
-		var err error
-		s, err = createState(enc)
-		return err
+			var err error
+			s, err = createState(enc)
+			return err
+		})
 	})
 	if err != nil {
 		return nil, err
@@ -113,20 +118,25 @@ func (k *Store) SaveState(ctx context.Context, state *state.BeaconState, blockRo
 	if state == nil {
 		return errors.New("nil state")
 	}
-	enc, err := encode(state.InnerStateUnsafe())
+	enc, err := encodeState(state.InnerStateUnsafe())
 	if err != nil {
 		return err
 	}
 
 	return k.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(stateBucket)
-		if err := bucket.Put(blockRoot[:], enc); err != nil {
-			return err
-		}
-		return k.setStateSlotBitField(ctx, tx, state.Slot())
+		return k.putState(ctx, tx, state.Slot(), blockRoot, enc)
 	})
 }
 
+// putState writes the encoded state of the slot in the transaction.
+func (k *Store) putState(ctx context.Context, tx *bolt.Tx, slot uint64, blockRoot [32]byte, enc []byte) error {
+	bucket := tx.Bucket(stateBucket)
+	if err := bucket.Put(blockRoot[:], enc); err != nil {
+		return err
+	}
+	return k.setStateSlotBitField(ctx, tx, slot)
+}
+
 // SaveStates stores multiple states to the db using the provided corresponding roots.
 func (k *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStates")
@@ -137,20 +147,15 @@ func (k *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blo
 	var err error
 	multipleEncs := make([][]byte, len(states))
 	for i, st := range states {
-		multipleEncs[i], err = encode(st.InnerStateUnsafe())
+		multipleEncs[i], err = encodeState(st.InnerStateUnsafe())
 		if err != nil {
 			return err
 		}
 	}
 
 	return k.db.Update(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(stateBucket)
 		for i, rt := range blockRoots {
-			if err := k.setStateSlotBitField(ctx, tx, states[i].Slot()); err != nil {
-				return err
-			}
-			err = bucket.Put(rt[:], multipleEncs[i])
-			if err != nil {
+			if err := k.putState(ctx, tx, states[i].Slot(), rt, multipleEncs[i]); err != nil {
 				return err
 			}
 		}
@@ -169,6 +174,13 @@ func (k *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 		exists = bucket.Get(blockRoot[:]) != nil
 		return nil
 	})
+	if !exists {
+		// #nosec G104. Always returns nil.
+		k.viewColdStates(func(coldBkt *bolt.Bucket) error {
+			exists = coldBkt != nil && coldBkt.Get(blockRoot[:]) != nil
+			return nil
+		})
+	}
 	return exists
 }
 
@@ -178,43 +190,49 @@ func (k *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	defer span.End()
 
 	return k.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
+		return k.deleteState(ctx, tx, blockRoot)
+	})
+}
 
-		bkt = tx.Bucket(checkpointBucket)
-		enc := bkt.Get(finalizedCheckpointKey)
-		checkpoint := &ethpb.Checkpoint{}
-		if enc == nil {
-			checkpoint = &ethpb.Checkpoint{Root: genesisBlockRoot}
-		} else if err := decode(enc, checkpoint); err != nil {
-			return err
-		}
+// deleteState deletes the state of the block root and clears its slot from the saved state slots
+// within the given write transaction.
+func (k *Store) deleteState(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	bkt := tx.Bucket(blocksBucket)
+	genesisBlockRoot := bkt.Get(genesisBlockRootKey)
 
-		bkt = tx.Bucket(blocksBucket)
-		headBlkRoot := bkt.Get(headBlockRootKey)
+	bkt = tx.Bucket(checkpointBucket)
+	enc := bkt.Get(finalizedCheckpointKey)
+	checkpoint := &ethpb.Checkpoint{}
+	if enc == nil {
+		checkpoint = &ethpb.Checkpoint{Root: genesisBlockRoot}
+	} else if err := decode(enc, checkpoint); err != nil {
+		return err
+	}
 
-		if featureconfig.Get().NewStateMgmt {
-			if tx.Bucket(stateSummaryBucket).Get(blockRoot[:]) == nil {
-				return errors.New("cannot delete state without state summary")
-			}
-		} else {
-			// Safe guard against deleting genesis, finalized, head state.
-			if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
-				return errors.New("cannot delete genesis, finalized, or head state")
-			}
-		}
+	bkt = tx.Bucket(blocksBucket)
+	headBlkRoot := bkt.Get(headBlockRootKey)
 
-		slot, err := slotByBlockRoot(ctx, tx, blockRoot[:])
-		if err != nil {
-			return err
+	if featureconfig.Get().NewStateMgmt {
+		if tx.Bucket(stateSummaryBucket).Get(blockRoot[:]) == nil {
+			return errors.New("cannot delete state without state summary")
 		}
-		if err := k.clearStateSlotBitField(ctx, tx, slot); err != nil {
-			return err
+	} else {
+		// Safe guard against deleting genesis, finalized, head state.
+		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
+			return errors.New("cannot delete genesis, finalized, or head state")
 		}
+	}
 
-		bkt = tx.Bucket(stateBucket)
-		return bkt.Delete(blockRoot[:])
-	})
+	slot, err := slotByBlockRoot(ctx, tx, blockRoot[:])
+	if err != nil {
+		return err
+	}
+	if err := k.clearStateSlotBitField(ctx, tx, slot); err != nil {
+		return err
+	}
+
+	bkt = tx.Bucket(stateBucket)
+	return bkt.Delete(blockRoot[:])
 }
 
 // DeleteStates by block roots.
@@ -282,8 +300,7 @@ func (k *Store) DeleteStates(ctx context.Context, blockRoots [][32]byte) error {
 
 // creates state from marshaled proto state bytes.
 func createState(enc []byte) (*pb.BeaconState, error) {
-	protoState := &pb.BeaconState{}
-	err := decode(enc, protoState)
+	protoState, err := decodeState(enc)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal encoding")
 	}
@@ -348,7 +365,7 @@ func (k *Store) HighestSlotStates(ctx context.Context) ([]*state.BeaconState, er
 	}
 
 	if len(states) == 0 {
-		return nil, errors.New("could not get one state")
+		return nil, iface.ErrStateNotFound
 	}
 
 	return states, nil
@@ -358,28 +375,64 @@ func (k *Store) HighestSlotStates(ctx context.Context) ([]*state.BeaconState, er
 // from the db. Ideally there should just be one state per slot, but given validator
 // can double propose, a single slot could have multiple block roots and
 // reuslts states. This returns a list of states.
+//
+// The states are looked up by the roots of the blocks below the input slot, from the block slot
+// indices, so long stretches of skip slots below the input slot aren't scanned. States saved at
+// a skip slot, keyed by the root of an earlier block, are skipped when they are past the input slot.
+// When none is found that way, the states are looked up from the saved state slots, which only
+// returns the genesis state when it's the highest state saved below the input slot.
 func (k *Store) HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotStatesBelow")
 	defer span.End()
 	var states []*state.BeaconState
-	err := k.db.View(func(tx *bolt.Tx) error {
-		slotBkt := tx.Bucket(slotsHasObjectBucket)
-		savedSlots := slotBkt.Get(savedStateSlotsKey)
-		highestIndex, err := bytesutil.HighestBitIndexAt(savedSlots, int(slot))
-		if err != nil {
+	err := k.viewColdStates(func(coldBkt *bolt.Bucket) error {
+		return k.db.View(func(tx *bolt.Tx) error {
+			stateBkt := tx.Bucket(stateBucket)
+			err := descendBlockSlotIndices(tx, slot, func(_ uint64, roots [][]byte) (bool, error) {
+				for _, r := range roots {
+					enc := stateBkt.Get(r)
+					if enc == nil && coldBkt != nil {
+						enc = coldBkt.Get(r)
+					}
+					if enc == nil {
+						continue
+					}
+					pbState, err := createState(enc)
+					if err != nil {
+						return false, err
+					}
+					if pbState.Slot >= slot {
+						continue
+					}
+					s, err := state.InitializeFromProtoUnsafe(pbState)
+					if err != nil {
+						return false, err
+					}
+					states = append(states, s)
+				}
+				return len(states) > 0, nil
+			})
+			if err != nil || len(states) > 0 {
+				return err
+			}
+			savedSlots := tx.Bucket(slotsHasObjectBucket).Get(savedStateSlotsKey)
+			if len(savedSlots) == 0 {
+				return nil
+			}
+			highestIndex, err := bytesutil.HighestBitIndexAt(savedSlots, int(slot))
+			if err != nil {
+				return err
+			}
+			states, err = k.statesAtSlotBitfieldIndex(ctx, tx, highestIndex)
 			return err
-		}
-
-		states, err = k.statesAtSlotBitfieldIndex(ctx, tx, highestIndex)
-
-		return err
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	if len(states) == 0 {
-		return nil, errors.New("could not get one state")
+		return nil, iface.ErrStateNotFound
 	}
 
 	return states, nil
@@ -396,7 +449,7 @@ func (k *Store) statesAtSlotBitfieldIndex(ctx context.Context, tx *bolt.Tx, inde
 
 	if highestSlot == 0 {
 		gState, err := k.GenesisState(ctx)
-		if err != nil {
+		if err != nil || gState == nil {
 			return nil, err
 		}
 		return []*state.BeaconState{gState}, nil
@@ -409,8 +462,9 @@ func (k *Store) statesAtSlotBitfieldIndex(ctx context.Context, tx *bolt.Tx, inde
 		return nil, err
 	}
 
+	// The callers report no state found.
 	if len(keys) == 0 {
-		return nil, errors.New("could not get one block root to get state")
+		return nil, nil
 	}
 
 	stateBkt := tx.Bucket(stateBucket)
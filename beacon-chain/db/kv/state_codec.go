@@ -0,0 +1,91 @@
+package kv
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// versionedStatePrefix marks a state encoding tagged with its fork version. As a snappy
+// header it declares a decoded length above the 2^32-1 maximum, so no untagged encoding
+// written before fork versions were recorded can start with it.
+var versionedStatePrefix = []byte{0xff, 0xff, 0xff, 0xff, 0x7f}
+
+// stateCodec marshals the beacon states of a fork version. Hard forks changing the
+// BeaconState schema register their own codec under their fork version.
+type stateCodec interface {
+	marshal(st *pb.BeaconState) ([]byte, error)
+	unmarshal(enc []byte) (*pb.BeaconState, error)
+}
+
+// phase0StateCodec is the snappy compressed protobuf encoding of phase 0 states.
+type phase0StateCodec struct{}
+
+func (phase0StateCodec) marshal(st *pb.BeaconState) ([]byte, error) {
+	return encode(st)
+}
+
+func (phase0StateCodec) unmarshal(enc []byte) (*pb.BeaconState, error) {
+	st := &pb.BeaconState{}
+	if err := decode(enc, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// stateCodecs maps fork versions to the codec of their state schema. Versions without a
+// registered codec, including the genesis version of every network, use the phase 0 codec.
+var stateCodecs = map[[4]byte]stateCodec{}
+
+func stateCodecForVersion(version [4]byte) stateCodec {
+	if c, ok := stateCodecs[version]; ok {
+		return c
+	}
+	return phase0StateCodec{}
+}
+
+// stateForkVersion returns the current fork version of the state, the zero version if the
+// state has no fork set.
+func stateForkVersion(st *pb.BeaconState) [4]byte {
+	if st == nil || st.Fork == nil {
+		return [4]byte{}
+	}
+	return bytesutil.ToBytes4(st.Fork.CurrentVersion)
+}
+
+// encodeState encodes the state with the codec of its fork version, tagged with the version
+// so it is decoded with the same codec.
+func encodeState(st *pb.BeaconState) ([]byte, error) {
+	if st == nil {
+		return nil, errors.New("cannot encode nil state")
+	}
+	version := stateForkVersion(st)
+	enc, err := stateCodecForVersion(version).marshal(st)
+	if err != nil {
+		return nil, err
+	}
+	tagged := make([]byte, 0, len(versionedStatePrefix)+len(version)+len(enc))
+	tagged = append(tagged, versionedStatePrefix...)
+	tagged = append(tagged, version[:]...)
+	return append(tagged, enc...), nil
+}
+
+// decodeState decodes a state with the codec of the fork version it was tagged with.
+// Untagged encodings predate versioning and are decoded as phase 0 states.
+func decodeState(enc []byte) (*pb.BeaconState, error) {
+	if !bytes.HasPrefix(enc, versionedStatePrefix) {
+		return phase0StateCodec{}.unmarshal(enc)
+	}
+	enc = enc[len(versionedStatePrefix):]
+	if len(enc) < 4 {
+		return nil, errors.New("state encoding is missing its fork version")
+	}
+	version := bytesutil.ToBytes4(enc[:4])
+	st, err := stateCodecForVersion(version).unmarshal(enc[4:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode state of fork version %#x", version)
+	}
+	return st, nil
+}
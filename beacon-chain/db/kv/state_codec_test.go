@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// slotOffsetCodec is a codec of a schema differing from phase 0, standing in for a future fork.
+type slotOffsetCodec struct{}
+
+func (slotOffsetCodec) marshal(st *pb.BeaconState) ([]byte, error) {
+	cpy := proto.Clone(st).(*pb.BeaconState)
+	cpy.Slot += 1000
+	return encode(cpy)
+}
+
+func (slotOffsetCodec) unmarshal(enc []byte) (*pb.BeaconState, error) {
+	st := &pb.BeaconState{}
+	if err := decode(enc, st); err != nil {
+		return nil, err
+	}
+	st.Slot -= 1000
+	return st, nil
+}
+
+func TestEncodeState_TaggedWithForkVersion(t *testing.T) {
+	st := &pb.BeaconState{
+		Slot: 10,
+		Fork: &pb.Fork{CurrentVersion: []byte{0, 0, 0, 4}, PreviousVersion: []byte{0, 0, 0, 4}},
+	}
+	enc, err := encodeState(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(enc, append(versionedStatePrefix, 0, 0, 0, 4)) {
+		t.Errorf("Wanted encoding tagged with fork version, got prefix %#x", enc[:9])
+	}
+	decoded, err := decodeState(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(st, decoded) {
+		t.Errorf("Wanted %v, received %v", st, decoded)
+	}
+}
+
+func TestDecodeState_Untagged(t *testing.T) {
+	st := &pb.BeaconState{Slot: 10}
+	enc, err := encode(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeState(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(st, decoded) {
+		t.Errorf("Wanted %v, received %v", st, decoded)
+	}
+}
+
+func TestDecodeState_RoutesToVersionCodec(t *testing.T) {
+	version := [4]byte{1, 0, 0, 0}
+	stateCodecs[version] = slotOffsetCodec{}
+	defer delete(stateCodecs, version)
+
+	st := &pb.BeaconState{
+		Slot: 10,
+		Fork: &pb.Fork{CurrentVersion: version[:]},
+	}
+	enc, err := encodeState(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The phase 0 codec would read the schema of the new fork with the wrong slot.
+	phase0, err := phase0StateCodec{}.unmarshal(enc[len(versionedStatePrefix)+4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phase0.Slot == st.Slot {
+		t.Fatal("Expected the registered codec to be used for encoding")
+	}
+	decoded, err := decodeState(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(st, decoded) {
+		t.Errorf("Wanted %v, received %v", st, decoded)
+	}
+}
+
+func TestDecodeState_MissingVersion(t *testing.T) {
+	if _, err := decodeState(append(versionedStatePrefix, 0, 0)); err == nil {
+		t.Error("Expected error decoding a state tag without a full fork version")
+	}
+}
@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves the diff of the cold state at the input slot against its archived point
+// state. This is used for cold state management.
+func (k *Store) SaveStateDiff(ctx context.Context, slot uint64, diff *pb.StateDiff) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	enc, err := encode(diff)
+	if err != nil {
+		return err
+	}
+	return k.stateDiffDB().Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateDiffsBucket)
+		return bucket.Put(uint64ToBytes(slot), enc)
+	})
+}
+
+// StateDiff returns the diff of the cold state at the input slot, or nil if no diff was saved
+// for the slot. Diffs saved in the main database before the cold states were stored apart are
+// still returned.
+func (k *Store) StateDiff(ctx context.Context, slot uint64) (*pb.StateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var diff *pb.StateDiff
+	view := func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateDiffsBucket).Get(uint64ToBytes(slot))
+		if enc == nil {
+			return nil
+		}
+		diff = &pb.StateDiff{}
+		return decode(enc, diff)
+	}
+	if err := k.stateDiffDB().View(view); err != nil || diff != nil || k.coldDB == nil {
+		return diff, err
+	}
+	err := k.db.View(view)
+	return diff, err
+}
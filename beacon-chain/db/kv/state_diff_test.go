@@ -0,0 +1,40 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestStore_StateDiff(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	diff, err := db.StateDiff(ctx, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil {
+		t.Fatal("Expected no state diff before saving one")
+	}
+
+	want := &pb.StateDiff{
+		BaseSlot:       0,
+		Remainder:      &pb.BeaconState{Slot: 64},
+		BalancesLength: 2,
+		Balances:       []*pb.IndexedBalance{{Index: 1, Balance: 32}},
+	}
+	if err := db.SaveStateDiff(ctx, 64, want); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = db.StateDiff(ctx, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(diff, want) {
+		t.Errorf("Wanted %v, received %v", want, diff)
+	}
+}
@@ -3,7 +3,10 @@ package kv
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -23,6 +26,55 @@ func (k *Store) SaveStateSummary(ctx context.Context, summary *pb.StateSummary)
 	})
 }
 
+// SaveStateSummaries saves the state summaries to the DB in a single transaction.
+func (k *Store) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSummary) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummaries")
+	defer span.End()
+
+	encoded := make([][]byte, len(summaries))
+	for i, summary := range summaries {
+		enc, err := encode(summary)
+		if err != nil {
+			return err
+		}
+		encoded[i] = enc
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateSummaryBucket)
+		for i, summary := range summaries {
+			if err := bucket.Put(summary.Root, encoded[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SaveStateAndSummary saves a full state and its state summary to the DB in a single transaction,
+// so a crash can never leave a summary pointing at a state that was not written.
+func (k *Store) SaveStateAndSummary(ctx context.Context, st *state.BeaconState, summary *pb.StateSummary) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateAndSummary")
+	defer span.End()
+	if st == nil {
+		return errors.New("nil state")
+	}
+	stateEnc, err := encodeState(st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	summaryEnc, err := encode(summary)
+	if err != nil {
+		return err
+	}
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		if err := k.putState(ctx, tx, st.Slot(), bytesutil.ToBytes32(summary.Root), stateEnc); err != nil {
+			return err
+		}
+		return tx.Bucket(stateSummaryBucket).Put(summary.Root, summaryEnc)
+	})
+}
+
 // StateSummary returns the state summary object from the db using input block root.
 func (k *Store) StateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateSummary")
@@ -55,3 +107,20 @@ func (k *Store) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
 	})
 	return exists
 }
+
+// DeleteStateSummaries deletes the state summaries of the block roots from the DB in a single
+// transaction.
+func (k *Store) DeleteStateSummaries(ctx context.Context, blockRoots [][32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteStateSummaries")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(stateSummaryBucket)
+		for _, blockRoot := range blockRoots {
+			if err := bucket.Delete(blockRoot[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
@@ -60,3 +61,80 @@ func TestStateSummary_CanSaveRretrieve(t *testing.T) {
 		t.Error("State summary does not equal")
 	}
 }
+
+func TestStateSummary_SaveStateAndSummary(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	r := bytesutil.ToBytes32([]byte{'A'})
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	summary := &pb.StateSummary{Slot: 64, Root: r[:]}
+
+	if err := db.SaveStateAndSummary(ctx, st, summary); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasState(ctx, r) {
+		t.Error("State should be saved")
+	}
+	saved, err := db.StateSummary(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(saved, summary) {
+		t.Error("State summary does not equal")
+	}
+
+	if err := db.SaveStateAndSummary(ctx, nil, &pb.StateSummary{Slot: 65, Root: []byte{'B'}}); err == nil {
+		t.Error("Expected error saving nil state")
+	}
+	if db.HasStateSummary(ctx, bytesutil.ToBytes32([]byte{'B'})) {
+		t.Error("Summary should not be saved without its state")
+	}
+}
+
+func TestStateSummary_SaveStateSummaries(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	r1 := bytesutil.ToBytes32([]byte{'A'})
+	r2 := bytesutil.ToBytes32([]byte{'B'})
+	summaries := []*pb.StateSummary{{Slot: 1, Root: r1[:]}, {Slot: 2, Root: r2[:]}}
+
+	if err := db.SaveStateSummaries(ctx, summaries); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range summaries {
+		saved, err := db.StateSummary(ctx, bytesutil.ToBytes32(s.Root))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(saved, s) {
+			t.Errorf("Wanted state summary %v, got %v", s, saved)
+		}
+	}
+}
+
+func TestStateSummary_CanDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	r1 := bytesutil.ToBytes32([]byte{'A'})
+	r2 := bytesutil.ToBytes32([]byte{'B'})
+	summaries := []*pb.StateSummary{{Slot: 1, Root: r1[:]}, {Slot: 2, Root: r2[:]}}
+	if err := db.SaveStateSummaries(ctx, summaries); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.DeleteStateSummaries(ctx, [][32]byte{r1}); err != nil {
+		t.Fatal(err)
+	}
+	if db.HasStateSummary(ctx, r1) {
+		t.Error("State summary should be deleted")
+	}
+	if !db.HasStateSummary(ctx, r2) {
+		t.Error("State summary should not be deleted")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -498,3 +499,82 @@ func TestStore_GenesisState_CanGetHighestBelow(t *testing.T) {
 		t.Errorf("Did not retrieve saved state: %v != %v", highest, s0)
 	}
 }
+
+func TestStore_HighestSlotStatesBelow_SkipSlotState(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisState, err := state.InitializeFromProto(&pb.BeaconState{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot := [32]byte{'a'}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	// The state at skip slot 64 is saved with the root of the last block, at slot 10.
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 10}}
+	r, _ := ssz.HashTreeRoot(b.Block)
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	s := &pb.BeaconState{Slot: 64}
+	st, err := state.InitializeFromProto(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, r); err != nil {
+		t.Fatal(err)
+	}
+
+	highest, err := db.HighestSlotStatesBelow(ctx, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(highest[0].InnerStateUnsafe(), s) {
+		t.Errorf("Did not retrieve saved state: %v != %v", highest, s)
+	}
+	highest, err = db.HighestSlotStatesBelow(ctx, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(highest[0].InnerStateUnsafe(), genesisState.InnerStateUnsafe()) {
+		t.Errorf("Did not retrieve genesis state: %v", highest)
+	}
+}
+
+func TestStore_HighestSlotStatesBelow_NoGenesisFallback(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisState, err := state.InitializeFromProto(&pb.BeaconState{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot := [32]byte{'a'}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	// A state saved at slot 10 without its block can't be looked up, but genesis isn't the
+	// highest state below slot 20.
+	st, err := state.InitializeFromProto(&pb.BeaconState{Slot: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, [32]byte{'b'}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.HighestSlotStatesBelow(ctx, 20); err != iface.ErrStateNotFound {
+		t.Errorf("Wanted %v when the highest saved state can't be looked up, received %v", iface.ErrStateNotFound, err)
+	}
+}
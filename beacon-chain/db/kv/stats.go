@@ -0,0 +1,72 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DatabaseStats returns the size of the database file, the size of its free pages and the number
+// of keys and bytes of each bucket, from the largest bucket to the smallest. Operators use it to
+// find out what is using disk space, and how much of it compacting the database would reclaim.
+// When the cold states are stored apart, the sizes include the cold state database, whose buckets
+// are reported with a cold/ prefix.
+func (k *Store) DatabaseStats(ctx context.Context) (*iface.DatabaseStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DatabaseStats")
+	defer span.End()
+
+	info, err := os.Stat(path.Join(k.databasePath, databaseFileName))
+	if err != nil {
+		return nil, err
+	}
+	version, err := k.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats := &iface.DatabaseStats{
+		FileSize:      info.Size(),
+		FreeSize:      int64(k.db.Stats().FreeAlloc),
+		SchemaVersion: version,
+		Buckets:       make([]*iface.BucketStats, 0),
+	}
+	if err := appendBucketStats(k.db, "", stats); err != nil {
+		return nil, err
+	}
+	if k.coldDB != nil {
+		coldInfo, err := os.Stat(path.Join(k.coldDatabasePath, coldDatabaseFileName))
+		if err != nil {
+			return nil, err
+		}
+		stats.FileSize += coldInfo.Size()
+		stats.FreeSize += int64(k.coldDB.Stats().FreeAlloc)
+		if err := appendBucketStats(k.coldDB, "cold/", stats); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(stats.Buckets, func(i, j int) bool {
+		return stats.Buckets[i].Size > stats.Buckets[j].Size
+	})
+	return stats, nil
+}
+
+// appendBucketStats adds the stats of every bucket of the database to the input stats, with the
+// bucket names prefixed.
+func appendBucketStats(db *bolt.DB, prefix string, stats *iface.DatabaseStats) error {
+	return db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			bs := b.Stats()
+			stats.Buckets = append(stats.Buckets, &iface.BucketStats{
+				Name:  prefix + string(name),
+				Keys:  bs.KeyN,
+				Size:  int64(bs.BranchAlloc + bs.LeafAlloc),
+				InUse: int64(bs.BranchInuse + bs.LeafInuse),
+			})
+			return nil
+		})
+	})
+}
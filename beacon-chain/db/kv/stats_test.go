@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func TestStore_DatabaseStats(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	blocks := make([]*eth.SignedBeaconBlock, 100)
+	for i := range blocks {
+		blocks[i] = &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: uint64(i)}}
+	}
+	if err := db.SaveBlocks(ctx, blocks); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := db.DatabaseStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.FileSize == 0 {
+		t.Error("Wanted the size of the database file")
+	}
+	found := false
+	for i, b := range stats.Buckets {
+		if i > 0 && b.Size > stats.Buckets[i-1].Size {
+			t.Errorf("Buckets are not sorted by decreasing size: %v", stats.Buckets)
+		}
+		if b.Name == string(blocksBucket) {
+			found = true
+			if b.Keys != len(blocks) {
+				t.Errorf("Wanted %d keys in the blocks bucket, received %d", len(blocks), b.Keys)
+			}
+		}
+	}
+	if !found {
+		t.Error("Did not find the blocks bucket")
+	}
+}
@@ -29,4 +29,12 @@ var (
 		Name:  "archive-attestations",
 		Usage: "Whether or not beacon chain should archive historical blocks",
 	}
+	// ArchiveMinFreeDiskSpaceFlag defines the free disk space below which archival writes are
+	// paused so a full disk doesn't crash the node.
+	ArchiveMinFreeDiskSpaceFlag = &cli.Uint64Flag{
+		Name: "archive-min-free-disk-mb",
+		Usage: "Free disk space of the data directory, in megabytes, below which archival writes are paused " +
+			"until space is freed. 0 disables the disk space watchdog",
+		Value: 1024,
+	}
 )
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"gopkg.in/urfave/cli.v2"
 )
 
@@ -42,6 +44,42 @@ var (
 		Usage: "Max number of items returned per page in RPC responses for paginated endpoints.",
 		Value: 500,
 	}
+	// EnableDebugRPCEndpoints enables the debug rpc service.
+	EnableDebugRPCEndpoints = &cli.BoolFlag{
+		Name:  "enable-debug-rpc-endpoints",
+		Usage: "Enables the debug rpc service, containing utility endpoints such as state generation tracing.",
+	}
+	// EnableAdminRPC enables the admin rpc service.
+	EnableAdminRPC = &cli.BoolFlag{
+		Name: "enable-admin-rpc",
+		Usage: "Enables the admin rpc service, which backs up and restores the database and changes the runtime " +
+			"configuration. It is served on the loopback interface only, on the admin rpc port.",
+	}
+	// AdminRPCPort defines the loopback port the admin rpc service listens on.
+	AdminRPCPort = &cli.IntFlag{
+		Name:  "admin-rpc-port",
+		Usage: "Port of the loopback interface the admin rpc service listens on",
+		Value: 4002,
+	}
+	// DatabaseBackupOutputDir defines the directory database backups are written to.
+	DatabaseBackupOutputDir = &cli.StringFlag{
+		Name: "db-backup-output-dir",
+		Usage: "Directory the database snapshots requested through the admin RPC or the backup webhook are written to. " +
+			"Defaults to the backups directory of the database in the data directory.",
+	}
+	// DatabaseRestoreFile defines a database snapshot the database is restored from on startup.
+	DatabaseRestoreFile = &cli.StringFlag{
+		Name: "db-restore-file",
+		Usage: "Database snapshot file, written by a database backup, which replaces the database of the node on startup. " +
+			"The snapshot is renamed with a .restored suffix once restored, so it is not restored again on the next startup.",
+	}
+	// ColdStateDataDir defines the directory of the separate database holding the cold states.
+	ColdStateDataDir = &cli.StringFlag{
+		Name: "cold-state-datadir",
+		Usage: "Directory of a separate database holding the cold states, the archived point states and diffs, " +
+			"so the historical data can be placed on a different volume than the hot data. Empty keeps them in " +
+			"the main database.",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -87,6 +125,22 @@ var (
 		Name:  "unsafe-sync",
 		Usage: "Starts the beacon node with the previously saved head state instead of finalized state.",
 	}
+	// CheckpointStateFlag defines the trusted state file a new node is started from instead of genesis.
+	CheckpointStateFlag = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "The trusted finalized state file (.SSZ) an empty database is initialized from, instead of syncing " +
+			"from genesis. Requires --checkpoint-block and --checkpoint-block-root",
+	}
+	// CheckpointBlockFlag defines the block file of the trusted checkpoint state.
+	CheckpointBlockFlag = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "The signed block file (.SSZ) of the checkpoint state, it must be the latest block applied to the state",
+	}
+	// CheckpointBlockRootFlag defines the trusted root the checkpoint block is verified against.
+	CheckpointBlockRootFlag = &cli.StringFlag{
+		Name:  "checkpoint-block-root",
+		Usage: "The hex encoded root of the checkpoint block, obtained from a trusted source",
+	}
 	// SlasherCertFlag defines a flag for the slasher TLS certificate.
 	SlasherCertFlag = &cli.StringFlag{
 		Name:  "slasher-tls-cert",
@@ -101,13 +155,104 @@ var (
 	// SlotsPerArchivedPoint specifies the number of slots between the archived points, to save beacon state in the cold
 	// section of DB.
 	SlotsPerArchivedPoint = &cli.IntFlag{
-		Name:  "slots-per-archive-point",
-		Usage: "The slot durations of when an archived state gets saved in the DB.",
-		Value: 128,
+		Name:    "slots-per-archived-point",
+		Aliases: []string{"slots-per-archive-point"},
+		Usage: "The slot durations of when an archived state gets saved in the DB. Lower values use more disk space " +
+			"and regenerate cold states faster by replaying fewer blocks. Must be a multiple of the slots per epoch.",
+		Value: 256,
+	}
+	// SlotsPerColdCheckpoint specifies the number of slots between the checkpoints saved in between archived points,
+	// as diffs against the archived point state, to bound the blocks replayed to regenerate a cold state.
+	SlotsPerColdCheckpoint = &cli.IntFlag{
+		Name: "slots-per-cold-checkpoint",
+		Usage: "The slot durations of when a cold state checkpoint gets saved in the DB as a diff against its archived " +
+			"point state. Must be a multiple of the slots per epoch dividing the slots per archived point, 0 disables checkpoints.",
+		Value: 64,
+	}
+	// ArchivedPointsPerFullState specifies the number of archived points between the archived point states saved
+	// in full, the ones in between are saved as diffs against the full archived point state below them.
+	ArchivedPointsPerFullState = &cli.IntFlag{
+		Name: "archived-points-per-full-state",
+		Usage: "The number of archived points between the archived point states saved in full in the DB, the ones in " +
+			"between are saved as diffs against the full state below them. Higher values use less disk space and " +
+			"regenerate cold states slower. 1 saves every archived point state in full.",
+		Value: 1,
+	}
+	// ReplayPrefetchDepth specifies how many blocks are read from the DB ahead of the state transitions
+	// when replaying blocks to regenerate a state.
+	ReplayPrefetchDepth = &cli.IntFlag{
+		Name: "replay-prefetch-depth",
+		Usage: "The number of blocks read from the DB ahead of the state transitions when replaying a range of " +
+			"blocks longer than it to regenerate a state. 0 loads all the blocks before replaying them.",
+		Value: 64,
+	}
+	// ReplayBundleDir specifies the directory where replays producing an unexpected state root are
+	// captured for offline reproduction.
+	ReplayBundleDir = &cli.StringFlag{
+		Name: "replay-bundle-dir",
+		Usage: "Verifies the state root of every replayed block and writes a reproducible bundle of the replay " +
+			"to this directory when a block's state root does not match. Empty disables the verification.",
+	}
+	// HotStateCacheSize specifies the number of hot states kept in memory by state generation.
+	HotStateCacheSize = &cli.IntFlag{
+		Name: "hot-state-cache-size",
+		Usage: "The maximum number of hot states cached in memory, the least recently used state is evicted " +
+			"first. Lower values reduce memory usage on chains with many forks at the cost of more replays.",
+		Value: 16,
+	}
+	// AdaptiveSaveReplayTarget specifies the replay latency targeted by the adaptive hot state save policy.
+	AdaptiveSaveReplayTarget = &cli.DurationFlag{
+		Name: "adaptive-save-replay-target",
+		Usage: "The average latency of hot state replays above which the adaptive save policy saves full states " +
+			"more often. Requires --adaptive-state-saves",
+		Value: time.Second,
+	}
+	// AdaptiveSaveMinFreeDiskSpace specifies the free disk space below which the adaptive save policy saves fewer states.
+	AdaptiveSaveMinFreeDiskSpace = &cli.Uint64Flag{
+		Name: "adaptive-save-min-free-disk-mb",
+		Usage: "Free disk space of the data directory, in megabytes, below which the adaptive save policy saves " +
+			"full states within epochs less often. Requires --adaptive-state-saves",
+		Value: 10240,
+	}
+	// StateSoftDeleteWindow specifies how long the hot states deleted by state migrations are kept aside
+	// before being permanently deleted.
+	StateSoftDeleteWindow = &cli.DurationFlag{
+		Name: "state-soft-delete-window",
+		Usage: "Keeps the hot states deleted when migrating finalized states to the cold section for this long, " +
+			"so they can be restored through the admin RPC service. 0 deletes the states right away.",
+	}
+	// HashSelfTestFlag runs the hashing self-test before starting the node.
+	HashSelfTestFlag = &cli.BoolFlag{
+		Name:  "hash-self-test",
+		Usage: "Verifies the hash functions against known vectors on startup, refusing to start the node on a mismatch.",
+	}
+	// DumpConfigFlag prints the effective configuration instead of starting the node.
+	DumpConfigFlag = &cli.BoolFlag{
+		Name: "dump-config",
+		Usage: "Prints the global and feature flags the node would run with, once the config file, environment and " +
+			"command line flags are merged, as YAML and exits without starting the node.",
 	}
 	// EnableDiscv5 enables running discv5.
 	EnableDiscv5 = &cli.BoolFlag{
 		Name:  "enable-discv5",
 		Usage: "Starts dv5 dht.",
 	}
+	// TrackValidatorFlag specifies the public keys of validators whose lifecycle status changes are tracked.
+	TrackValidatorFlag = &cli.StringSliceFlag{
+		Name:  "track-validator",
+		Usage: "Hex encoded public key of a validator to track lifecycle status changes for. Can be specified multiple times.",
+	}
+	// ValidatorStatusWebhookFlag specifies the HTTP endpoints that tracked validator status changes are posted to.
+	ValidatorStatusWebhookFlag = &cli.StringSliceFlag{
+		Name: "validator-status-webhook",
+		Usage: "URL to POST a JSON notification to whenever a tracked validator is queued for activation, " +
+			"activated, exited or slashed. Can be specified multiple times.",
+	}
+	// ValidatorBalanceAlertThresholdFlag specifies the balance loss per epoch of a tracked validator alerted as a penalty.
+	ValidatorBalanceAlertThresholdFlag = &cli.Uint64Flag{
+		Name: "validator-balance-alert-threshold",
+		Usage: "Balance loss in Gwei per epoch of a tracked validator above which it is reported as penalized, " +
+			"through the logs, the metrics and the validator status webhooks. 0 disables the penalty alerts.",
+		Value: 1000000,
+	}
 )
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"gopkg.in/urfave/cli.v2"
+)
+
+var (
+	// BenchValidatorsFlag defines the number of genesis validators used by the bench subcommand.
+	BenchValidatorsFlag = &cli.Uint64Flag{
+		Name:  "validators",
+		Usage: "Number of genesis validators in the synthetic chain",
+		Value: 256,
+	}
+	// BenchBlocksFlag defines the number of blocks processed by the bench subcommand.
+	BenchBlocksFlag = &cli.Uint64Flag{
+		Name:  "blocks",
+		Usage: "Number of blocks, one per slot, driven through the processing pipeline",
+		Value: 128,
+	}
+	// BenchAttestationsPerBlockFlag caps the number of attestations in each block generated by the bench subcommand.
+	BenchAttestationsPerBlockFlag = &cli.Uint64Flag{
+		Name:  "attestations-per-block",
+		Usage: "Maximum number of aggregated attestations included in each synthetic block",
+		Value: 128,
+	}
+)
@@ -1,7 +1,13 @@
 package flags
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/urfave/cli.v2"
 )
@@ -18,12 +24,34 @@ type GlobalFlags struct {
 	DeploymentBlock                   int
 	UnsafeSync                        bool
 	EnableDiscv5                      bool
+	SlotsPerArchivedPoint             int
+	SlotsPerColdCheckpoint            int
+	ArchivedPointsPerFullState        int
+	ReplayPrefetchDepth               int
+	ReplayBundleDir                   string
+	HotStateCacheSize                 int
+	AdaptiveSaveReplayTarget          time.Duration
+	AdaptiveSaveMinFreeDiskSpace      uint64
+	StateSoftDeleteWindow             time.Duration
 }
 
 var globalConfig *GlobalFlags
 
+// globalConfigLock guards swapping the global config. A config is never modified once set,
+// reloading flags at runtime sets a modified copy instead.
+var globalConfigLock sync.RWMutex
+
+// MaxPageSizeLimit is the hard limit of the max page size of paginated RPC responses, above which
+// a single response could take too much memory to build.
+const MaxPageSizeLimit = 100000
+
+// maxPeers is the P2P max peers the minimum sync peers are capped to.
+var maxPeers int
+
 // Get retrieves the global config.
 func Get() *GlobalFlags {
+	globalConfigLock.RLock()
+	defer globalConfigLock.RUnlock()
 	if globalConfig == nil {
 		return &GlobalFlags{}
 	}
@@ -32,12 +60,14 @@ func Get() *GlobalFlags {
 
 // Init sets the global config equal to the config that is passed in.
 func Init(c *GlobalFlags) {
+	globalConfigLock.Lock()
+	defer globalConfigLock.Unlock()
 	globalConfig = c
 }
 
 // ConfigureGlobalFlags initializes the global config.
-// based on the provided cli context.
-func ConfigureGlobalFlags(ctx *cli.Context) {
+// based on the provided cli context. It returns an error for incoherent flag combinations.
+func ConfigureGlobalFlags(ctx *cli.Context) error {
 	cfg := &GlobalFlags{}
 	if ctx.Bool(ArchiveEnableFlag.Name) {
 		cfg.EnableArchive = true
@@ -59,14 +89,66 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	}
 	cfg.MaxPageSize = ctx.Int(RPCMaxPageSize.Name)
 	cfg.DeploymentBlock = ctx.Int(ContractDeploymentBlock.Name)
+	cfg.SlotsPerArchivedPoint = ctx.Int(SlotsPerArchivedPoint.Name)
+	cfg.SlotsPerColdCheckpoint = ctx.Int(SlotsPerColdCheckpoint.Name)
+	cfg.ArchivedPointsPerFullState = ctx.Int(ArchivedPointsPerFullState.Name)
+	cfg.ReplayPrefetchDepth = ctx.Int(ReplayPrefetchDepth.Name)
+	cfg.ReplayBundleDir = ctx.String(ReplayBundleDir.Name)
+	cfg.HotStateCacheSize = ctx.Int(HotStateCacheSize.Name)
+	cfg.AdaptiveSaveReplayTarget = ctx.Duration(AdaptiveSaveReplayTarget.Name)
+	cfg.AdaptiveSaveMinFreeDiskSpace = ctx.Uint64(AdaptiveSaveMinFreeDiskSpace.Name) * 1024 * 1024
+	cfg.StateSoftDeleteWindow = ctx.Duration(StateSoftDeleteWindow.Name)
 	configureMinimumPeers(ctx, cfg)
 
+	if err := validateGlobalFlags(cfg); err != nil {
+		return err
+	}
 	Init(cfg)
+	return nil
+}
+
+// validateGlobalFlags rejects the configurations which would silently misbehave once the node
+// runs, the error lists all the problems found along with the flags to fix them.
+func validateGlobalFlags(cfg *GlobalFlags) error {
+	var problems []string
+	if cfg.EnableArchivedValidatorSetChanges && !cfg.EnableArchive {
+		problems = append(problems, fmt.Sprintf("--%s has no effect without --%s",
+			ArchiveValidatorSetChangesFlag.Name, ArchiveEnableFlag.Name))
+	}
+	if cfg.EnableArchivedBlocks && !cfg.EnableArchive {
+		problems = append(problems, fmt.Sprintf("--%s has no effect without --%s",
+			ArchiveBlocksFlag.Name, ArchiveEnableFlag.Name))
+	}
+	if cfg.EnableArchivedAttestations && !cfg.EnableArchive {
+		problems = append(problems, fmt.Sprintf("--%s has no effect without --%s",
+			ArchiveAttestationsFlag.Name, ArchiveEnableFlag.Name))
+	}
+	if cfg.MinimumSyncPeers <= 0 {
+		problems = append(problems, fmt.Sprintf("--%s must be positive, got %d after capping it to --%s",
+			MinSyncPeers.Name, cfg.MinimumSyncPeers, cmd.P2PMaxPeers.Name))
+	}
+	if cfg.MaxPageSize <= 0 || cfg.MaxPageSize > MaxPageSizeLimit {
+		problems = append(problems, fmt.Sprintf("--%s must be between 1 and %d, got %d",
+			RPCMaxPageSize.Name, MaxPageSizeLimit, cfg.MaxPageSize))
+	}
+	if slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch; cfg.SlotsPerArchivedPoint < 0 ||
+		uint64(cfg.SlotsPerArchivedPoint)%slotsPerEpoch != 0 {
+		problems = append(problems, fmt.Sprintf("--%s must be a multiple of the slots per epoch %d, got %d",
+			SlotsPerArchivedPoint.Name, slotsPerEpoch, cfg.SlotsPerArchivedPoint))
+	}
+	if cfg.ReplayPrefetchDepth < 0 {
+		problems = append(problems, fmt.Sprintf("--%s can't be negative, got %d",
+			ReplayPrefetchDepth.Name, cfg.ReplayPrefetchDepth))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid flags: %s", strings.Join(problems, "; "))
+	}
+	return nil
 }
 
 func configureMinimumPeers(ctx *cli.Context, cfg *GlobalFlags) {
 	cfg.MinimumSyncPeers = ctx.Int(MinSyncPeers.Name)
-	maxPeers := int(ctx.Int64(cmd.P2PMaxPeers.Name))
+	maxPeers = int(ctx.Int64(cmd.P2PMaxPeers.Name))
 	if cfg.MinimumSyncPeers > maxPeers {
 		log.Warnf("Changing Minimum Sync Peers to %d", maxPeers)
 		cfg.MinimumSyncPeers = maxPeers
@@ -0,0 +1,13 @@
+package flags
+
+import (
+	"gopkg.in/urfave/cli.v2"
+)
+
+var (
+	// ConfigDiffRPCFlag defines the RPC endpoints of the nodes compared by the config diff subcommand.
+	ConfigDiffRPCFlag = &cli.StringSliceFlag{
+		Name:  "rpc",
+		Usage: "RPC endpoint of a beacon node running with --enable-debug-rpc-endpoints, set twice to compare two nodes",
+	}
+)
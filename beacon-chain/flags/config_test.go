@@ -0,0 +1,111 @@
+package flags
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"gopkg.in/urfave/cli.v2"
+)
+
+// globalFlagsTestContext returns a context with the flags validated by ConfigureGlobalFlags set
+// to their default, then to the given values.
+func globalFlagsTestContext(t *testing.T, values map[string]string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.Bool(ArchiveEnableFlag.Name, false, "")
+	set.Bool(ArchiveValidatorSetChangesFlag.Name, false, "")
+	set.Bool(ArchiveBlocksFlag.Name, false, "")
+	set.Bool(ArchiveAttestationsFlag.Name, false, "")
+	set.Int(MinSyncPeers.Name, MinSyncPeers.Value, "")
+	set.Int(RPCMaxPageSize.Name, RPCMaxPageSize.Value, "")
+	set.Int(ReplayPrefetchDepth.Name, ReplayPrefetchDepth.Value, "")
+	set.Int(SlotsPerArchivedPoint.Name, SlotsPerArchivedPoint.Value, "")
+	set.Int64(cmd.P2PMaxPeers.Name, 30, "")
+	for name, value := range values {
+		if err := set.Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func TestConfigureGlobalFlags_Valid(t *testing.T) {
+	resetCfg := Get()
+	defer Init(resetCfg)
+
+	ctx := globalFlagsTestContext(t, map[string]string{
+		ArchiveEnableFlag.Name:       "true",
+		ArchiveAttestationsFlag.Name: "true",
+	})
+	if err := ConfigureGlobalFlags(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !Get().EnableArchivedAttestations || Get().MaxPageSize != RPCMaxPageSize.Value {
+		t.Errorf("Unexpected global config %+v", Get())
+	}
+}
+
+func TestConfigureGlobalFlags_RejectsConflicts(t *testing.T) {
+	resetCfg := Get()
+	defer Init(resetCfg)
+
+	tests := []struct {
+		name   string
+		values map[string]string
+		errMsg string
+	}{
+		{
+			name:   "archive attestations without archive",
+			values: map[string]string{ArchiveAttestationsFlag.Name: "true"},
+			errMsg: "--archive-attestations has no effect without --archive",
+		},
+		{
+			name:   "archive blocks without archive",
+			values: map[string]string{ArchiveBlocksFlag.Name: "true"},
+			errMsg: "--archive-blocks has no effect without --archive",
+		},
+		{
+			name:   "archive validator set changes without archive",
+			values: map[string]string{ArchiveValidatorSetChangesFlag.Name: "true"},
+			errMsg: "--archive-validator-set-changes has no effect without --archive",
+		},
+		{
+			name:   "no minimum sync peers",
+			values: map[string]string{MinSyncPeers.Name: "0"},
+			errMsg: "--min-sync-peers must be positive",
+		},
+		{
+			name:   "no max peers",
+			values: map[string]string{cmd.P2PMaxPeers.Name: "0"},
+			errMsg: "--min-sync-peers must be positive",
+		},
+		{
+			name:   "max page size over the limit",
+			values: map[string]string{RPCMaxPageSize.Name: "100001"},
+			errMsg: "--rpc-max-page-size must be between 1 and 100000",
+		},
+		{
+			name:   "negative replay prefetch depth",
+			values: map[string]string{ReplayPrefetchDepth.Name: "-1"},
+			errMsg: "--" + ReplayPrefetchDepth.Name + " can't be negative",
+		},
+		{
+			name:   "slots per archived point not a multiple of the slots per epoch",
+			values: map[string]string{SlotsPerArchivedPoint.Name: "100"},
+			errMsg: "--slots-per-archived-point must be a multiple of the slots per epoch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Init(&GlobalFlags{})
+			err := ConfigureGlobalFlags(globalFlagsTestContext(t, tt.values))
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("Wanted error containing %q, received %v", tt.errMsg, err)
+			}
+			if Get().MaxPageSize != 0 {
+				t.Error("Expected an invalid config to not be set")
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package flags
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+)
+
+// DeprecatedFlag is a flag which was renamed. The deprecated name keeps working for a release
+// cycle after the rename: it is hidden from the help, and its value is forwarded to the flag
+// which replaces it with a warning.
+type DeprecatedFlag struct {
+	// Name is the deprecated name of the flag.
+	Name string
+	// NewFlag is the flag replacing the deprecated one.
+	NewFlag cli.Flag
+}
+
+// DeprecatedFlags lists the renamed beacon node flags. Remove a flag from the list one release
+// after its rename.
+var DeprecatedFlags = []*DeprecatedFlag{}
+
+// Flag returns the hidden flag of the deprecated name, of the same type as the new flag.
+func (d *DeprecatedFlag) Flag() cli.Flag {
+	f := reflect.New(reflect.Indirect(reflect.ValueOf(d.NewFlag)).Type())
+	f.Elem().FieldByName("Name").SetString(d.Name)
+	f.Elem().FieldByName("Usage").SetString(fmt.Sprintf("DEPRECATED. Use --%s instead.", d.newName()))
+	f.Elem().FieldByName("Hidden").SetBool(true)
+	return f.Interface().(cli.Flag)
+}
+
+func (d *DeprecatedFlag) newName() string {
+	return d.NewFlag.Names()[0]
+}
+
+// DeprecatedAliasFlags returns the hidden flags of the deprecated names, to be added to the
+// flags of the app.
+func DeprecatedAliasFlags(deprecated []*DeprecatedFlag) []cli.Flag {
+	aliases := make([]cli.Flag, len(deprecated))
+	for i, d := range deprecated {
+		aliases[i] = d.Flag()
+	}
+	return aliases
+}
+
+// ForwardDeprecatedFlags warns about the deprecated flags which are set and sets their value to
+// the flags replacing them. Setting both a deprecated flag and its new flag to different values
+// is rejected, rather than silently picking one of them.
+func ForwardDeprecatedFlags(ctx *cli.Context, deprecated []*DeprecatedFlag) error {
+	for _, d := range deprecated {
+		if !ctx.IsSet(d.Name) {
+			continue
+		}
+		value, ok := flagValueString(ctx, d.Name)
+		if !ok {
+			return fmt.Errorf("could not read deprecated flag --%s", d.Name)
+		}
+		if ctx.IsSet(d.newName()) {
+			if newValue, _ := flagValueString(ctx, d.newName()); newValue != value {
+				return fmt.Errorf("--%s is deprecated and conflicts with --%s, only set --%s", d.Name, d.newName(), d.newName())
+			}
+			log.Warnf("--%s is deprecated and will be removed in a future release, only set --%s", d.Name, d.newName())
+			continue
+		}
+		if err := ctx.Set(d.newName(), value); err != nil {
+			return errors.Wrapf(err, "could not forward deprecated flag --%s to --%s", d.Name, d.newName())
+		}
+		log.Warnf("--%s is deprecated and will be removed in a future release, use --%s instead", d.Name, d.newName())
+	}
+	return nil
+}
+
+// flagValueString returns the value of the flag in the format taken by ctx.Set, false if the
+// flag is not defined.
+func flagValueString(ctx *cli.Context, name string) (string, bool) {
+	value, ok := ctx.Generic(name).(flag.Value)
+	if !ok {
+		return "", false
+	}
+	if serialized, ok := value.(cli.Serializeder); ok {
+		return serialized.Serialized(), true
+	}
+	return value.String(), true
+}
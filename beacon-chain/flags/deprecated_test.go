@@ -0,0 +1,92 @@
+package flags
+
+import (
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+// deprecatedTestContext returns a context parsing the args with the new flags and the hidden
+// flags of their deprecated names.
+func deprecatedTestContext(t *testing.T, deprecated []*DeprecatedFlag, args []string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, d := range deprecated {
+		d.NewFlag.Apply(set)
+	}
+	for _, f := range DeprecatedAliasFlags(deprecated) {
+		f.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func testDeprecatedFlags() []*DeprecatedFlag {
+	return []*DeprecatedFlag{
+		{Name: "old-string", NewFlag: &cli.StringFlag{Name: "new-string", Value: "default"}},
+		{Name: "old-bool", NewFlag: &cli.BoolFlag{Name: "new-bool"}},
+		{Name: "old-uint64", NewFlag: &cli.Uint64Flag{Name: "new-uint64", Value: 1}},
+		{Name: "old-slice", NewFlag: &cli.StringSliceFlag{Name: "new-slice"}},
+	}
+}
+
+func TestDeprecatedFlag_HiddenWithNewFlagType(t *testing.T) {
+	for _, d := range testDeprecatedFlags() {
+		f := d.Flag()
+		if reflect.TypeOf(f) != reflect.TypeOf(d.NewFlag) {
+			t.Errorf("Wanted flag of type %T for %s, got %T", d.NewFlag, d.Name, f)
+		}
+		fv := reflect.Indirect(reflect.ValueOf(f))
+		if !fv.FieldByName("Hidden").Bool() {
+			t.Errorf("%s must be hidden when deprecated", d.Name)
+		}
+		if !strings.Contains(fv.FieldByName("Usage").String(), "--"+d.newName()) {
+			t.Errorf("Usage of %s must point to --%s", d.Name, d.newName())
+		}
+	}
+}
+
+func TestForwardDeprecatedFlags(t *testing.T) {
+	deprecated := testDeprecatedFlags()
+	ctx := deprecatedTestContext(t, deprecated, []string{
+		"--old-string", "value",
+		"--old-bool",
+		"--old-uint64", "42",
+		"--old-slice", "a", "--old-slice", "b",
+	})
+	if err := ForwardDeprecatedFlags(ctx, deprecated); err != nil {
+		t.Fatal(err)
+	}
+	if got := ctx.String("new-string"); got != "value" {
+		t.Errorf("Wanted new-string value, got %s", got)
+	}
+	if !ctx.Bool("new-bool") {
+		t.Error("Wanted new-bool to be forwarded")
+	}
+	if got := ctx.Uint64("new-uint64"); got != 42 {
+		t.Errorf("Wanted new-uint64 42, got %d", got)
+	}
+	if got := ctx.StringSlice("new-slice"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Wanted new-slice [a b], got %v", got)
+	}
+}
+
+func TestForwardDeprecatedFlags_NewFlagSet(t *testing.T) {
+	deprecated := testDeprecatedFlags()
+	ctx := deprecatedTestContext(t, deprecated, []string{"--old-string", "value", "--new-string", "value"})
+	if err := ForwardDeprecatedFlags(ctx, deprecated); err != nil {
+		t.Fatal(err)
+	}
+	if got := ctx.String("new-string"); got != "value" {
+		t.Errorf("Wanted new-string value, got %s", got)
+	}
+
+	ctx = deprecatedTestContext(t, deprecated, []string{"--old-string", "old", "--new-string", "new"})
+	if err := ForwardDeprecatedFlags(ctx, deprecated); err == nil {
+		t.Error("Expected conflicting deprecated and new flags to be rejected")
+	}
+}
@@ -0,0 +1,46 @@
+package flags
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"gopkg.in/yaml.v2"
+)
+
+// WriteEffectiveConfig writes the global flags and the feature flags the node runs with as YAML,
+// once the config file, the environment and the command line flags are merged. The fields keep
+// the names of the config structs, in their order.
+func WriteEffectiveConfig(w io.Writer) error {
+	cfg := yaml.MapSlice{
+		{Key: "GlobalFlags", Value: structToMapSlice(Get())},
+		{Key: "FeatureFlags", Value: structToMapSlice(featureconfig.Get())},
+	}
+	enc, err := yaml.Marshal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal effective config")
+	}
+	_, err = w.Write(enc)
+	return err
+}
+
+// structToMapSlice returns the exported fields of the struct pointed to by v, with the durations
+// written in their human readable form rather than in nanoseconds.
+func structToMapSlice(v interface{}) yaml.MapSlice {
+	val := reflect.Indirect(reflect.ValueOf(v))
+	fields := make(yaml.MapSlice, 0, val.NumField())
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		value := val.Field(i).Interface()
+		if d, ok := value.(time.Duration); ok {
+			value = d.String()
+		}
+		fields = append(fields, yaml.MapItem{Key: field.Name, Value: value})
+	}
+	return fields
+}
@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWriteEffectiveConfig(t *testing.T) {
+	resetCfg := Get()
+	defer Init(resetCfg)
+	resetFeatures := featureconfig.Get()
+	defer featureconfig.Init(resetFeatures)
+	Init(&GlobalFlags{
+		EnableArchive:            true,
+		MinimumSyncPeers:         3,
+		MaxPageSize:              500,
+		AdaptiveSaveReplayTarget: 2 * time.Second,
+	})
+	featureconfig.Init(&featureconfig.Flags{EnableNoise: true})
+
+	var buf bytes.Buffer
+	if err := WriteEffectiveConfig(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"GlobalFlags:\n  EnableArchive: true\n",
+		"  MaxPageSize: 500\n",
+		"  AdaptiveSaveReplayTarget: 2s\n",
+		"FeatureFlags:\n",
+		"  EnableNoise: true\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Wanted %q in the effective config, received:\n%s", want, buf.String())
+		}
+	}
+
+	// The output is valid YAML, listing every global flag.
+	dumped := make(map[string]map[string]interface{})
+	if err := yaml.Unmarshal(buf.Bytes(), &dumped); err != nil {
+		t.Fatal(err)
+	}
+	if got := dumped["GlobalFlags"]["MinimumSyncPeers"]; got != 3 {
+		t.Errorf("Wanted minimum sync peers 3, received %v", got)
+	}
+}
@@ -0,0 +1,171 @@
+package flags
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// NetworkFlag selects a named preset of the network specific flags.
+	NetworkFlag = &cli.StringFlag{
+		Name:  "network",
+		Usage: "Named network preset setting the deposit contract, its deployment block, the genesis state and fork versions. Built in presets: " + strings.Join(builtinNetworkNames(), ", "),
+	}
+	// NetworkConfigFileFlag defines a YAML file with custom network presets.
+	NetworkConfigFileFlag = &cli.StringFlag{
+		Name:  "network-config-file",
+		Usage: "YAML file defining custom network presets selectable with --network",
+	}
+)
+
+// NetworkPreset bundles the values which have to match for a node to join a network. The fork
+// schedule maps the epochs of the scheduled forks to the version each fork switches to.
+type NetworkPreset struct {
+	DepositContract    string            `yaml:"deposit_contract"`
+	DeploymentBlock    int               `yaml:"deployment_block"`
+	GenesisState       string            `yaml:"genesis_state"`
+	GenesisForkVersion string            `yaml:"genesis_fork_version"`
+	ForkSchedule       map[uint64]string `yaml:"fork_schedule"`
+}
+
+// networkConfigFile is the layout of the file passed with --network-config-file.
+type networkConfigFile struct {
+	Networks map[string]*NetworkPreset `yaml:"networks"`
+}
+
+// builtinNetworks are the presets known without a network config file.
+var builtinNetworks = map[string]*NetworkPreset{
+	"topaz": {
+		DepositContract: "0x5cA1e00004366Ac85f492887AAab12d0e6418876",
+		DeploymentBlock: 2523557,
+	},
+}
+
+func builtinNetworkNames() []string {
+	names := make([]string, 0, len(builtinNetworks))
+	for name := range builtinNetworks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadNetworkPreset returns the preset of the given name, looking up custom networks
+// defined in configFile before the built in ones.
+func LoadNetworkPreset(name string, configFile string) (*NetworkPreset, error) {
+	if configFile != "" {
+		enc, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read network config file")
+		}
+		cfg := &networkConfigFile{}
+		if err := yaml.Unmarshal(enc, cfg); err != nil {
+			return nil, errors.Wrap(err, "could not parse network config file")
+		}
+		if preset, ok := cfg.Networks[name]; ok {
+			return preset, nil
+		}
+	}
+	preset, ok := builtinNetworks[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q", name)
+	}
+	return preset, nil
+}
+
+// ApplyNetworkPreset sets the flags bundled by the preset selected with --network. Flags set
+// explicitly must agree with the preset, so mismatched values across flags are rejected
+// rather than silently mixed.
+func ApplyNetworkPreset(ctx *cli.Context) error {
+	if !ctx.IsSet(NetworkFlag.Name) {
+		return nil
+	}
+	name := ctx.String(NetworkFlag.Name)
+	preset, err := LoadNetworkPreset(name, ctx.String(NetworkConfigFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if preset.DepositContract != "" {
+		matches := strings.EqualFold(ctx.String(DepositContractFlag.Name), preset.DepositContract)
+		if err := setPresetFlag(ctx, name, DepositContractFlag.Name, preset.DepositContract, matches); err != nil {
+			return err
+		}
+	}
+	if preset.DeploymentBlock != 0 {
+		matches := ctx.Int(ContractDeploymentBlock.Name) == preset.DeploymentBlock
+		if err := setPresetFlag(ctx, name, ContractDeploymentBlock.Name, strconv.Itoa(preset.DeploymentBlock), matches); err != nil {
+			return err
+		}
+	}
+	if preset.GenesisState != "" {
+		matches := ctx.String(InteropGenesisStateFlag.Name) == preset.GenesisState
+		if err := setPresetFlag(ctx, name, InteropGenesisStateFlag.Name, preset.GenesisState, matches); err != nil {
+			return err
+		}
+	}
+	log.WithField("network", name).Info("Using network preset")
+	return nil
+}
+
+// setPresetFlag sets the flag to the preset value, unless it was explicitly set to a
+// value which has to match the preset.
+func setPresetFlag(ctx *cli.Context, network string, flag string, value string, matches bool) error {
+	if ctx.IsSet(flag) {
+		if !matches {
+			current, _ := flagValueString(ctx, flag)
+			return fmt.Errorf("--%s=%s does not match network %s, which uses %s", flag, current, network, value)
+		}
+		return nil
+	}
+	if err := ctx.Set(flag, value); err != nil {
+		return errors.Wrapf(err, "could not set %s from network %s", flag, network)
+	}
+	return nil
+}
+
+// ConfigureNetworkForks overrides the genesis fork version and the fork schedule of the beacon
+// config with the ones of the selected network preset. It has to run after the chain config is
+// selected.
+func ConfigureNetworkForks(ctx *cli.Context) error {
+	if !ctx.IsSet(NetworkFlag.Name) {
+		return nil
+	}
+	preset, err := LoadNetworkPreset(ctx.String(NetworkFlag.Name), ctx.String(NetworkConfigFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	cfg := *params.BeaconConfig()
+	if preset.GenesisForkVersion != "" {
+		if cfg.GenesisForkVersion, err = decodeForkVersion(preset.GenesisForkVersion); err != nil {
+			return errors.Wrap(err, "invalid genesis fork version")
+		}
+	}
+	if len(preset.ForkSchedule) > 0 {
+		cfg.ForkVersionSchedule = make(map[uint64][]byte, len(preset.ForkSchedule))
+		for epoch, version := range preset.ForkSchedule {
+			if cfg.ForkVersionSchedule[epoch], err = decodeForkVersion(version); err != nil {
+				return errors.Wrapf(err, "invalid version of the fork at epoch %d", epoch)
+			}
+		}
+	}
+	params.OverrideBeaconConfig(&cfg)
+	return nil
+}
+
+func decodeForkVersion(version string) ([]byte, error) {
+	enc, err := hex.DecodeString(strings.TrimPrefix(version, "0x"))
+	if err != nil || len(enc) != 4 {
+		return nil, fmt.Errorf("%q is not a 4 bytes hex version", version)
+	}
+	return enc, nil
+}
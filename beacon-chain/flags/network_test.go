@@ -0,0 +1,148 @@
+package flags
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/urfave/cli.v2"
+)
+
+const testNetworkConfig = `networks:
+  custom:
+    deposit_contract: "0x5cA1e00004366Ac85f492887AAab12d0e6418876"
+    deployment_block: 2523557
+    genesis_state: "/tmp/genesis.ssz"
+    genesis_fork_version: "0x00000113"
+    fork_schedule:
+      100: "0x01000113"
+`
+
+// networkTestContext returns a context selecting the network, with the test network config
+// file written to dir.
+func networkTestContext(t *testing.T, dir string, network string, overrides map[string]string) *cli.Context {
+	configFile := filepath.Join(dir, "networks.yaml")
+	if err := ioutil.WriteFile(configFile, []byte(testNetworkConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(NetworkFlag.Name, "", "")
+	set.String(NetworkConfigFileFlag.Name, "", "")
+	set.String(DepositContractFlag.Name, DepositContractFlag.Value, "")
+	set.Int(ContractDeploymentBlock.Name, ContractDeploymentBlock.Value, "")
+	set.String(InteropGenesisStateFlag.Name, "", "")
+	if err := set.Set(NetworkFlag.Name, network); err != nil {
+		t.Fatal(err)
+	}
+	if err := set.Set(NetworkConfigFileFlag.Name, configFile); err != nil {
+		t.Fatal(err)
+	}
+	for name, value := range overrides {
+		if err := set.Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func TestApplyNetworkPreset_CustomNetwork(t *testing.T) {
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := networkTestContext(t, dir, "custom", nil)
+	if err := ApplyNetworkPreset(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := ctx.String(DepositContractFlag.Name); got != "0x5cA1e00004366Ac85f492887AAab12d0e6418876" {
+		t.Errorf("Wanted deposit contract from preset, got %s", got)
+	}
+	if got := ctx.Int(ContractDeploymentBlock.Name); got != 2523557 {
+		t.Errorf("Wanted deployment block 2523557, got %d", got)
+	}
+	if got := ctx.String(InteropGenesisStateFlag.Name); got != "/tmp/genesis.ssz" {
+		t.Errorf("Wanted genesis state from preset, got %s", got)
+	}
+}
+
+func TestApplyNetworkPreset_BuiltinNetwork(t *testing.T) {
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := networkTestContext(t, dir, "topaz", nil)
+	if err := ApplyNetworkPreset(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := ctx.String(DepositContractFlag.Name); got != builtinNetworks["topaz"].DepositContract {
+		t.Errorf("Wanted deposit contract from the built in preset, got %s", got)
+	}
+}
+
+func TestApplyNetworkPreset_MatchingExplicitFlag(t *testing.T) {
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := networkTestContext(t, dir, "custom", map[string]string{
+		DepositContractFlag.Name: "0x5ca1e00004366ac85f492887aaab12d0e6418876",
+	})
+	if err := ApplyNetworkPreset(ctx); err != nil {
+		t.Errorf("Did not want an error for a flag matching the preset, got %v", err)
+	}
+}
+
+func TestApplyNetworkPreset_MismatchedFlag(t *testing.T) {
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := networkTestContext(t, dir, "custom", map[string]string{
+		ContractDeploymentBlock.Name: "1",
+	})
+	if err := ApplyNetworkPreset(ctx); err == nil {
+		t.Error("Expected an error for a deployment block not matching the preset")
+	}
+}
+
+func TestApplyNetworkPreset_UnknownNetwork(t *testing.T) {
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := networkTestContext(t, dir, "unknown", nil)
+	if err := ApplyNetworkPreset(ctx); err == nil {
+		t.Error("Expected an error for an unknown network")
+	}
+}
+
+func TestConfigureNetworkForks(t *testing.T) {
+	defer params.UseMainnetConfig()
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := networkTestContext(t, dir, "custom", nil)
+	if err := ConfigureNetworkForks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0, 0, 1, 0x13}
+	if got := params.BeaconConfig().GenesisForkVersion; !bytes.Equal(got, want) {
+		t.Errorf("Wanted genesis fork version %#x, got %#x", want, got)
+	}
+	want = []byte{1, 0, 1, 0x13}
+	if got := params.BeaconConfig().ForkVersionSchedule[100]; !bytes.Equal(got, want) {
+		t.Errorf("Wanted version %#x for the fork at epoch 100, got %#x", want, got)
+	}
+}
@@ -0,0 +1,96 @@
+package flags
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	log "github.com/sirupsen/logrus"
+)
+
+// ReloadableFlags are the flags which can be changed while the node runs, without a restart.
+type ReloadableFlags struct {
+	MinimumSyncPeers int
+	MaxPageSize      int
+	LogLevel         string
+}
+
+// reloadLock serializes the reloads, so concurrent reloads don't overwrite each other's changes.
+var reloadLock sync.Mutex
+
+// CurrentReloadableFlags returns the current values of the reloadable flags.
+func CurrentReloadableFlags() *ReloadableFlags {
+	cfg := Get()
+	return &ReloadableFlags{
+		MinimumSyncPeers: cfg.MinimumSyncPeers,
+		MaxPageSize:      cfg.MaxPageSize,
+		LogLevel:         log.GetLevel().String(),
+	}
+}
+
+// ReloadFlags sets the non zero values of the reloadable flags on the global config and the
+// logger, the other flags keep their current value. As on startup, the minimum sync peers are
+// capped to the P2P max peers. It returns the resulting values of the reloadable flags.
+func ReloadFlags(flags *ReloadableFlags) (*ReloadableFlags, error) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	var level log.Level
+	if flags.LogLevel != "" {
+		var err error
+		level, err = log.ParseLevel(flags.LogLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if flags.MinimumSyncPeers < 0 || flags.MaxPageSize < 0 {
+		return nil, errors.New("minimum sync peers and max page size must not be negative")
+	}
+
+	cfg := *Get()
+	if flags.MinimumSyncPeers > 0 {
+		cfg.MinimumSyncPeers = flags.MinimumSyncPeers
+		if maxPeers > 0 && cfg.MinimumSyncPeers > maxPeers {
+			log.Warnf("Changing Minimum Sync Peers to %d", maxPeers)
+			cfg.MinimumSyncPeers = maxPeers
+		}
+	}
+	if flags.MaxPageSize > 0 {
+		cfg.MaxPageSize = flags.MaxPageSize
+	}
+	if err := validateGlobalFlags(&cfg); err != nil {
+		return nil, err
+	}
+	Init(&cfg)
+	if flags.LogLevel != "" {
+		log.SetLevel(level)
+	}
+
+	reloaded := CurrentReloadableFlags()
+	log.WithFields(log.Fields{
+		"minimumSyncPeers": reloaded.MinimumSyncPeers,
+		"maxPageSize":      reloaded.MaxPageSize,
+		"logLevel":         reloaded.LogLevel,
+	}).Info("Reloaded flags")
+	return reloaded, nil
+}
+
+// ReloadFlagsFromConfigFile reloads the reloadable flags set in the config file, as the file of
+// --config-file is read on startup. Flags missing from the file keep their current value.
+func ReloadFlagsFromConfigFile(path string) (*ReloadableFlags, error) {
+	source, err := cmd.ConfigFileSource(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config file")
+	}
+	flags := &ReloadableFlags{}
+	if flags.MinimumSyncPeers, err = source.Int(MinSyncPeers.Name); err != nil {
+		return nil, err
+	}
+	if flags.MaxPageSize, err = source.Int(RPCMaxPageSize.Name); err != nil {
+		return nil, err
+	}
+	if flags.LogLevel, err = source.String(cmd.VerbosityFlag.Name); err != nil {
+		return nil, err
+	}
+	return ReloadFlags(flags)
+}
@@ -0,0 +1,64 @@
+package flags
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func TestReloadFlagsFromConfigFile(t *testing.T) {
+	resetCfg := Get()
+	defer Init(resetCfg)
+	resetLevel := log.GetLevel()
+	defer log.SetLevel(resetLevel)
+	Init(&GlobalFlags{MinimumSyncPeers: 3, MaxPageSize: 500, SlotsPerArchivedPoint: 2048})
+
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	configFile := filepath.Join(dir, "config.yaml")
+	content := "min-sync-peers: 5\nverbosity: warn\nslots-per-archive-point: 64\n"
+	if err := ioutil.WriteFile(configFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := ReloadFlagsFromConfigFile(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ReloadableFlags{MinimumSyncPeers: 5, MaxPageSize: 500, LogLevel: "warning"}
+	if *reloaded != want {
+		t.Errorf("Wanted reloaded flags %+v, received %+v", want, *reloaded)
+	}
+	if Get().MinimumSyncPeers != 5 {
+		t.Errorf("Wanted minimum sync peers %d, received %d", 5, Get().MinimumSyncPeers)
+	}
+	if Get().SlotsPerArchivedPoint != 2048 {
+		t.Errorf("Expected flags which aren't reloadable to keep their value, received slots per archived point %d", Get().SlotsPerArchivedPoint)
+	}
+	if log.GetLevel() != log.WarnLevel {
+		t.Errorf("Wanted log level %v, received %v", log.WarnLevel, log.GetLevel())
+	}
+}
+
+func TestReloadFlags_RejectsMaxPageSizeOverLimit(t *testing.T) {
+	resetCfg := Get()
+	defer Init(resetCfg)
+	Init(&GlobalFlags{MinimumSyncPeers: 3, MaxPageSize: 500})
+
+	if _, err := ReloadFlags(&ReloadableFlags{MaxPageSize: MaxPageSizeLimit + 1}); err == nil {
+		t.Error("Expected a max page size over the limit to be rejected")
+	}
+	if Get().MaxPageSize != 500 {
+		t.Errorf("Expected a rejected reload to not change the config, received max page size %d", Get().MaxPageSize)
+	}
+}
@@ -2,18 +2,25 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	runtimeDebug "runtime/debug"
+	"text/tabwriter"
 
 	golog "github.com/ipfs/go-log"
 	joonix "github.com/joonix/log"
+	"github.com/prysmaticlabs/prysm/beacon-chain/bench"
+	"github.com/prysmaticlabs/prysm/beacon-chain/configdiff"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
@@ -21,10 +28,11 @@ import (
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	_ "go.uber.org/automaxprocs"
 	"gopkg.in/urfave/cli.v2"
-	"gopkg.in/urfave/cli.v2/altsrc"
 )
 
 var appFlags = []cli.Flag{
+	flags.NetworkFlag,
+	flags.NetworkConfigFileFlag,
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
@@ -35,10 +43,22 @@ var appFlags = []cli.Flag{
 	flags.GRPCGatewayPort,
 	flags.MinSyncPeers,
 	flags.RPCMaxPageSize,
+	flags.EnableDebugRPCEndpoints,
+	flags.EnableAdminRPC,
+	flags.AdminRPCPort,
+	flags.DatabaseBackupOutputDir,
+	flags.DatabaseRestoreFile,
+	flags.ColdStateDataDir,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.UnsafeSync,
+	flags.CheckpointStateFlag,
+	flags.CheckpointBlockFlag,
+	flags.CheckpointBlockRootFlag,
 	flags.EnableDiscv5,
+	flags.TrackValidatorFlag,
+	flags.ValidatorStatusWebhookFlag,
+	flags.ValidatorBalanceAlertThresholdFlag,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -47,7 +67,18 @@ var appFlags = []cli.Flag{
 	flags.ArchiveValidatorSetChangesFlag,
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
+	flags.ArchiveMinFreeDiskSpaceFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.SlotsPerColdCheckpoint,
+	flags.ArchivedPointsPerFullState,
+	flags.ReplayPrefetchDepth,
+	flags.ReplayBundleDir,
+	flags.HotStateCacheSize,
+	flags.AdaptiveSaveReplayTarget,
+	flags.AdaptiveSaveMinFreeDiskSpace,
+	flags.StateSoftDeleteWindow,
+	flags.HashSelfTestFlag,
+	flags.DumpConfigFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -57,6 +88,9 @@ var appFlags = []cli.Flag{
 	cmd.P2PIP,
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
+	cmd.P2PIPMode,
+	cmd.P2PIP6,
+	cmd.P2PHost6,
 	cmd.P2PMaxPeers,
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
@@ -85,9 +119,83 @@ var appFlags = []cli.Flag{
 }
 
 func init() {
+	appFlags = append(appFlags, flags.DeprecatedAliasFlags(flags.DeprecatedFlags)...)
 	appFlags = cmd.WrapFlags(append(appFlags, featureconfig.BeaconChainFlags...))
 }
 
+func runBench(ctx *cli.Context) error {
+	featureconfig.ConfigureBeaconChain(ctx)
+	report, err := bench.Run(context.Background(), &bench.Config{
+		DataDir:                 ctx.String(cmd.DataDirFlag.Name),
+		NumValidators:           ctx.Uint64(flags.BenchValidatorsFlag.Name),
+		NumBlocks:               ctx.Uint64(flags.BenchBlocksFlag.Name),
+		MaxAttestationsPerBlock: ctx.Uint64(flags.BenchAttestationsPerBlockFlag.Name),
+		HotStateCacheSize:       ctx.Int(flags.HotStateCacheSize.Name),
+	})
+	if err != nil {
+		return err
+	}
+	report.Print(os.Stdout)
+	return nil
+}
+
+func runConfigDiff(ctx *cli.Context) error {
+	return configdiff.Run(context.Background(), ctx.StringSlice(flags.ConfigDiffRPCFlag.Name), os.Stdout)
+}
+
+func runDBStats(ctx *cli.Context) error {
+	dbPath := filepath.Join(ctx.String(cmd.DataDirFlag.Name), node.BeaconChainDBName)
+	d, err := db.NewDBWithColdStorage(dbPath, ctx.String(flags.ColdStateDataDir.Name))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			logrus.WithError(err).Error("Could not close database")
+		}
+	}()
+	stats, err := d.DatabaseStats(context.Background())
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Schema version\t%d\n", stats.SchemaVersion)
+	fmt.Fprintf(w, "File size\t%d\n", stats.FileSize)
+	fmt.Fprintf(w, "Free size\t%d\n\n", stats.FreeSize)
+	fmt.Fprintln(w, "BUCKET\tKEYS\tSIZE\tIN USE")
+	for _, b := range stats.Buckets {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", b.Name, b.Keys, b.Size, b.InUse)
+	}
+	return w.Flush()
+}
+
+func runDBCompact(ctx *cli.Context) error {
+	dbPath := filepath.Join(ctx.String(cmd.DataDirFlag.Name), node.BeaconChainDBName)
+	before, after, err := db.Compact(context.Background(), dbPath, ctx.String(flags.ColdStateDataDir.Name))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Compacted database from %d to %d bytes\n", before, after)
+	return nil
+}
+
+// dumpConfig resolves the global and feature flags as the node does on startup and prints them.
+func dumpConfig(ctx *cli.Context) error {
+	featureconfig.ConfigureBeaconChain(ctx)
+	if err := flags.ConfigureGlobalFlags(ctx); err != nil {
+		return err
+	}
+	return flags.WriteEffectiveConfig(os.Stdout)
+}
+
+func runHashSelfTest(_ *cli.Context) error {
+	if err := hashutil.SelfTest(); err != nil {
+		return err
+	}
+	fmt.Println("All hash self-tests passed")
+	return nil
+}
+
 func main() {
 	log := logrus.WithField("prefix", "main")
 	app := cli.App{}
@@ -97,13 +205,66 @@ func main() {
 	app.Version = version.GetVersion()
 
 	app.Flags = appFlags
+	app.Commands = []*cli.Command{
+		{
+			Name:  "bench",
+			Usage: "drives synthetic blocks and attestations through the processing pipeline and reports throughput",
+			Flags: append([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.BenchValidatorsFlag,
+				flags.BenchBlocksFlag,
+				flags.BenchAttestationsPerBlockFlag,
+				flags.HotStateCacheSize,
+			}, featureconfig.BeaconChainFlags...),
+			Action: runBench,
+		},
+		{
+			Name:  "config",
+			Usage: "inspects the configuration of running beacon nodes",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "diff",
+					Usage:  "prints the flags whose values differ between two nodes, fetched from their debug RPC endpoints",
+					Flags:  []cli.Flag{flags.ConfigDiffRPCFlag},
+					Action: runConfigDiff,
+				},
+			},
+		},
+		{
+			Name:  "db",
+			Usage: "inspects and maintains the beacon node database, the beacon node must be stopped",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "stats",
+					Usage:  "prints the size of the database and the number of keys and bytes of each of its buckets",
+					Flags:  []cli.Flag{cmd.DataDirFlag, flags.ColdStateDataDir},
+					Action: runDBStats,
+				},
+				{
+					Name:   "compact",
+					Usage:  "rewrites the database without the free pages left by pruning to reclaim disk space, offline only: stop the beacon node first",
+					Flags:  []cli.Flag{cmd.DataDirFlag, flags.ColdStateDataDir},
+					Action: runDBCompact,
+				},
+			},
+		},
+		{
+			Name:   "hash-self-test",
+			Usage:  "verifies the hash functions against known vectors, catching broken hashing on unusual hardware",
+			Action: runHashSelfTest,
+		},
+	}
 
 	app.Before = func(ctx *cli.Context) error {
 		// Load any flags from file, if specified.
-		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
-			if err := altsrc.InitInputSourceWithContext(appFlags, altsrc.NewYamlSourceFromFlagFunc(cmd.ConfigFileFlag.Name))(ctx); err != nil {
-				return err
-			}
+		if err := cmd.LoadFlagsFromConfigFile(ctx, appFlags); err != nil {
+			return err
+		}
+		if err := flags.ForwardDeprecatedFlags(ctx, flags.DeprecatedFlags); err != nil {
+			return err
+		}
+		if err := flags.ApplyNetworkPreset(ctx); err != nil {
+			return err
 		}
 
 		format := ctx.String(cmd.LogFormat.Name)
@@ -125,7 +286,7 @@ func main() {
 			logrus.SetFormatter(f)
 			break
 		case "json":
-			logrus.SetFormatter(&logrus.JSONFormatter{})
+			logrus.SetFormatter(logutil.NewJSONFormatter())
 			break
 		default:
 			return fmt.Errorf("unknown log format %s", format)
@@ -169,6 +330,17 @@ func startNode(ctx *cli.Context) error {
 		golog.SetAllLoggers(gologging.DEBUG)
 	}
 
+	if ctx.Bool(flags.DumpConfigFlag.Name) {
+		return dumpConfig(ctx)
+	}
+
+	if ctx.Bool(flags.HashSelfTestFlag.Name) {
+		if err := hashutil.SelfTest(); err != nil {
+			return fmt.Errorf("hash self-test failed, refusing to start: %v", err)
+		}
+		logrus.WithField("prefix", "main").Info("Hash self-test passed")
+	}
+
 	beacon, err := node.NewBeaconNode(ctx)
 	if err != nil {
 		return err
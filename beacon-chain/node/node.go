@@ -4,6 +4,7 @@ package node
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -30,12 +31,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	initialsyncold "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync-old"
+	"github.com/prysmaticlabs/prysm/beacon-chain/validatorstatus"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -51,7 +55,9 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const beaconChainDBName = "beaconchaindata"
+// BeaconChainDBName is the directory of the beacon chain database in the data directory.
+const BeaconChainDBName = "beaconchaindata"
+
 const testSkipPowFlag = "test-skip-pow"
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -88,7 +94,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 	}
 
 	featureconfig.ConfigureBeaconChain(ctx)
-	flags.ConfigureGlobalFlags(ctx)
+	if err := flags.ConfigureNetworkForks(ctx); err != nil {
+		return nil, err
+	}
+	if err := flags.ConfigureGlobalFlags(ctx); err != nil {
+		return nil, err
+	}
 	registry := shared.NewServiceRegistry()
 
 	beacon := &BeaconNode{
@@ -151,6 +162,14 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerPrunerService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerValidatorStatusService(ctx); err != nil {
+		return nil, err
+	}
+
 	if !ctx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := beacon.registerPrometheusService(ctx); err != nil {
 			return nil, err
@@ -188,6 +207,8 @@ func (b *BeaconNode) Start() {
 	stop := b.stop
 	b.lock.Unlock()
 
+	go b.reloadFlagsOnSignal(stop)
+
 	go func() {
 		sigc := make(chan os.Signal, 1)
 		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
@@ -209,6 +230,29 @@ func (b *BeaconNode) Start() {
 	<-stop
 }
 
+// reloadFlagsOnSignal reloads the flags which can be changed at runtime from the config file of
+// --config-file every time the node receives a SIGHUP, until the node stops.
+func (b *BeaconNode) reloadFlagsOnSignal(stop chan struct{}) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+	for {
+		select {
+		case <-sighup:
+			configFile := b.ctx.String(cmd.ConfigFileFlag.Name)
+			if configFile == "" {
+				log.Warn("Received SIGHUP without a --config-file to reload flags from")
+				continue
+			}
+			if _, err := flags.ReloadFlagsFromConfigFile(configFile); err != nil {
+				log.WithError(err).Error("Could not reload flags from config file")
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
 // Close handles graceful shutdown of the system.
 func (b *BeaconNode) Close() {
 	b.lock.Lock()
@@ -227,13 +271,43 @@ func (b *BeaconNode) startForkChoice() {
 	b.forkChoiceStore = f
 }
 
+// restoreDB replaces the database with the snapshot file once. The snapshot is renamed after being
+// restored, the flag being left in the configuration of the node must not roll the database back
+// to the snapshot on every restart.
+func restoreDB(snapshotPath string, dbPath string, coldDBPath string) error {
+	restoredPath := snapshotPath + ".restored"
+	if _, err := os.Stat(snapshotPath); os.IsNotExist(err) {
+		if _, err := os.Stat(restoredPath); err == nil {
+			log.WithField("snapshot", restoredPath).Info("Database snapshot already restored, skipping restore")
+			return nil
+		}
+	}
+	if err := db.Restore(context.Background(), snapshotPath, dbPath, coldDBPath); err != nil {
+		return errors.Wrap(err, "could not restore database")
+	}
+	if err := os.Rename(snapshotPath, restoredPath); err != nil {
+		return errors.Wrap(err, "could not mark database snapshot as restored")
+	}
+	coldSnapshotPath := db.ColdSnapshotPath(snapshotPath)
+	if err := os.Rename(coldSnapshotPath, coldSnapshotPath+".restored"); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "could not mark cold state database snapshot as restored")
+	}
+	return nil
+}
+
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.String(cmd.DataDirFlag.Name)
-	dbPath := path.Join(baseDir, beaconChainDBName)
+	dbPath := path.Join(baseDir, BeaconChainDBName)
 	clearDB := ctx.Bool(cmd.ClearDB.Name)
 	forceClearDB := ctx.Bool(cmd.ForceClearDB.Name)
 
-	d, err := db.NewDB(dbPath)
+	coldDBPath := ctx.String(flags.ColdStateDataDir.Name)
+	if restoreFile := ctx.String(flags.DatabaseRestoreFile.Name); restoreFile != "" {
+		if err := restoreDB(restoreFile, dbPath, coldDBPath); err != nil {
+			return err
+		}
+	}
+	d, err := db.NewDBWithColdStorage(dbPath, coldDBPath)
 	if err != nil {
 		return err
 	}
@@ -252,12 +326,15 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 		if err := d.ClearDB(); err != nil {
 			return err
 		}
-		d, err = db.NewDB(dbPath)
+		d, err = db.NewDBWithColdStorage(dbPath, coldDBPath)
 		if err != nil {
 			return err
 		}
 	}
 	log.WithField("database-path", dbPath).Info("Checking DB")
+	if coldDBPath != "" {
+		log.WithField("cold-database-path", coldDBPath).Info("Storing cold states in a separate database")
+	}
 	b.db = d
 	b.depositCache = depositcache.NewDepositCache()
 	return nil
@@ -265,6 +342,9 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
+	if featureconfig.Get().AdaptiveStateSaves {
+		b.stateGen.EnableAdaptiveSaves(b.db.DatabasePath())
+	}
 }
 
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
@@ -294,6 +374,9 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		LocalIP:           ctx.String(cmd.P2PIP.Name),
 		HostAddress:       ctx.String(cmd.P2PHost.Name),
 		HostDNS:           ctx.String(cmd.P2PHostDNS.Name),
+		IPMode:            ctx.String(cmd.P2PIPMode.Name),
+		LocalIP6:          ctx.String(cmd.P2PIP6.Name),
+		HostAddress6:      ctx.String(cmd.P2PHost6.Name),
 		PrivateKey:        ctx.String(cmd.P2PPrivKey.Name),
 		TCPPort:           ctx.Uint(cmd.P2PTCPPort.Name),
 		UDPPort:           ctx.Uint(cmd.P2PUDPPort.Name),
@@ -338,6 +421,11 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		return err
 	}
 
+	checkpointSync, err := checkpointSyncConfig(ctx)
+	if err != nil {
+		return err
+	}
+
 	maxRoutines := ctx.Int64(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(context.Background(), &blockchain.Config{
 		BeaconDB:          b.db,
@@ -352,13 +440,37 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		ForkChoiceStore:   b.forkChoiceStore,
 		OpsService:        opsService,
 		StateGen:          b.stateGen,
+		CheckpointSync:    checkpointSync,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
 	}
+	// State gen resolves the canonical chain from the head chosen by fork choice.
+	b.stateGen.SetHeadFetcher(blockchainService)
 	return b.services.RegisterService(blockchainService)
 }
 
+// This returns the checkpoint state the node starts from, or nil when no checkpoint state was set.
+func checkpointSyncConfig(ctx *cli.Context) (*blockchain.CheckpointSyncConfig, error) {
+	statePath := ctx.String(flags.CheckpointStateFlag.Name)
+	if statePath == "" {
+		return nil, nil
+	}
+	blockPath := ctx.String(flags.CheckpointBlockFlag.Name)
+	if blockPath == "" {
+		return nil, fmt.Errorf("--%s is required with --%s", flags.CheckpointBlockFlag.Name, flags.CheckpointStateFlag.Name)
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(ctx.String(flags.CheckpointBlockRootFlag.Name), "0x"))
+	if err != nil || len(root) != 32 {
+		return nil, fmt.Errorf("--%s must be a hex encoded 32 bytes root", flags.CheckpointBlockRootFlag.Name)
+	}
+	return &blockchain.CheckpointSyncConfig{
+		StatePath: statePath,
+		BlockPath: blockPath,
+		BlockRoot: bytesutil.ToBytes32(root),
+	}, nil
+}
+
 func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	if cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
@@ -437,6 +549,7 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 		AttPool:             b.attestationPool,
 		ExitPool:            b.exitPool,
 		SlashingPool:        b.slashingsPool,
+		StateGen:            b.stateGen,
 	})
 
 	return b.services.RegisterService(rs)
@@ -519,37 +632,48 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	slasherProvider := ctx.String(flags.SlasherProviderFlag.Name)
 
 	mockEth1DataVotes := ctx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
-	rpcService := rpc.NewService(context.Background(), &rpc.Config{
-		Host:                  host,
-		Port:                  port,
-		CertFlag:              cert,
-		KeyFlag:               key,
-		BeaconDB:              b.db,
-		Broadcaster:           b.fetchP2P(ctx),
-		PeersFetcher:          b.fetchP2P(ctx),
-		HeadFetcher:           chainService,
-		ForkFetcher:           chainService,
-		FinalizationFetcher:   chainService,
-		ParticipationFetcher:  chainService,
-		BlockReceiver:         chainService,
-		AttestationReceiver:   chainService,
-		GenesisTimeFetcher:    chainService,
-		AttestationsPool:      b.attestationPool,
-		ExitPool:              b.exitPool,
-		SlashingsPool:         b.slashingsPool,
-		POWChainService:       web3Service,
-		ChainStartFetcher:     chainStartFetcher,
-		MockEth1Votes:         mockEth1DataVotes,
-		SyncService:           syncService,
-		DepositFetcher:        depositFetcher,
-		PendingDepositFetcher: b.depositCache,
-		BlockNotifier:         b,
-		StateNotifier:         b,
-		OperationNotifier:     b,
-		SlasherCert:           slasherCert,
-		SlasherProvider:       slasherProvider,
-		StateGen:              b.stateGen,
-	})
+	cfg := &rpc.Config{
+		Host:                    host,
+		Port:                    port,
+		CertFlag:                cert,
+		KeyFlag:                 key,
+		BeaconDB:                b.db,
+		Broadcaster:             b.fetchP2P(ctx),
+		PeersFetcher:            b.fetchP2P(ctx),
+		PeerInfoFetcher:         b.fetchP2P(ctx),
+		HeadFetcher:             chainService,
+		ForkFetcher:             chainService,
+		FinalizationFetcher:     chainService,
+		ParticipationFetcher:    chainService,
+		BlockArrivalFetcher:     chainService,
+		BlockReceiver:           chainService,
+		AttestationReceiver:     chainService,
+		GenesisTimeFetcher:      chainService,
+		AttestationsPool:        b.attestationPool,
+		ExitPool:                b.exitPool,
+		SlashingsPool:           b.slashingsPool,
+		POWChainService:         web3Service,
+		ChainStartFetcher:       chainStartFetcher,
+		MockEth1Votes:           mockEth1DataVotes,
+		SyncService:             syncService,
+		DepositFetcher:          depositFetcher,
+		PendingDepositFetcher:   b.depositCache,
+		BlockNotifier:           b,
+		StateNotifier:           b,
+		OperationNotifier:       b,
+		SlasherCert:             slasherCert,
+		SlasherProvider:         slasherProvider,
+		StateGen:                b.stateGen,
+		EnableDebugRPCEndpoints: ctx.Bool(flags.EnableDebugRPCEndpoints.Name),
+		EffectiveFlags:          cmd.EffectiveFlags(ctx),
+		BackupOutputDir:         ctx.String(flags.DatabaseBackupOutputDir.Name),
+		AdminPort:               ctx.String(flags.AdminRPCPort.Name),
+	}
+	if ctx.Bool(flags.EnableAdminRPC.Name) {
+		cfg.DatabaseBackuper = b.db
+		cfg.StateRestorer = b.db
+	}
+	rpcService := rpc.NewService(context.Background(), cfg)
 
 	return b.services.RegisterService(rpcService)
 }
@@ -568,7 +692,7 @@ func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
 	}
 
 	if featureconfig.Get().EnableBackupWebhook {
-		additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/db/backup", Handler: db.BackupHandler(b.db)})
+		additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/db/backup", Handler: db.BackupHandler(b.db, ctx.String(flags.DatabaseBackupOutputDir.Name))})
 	}
 
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
@@ -626,6 +750,50 @@ func (b *BeaconNode) registerArchiverService(ctx *cli.Context) error {
 		HeadFetcher:          chainService,
 		ParticipationFetcher: chainService,
 		StateNotifier:        b,
+		DataDir:              ctx.String(cmd.DataDirFlag.Name),
+		MinFreeDiskSpace:     ctx.Uint64(flags.ArchiveMinFreeDiskSpaceFlag.Name) * 1024 * 1024,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerPrunerService() error {
+	// Archival nodes keep the history of all the forks.
+	if flags.Get().EnableArchive || flags.Get().EnableArchivedBlocks {
+		return nil
+	}
+	svc := pruner.NewPrunerService(context.Background(), &pruner.Config{
+		BeaconDB:      b.db,
+		StateNotifier: b,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerValidatorStatusService(ctx *cli.Context) error {
+	keys := ctx.StringSlice(flags.TrackValidatorFlag.Name)
+	if len(keys) == 0 {
+		return nil
+	}
+	pubKeys := make([][48]byte, len(keys))
+	for i, k := range keys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(k, "0x"))
+		if err != nil {
+			return errors.Wrapf(err, "could not decode tracked validator public key %s", k)
+		}
+		if len(pubKey) != 48 {
+			return fmt.Errorf("tracked validator public key %s is not 48 bytes", k)
+		}
+		copy(pubKeys[i][:], pubKey)
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc := validatorstatus.NewService(context.Background(), &validatorstatus.Config{
+		HeadFetcher:      chainService,
+		StateNotifier:    b,
+		TrackedPubkeys:   pubKeys,
+		WebhookEndpoints: ctx.StringSlice(flags.ValidatorStatusWebhookFlag.Name),
+		BalanceThreshold: ctx.Uint64(flags.ValidatorBalanceAlertThresholdFlag.Name),
 	})
 	return b.services.RegisterService(svc)
 }
@@ -1,12 +1,17 @@
 package node
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"path"
 	"testing"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"gopkg.in/urfave/cli.v2"
@@ -44,3 +49,39 @@ func TestNodeClose_OK(t *testing.T) {
 
 	os.RemoveAll(tmp)
 }
+
+func TestRestoreDB_RestoresSnapshotOnce(t *testing.T) {
+	d := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, d)
+	ctx := context.Background()
+
+	head := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	if err := d.SaveBlock(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(head.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SaveHeadBlockRoot(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	tmp := fmt.Sprintf("%s/restoretest", testutil.TempDir())
+	defer os.RemoveAll(tmp)
+	snapshotPath, err := d.Backup(ctx, path.Join(tmp, "backups"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbPath := path.Join(tmp, BeaconChainDBName)
+	if err := restoreDB(snapshotPath, dbPath, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(snapshotPath + ".restored"); err != nil {
+		t.Fatalf("Expected the snapshot to be marked as restored: %v", err)
+	}
+	// A restart with the flag still set keeps the database.
+	if err := restoreDB(snapshotPath, dbPath, ""); err != nil {
+		t.Errorf("Expected the restored snapshot to be skipped, got %v", err)
+	}
+}
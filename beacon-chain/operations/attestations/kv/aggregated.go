@@ -15,7 +15,7 @@ func (p *AttCaches) SaveAggregatedAttestation(att *ethpb.Attestation) error {
 	if !helpers.IsAggregated(att) {
 		return errors.New("attestation is not aggregated")
 	}
-	r, err := hashFn(att.Data)
+	r, err := hashFn(aggregatedAttNamespace, att.Data)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
@@ -86,7 +86,7 @@ func (p *AttCaches) DeleteAggregatedAttestation(att *ethpb.Attestation) error {
 	if !helpers.IsAggregated(att) {
 		return errors.New("attestation is not aggregated")
 	}
-	r, err := hashFn(att.Data)
+	r, err := hashFn(aggregatedAttNamespace, att.Data)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation data")
 	}
@@ -118,7 +118,7 @@ func (p *AttCaches) HasAggregatedAttestation(att *ethpb.Attestation) (bool, erro
 	if att == nil || att.Data == nil {
 		return false, nil
 	}
-	r, err := hashFn(att.Data)
+	r, err := hashFn(aggregatedAttNamespace, att.Data)
 	if err != nil {
 		return false, errors.Wrap(err, "could not tree hash attestation")
 	}
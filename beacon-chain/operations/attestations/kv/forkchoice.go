@@ -11,7 +11,7 @@ func (p *AttCaches) SaveForkchoiceAttestation(att *ethpb.Attestation) error {
 	if att == nil {
 		return nil
 	}
-	r, err := hashFn(att)
+	r, err := hashFn(forkchoiceAttNamespace, att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
@@ -52,7 +52,7 @@ func (p *AttCaches) DeleteForkchoiceAttestation(att *ethpb.Attestation) error {
 	if att == nil {
 		return nil
 	}
-	r, err := hashFn(att)
+	r, err := hashFn(forkchoiceAttNamespace, att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
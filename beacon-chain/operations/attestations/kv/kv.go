@@ -7,7 +7,15 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
 
-var hashFn = hashutil.HashProto
+// Namespaces separating the keys of the attestation caches, see hashutil.CacheKey.
+const (
+	aggregatedAttNamespace   = "aggregated-attestation"
+	unaggregatedAttNamespace = "unaggregated-attestation"
+	forkchoiceAttNamespace   = "forkchoice-attestation"
+	blockAttNamespace        = "block-attestation"
+)
+
+var hashFn = hashutil.CacheKeyProto
 
 // AttCaches defines the caches used to satisfy attestation pool interface.
 // These caches are KV store for various attestations
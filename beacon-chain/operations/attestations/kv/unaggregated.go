@@ -16,7 +16,7 @@ func (p *AttCaches) SaveUnaggregatedAttestation(att *ethpb.Attestation) error {
 		return errors.New("attestation is aggregated")
 	}
 
-	r, err := hashFn(att)
+	r, err := hashFn(unaggregatedAttNamespace, att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
@@ -61,7 +61,7 @@ func (p *AttCaches) DeleteUnaggregatedAttestation(att *ethpb.Attestation) error
 		return errors.New("attestation is aggregated")
 	}
 
-	r, err := hashFn(att)
+	r, err := hashFn(unaggregatedAttNamespace, att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
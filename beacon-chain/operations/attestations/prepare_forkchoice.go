@@ -19,6 +19,9 @@ import (
 // Prepare attestations for fork choice at every half of the slot.
 var prepareForkChoiceAttsPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot/3) * time.Second
 
+// seenAttNamespace separates the keys of the fork choice processed roots cache from other cache keys.
+const seenAttNamespace = "seen-attestation"
+
 // This prepares fork choice attestations by running batchForkChoiceAtts
 // every prepareForkChoiceAttsPeriod.
 func (s *Service) prepareForkChoiceAtts() {
@@ -104,7 +107,7 @@ func (s *Service) aggregateAndSaveForkChoiceAtts(atts []*ethpb.Attestation) erro
 // This checks if the attestation has previously been aggregated for fork choice
 // return true if yes, false if no.
 func (s *Service) seen(att *ethpb.Attestation) (bool, error) {
-	attRoot, err := hashutil.HashProto(att.Data)
+	attRoot, err := hashutil.CacheKeyProto(seenAttNamespace, att.Data)
 	if err != nil {
 		return false, err
 	}
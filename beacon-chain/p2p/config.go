@@ -1,5 +1,15 @@
 package p2p
 
+// The IP modes of the p2p service, the IP versions used to listen and to advertise the node.
+const (
+	// IPModeIPv4 listens and advertises on IPv4 only.
+	IPModeIPv4 = "ipv4"
+	// IPModeIPv6 listens and advertises on IPv6 only, for hosts without an IPv4 address.
+	IPModeIPv6 = "ipv6"
+	// IPModeDualStack listens and advertises on both IPv4 and IPv6.
+	IPModeDualStack = "dual-stack"
+)
+
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
@@ -12,6 +22,9 @@ type Config struct {
 	LocalIP               string
 	HostAddress           string
 	HostDNS               string
+	IPMode                string
+	LocalIP6              string
+	HostAddress6          string
 	PrivateKey            string
 	DataDir               string
 	TCPPort               uint
@@ -34,23 +34,36 @@ type Listener interface {
 	LocalNode() *enode.LocalNode
 }
 
-func createListener(ipAddr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) *discover.UDPv5 {
+// createListener creates the discv5 listener on the IPv4 or the IPv6 address which isn't nil. When
+// both are given, in dual-stack mode, a single dual-stack socket listens on all the addresses.
+func createListener(ipAddr net.IP, ipAddr6 net.IP, privKey *ecdsa.PrivateKey, cfg *Config) *discover.UDPv5 {
 	udpAddr := &net.UDPAddr{
-		IP:   ipAddr,
 		Port: int(cfg.UDPPort),
 	}
-	// assume ip is either ipv4 or ipv6
 	networkVersion := ""
-	if ipAddr.To4() != nil {
-		networkVersion = "udp4"
-	} else {
+	switch {
+	case ipAddr != nil && ipAddr6 != nil:
+		// Discv5 takes a single connection, which receives both the IPv4 and the IPv6 packets
+		// when bound to the unspecified IPv6 address.
+		udpAddr.IP = net.IPv6unspecified
+		networkVersion = "udp"
+	case ipAddr6 != nil:
+		udpAddr.IP = ipAddr6
 		networkVersion = "udp6"
+	default:
+		// assume ip is either ipv4 or ipv6
+		udpAddr.IP = ipAddr
+		if ipAddr.To4() != nil {
+			networkVersion = "udp4"
+		} else {
+			networkVersion = "udp6"
+		}
 	}
 	conn, err := net.ListenUDP(networkVersion, udpAddr)
 	if err != nil {
 		log.Fatal(err)
 	}
-	localNode, err := createLocalNode(privKey, ipAddr, int(cfg.UDPPort), int(cfg.TCPPort))
+	localNode, err := createLocalNode(privKey, ipAddr, ipAddr6, int(cfg.UDPPort), int(cfg.TCPPort))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -62,6 +75,18 @@ func createListener(ipAddr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) *disc
 			localNode.SetFallbackIP(hostIP)
 		}
 	}
+	if cfg.HostAddress6 != "" && ipAddr6 != nil {
+		hostIP := net.ParseIP(cfg.HostAddress6)
+		switch {
+		case hostIP == nil || hostIP.To4() != nil:
+			log.Errorf("Invalid ipv6 host address given: %s", cfg.HostAddress6)
+		case ipAddr != nil:
+			// The fallback IP is the IPv4 one in dual-stack mode, only the ip6 entry is replaced.
+			localNode.Set(enr.IPv6(hostIP))
+		default:
+			localNode.SetFallbackIP(hostIP)
+		}
+	}
 	dv5Cfg := discover.Config{
 		PrivateKey: privKey,
 	}
@@ -81,26 +106,43 @@ func createListener(ipAddr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) *disc
 	return network
 }
 
-func createLocalNode(privKey *ecdsa.PrivateKey, ipAddr net.IP, udpPort int, tcpPort int) (*enode.LocalNode, error) {
+// createLocalNode creates the local node with the ENR entries of the IPv4 and the IPv6 addresses
+// which aren't nil. The IPv6 entries use the same ports as the IPv4 ones. The fallback IP of the
+// node is the IPv4 address, the IPv6 one only in IPv6 mode. A loopback IPv6 address can't be
+// reached by peers and isn't advertised.
+func createLocalNode(privKey *ecdsa.PrivateKey, ipAddr net.IP, ipAddr6 net.IP, udpPort int, tcpPort int) (*enode.LocalNode, error) {
 	db, err := enode.OpenDB("")
 	if err != nil {
 		return nil, errors.Wrap(err, "could not open node's peer database")
 	}
 	localNode := enode.NewLocalNode(db, privKey)
-	ipEntry := enr.IP(ipAddr)
-	udpEntry := enr.UDP(udpPort)
-	tcpEntry := enr.TCP(tcpPort)
-	localNode.Set(ipEntry)
-	localNode.Set(udpEntry)
-	localNode.Set(tcpEntry)
-	localNode.SetFallbackIP(ipAddr)
+	if ipAddr != nil || ipAddr6 == nil {
+		ipEntry := enr.IP(ipAddr)
+		udpEntry := enr.UDP(udpPort)
+		tcpEntry := enr.TCP(tcpPort)
+		localNode.Set(ipEntry)
+		localNode.Set(udpEntry)
+		localNode.Set(tcpEntry)
+		localNode.SetFallbackIP(ipAddr)
+	}
+	if ipAddr6 != nil && !ipAddr6.IsLoopback() {
+		ip6Entry := enr.IPv6(ipAddr6)
+		udp6Entry := enr.UDP6(udpPort)
+		tcp6Entry := enr.TCP6(tcpPort)
+		localNode.Set(ip6Entry)
+		localNode.Set(udp6Entry)
+		localNode.Set(tcp6Entry)
+		if ipAddr == nil {
+			localNode.SetFallbackIP(ipAddr6)
+		}
+	}
 	localNode.SetFallbackUDP(udpPort)
 
 	return intializeAttSubnets(localNode), nil
 }
 
-func startDiscoveryV5(addr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) (*discover.UDPv5, error) {
-	listener := createListener(addr, privKey, cfg)
+func startDiscoveryV5(addr net.IP, addr6 net.IP, privKey *ecdsa.PrivateKey, cfg *Config) (*discover.UDPv5, error) {
+	listener := createListener(addr, addr6, privKey, cfg)
 	record := listener.Self()
 	log.WithField("ENR", record.String()).Info("Started discovery v5")
 	return listener, nil
@@ -176,7 +218,7 @@ func convertToMultiAddr(nodes []*enode.Node) []ma.Multiaddr {
 	var multiAddrs []ma.Multiaddr
 	for _, node := range nodes {
 		// ignore nodes with no ip address stored
-		if node.IP() == nil {
+		if node.IP() == nil && nodeIP6(node) == nil {
 			continue
 		}
 		multiAddr, err := convertToSingleMultiAddr(node)
@@ -189,18 +231,31 @@ func convertToMultiAddr(nodes []*enode.Node) []ma.Multiaddr {
 	return multiAddrs
 }
 
+// convertToSingleMultiAddr converts the node to the multiaddr of its IPv4 address, or of its IPv6
+// address for the nodes which only advertise IPv6.
 func convertToSingleMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
-	ip4 := node.IP().To4()
-	if ip4 == nil {
-		return nil, errors.Errorf("node doesn't have an ip4 address, it's stated IP is %s", node.IP().String())
-	}
 	pubkey := node.Pubkey()
 	assertedKey := convertToInterfacePubkey(pubkey)
 	id, err := peer.IDFromPublicKey(assertedKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get peer id")
 	}
-	multiAddrString := fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ip4.String(), node.TCP(), id)
+	var multiAddrString string
+	if ip4 := node.IP().To4(); ip4 != nil {
+		multiAddrString = fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ip4.String(), node.TCP(), id)
+	} else {
+		ip6 := nodeIP6(node)
+		if ip6 == nil {
+			return nil, errors.Errorf("node doesn't have an ip4 or ip6 address, it's stated IP is %s", node.IP().String())
+		}
+		// The tcp6 entry defaults to the tcp entry when missing.
+		tcpPort := node.TCP()
+		var tcp6 enr.TCP6
+		if err := node.Load(&tcp6); err == nil {
+			tcpPort = int(tcp6)
+		}
+		multiAddrString = fmt.Sprintf("/ip6/%s/tcp/%d/p2p/%s", ip6.String(), tcpPort, id)
+	}
 	multiAddr, err := ma.NewMultiaddr(multiAddrString)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get multiaddr")
@@ -208,6 +263,15 @@ func convertToSingleMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
 	return multiAddr, nil
 }
 
+// nodeIP6 returns the ip6 entry of the node, or nil if the node doesn't advertise an IPv6 address.
+func nodeIP6(node *enode.Node) net.IP {
+	var ip6 enr.IPv6
+	if err := node.Load(&ip6); err != nil {
+		return nil
+	}
+	return net.IP(ip6).To16()
+}
+
 func peersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 	var allAddrs []ma.Multiaddr
 	enodeString, multiAddrString := parseGenericAddrs(addrs)
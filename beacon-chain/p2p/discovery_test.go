@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -51,7 +52,7 @@ func createAddrAndPrivKey(t *testing.T) (net.IP, *ecdsa.PrivateKey) {
 func TestCreateListener(t *testing.T) {
 	port := 1024
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	listener := createListener(ipAddr, pkey, &Config{UDPPort: uint(port)})
+	listener := createListener(ipAddr, nil, pkey, &Config{UDPPort: uint(port)})
 	defer listener.Close()
 
 	if !listener.Self().IP().Equal(ipAddr) {
@@ -73,7 +74,7 @@ func TestCreateListener(t *testing.T) {
 func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 	port := 2000
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	bootListener := createListener(ipAddr, pkey, &Config{UDPPort: uint(port)})
+	bootListener := createListener(ipAddr, nil, pkey, &Config{UDPPort: uint(port)})
 	defer bootListener.Close()
 
 	bootNode := bootListener.Self()
@@ -87,7 +88,7 @@ func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 		port = 3000 + i
 		cfg.UDPPort = uint(port)
 		ipAddr, pkey := createAddrAndPrivKey(t)
-		listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+		listener, err := startDiscoveryV5(ipAddr, nil, pkey, cfg)
 		if err != nil {
 			t.Errorf("Could not start discovery for node: %v", err)
 		}
@@ -113,7 +114,7 @@ func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 	port := 2000
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	bootListener := createListener(ipAddr, pkey, &Config{UDPPort: uint(port)})
+	bootListener := createListener(ipAddr, nil, pkey, &Config{UDPPort: uint(port)})
 	defer bootListener.Close()
 
 	bootNode := bootListener.Self()
@@ -135,7 +136,7 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 		port = 3000 + i
 		cfg.UDPPort = uint(port)
 		ipAddr, pkey := createAddrAndPrivKey(t)
-		listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+		listener, err := startDiscoveryV5(ipAddr, nil, pkey, cfg)
 		if err != nil {
 			t.Errorf("Could not start discovery for node: %v", err)
 		}
@@ -197,7 +198,7 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 	addr := net.ParseIP("invalidIP")
 	_, pkey := createAddrAndPrivKey(t)
-	node, err := createLocalNode(pkey, addr, 0, 0)
+	node, err := createLocalNode(pkey, addr, nil, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -210,7 +211,7 @@ func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 func TestMultiAddrConversion_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ipAddr, pkey := createAddrAndPrivKey(t)
-	listener := createListener(ipAddr, pkey, &Config{})
+	listener := createListener(ipAddr, nil, pkey, &Config{})
 
 	_ = convertToMultiAddr([]*enode.Node{listener.Self()})
 	testutil.AssertLogsDoNotContain(t, hook, "Node doesn't have an ip4 address")
@@ -218,6 +219,97 @@ func TestMultiAddrConversion_OK(t *testing.T) {
 	testutil.AssertLogsDoNotContain(t, hook, "Could not get multiaddr")
 }
 
+func TestMultiAddrConversion_IPv6Only(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	ip6 := net.ParseIP("2001:db8::1")
+	node, err := createLocalNode(pkey, nil, ip6, 4000, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	multiAddr, err := convertToSingleMultiAddr(node.Node())
+	if err != nil {
+		t.Fatalf("Could not convert ipv6 only node: %v", err)
+	}
+	if !strings.HasPrefix(multiAddr.String(), "/ip6/2001:db8::1/tcp/5000/p2p/") {
+		t.Errorf("Wanted an ip6 multiaddr with the tcp6 port, got %s", multiAddr.String())
+	}
+}
+
+func TestCreateLocalNode_DualStack(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	ip4 := net.ParseIP("192.0.2.1")
+	ip6 := net.ParseIP("2001:db8::1")
+	node, err := createLocalNode(pkey, ip4, ip6, 4000, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !node.Node().IP().Equal(ip4) {
+		t.Errorf("Wanted ip %s, got %s", ip4, node.Node().IP())
+	}
+	if got := nodeIP6(node.Node()); !got.Equal(ip6) {
+		t.Errorf("Wanted ip6 %s, got %s", ip6, got)
+	}
+	var tcp6 enr.TCP6
+	if err := node.Node().Load(&tcp6); err != nil || tcp6 != 5000 {
+		t.Errorf("Wanted tcp6 port 5000, got %d: %v", tcp6, err)
+	}
+	// IPv4 is preferred to dial dual-stack nodes.
+	multiAddr, err := convertToSingleMultiAddr(node.Node())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(multiAddr.String(), "/ip4/192.0.2.1/tcp/5000/p2p/") {
+		t.Errorf("Wanted an ip4 multiaddr, got %s", multiAddr.String())
+	}
+}
+
+func TestCreateLocalNode_DualStackLoopbackIPv6(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	ip4 := net.ParseIP("192.0.2.1")
+	node, err := createLocalNode(pkey, ip4, net.IPv6loopback, 4000, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !node.Node().IP().Equal(ip4) {
+		t.Errorf("Wanted ip %s, got %s", ip4, node.Node().IP())
+	}
+	if got := nodeIP6(node.Node()); got != nil {
+		t.Errorf("Wanted the loopback ip6 not advertised, got %s", got)
+	}
+}
+
+func TestCreateListener_DualStackHostAddress6(t *testing.T) {
+	port := 2200
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	hostIP6 := net.ParseIP("2001:db8::2")
+	listener := createListener(ipAddr, net.ParseIP("::1"), pkey, &Config{
+		UDPPort:      uint(port),
+		HostAddress6: hostIP6.String(),
+	})
+	defer listener.Close()
+
+	// The ipv6 host address doesn't replace the advertised IPv4 address.
+	if !listener.Self().IP().Equal(ipAddr) {
+		t.Errorf("Wanted ip %s, got %s", ipAddr, listener.Self().IP())
+	}
+	if got := nodeIP6(listener.Self()); !got.Equal(hostIP6) {
+		t.Errorf("Wanted ip6 %s, got %s", hostIP6, got)
+	}
+}
+
+func TestListenIPs_InvalidIPMode(t *testing.T) {
+	if _, _, err := listenIPs(&Config{IPMode: "ipv5"}); err == nil {
+		t.Error("Expected an error for an invalid ip mode")
+	}
+	ip4, ip6, err := listenIPs(&Config{IPMode: IPModeIPv6, LocalIP6: "2001:db8::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip4 != nil || !ip6.Equal(net.ParseIP("2001:db8::1")) {
+		t.Errorf("Wanted only the local ipv6 address in ipv6 mode, got %s and %s", ip4, ip6)
+	}
+}
+
 func TestStaticPeering_PeersAreAdded(t *testing.T) {
 	cfg := &Config{Encoding: "ssz", MaxPeers: 30}
 	port := 3000
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -21,6 +22,7 @@ type P2P interface {
 	Sender
 	ConnectionHandler
 	PeersProvider
+	PeerInfoProvider
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -66,3 +68,10 @@ type Sender interface {
 type PeersProvider interface {
 	Peers() *peers.Status
 }
+
+// PeerInfoProvider exposes what the libp2p host knows about the peers it is connected to.
+type PeerInfoProvider interface {
+	PeerAgent(pid peer.ID) string
+	PeerBandwidth(pid peer.ID) metrics.Stats
+	TotalBandwidth() metrics.Stats
+}
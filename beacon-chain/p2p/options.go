@@ -16,16 +16,24 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 )
 
-// buildOptions for the libp2p host.
-func buildOptions(cfg *Config, ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
-	listen, err := multiAddressBuilder(ip.String(), cfg.TCPPort)
-	if err != nil {
-		log.Fatalf("Failed to p2p listen: %v", err)
+// buildOptions for the libp2p host. The host listens on the IPv4 and IPv6 addresses which
+// aren't nil, both of them in dual-stack mode.
+func buildOptions(cfg *Config, ip net.IP, ip6 net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
+	var listenAddrs []ma.Multiaddr
+	for _, listenIP := range []net.IP{ip, ip6} {
+		if listenIP == nil {
+			continue
+		}
+		listen, err := multiAddressBuilder(listenIP.String(), cfg.TCPPort)
+		if err != nil {
+			log.Fatalf("Failed to p2p listen: %v", err)
+		}
+		listenAddrs = append(listenAddrs, listen)
 	}
 	options := []libp2p.Option{
 		privKeyOption(priKey),
 		libp2p.EnableRelay(),
-		libp2p.ListenAddrs(listen),
+		libp2p.ListenAddrs(listenAddrs...),
 		whitelistSubnet(cfg.WhitelistCIDR),
 		// Add one for the boot node and another for the relay, otherwise when we are close to maxPeers we will be above the high
 		// water mark and continually trigger pruning.
@@ -41,12 +49,16 @@ func buildOptions(cfg *Config, ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 	if cfg.RelayNodeAddr != "" {
 		options = append(options, libp2p.AddrsFactory(withRelayAddrs(cfg.RelayNodeAddr)))
 	}
-	if cfg.HostAddress != "" {
+	hostAddrs := hostAddresses(cfg, ip6 != nil)
+	if len(hostAddrs) > 0 {
+		// A single factory advertises the IPv4 and the IPv6 host addresses, as libp2p only takes one.
 		options = append(options, libp2p.AddrsFactory(func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
-			external, err := multiAddressBuilder(cfg.HostAddress, cfg.TCPPort)
-			if err != nil {
-				log.WithError(err).Error("Unable to create external multiaddress")
-			} else {
+			for _, hostAddr := range hostAddrs {
+				external, err := multiAddressBuilder(hostAddr, cfg.TCPPort)
+				if err != nil {
+					log.WithError(err).Error("Unable to create external multiaddress")
+					continue
+				}
 				addrs = append(addrs, external)
 			}
 			return addrs
@@ -54,10 +66,20 @@ func buildOptions(cfg *Config, ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 	}
 	if cfg.HostDNS != "" {
 		options = append(options, libp2p.AddrsFactory(func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
-			external, err := multiaddr.NewMultiaddr(fmt.Sprintf("/dns4/%s/tcp/%d", cfg.HostDNS, cfg.TCPPort))
-			if err != nil {
-				log.WithError(err).Error("Unable to create external multiaddress")
-			} else {
+			// The DNS is resolved to the addresses of the IP versions the node listens on.
+			var protocols []string
+			if ip != nil {
+				protocols = append(protocols, "dns4")
+			}
+			if ip6 != nil {
+				protocols = append(protocols, "dns6")
+			}
+			for _, protocol := range protocols {
+				external, err := multiaddr.NewMultiaddr(fmt.Sprintf("/%s/%s/tcp/%d", protocol, cfg.HostDNS, cfg.TCPPort))
+				if err != nil {
+					log.WithError(err).Error("Unable to create external multiaddress")
+					continue
+				}
 				addrs = append(addrs, external)
 			}
 			return addrs
@@ -68,7 +90,7 @@ func buildOptions(cfg *Config, ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			log.Errorf("Invalid local ip provided: %s", cfg.LocalIP)
 			return options
 		}
-		listen, err = multiAddressBuilder(cfg.LocalIP, cfg.TCPPort)
+		listen, err := multiAddressBuilder(cfg.LocalIP, cfg.TCPPort)
 		if err != nil {
 			log.Fatalf("Failed to p2p listen: %v", err)
 		}
@@ -77,6 +99,19 @@ func buildOptions(cfg *Config, ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 	return options
 }
 
+// hostAddresses returns the host addresses to advertise, the IPv6 host address is only
+// advertised when the node listens on IPv6.
+func hostAddresses(cfg *Config, ipv6Enabled bool) []string {
+	var addrs []string
+	if cfg.HostAddress != "" {
+		addrs = append(addrs, cfg.HostAddress)
+	}
+	if cfg.HostAddress6 != "" && ipv6Enabled {
+		addrs = append(addrs, cfg.HostAddress6)
+	}
+	return addrs
+}
+
 func multiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
 	parsedIP := net.ParseIP(ipAddr)
 	if parsedIP.To4() == nil && parsedIP.To16() == nil {
@@ -14,6 +14,7 @@ import (
 	dsync "github.com/ipfs/go-datastore/sync"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
@@ -59,6 +60,7 @@ type Service struct {
 	privKey       *ecdsa.PrivateKey
 	dht           *kaddht.IpfsDHT
 	peers         *peers.Status
+	bandwidth     *metrics.BandwidthCounter
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -77,6 +79,7 @@ func NewService(cfg *Config) (*Service, error) {
 		cancel:        cancel,
 		cfg:           cfg,
 		exclusionList: cache,
+		bandwidth:     metrics.NewBandwidthCounter(),
 	}
 
 	dv5Nodes, kadDHTNodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -84,14 +87,19 @@ func NewService(cfg *Config) (*Service, error) {
 	cfg.Discv5BootStrapAddr = dv5Nodes
 	cfg.KademliaBootStrapAddr = kadDHTNodes
 
-	ipAddr := ipAddr()
+	ipAddr, ipAddr6, err := listenIPs(s.cfg)
+	if err != nil {
+		log.WithError(err).Error("Failed to get p2p ip addresses")
+		return nil, err
+	}
 	s.privKey, err = privKey(s.cfg)
 	if err != nil {
 		log.WithError(err).Error("Failed to generate p2p private key")
 		return nil, err
 	}
 
-	opts := buildOptions(s.cfg, ipAddr, s.privKey)
+	opts := buildOptions(s.cfg, ipAddr, ipAddr6, s.privKey)
+	opts = append(opts, libp2p.BandwidthReporter(s.bandwidth))
 	h, err := libp2p.New(s.ctx, opts...)
 	if err != nil {
 		log.WithError(err).Error("Failed to create p2p host")
@@ -159,8 +167,9 @@ func (s *Service) Start() {
 	}
 
 	if (len(s.cfg.Discv5BootStrapAddr) != 0 && !s.cfg.NoDiscovery) || s.cfg.EnableDiscv5 {
-		ipAddr := ipAddr()
-		listener, err := startDiscoveryV5(ipAddr, s.privKey, s.cfg)
+		// The IP mode was validated when creating the service.
+		ipAddr, ipAddr6, _ := listenIPs(s.cfg)
+		listener, err := startDiscoveryV5(ipAddr, ipAddr6, s.privKey, s.cfg)
 		if err != nil {
 			log.WithError(err).Error("Failed to start discovery")
 			s.startupErr = err
@@ -298,6 +307,30 @@ func (s *Service) Peers() *peers.Status {
 	return s.peers
 }
 
+// PeerAgent returns the agent version the peer reported through the identify protocol, or an
+// empty string if it's unknown.
+func (s *Service) PeerAgent(pid peer.ID) string {
+	agent, err := s.host.Peerstore().Get(pid, "AgentVersion")
+	if err != nil {
+		return ""
+	}
+	agentString, ok := agent.(string)
+	if !ok {
+		return ""
+	}
+	return agentString
+}
+
+// PeerBandwidth returns the bandwidth used with the peer since the node started.
+func (s *Service) PeerBandwidth(pid peer.ID) metrics.Stats {
+	return s.bandwidth.GetBandwidthForPeer(pid)
+}
+
+// TotalBandwidth returns the bandwidth used with all peers since the node started.
+func (s *Service) TotalBandwidth() metrics.Stats {
+	return s.bandwidth.GetBandwidthTotals()
+}
+
 // RefreshENR uses an epoch to refresh the enr entry for our node
 // with the tracked committee id's for the epoch, allowing our node
 // to be dynamically discoverable by others given our tracked committee id's.
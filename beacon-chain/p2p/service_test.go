@@ -60,7 +60,7 @@ func createPeer(t *testing.T, cfg *Config, port int) (Listener, host.Host) {
 	h, pkey, ipAddr := createHost(t, port)
 	cfg.UDPPort = uint(port)
 	cfg.TCPPort = uint(port)
-	listener, err := startDiscoveryV5(ipAddr, pkey, cfg)
+	listener, err := startDiscoveryV5(ipAddr, nil, pkey, cfg)
 	if err != nil {
 		t.Errorf("Could not start discovery for node: %v", err)
 	}
@@ -131,7 +131,7 @@ func TestListenForNewNodes(t *testing.T) {
 	cfg.UDPPort = uint(port)
 	_, pkey := createAddrAndPrivKey(t)
 	ipAddr := net.ParseIP("127.0.0.1")
-	bootListener := createListener(ipAddr, pkey, cfg)
+	bootListener := createListener(ipAddr, nil, pkey, cfg)
 	defer bootListener.Close()
 
 	// Use shorter period for testing.
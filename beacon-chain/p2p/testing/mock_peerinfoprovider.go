@@ -0,0 +1,34 @@
+package testing
+
+import (
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// MockPeerInfoProvider implements PeerInfoProvider for testing.
+type MockPeerInfoProvider struct {
+	Agents    map[peer.ID]string
+	Bandwidth map[peer.ID]metrics.Stats
+}
+
+// PeerAgent returns the agent of the peer.
+func (m *MockPeerInfoProvider) PeerAgent(pid peer.ID) string {
+	return m.Agents[pid]
+}
+
+// PeerBandwidth returns the bandwidth used with the peer.
+func (m *MockPeerInfoProvider) PeerBandwidth(pid peer.ID) metrics.Stats {
+	return m.Bandwidth[pid]
+}
+
+// TotalBandwidth returns the sum of the bandwidth used with the peers.
+func (m *MockPeerInfoProvider) TotalBandwidth() metrics.Stats {
+	var total metrics.Stats
+	for _, stats := range m.Bandwidth {
+		total.TotalIn += stats.TotalIn
+		total.TotalOut += stats.TotalOut
+		total.RateIn += stats.RateIn
+		total.RateOut += stats.RateOut
+	}
+	return total
+}
@@ -12,6 +12,7 @@ import (
 	bhost "github.com/libp2p/go-libp2p-blankhost"
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
@@ -237,3 +238,18 @@ func (p *TestP2P) FindPeersWithSubnet(index uint64) (bool, error) {
 func (p *TestP2P) RefreshENR(epoch uint64) {
 	return
 }
+
+// PeerAgent mocks the p2p func.
+func (p *TestP2P) PeerAgent(pid peer.ID) string {
+	return ""
+}
+
+// PeerBandwidth mocks the p2p func.
+func (p *TestP2P) PeerBandwidth(pid peer.ID) metrics.Stats {
+	return metrics.Stats{}
+}
+
+// TotalBandwidth mocks the p2p func.
+func (p *TestP2P) TotalBandwidth() metrics.Stats {
+	return metrics.Stats{}
+}
@@ -90,3 +90,34 @@ func ipAddr() net.IP {
 	}
 	return net.ParseIP(ip)
 }
+
+func ipAddr6(cfg *Config) net.IP {
+	if cfg.LocalIP6 != "" {
+		ip := net.ParseIP(cfg.LocalIP6)
+		if ip == nil || ip.To4() != nil {
+			log.Fatalf("Invalid local ipv6 address provided: %s", cfg.LocalIP6)
+		}
+		return ip
+	}
+	ip, err := iputils.ExternalIPv6()
+	if err != nil {
+		log.Fatalf("Could not get IPv6 address, set one with --p2p-local-ip6: %v", err)
+	}
+	return net.ParseIP(ip)
+}
+
+// listenIPs returns the IPv4 and IPv6 addresses of the node for the IP mode of the config. The
+// address of an IP version which isn't used by the IP mode is nil.
+func listenIPs(cfg *Config) (ip4 net.IP, ip6 net.IP, err error) {
+	switch cfg.IPMode {
+	case "", IPModeIPv4:
+		return ipAddr(), nil, nil
+	case IPModeIPv6:
+		return nil, ipAddr6(cfg), nil
+	case IPModeDualStack:
+		return ipAddr(), ipAddr6(cfg), nil
+	default:
+		return nil, nil, errors.Errorf("invalid ip mode %q, wanted one of %s, %s or %s",
+			cfg.IPMode, IPModeIPv4, IPModeIPv6, IPModeDualStack)
+	}
+}
@@ -0,0 +1,17 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruner_blocks_pruned_total",
+		Help: "The # of non-canonical blocks behind finality deleted along with their states and state summaries",
+	})
+	lastPrunedSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruner_last_pruned_slot",
+		Help: "The slot up to which non-canonical data behind finality was pruned",
+	})
+)
@@ -0,0 +1,179 @@
+// Package pruner defines a service deleting the data left behind finality by forks: the
+// non-canonical blocks older than the finalized checkpoint, along with their states and state
+// summaries. It keeps the disk usage of non-archival nodes from growing with every fork.
+package pruner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "pruner")
+
+// The slots behind finality are pruned in ranges of this many slots, each range in its own
+// transactions, so catching up on a large DB doesn't hold the DB for long.
+const slotsPerPruneRange = 1024
+
+// Service prunes non-canonical blocks, states and state summaries behind the finalized checkpoint.
+type Service struct {
+	ctx                context.Context
+	cancel             context.CancelFunc
+	beaconDB           db.NoHeadAccessDatabase
+	stateNotifier      statefeed.Notifier
+	lastPrunedSlot     uint64
+	lastFinalizedEpoch uint64
+}
+
+// Config options for the pruner service.
+type Config struct {
+	BeaconDB      db.NoHeadAccessDatabase
+	StateNotifier statefeed.Notifier
+}
+
+// NewPrunerService initializes the service from configuration options.
+func NewPrunerService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		beaconDB:      cfg.BeaconDB,
+		stateNotifier: cfg.StateNotifier,
+	}
+}
+
+// Start the pruner service event loop.
+func (s *Service) Start() {
+	lastPrunedSlot, err := s.beaconDB.LastPrunedSlot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get last pruned slot, pruning from genesis")
+	}
+	s.lastPrunedSlot = lastPrunedSlot
+	go s.run(s.ctx)
+}
+
+// Stop the pruner service event loop.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	return nil
+}
+
+// Status reports the healthy status of the pruner. Returning nil means service
+// is correctly running without error.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			// The finalized checkpoint is read from the DB rather than from the head, the
+			// canonical chain is determined from the finalized block roots index it updates.
+			finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not get finalized checkpoint")
+				continue
+			}
+			if finalized == nil || finalized.Epoch <= s.lastFinalizedEpoch {
+				continue
+			}
+			if err := s.prune(ctx, helpers.StartSlot(finalized.Epoch)); err != nil {
+				log.WithError(err).Error("Could not prune data behind finality")
+				continue
+			}
+			s.lastFinalizedEpoch = finalized.Epoch
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed notifier failed")
+			return
+		}
+	}
+}
+
+// prune deletes the non-canonical blocks, and their states and state summaries, from the last
+// pruned slot up to the end slot, excluded. The end slot must not be above the start slot of the
+// finalized epoch: only the blocks of earlier epochs are in the finalized block roots index when
+// canonical.
+func (s *Service) prune(ctx context.Context, endSlot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "pruner.prune")
+	defer span.End()
+
+	if endSlot <= s.lastPrunedSlot+1 {
+		return nil
+	}
+	genesisBlock, err := s.beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block")
+	}
+	var genesisRoot [32]byte
+	if genesisBlock != nil && genesisBlock.Block != nil {
+		genesisRoot, err = stateutil.BlockRoot(genesisBlock.Block)
+		if err != nil {
+			return errors.Wrap(err, "could not get genesis block root")
+		}
+	}
+
+	var prunedTotal int
+	for start := s.lastPrunedSlot + 1; start < endSlot; start += slotsPerPruneRange {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := start + slotsPerPruneRange - 1
+		if end >= endSlot {
+			end = endSlot - 1
+		}
+		roots, err := s.beaconDB.BlockRoots(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+		if err != nil {
+			return errors.Wrap(err, "could not get block roots")
+		}
+		pruned := make([][32]byte, 0)
+		for _, r := range roots {
+			// The genesis block is never part of the finalized block roots index.
+			if r == genesisRoot || s.beaconDB.IsFinalizedBlock(ctx, r) {
+				continue
+			}
+			pruned = append(pruned, r)
+		}
+		if len(pruned) > 0 {
+			// States are deleted first, their slot is looked up from their block or summary.
+			if err := s.beaconDB.DeleteStates(ctx, pruned); err != nil {
+				return errors.Wrap(err, "could not delete fork states")
+			}
+			if err := s.beaconDB.DeleteStateSummaries(ctx, pruned); err != nil {
+				return errors.Wrap(err, "could not delete fork state summaries")
+			}
+			if err := s.beaconDB.DeleteBlocks(ctx, pruned); err != nil {
+				return errors.Wrap(err, "could not delete fork blocks")
+			}
+			prunedTotal += len(pruned)
+			prunedBlocks.Add(float64(len(pruned)))
+		}
+		if err := s.beaconDB.SaveLastPrunedSlot(ctx, end); err != nil {
+			return errors.Wrap(err, "could not save last pruned slot")
+		}
+		s.lastPrunedSlot = end
+		lastPrunedSlot.Set(float64(end))
+	}
+	log.WithFields(logrus.Fields{
+		"prunedBlocks":   prunedTotal,
+		"lastPrunedSlot": s.lastPrunedSlot,
+	}).Debug("Pruned non-canonical data behind finality")
+	return nil
+}
@@ -0,0 +1,96 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestService_PrunesNonCanonicalDataBehindFinality(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	saveBlock := func(slot uint64, parentRoot [32]byte) [32]byte {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+		if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := stateutil.BlockRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveState(ctx, st, root); err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: root[:]}); err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+
+	genesisRoot := saveBlock(0, [32]byte{})
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	finalizedSlot := helpers.StartSlot(2)
+	canonical := make([][32]byte, 0)
+	parent := genesisRoot
+	for slot := uint64(1); slot <= finalizedSlot; slot++ {
+		parent = saveBlock(slot, parent)
+		canonical = append(canonical, parent)
+	}
+	// A fork from genesis, and a fork block in the finalized epoch which is pruned on a later
+	// finalization only.
+	forkRoot := saveBlock(3, genesisRoot)
+	recentForkRoot := saveBlock(finalizedSlot+1, canonical[0])
+	if err := beaconDB.SaveHeadBlockRoot(ctx, parent); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: parent[:]}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Service{beaconDB: beaconDB}
+	if err := s.prune(ctx, finalizedSlot); err != nil {
+		t.Fatal(err)
+	}
+
+	if beaconDB.HasBlock(ctx, forkRoot) || beaconDB.HasState(ctx, forkRoot) || beaconDB.HasStateSummary(ctx, forkRoot) {
+		t.Error("Expected the fork block, state and state summary to be pruned")
+	}
+	if !beaconDB.HasBlock(ctx, recentForkRoot) {
+		t.Error("Expected the fork block after the finalized slot to be kept")
+	}
+	if !beaconDB.HasBlock(ctx, genesisRoot) {
+		t.Error("Expected the genesis block to be kept")
+	}
+	for _, r := range canonical {
+		if !beaconDB.HasBlock(ctx, r) {
+			t.Fatalf("Expected canonical block %#x to be kept", r)
+		}
+	}
+	lastPruned, err := beaconDB.LastPrunedSlot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastPruned != finalizedSlot-1 {
+		t.Errorf("Wanted last pruned slot %d, received %d", finalizedSlot-1, lastPruned)
+	}
+
+	// Pruning resumes from the last pruned slot.
+	s.lastPrunedSlot = lastPruned
+	if err := s.prune(ctx, finalizedSlot); err != nil {
+		t.Fatal(err)
+	}
+}
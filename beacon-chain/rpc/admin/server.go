@@ -0,0 +1,115 @@
+// Package admin defines a gRPC server implementation of the beacon node admin service, exposing
+// maintenance operations which are run without stopping the node.
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "rpc/admin")
+
+// DatabaseBackuper writes snapshots of the beacon chain database while it is in use.
+type DatabaseBackuper interface {
+	Backup(ctx context.Context, outputDir string) (string, error)
+}
+
+// StateRestorer restores the hot states soft deleted by state migrations.
+type StateRestorer interface {
+	RestoreSoftDeletedState(ctx context.Context, blockRoot [32]byte) error
+}
+
+// Server defines a server implementation of the gRPC Admin service.
+type Server struct {
+	BeaconDB DatabaseBackuper
+	// BackupOutputDir is the directory snapshots are written to, the database picks its default
+	// backups directory when empty.
+	BackupOutputDir string
+	StateRestorer   StateRestorer
+	backupLock      sync.Mutex
+}
+
+// BackupDatabase writes a snapshot of the database to the backup directory of the node. Backups
+// are written one at a time, a request made while a backup is in progress waits for it to end.
+func (as *Server) BackupDatabase(ctx context.Context, _ *pb.BackupDatabaseRequest) (*pb.BackupDatabaseResponse, error) {
+	as.backupLock.Lock()
+	defer as.backupLock.Unlock()
+
+	start := time.Now()
+	backupPath, err := as.BeaconDB.Backup(ctx, as.BackupOutputDir)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not back up database: %v", err)
+	}
+	info, err := os.Stat(backupPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read backup file: %v", err)
+	}
+	duration := time.Since(start)
+	log.WithFields(logrus.Fields{
+		"path":     backupPath,
+		"size":     info.Size(),
+		"duration": duration,
+	}).Info("Backed up database")
+	return &pb.BackupDatabaseResponse{
+		Path:       backupPath,
+		SizeBytes:  uint64(info.Size()),
+		DurationMs: uint64(duration / time.Millisecond),
+	}, nil
+}
+
+// RestoreDeletedState restores the hot state of the requested block root, deleted by a state
+// migration within the soft delete window of the node. It is only served on the loopback admin
+// endpoint enabled by --enable-admin-rpc.
+func (as *Server) RestoreDeletedState(ctx context.Context, req *pb.RestoreDeletedStateRequest) (*pb.RestoreDeletedStateResponse, error) {
+	if as.StateRestorer == nil {
+		return nil, status.Error(codes.Unavailable, "State restoration is not available")
+	}
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block root must be 32 bytes, received %d", len(req.BlockRoot))
+	}
+	blockRoot := bytesutil.ToBytes32(req.BlockRoot)
+	if err := as.StateRestorer.RestoreSoftDeletedState(ctx, blockRoot); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Could not restore deleted state: %v", err)
+	}
+	log.WithField("root", hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))).Info("Restored deleted state")
+	return &pb.RestoreDeletedStateResponse{}, nil
+}
+
+// UpdateRuntimeConfig updates the flags which can be changed at runtime to the non zero values of
+// the request, and returns the resulting values. Like the rest of the admin service, it is only
+// served on the loopback admin endpoint enabled by --enable-admin-rpc.
+func (as *Server) UpdateRuntimeConfig(ctx context.Context, req *pb.UpdateRuntimeConfigRequest) (*pb.RuntimeConfig, error) {
+	reloaded, err := flags.ReloadFlags(&flags.ReloadableFlags{
+		MinimumSyncPeers: int(req.MinimumSyncPeers),
+		MaxPageSize:      int(req.MaxPageSize),
+		LogLevel:         req.LogLevel,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not update runtime config: %v", err)
+	}
+	fields := logrus.Fields{
+		"minSyncPeers": reloaded.MinimumSyncPeers,
+		"maxPageSize":  reloaded.MaxPageSize,
+		"logLevel":     reloaded.LogLevel,
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields["peer"] = p.Addr.String()
+	}
+	log.WithFields(fields).Info("Updated runtime config")
+	return &pb.RuntimeConfig{
+		MinimumSyncPeers: uint64(reloaded.MinimumSyncPeers),
+		MaxPageSize:      uint64(reloaded.MaxPageSize),
+		LogLevel:         reloaded.LogLevel,
+	}, nil
+}
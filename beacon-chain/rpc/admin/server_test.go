@@ -0,0 +1,144 @@
+package admin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+)
+
+func TestServer_BackupDatabase(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	head := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	if err := db.SaveBlock(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(head.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+
+	outputDir, err := ioutil.TempDir("", "backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(outputDir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	as := &Server{BeaconDB: db, BackupOutputDir: outputDir}
+	res, err := as.BackupDatabase(ctx, &pb.BackupDatabaseRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path.Dir(res.Path) != outputDir {
+		t.Errorf("Wanted backup in %s, got %s", outputDir, res.Path)
+	}
+	if res.SizeBytes == 0 {
+		t.Error("Expected a non empty backup")
+	}
+}
+
+func TestServer_RestoreDeletedState(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: 64, Root: root[:]}); err != nil {
+		t.Fatal(err)
+	}
+	st, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{Slot: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SoftDeleteState(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+
+	as := &Server{BeaconDB: db, StateRestorer: db}
+	if _, err := as.RestoreDeletedState(ctx, &pb.RestoreDeletedStateRequest{BlockRoot: []byte{'a'}}); err == nil {
+		t.Error("Expected an invalid block root to be rejected")
+	}
+	if _, err := as.RestoreDeletedState(ctx, &pb.RestoreDeletedStateRequest{BlockRoot: root[:]}); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasState(ctx, root) {
+		t.Error("Expected deleted state to be restored")
+	}
+	if _, err := as.RestoreDeletedState(ctx, &pb.RestoreDeletedStateRequest{BlockRoot: root[:]}); err == nil {
+		t.Error("Expected restoring a state which is not deleted to fail")
+	}
+}
+
+func TestServer_UpdateRuntimeConfig(t *testing.T) {
+	resetCfg := flags.Get()
+	defer flags.Init(resetCfg)
+	resetLevel := logrus.GetLevel()
+	defer logrus.SetLevel(resetLevel)
+	flags.Init(&flags.GlobalFlags{MinimumSyncPeers: 3, MaxPageSize: 500})
+	as := &Server{}
+
+	res, err := as.UpdateRuntimeConfig(context.Background(), &pb.UpdateRuntimeConfigRequest{
+		MaxPageSize: 1000,
+		LogLevel:    "debug",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.RuntimeConfig{MinimumSyncPeers: 3, MaxPageSize: 1000, LogLevel: "debug"}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted runtime config %v, received %v", want, res)
+	}
+	if flags.Get().MaxPageSize != 1000 {
+		t.Errorf("Wanted max page size %d, received %d", 1000, flags.Get().MaxPageSize)
+	}
+	if logrus.GetLevel() != logrus.DebugLevel {
+		t.Errorf("Wanted log level %v, received %v", logrus.DebugLevel, logrus.GetLevel())
+	}
+
+	if _, err := as.UpdateRuntimeConfig(context.Background(), &pb.UpdateRuntimeConfigRequest{
+		MinimumSyncPeers: 10,
+		LogLevel:         "loud",
+	}); err == nil {
+		t.Error("Expected an invalid log level to be rejected")
+	}
+	if flags.Get().MinimumSyncPeers != 3 {
+		t.Errorf("Expected a rejected update to not change the config, received minimum sync peers %d", flags.Get().MinimumSyncPeers)
+	}
+}
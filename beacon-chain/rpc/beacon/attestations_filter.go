@@ -0,0 +1,164 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamFilteredAttestations to clients as they are received by the beacon node, the attestations
+// not matching the slot range, committee indices and validator indices of the filter are left
+// out of the stream.
+func (bs *Server) StreamFilteredAttestations(
+	req *pbrpc.AttestationStreamFilter, stream pbrpc.BeaconChain_StreamFilteredAttestationsServer,
+) error {
+	if req.EndSlot != 0 && req.StartSlot > req.EndSlot {
+		return status.Errorf(codes.InvalidArgument, "Start slot %d is after end slot %d", req.StartSlot, req.EndSlot)
+	}
+	if len(req.ValidatorIndices) > flags.Get().MaxPageSize {
+		return status.Errorf(codes.InvalidArgument, "Requested %d validator indices is more than the max %d",
+			len(req.ValidatorIndices), flags.Get().MaxPageSize)
+	}
+	f := newAttestationFilter(bs, req)
+
+	attestationsChannel := make(chan *feed.Event, 1)
+	attSub := bs.AttestationNotifier.OperationFeed().Subscribe(attestationsChannel)
+	defer attSub.Unsubscribe()
+	for {
+		select {
+		case event := <-attestationsChannel:
+			if event.Type == operation.UnaggregatedAttReceived {
+				data, ok := event.Data.(*operation.UnAggregatedAttReceivedData)
+				if !ok {
+					// Got bad data over the stream.
+					continue
+				}
+				if data.Attestation == nil || data.Attestation.Data == nil {
+					// One nil attestation shouldn't stop the stream.
+					continue
+				}
+				if !f.matches(stream.Context(), data.Attestation) {
+					continue
+				}
+				if err := stream.Send(data.Attestation); err != nil {
+					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+				}
+			}
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// committeesCacheEpochs is the number of epochs whose committees are kept by an attestation stream
+// filter, attestations of the previous epoch are still received at the start of an epoch.
+const committeesCacheEpochs = 2
+
+// attestationFilter evaluates the filter of an attestation stream. The committees of the latest
+// epochs are kept, the attestations received at a time mostly belong to the same two epochs.
+type attestationFilter struct {
+	bs               *Server
+	req              *pbrpc.AttestationStreamFilter
+	committeeIndices map[uint64]bool
+	validatorIndices map[uint64]bool
+	committees       map[uint64]*epochCommittees
+}
+
+// epochCommittees are the committees of an epoch by slot, nil when they could not be retrieved.
+// A failed retrieval is retried after a slot, the committees of a future epoch become known once
+// the chain reaches it.
+type epochCommittees struct {
+	bySlot   map[uint64]*ethpb.BeaconCommittees_CommitteesList
+	failedAt time.Time
+}
+
+func newAttestationFilter(bs *Server, req *pbrpc.AttestationStreamFilter) *attestationFilter {
+	f := &attestationFilter{
+		bs:               bs,
+		req:              req,
+		committeeIndices: make(map[uint64]bool, len(req.CommitteeIndices)),
+		validatorIndices: make(map[uint64]bool, len(req.ValidatorIndices)),
+		committees:       make(map[uint64]*epochCommittees, committeesCacheEpochs),
+	}
+	for _, i := range req.CommitteeIndices {
+		f.committeeIndices[i] = true
+	}
+	for _, i := range req.ValidatorIndices {
+		f.validatorIndices[i] = true
+	}
+	return f
+}
+
+// This returns true if the attestation is within the slot range and committees of the filter,
+// and one of the validators of the filter is among its attesters.
+func (f *attestationFilter) matches(ctx context.Context, att *ethpb.Attestation) bool {
+	if att.Data.Slot < f.req.StartSlot || (f.req.EndSlot != 0 && att.Data.Slot > f.req.EndSlot) {
+		return false
+	}
+	if len(f.committeeIndices) > 0 && !f.committeeIndices[att.Data.CommitteeIndex] {
+		return false
+	}
+	if len(f.validatorIndices) == 0 {
+		return true
+	}
+
+	committeesBySlot := f.committeesForEpoch(ctx, helpers.SlotToEpoch(att.Data.Slot))
+	if committeesBySlot == nil {
+		// The attesters of an attestation from an epoch without known committees, such as
+		// a future epoch, can't be determined. It shouldn't stop the stream.
+		return false
+	}
+	committeesForSlot, ok := committeesBySlot[att.Data.Slot]
+	if !ok || uint64(len(committeesForSlot.Committees)) <= att.Data.CommitteeIndex {
+		return false
+	}
+	committee := committeesForSlot.Committees[att.Data.CommitteeIndex].ValidatorIndices
+	for _, i := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+		if f.validatorIndices[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// This returns the committees of the input epoch by slot, or nil if they could not be retrieved.
+// The results are cached, failures included, and the committees of the oldest epochs are evicted.
+func (f *attestationFilter) committeesForEpoch(
+	ctx context.Context, epoch uint64,
+) map[uint64]*ethpb.BeaconCommittees_CommitteesList {
+	retryInterval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if c, ok := f.committees[epoch]; ok && (c.bySlot != nil || roughtime.Since(c.failedAt) < retryInterval) {
+		return c.bySlot
+	}
+	c := &epochCommittees{}
+	committeesBySlot, _, err := f.bs.retrieveCommitteesForEpoch(ctx, epoch)
+	if err != nil {
+		c.failedAt = roughtime.Now()
+	} else {
+		c.bySlot = committeesBySlot
+	}
+	f.committees[epoch] = c
+	for len(f.committees) > committeesCacheEpochs {
+		oldest := epoch
+		for e := range f.committees {
+			if e < oldest {
+				oldest = e
+			}
+		}
+		delete(f.committees, oldest)
+	}
+	return c.bySlot
+}
@@ -0,0 +1,223 @@
+package beacon
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"google.golang.org/grpc"
+)
+
+type attestationsFilterStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.Attestation
+}
+
+func (s *attestationsFilterStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *attestationsFilterStream) Send(att *ethpb.Attestation) error {
+	s.sent <- att
+	return nil
+}
+
+func TestServer_StreamFilteredAttestations_InvalidSlotRange(t *testing.T) {
+	bs := &Server{}
+	stream := &attestationsFilterStream{ctx: context.Background()}
+	err := bs.StreamFilteredAttestations(&pbrpc.AttestationStreamFilter{StartSlot: 5, EndSlot: 4}, stream)
+	if err == nil || !strings.Contains(err.Error(), "Start slot 5 is after end slot 4") {
+		t.Errorf("Expected invalid slot range error, received %v", err)
+	}
+}
+
+func TestServer_StreamFilteredAttestations_SlotRange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:                 ctx,
+		AttestationNotifier: chainService.OperationNotifier(),
+	}
+
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}},
+		{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b1101}},
+		{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b1101}},
+	}
+	stream := &attestationsFilterStream{ctx: ctx, sent: make(chan *ethpb.Attestation, len(atts))}
+	go func(tt *testing.T) {
+		req := &pbrpc.AttestationStreamFilter{StartSlot: 2, EndSlot: 3}
+		if err := server.StreamFilteredAttestations(req, stream); !strings.Contains(err.Error(), "Context canceled") {
+			tt.Errorf("Expected context canceled error got: %v", err)
+		}
+	}(t)
+	for i := 0; i < len(atts); i++ {
+		// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+		for sent := 0; sent == 0; {
+			sent = server.AttestationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.UnaggregatedAttReceived,
+				Data: &operation.UnAggregatedAttReceivedData{Attestation: atts[i]},
+			})
+		}
+	}
+	for _, want := range atts[1:3] {
+		select {
+		case att := <-stream.sent:
+			if att != want {
+				t.Errorf("Wanted attestation of slot %d, received slot %d", want.Data.Slot, att.Data.Slot)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive attestation")
+		}
+	}
+}
+
+func TestAttestationFilter_Matches(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	headState := setupActiveValidators(t, db, 128)
+	randaoMixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := 0; i < len(randaoMixes); i++ {
+		randaoMixes[i] = make([]byte, 32)
+	}
+	if err := headState.SetRandaoMixes(randaoMixes); err != nil {
+		t.Fatal(err)
+	}
+	m := &mock.ChainService{
+		State:   headState,
+		Genesis: roughtime.Now().Add(time.Duration(-1*int64((headState.Slot()*params.BeaconConfig().SecondsPerSlot))) * time.Second),
+	}
+	bs := &Server{
+		HeadFetcher:        m,
+		GenesisTimeFetcher: m,
+	}
+	committeesBySlot, _, err := bs.retrieveCommitteesForEpoch(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committeesBySlot[1].Committees[0].ValidatorIndices
+	if len(committee) < 2 {
+		t.Fatalf("Expected a committee of at least 2 validators, received %d", len(committee))
+	}
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregationBits.SetBitAt(0, true)
+	att := &ethpb.Attestation{
+		Data:            &ethpb.AttestationData{Slot: 1, CommitteeIndex: 0},
+		AggregationBits: aggregationBits,
+	}
+
+	tests := []struct {
+		name string
+		req  *pbrpc.AttestationStreamFilter
+		want bool
+	}{
+		{
+			name: "no filter",
+			req:  &pbrpc.AttestationStreamFilter{},
+			want: true,
+		},
+		{
+			name: "before start slot",
+			req:  &pbrpc.AttestationStreamFilter{StartSlot: 2},
+			want: false,
+		},
+		{
+			name: "other committee",
+			req:  &pbrpc.AttestationStreamFilter{CommitteeIndices: []uint64{1}},
+			want: false,
+		},
+		{
+			name: "matching committee",
+			req:  &pbrpc.AttestationStreamFilter{EndSlot: 1, CommitteeIndices: []uint64{1, 0}},
+			want: true,
+		},
+		{
+			name: "attesting validator",
+			req:  &pbrpc.AttestationStreamFilter{ValidatorIndices: []uint64{committee[0]}},
+			want: true,
+		},
+		{
+			name: "non attesting committee member",
+			req:  &pbrpc.AttestationStreamFilter{ValidatorIndices: []uint64{committee[1]}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newAttestationFilter(bs, tt.req).matches(ctx, att); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttestationFilter_CachesCommitteesOfTwoEpochs(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	headState := setupActiveValidators(t, db, 128)
+	randaoMixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := 0; i < len(randaoMixes); i++ {
+		randaoMixes[i] = make([]byte, 32)
+	}
+	if err := headState.SetRandaoMixes(randaoMixes); err != nil {
+		t.Fatal(err)
+	}
+	m := &mock.ChainService{
+		State:   headState,
+		Genesis: roughtime.Now().Add(time.Duration(-1*int64((headState.Slot()*params.BeaconConfig().SecondsPerSlot))) * time.Second),
+	}
+	bs := &Server{
+		HeadFetcher:        m,
+		GenesisTimeFetcher: m,
+	}
+	f := newAttestationFilter(bs, &pbrpc.AttestationStreamFilter{ValidatorIndices: []uint64{0}})
+	attAtEpoch := func(epoch uint64) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: helpers.StartSlot(epoch)},
+			AggregationBits: bitfield.NewBitlist(1),
+		}
+	}
+
+	f.matches(ctx, attAtEpoch(0))
+	if c, ok := f.committees[0]; !ok || c.bySlot == nil {
+		t.Fatal("Expected the committees of epoch 0 to be cached")
+	}
+	// The committees of a future epoch are unknown, the failure is cached.
+	futureEpoch := uint64(100)
+	f.matches(ctx, attAtEpoch(futureEpoch))
+	if c, ok := f.committees[futureEpoch]; !ok || c.bySlot != nil || c.failedAt.IsZero() {
+		t.Fatal("Expected the failure of the future epoch to be cached")
+	}
+	if _, ok := f.committees[0]; !ok {
+		t.Error("Expected the committees of epoch 0 to be kept")
+	}
+
+	// The oldest epoch is evicted beyond two epochs.
+	f.matches(ctx, attAtEpoch(futureEpoch+1))
+	if len(f.committees) != committeesCacheEpochs {
+		t.Errorf("Wanted %d cached epochs, received %d", committeesCacheEpochs, len(f.committees))
+	}
+	if _, ok := f.committees[0]; ok {
+		t.Error("Expected the committees of epoch 0 to be evicted")
+	}
+}
@@ -0,0 +1,131 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockOperations returns the deposits, voluntary exits and slashings of the requested block,
+// with their position in the block body and the validators they apply to. A block requested by
+// slot is the canonical block of the slot.
+func (bs *Server) GetBlockOperations(ctx context.Context, req *pbrpc.BlockOperationsRequest) (*pbrpc.BlockOperations, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	headRoot, err := bs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+	}
+
+	var blk *ethpb.SignedBeaconBlock
+	var root [32]byte
+	switch q := req.Query.(type) {
+	case *pbrpc.BlockOperationsRequest_BlockRoot:
+		if len(q.BlockRoot) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Block root must be 32 bytes, received %d", len(q.BlockRoot))
+		}
+		root = bytesutil.ToBytes32(q.BlockRoot)
+		blk, err = bs.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		if blk == nil || blk.Block == nil {
+			return nil, status.Errorf(codes.NotFound, "No block found for root %#x", root)
+		}
+	case *pbrpc.BlockOperationsRequest_Slot:
+		blks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(q.Slot).SetEndSlot(q.Slot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+		}
+		for _, b := range blks {
+			if b == nil || b.Block == nil {
+				continue
+			}
+			r, err := ssz.HashTreeRoot(b.Block)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute block root: %v", err)
+			}
+			if bs.isCanonicalBlock(ctx, headState, headRoot, r, b.Block.Slot) {
+				blk, root = b, r
+				break
+			}
+		}
+		if blk == nil {
+			return nil, status.Errorf(codes.NotFound, "No canonical block found at slot %d", q.Slot)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a block root or a slot")
+	}
+
+	res := &pbrpc.BlockOperations{
+		BlockRoot: root[:],
+		Slot:      blk.Block.Slot,
+		Canonical: bs.isCanonicalBlock(ctx, headState, headRoot, root, blk.Block.Slot),
+	}
+	body := blk.Block.Body
+	if body == nil {
+		return res, nil
+	}
+	res.Deposits = make([]*pbrpc.DepositOperation, len(body.Deposits))
+	for i, d := range body.Deposits {
+		op := &pbrpc.DepositOperation{Index: uint64(i), Deposit: d}
+		if d.Data != nil {
+			op.ValidatorIndex, op.ValidatorKnown = headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(d.Data.PublicKey))
+		}
+		res.Deposits[i] = op
+	}
+	res.VoluntaryExits = make([]*pbrpc.VoluntaryExitOperation, len(body.VoluntaryExits))
+	for i, e := range body.VoluntaryExits {
+		res.VoluntaryExits[i] = &pbrpc.VoluntaryExitOperation{Index: uint64(i), Exit: e}
+	}
+	res.ProposerSlashings = make([]*pbrpc.ProposerSlashingOperation, len(body.ProposerSlashings))
+	for i, s := range body.ProposerSlashings {
+		res.ProposerSlashings[i] = &pbrpc.ProposerSlashingOperation{
+			Index:        uint64(i),
+			Slashing:     s,
+			SlashedIndex: s.ProposerIndex,
+		}
+	}
+	res.AttesterSlashings = make([]*pbrpc.AttesterSlashingOperation, len(body.AttesterSlashings))
+	for i, s := range body.AttesterSlashings {
+		op := &pbrpc.AttesterSlashingOperation{Index: uint64(i), Slashing: s}
+		if s.Attestation_1 != nil && s.Attestation_2 != nil {
+			op.SlashedIndices = sliceutil.IntersectionUint64(s.Attestation_1.AttestingIndices, s.Attestation_2.AttestingIndices)
+		}
+		res.AttesterSlashings[i] = op
+	}
+	return res, nil
+}
+
+// This returns true if the block of the input root and slot is part of the canonical chain. The
+// block roots history of the head state covers the recent blocks, the older blocks are canonical if
+// they were finalized.
+func (bs *Server) isCanonicalBlock(
+	ctx context.Context,
+	headState *state.BeaconState,
+	headRoot []byte,
+	blockRoot [32]byte,
+	slot uint64,
+) bool {
+	if bytes.Equal(headRoot, blockRoot[:]) {
+		return true
+	}
+	headSlot := headState.Slot()
+	if slot < headSlot && headSlot-slot <= params.BeaconConfig().SlotsPerHistoricalRoot {
+		root, err := headState.BlockRootAtIndex(slot % params.BeaconConfig().SlotsPerHistoricalRoot)
+		return err == nil && bytes.Equal(root, blockRoot[:])
+	}
+	return bs.BeaconDB.IsFinalizedBlock(ctx, blockRoot)
+}
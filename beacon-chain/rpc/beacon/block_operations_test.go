@@ -0,0 +1,123 @@
+package beacon
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_GetBlockOperations(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubKey := bytesutil.PadTo([]byte{'a'}, 48)
+	canonical := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+		Slot:       1,
+		ParentRoot: make([]byte, 32),
+		Body: &ethpb.BeaconBlockBody{
+			Deposits: []*ethpb.Deposit{
+				{Data: &ethpb.Deposit_Data{PublicKey: pubKey}},
+				{Data: &ethpb.Deposit_Data{PublicKey: bytesutil.PadTo([]byte{'b'}, 48)}},
+			},
+			VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+				{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 4}},
+			},
+			ProposerSlashings: []*ethpb.ProposerSlashing{
+				{ProposerIndex: 5},
+			},
+			AttesterSlashings: []*ethpb.AttesterSlashing{
+				{
+					Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2, 3}},
+					Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{2, 3, 4}},
+				},
+			},
+		},
+	}}
+	fork := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: []byte{'f'}}}
+	for _, blk := range []*ethpb.SignedBeaconBlock{canonical, fork} {
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	canonicalRoot, err := ssz.HashTreeRoot(canonical.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forkRoot, err := ssz.HashTreeRoot(fork.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = make([]byte, 32)
+	}
+	blockRoots[1] = canonicalRoot[:]
+	headState, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		Slot:       2,
+		BlockRoots: blockRoots,
+		Validators: []*ethpb.Validator{{PublicKey: pubKey}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: headState, Root: bytesutil.PadTo([]byte{'h'}, 32)},
+	}
+
+	res, err := bs.GetBlockOperations(ctx, &pbrpc.BlockOperationsRequest{
+		Query: &pbrpc.BlockOperationsRequest_Slot{Slot: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Canonical || bytesutil.ToBytes32(res.BlockRoot) != canonicalRoot {
+		t.Fatalf("Wanted canonical block %#x, received %#x", canonicalRoot, res.BlockRoot)
+	}
+	if len(res.Deposits) != 2 {
+		t.Fatalf("Wanted 2 deposits, received %d", len(res.Deposits))
+	}
+	if !res.Deposits[0].ValidatorKnown || res.Deposits[0].ValidatorIndex != 0 {
+		t.Errorf("Wanted first deposit credited to validator 0, received %v", res.Deposits[0])
+	}
+	if res.Deposits[1].Index != 1 || res.Deposits[1].ValidatorKnown {
+		t.Errorf("Wanted second deposit at index 1 with an unknown validator, received %v", res.Deposits[1])
+	}
+	if len(res.VoluntaryExits) != 1 || res.VoluntaryExits[0].Exit.Exit.ValidatorIndex != 4 {
+		t.Errorf("Unexpected voluntary exits %v", res.VoluntaryExits)
+	}
+	if len(res.ProposerSlashings) != 1 || res.ProposerSlashings[0].SlashedIndex != 5 {
+		t.Errorf("Unexpected proposer slashings %v", res.ProposerSlashings)
+	}
+	if len(res.AttesterSlashings) != 1 || !reflect.DeepEqual(res.AttesterSlashings[0].SlashedIndices, []uint64{2, 3}) {
+		t.Errorf("Unexpected attester slashings %v", res.AttesterSlashings)
+	}
+
+	res, err = bs.GetBlockOperations(ctx, &pbrpc.BlockOperationsRequest{
+		Query: &pbrpc.BlockOperationsRequest_BlockRoot{BlockRoot: forkRoot[:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Canonical {
+		t.Error("Wanted fork block to not be canonical")
+	}
+
+	if _, err := bs.GetBlockOperations(ctx, &pbrpc.BlockOperationsRequest{
+		Query: &pbrpc.BlockOperationsRequest_Slot{Slot: 2},
+	}); err == nil {
+		t.Error("Expected an error for a slot without blocks")
+	}
+}
@@ -0,0 +1,51 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCheckpointTimeline returns the justification and finalization timeline of the epochs of the
+// requested range, built from the checkpoints archived by the node. The slots reported are the
+// ones of the head state when the archiver first saw the checkpoint justified or finalized, so
+// the timeline only covers epochs archived while the node was running with archival enabled.
+func (bs *Server) GetCheckpointTimeline(ctx context.Context, req *pbrpc.CheckpointTimelineRequest) (*pbrpc.CheckpointTimeline, error) {
+	if req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"End epoch %d can not be before start epoch %d",
+			req.EndEpoch,
+			req.StartEpoch,
+		)
+	}
+	if req.EndEpoch-req.StartEpoch >= uint64(flags.Get().MaxPageSize) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch range %d-%d can not be greater than max size %d",
+			req.StartEpoch,
+			req.EndEpoch,
+			flags.Get().MaxPageSize,
+		)
+	}
+
+	archived, err := bs.BeaconDB.ArchivedCheckpoints(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived checkpoints: %v", err)
+	}
+	checkpoints := make([]*pbrpc.CheckpointTimelineEntry, len(archived))
+	for i, cp := range archived {
+		checkpoints[i] = &pbrpc.CheckpointTimelineEntry{
+			Epoch:         cp.Epoch,
+			Root:          cp.Root,
+			Justified:     cp.Justified,
+			JustifiedSlot: cp.JustifiedSlot,
+			Finalized:     cp.Finalized,
+			FinalizedSlot: cp.FinalizedSlot,
+		}
+	}
+	return &pbrpc.CheckpointTimeline{Checkpoints: checkpoints}, nil
+}
@@ -0,0 +1,68 @@
+package beacon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+func TestServer_GetCheckpointTimeline(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	archived := []*pbp2p.ArchivedCheckpoint{
+		{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32), Justified: true, JustifiedSlot: 16, Finalized: true, FinalizedSlot: 24},
+		{Epoch: 2, Root: bytesutil.PadTo([]byte{'b'}, 32), Justified: true, JustifiedSlot: 24},
+		{Epoch: 4, Root: bytesutil.PadTo([]byte{'c'}, 32), Justified: true, JustifiedSlot: 40},
+	}
+	for _, cp := range archived {
+		if err := db.SaveArchivedCheckpoint(ctx, cp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bs := &Server{BeaconDB: db}
+
+	res, err := bs.GetCheckpointTimeline(ctx, &pbrpc.CheckpointTimelineRequest{StartEpoch: 2, EndEpoch: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Checkpoints) != 2 {
+		t.Fatalf("Wanted 2 checkpoints, received %d", len(res.Checkpoints))
+	}
+	if res.Checkpoints[0].Epoch != 2 || res.Checkpoints[0].JustifiedSlot != 24 || res.Checkpoints[0].Finalized {
+		t.Errorf("Unexpected checkpoint %v", res.Checkpoints[0])
+	}
+	if res.Checkpoints[1].Epoch != 4 || res.Checkpoints[1].JustifiedSlot != 40 {
+		t.Errorf("Unexpected checkpoint %v", res.Checkpoints[1])
+	}
+
+	res, err = bs.GetCheckpointTimeline(ctx, &pbrpc.CheckpointTimelineRequest{StartEpoch: 1, EndEpoch: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Checkpoints) != 1 || !res.Checkpoints[0].Finalized || res.Checkpoints[0].FinalizedSlot != 24 {
+		t.Errorf("Unexpected checkpoints %v", res.Checkpoints)
+	}
+}
+
+func TestServer_GetCheckpointTimeline_InvalidRange(t *testing.T) {
+	bs := &Server{}
+	ctx := context.Background()
+
+	_, err := bs.GetCheckpointTimeline(ctx, &pbrpc.CheckpointTimelineRequest{StartEpoch: 5, EndEpoch: 4})
+	if err == nil || !strings.Contains(err.Error(), "can not be before start epoch") {
+		t.Errorf("Expected error for a reversed range, received %v", err)
+	}
+	maxEpochs := uint64(flags.Get().MaxPageSize)
+	_, err = bs.GetCheckpointTimeline(ctx, &pbrpc.CheckpointTimelineRequest{StartEpoch: 0, EndEpoch: maxEpochs})
+	if err == nil || !strings.Contains(err.Error(), "can not be greater than max size") {
+		t.Errorf("Expected error for a range larger than the max size, received %v", err)
+	}
+}
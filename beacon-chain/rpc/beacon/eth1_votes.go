@@ -0,0 +1,73 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEth1VotingStatus reports the eth1 data votes cast in the current voting period of the head
+// state. Candidates are grouped by identical eth1 data and sorted by descending vote count, so the
+// first candidate is the one leading the vote. A candidate is adopted by the beacon state once it
+// receives more than half of the votes of the period.
+func (bs *Server) GetEth1VotingStatus(ctx context.Context, _ *pbrpc.Eth1VotingStatusRequest) (*pbrpc.Eth1VotingStatus, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state is nil")
+	}
+
+	periodLength := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	slot := headState.Slot()
+	periodStart := slot - slot%periodLength
+
+	votes := headState.Eth1DataVotes()
+	candidates := make([]*pbrpc.Eth1DataCandidate, 0)
+	for _, vote := range votes {
+		counted := false
+		for _, c := range candidates {
+			if proto.Equal(c.Eth1Data, vote) {
+				c.VoteCount++
+				counted = true
+				break
+			}
+		}
+		if !counted {
+			candidates = append(candidates, &pbrpc.Eth1DataCandidate{Eth1Data: vote, VoteCount: 1})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].VoteCount > candidates[j].VoteCount
+	})
+
+	if bs.BlockFetcher != nil {
+		for _, c := range candidates {
+			// Blocks unknown to the eth1 node are reported as such rather than failing the request,
+			// they are the ones worth diagnosing.
+			exists, height, err := bs.BlockFetcher.BlockExists(ctx, common.BytesToHash(c.Eth1Data.BlockHash))
+			if err != nil || !exists || height == nil {
+				continue
+			}
+			c.BlockKnown = true
+			c.BlockNumber = height.Uint64()
+		}
+	}
+
+	return &pbrpc.Eth1VotingStatus{
+		Slot:                slot,
+		PeriodStartSlot:     periodStart,
+		SlotsUntilPeriodEnd: periodStart + periodLength - slot,
+		CurrentEth1Data:     headState.Eth1Data(),
+		TotalVotes:          uint64(len(votes)),
+		VotesRequired:       periodLength/2 + 1,
+		Candidates:          candidates,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_GetEth1VotingStatus(t *testing.T) {
+	eth1Data := func(hash byte, count uint64) *ethpb.Eth1Data {
+		return &ethpb.Eth1Data{
+			DepositRoot:  make([]byte, 32),
+			DepositCount: count,
+			BlockHash:    bytesutil.PadTo([]byte{hash}, 32),
+		}
+	}
+	periodLength := params.BeaconConfig().SlotsPerEth1VotingPeriod
+	headState, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		Slot:     periodLength + 3,
+		Eth1Data: eth1Data(1, 10),
+		Eth1DataVotes: []*ethpb.Eth1Data{
+			eth1Data(2, 12),
+			eth1Data(3, 13),
+			eth1Data(3, 13),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{State: headState},
+		BlockFetcher: &mockPOW.POWChain{
+			HashesByHeight: map[int][]byte{
+				100: bytesutil.PadTo([]byte{3}, 32),
+			},
+		},
+	}
+
+	res, err := bs.GetEth1VotingStatus(context.Background(), &pbrpc.Eth1VotingStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PeriodStartSlot != periodLength {
+		t.Errorf("Wanted period start slot %d, received %d", periodLength, res.PeriodStartSlot)
+	}
+	if res.SlotsUntilPeriodEnd != periodLength-3 {
+		t.Errorf("Wanted %d slots until period end, received %d", periodLength-3, res.SlotsUntilPeriodEnd)
+	}
+	if res.TotalVotes != 3 {
+		t.Errorf("Wanted 3 votes, received %d", res.TotalVotes)
+	}
+	if res.VotesRequired != periodLength/2+1 {
+		t.Errorf("Wanted %d votes required, received %d", periodLength/2+1, res.VotesRequired)
+	}
+	if res.CurrentEth1Data.DepositCount != 10 {
+		t.Errorf("Wanted current deposit count 10, received %d", res.CurrentEth1Data.DepositCount)
+	}
+	if len(res.Candidates) != 2 {
+		t.Fatalf("Wanted 2 candidates, received %d", len(res.Candidates))
+	}
+	leading := res.Candidates[0]
+	if leading.VoteCount != 2 || leading.Eth1Data.DepositCount != 13 {
+		t.Errorf("Unexpected leading candidate %v", leading)
+	}
+	if !leading.BlockKnown || leading.BlockNumber != 100 {
+		t.Errorf("Wanted leading candidate at known block 100, received %v", leading)
+	}
+	if res.Candidates[1].VoteCount != 1 || res.Candidates[1].BlockKnown {
+		t.Errorf("Unexpected second candidate %v", res.Candidates[1])
+	}
+}
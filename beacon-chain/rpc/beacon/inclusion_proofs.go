@@ -0,0 +1,95 @@
+package beacon
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAttestationInclusionProof retrieves the earliest block which included an attestation with the
+// requested attestation data root, along with a Merkle proof of the attestation against the
+// hash tree root of the block body.
+func (bs *Server) GetAttestationInclusionProof(
+	ctx context.Context,
+	req *pbrpc.AttestationInclusionProofRequest,
+) (*pbrpc.AttestationInclusionProof, error) {
+	if len(req.AttestationDataRoot) != 32 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Attestation data root must be 32 bytes, received %d",
+			len(req.AttestationDataRoot),
+		)
+	}
+	dataRoot := bytesutil.ToBytes32(req.AttestationDataRoot)
+	atts, err := bs.BeaconDB.AttestationsByDataRoot(ctx, dataRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve attestations: %v", err)
+	}
+	if len(atts) == 0 || atts[0].Data == nil {
+		return nil, status.Errorf(codes.NotFound, "No included attestation found for data root %#x", dataRoot)
+	}
+
+	// An attestation can only be included within an epoch after its slot.
+	attSlot := atts[0].Data.Slot
+	blks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().
+		SetStartSlot(attSlot+params.BeaconConfig().MinAttestationInclusionDelay).
+		SetEndSlot(attSlot+params.BeaconConfig().SlotsPerEpoch))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+	}
+
+	var includingBlk *ethpb.SignedBeaconBlock
+	var attIndex uint64
+	for _, blk := range blks {
+		if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+			continue
+		}
+		if includingBlk != nil && blk.Block.Slot >= includingBlk.Block.Slot {
+			continue
+		}
+		for i, att := range blk.Block.Body.Attestations {
+			r, err := ssz.HashTreeRoot(att.Data)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not hash attestation data: %v", err)
+			}
+			if r == dataRoot {
+				includingBlk = blk
+				attIndex = uint64(i)
+				break
+			}
+		}
+	}
+	if includingBlk == nil {
+		return nil, status.Errorf(codes.NotFound, "No block including data root %#x found", dataRoot)
+	}
+
+	proof, err := stateutil.BlockBodyAttestationProof(includingBlk.Block.Body, attIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute inclusion proof: %v", err)
+	}
+	bodyRoot, err := ssz.HashTreeRoot(includingBlk.Block.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash block body: %v", err)
+	}
+	blkRoot, err := ssz.HashTreeRoot(includingBlk.Block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
+	}
+	return &pbrpc.AttestationInclusionProof{
+		BlockRoot:        blkRoot[:],
+		Block:            includingBlk,
+		AttestationIndex: attIndex,
+		AttestationRoot:  proof.Leaf[:],
+		BodyRoot:         bodyRoot[:],
+		Proof:            proof.Branch,
+		GeneralizedIndex: proof.GeneralizedIndex,
+	}, nil
+}
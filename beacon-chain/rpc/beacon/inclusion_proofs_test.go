@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestServer_GetAttestationInclusionProof(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	atts := make([]*ethpb.Attestation, 2)
+	for i := range atts {
+		atts[i] = &ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0b1101},
+			Data: &ethpb.AttestationData{
+				Slot:            1,
+				CommitteeIndex:  uint64(i),
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	blk := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:       2,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti:     make([]byte, 32),
+				Attestations: atts,
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttestations(ctx, atts); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &Server{BeaconDB: db}
+	dataRoot, err := ssz.HashTreeRoot(atts[1].Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := bs.GetAttestationInclusionProof(ctx, &pbrpc.AttestationInclusionProofRequest{
+		AttestationDataRoot: dataRoot[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AttestationIndex != 1 {
+		t.Errorf("Wanted attestation index 1, got %d", res.AttestationIndex)
+	}
+	blkRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.BlockRoot) != string(blkRoot[:]) {
+		t.Errorf("Wanted block root %#x, got %#x", blkRoot, res.BlockRoot)
+	}
+	depth := uint64(len(res.Proof))
+	merkleIndex := int(res.GeneralizedIndex - 1<<depth)
+	if !trieutil.VerifyMerkleBranch(res.BodyRoot, res.AttestationRoot, merkleIndex, res.Proof) {
+		t.Error("Inclusion proof does not verify against the block body root")
+	}
+}
+
+func TestServer_GetAttestationInclusionProof_NotFound(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	bs := &Server{BeaconDB: db}
+	root := [32]byte{'a'}
+	if _, err := bs.GetAttestationInclusionProof(context.Background(), &pbrpc.AttestationInclusionProofRequest{
+		AttestationDataRoot: root[:],
+	}); err == nil {
+		t.Error("Expected error for an unknown attestation data root")
+	}
+}
@@ -0,0 +1,129 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStateRoots returns the state roots of the canonical blocks of the requested slot range, with
+// the slots without a canonical block marked as skipped. The canonical chain of the range is
+// walked back through the parent roots of the blocks, starting from the head block, or from the
+// block root recorded for the end of the range in the head state when it's within its block roots
+// history. Older ranges start from the highest block of the range, which is only guaranteed to be
+// canonical once the range is finalized.
+func (bs *Server) GetStateRoots(ctx context.Context, req *pbrpc.StateRootsRequest) (*pbrpc.StateRoots, error) {
+	if req.EndSlot < req.StartSlot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"End slot %d can not be before start slot %d",
+			req.EndSlot,
+			req.StartSlot,
+		)
+	}
+	if req.EndSlot-req.StartSlot >= uint64(flags.Get().MaxPageSize) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested slot range %d-%d can not be greater than max size %d",
+			req.StartSlot,
+			req.EndSlot,
+			flags.Get().MaxPageSize,
+		)
+	}
+
+	headSlot := bs.HeadFetcher.HeadSlot()
+	if req.StartSlot > headSlot {
+		return &pbrpc.StateRoots{}, nil
+	}
+	endSlot := req.EndSlot
+	if endSlot > headSlot {
+		endSlot = headSlot
+	}
+
+	tip, err := bs.stateRootsTip(ctx, req.StartSlot, endSlot, headSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the canonical chain back from the tip, down to the start of the range.
+	canonical := make(map[uint64]*pbrpc.SlotStateRoot)
+	for root := tip; root != nil; {
+		blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(root))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		if blk == nil || blk.Block == nil || blk.Block.Slot < req.StartSlot {
+			break
+		}
+		if blk.Block.Slot <= endSlot {
+			canonical[blk.Block.Slot] = &pbrpc.SlotStateRoot{
+				Slot:      blk.Block.Slot,
+				BlockRoot: root,
+				StateRoot: blk.Block.StateRoot,
+			}
+		}
+		if blk.Block.Slot == 0 {
+			break
+		}
+		root = blk.Block.ParentRoot
+	}
+
+	roots := make([]*pbrpc.SlotStateRoot, 0, endSlot-req.StartSlot+1)
+	for slot := req.StartSlot; slot <= endSlot; slot++ {
+		if r, ok := canonical[slot]; ok {
+			roots = append(roots, r)
+			continue
+		}
+		roots = append(roots, &pbrpc.SlotStateRoot{Slot: slot, Skipped: true})
+	}
+	return &pbrpc.StateRoots{Roots: roots}, nil
+}
+
+// This returns the root of the canonical block the walk of the input slot range starts from, or nil
+// if no block of the range is known.
+func (bs *Server) stateRootsTip(ctx context.Context, startSlot uint64, endSlot uint64, headSlot uint64) ([]byte, error) {
+	if endSlot == headSlot {
+		root, err := bs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+		}
+		return root, nil
+	}
+	if headSlot-endSlot <= params.BeaconConfig().SlotsPerHistoricalRoot {
+		headState, err := bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+		}
+		root, err := headState.BlockRootAtIndex(endSlot % params.BeaconConfig().SlotsPerHistoricalRoot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block root: %v", err)
+		}
+		return root, nil
+	}
+
+	blocks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+	}
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	highest := blocks[0]
+	for _, b := range blocks[1:] {
+		if b.Block.Slot > highest.Block.Slot {
+			highest = b
+		}
+	}
+	root, err := ssz.HashTreeRoot(highest.Block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute block root: %v", err)
+	}
+	return root[:], nil
+}
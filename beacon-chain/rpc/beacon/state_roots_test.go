@@ -0,0 +1,116 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_GetStateRoots(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// Canonical blocks at slots 0, 1 and 3, and a fork block at slot 2.
+	saveBlock := func(slot uint64, parentRoot []byte, stateRoot byte) [32]byte {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot,
+			StateRoot:  bytesutil.PadTo([]byte{stateRoot}, 32),
+		}}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+	root0 := saveBlock(0, make([]byte, 32), 'a')
+	root1 := saveBlock(1, root0[:], 'b')
+	saveBlock(2, root0[:], 'c')
+	root3 := saveBlock(3, root1[:], 'd')
+
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = make([]byte, 32)
+	}
+	blockRoots[0] = root0[:]
+	blockRoots[1] = root1[:]
+	blockRoots[2] = root1[:]
+	headState, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{Slot: 3, BlockRoots: blockRoots})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: headState, Root: root3[:]},
+	}
+
+	// The range is cut at the head slot.
+	res, err := bs.GetStateRoots(ctx, &pbrpc.StateRootsRequest{StartSlot: 0, EndSlot: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Roots) != 4 {
+		t.Fatalf("Wanted 4 roots, received %d", len(res.Roots))
+	}
+	wanted := []byte{'a', 'b', 0, 'd'}
+	for i, r := range res.Roots {
+		if r.Slot != uint64(i) {
+			t.Errorf("Wanted slot %d, received %d", i, r.Slot)
+		}
+		if wanted[i] == 0 {
+			if !r.Skipped || r.StateRoot != nil {
+				t.Errorf("Wanted slot %d marked as skipped, received %v", i, r)
+			}
+			continue
+		}
+		if r.Skipped || !bytes.Equal(r.StateRoot, bytesutil.PadTo([]byte{wanted[i]}, 32)) {
+			t.Errorf("Unexpected state root for slot %d: %v", i, r)
+		}
+	}
+	if !bytes.Equal(res.Roots[3].BlockRoot, root3[:]) {
+		t.Errorf("Wanted block root %#x, received %#x", root3, res.Roots[3].BlockRoot)
+	}
+
+	// Ranges behind the head start from the block roots of the head state.
+	res, err = bs.GetStateRoots(ctx, &pbrpc.StateRootsRequest{StartSlot: 1, EndSlot: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Roots) != 2 || res.Roots[0].Skipped || !res.Roots[1].Skipped {
+		t.Errorf("Unexpected roots %v", res.Roots)
+	}
+	if !bytes.Equal(res.Roots[0].BlockRoot, root1[:]) {
+		t.Errorf("Wanted block root %#x, received %#x", root1, res.Roots[0].BlockRoot)
+	}
+}
+
+func TestServer_GetStateRoots_InvalidRange(t *testing.T) {
+	bs := &Server{}
+	ctx := context.Background()
+
+	_, err := bs.GetStateRoots(ctx, &pbrpc.StateRootsRequest{StartSlot: 5, EndSlot: 4})
+	if err == nil || !strings.Contains(err.Error(), "can not be before start slot") {
+		t.Errorf("Expected error for a reversed range, received %v", err)
+	}
+	maxSlots := uint64(flags.Get().MaxPageSize)
+	_, err = bs.GetStateRoots(ctx, &pbrpc.StateRootsRequest{StartSlot: 0, EndSlot: maxSlots})
+	if err == nil || !strings.Contains(err.Error(), "can not be greater than max size") {
+		t.Errorf("Expected error for a range larger than the max size, received %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// syncRateWindow is how far back the head slot samples used to compute the sync rate go.
+const syncRateWindow = 2 * time.Minute
+
+// headSample is the head slot observed at a given time.
+type headSample struct {
+	at   time.Time
+	slot uint64
+}
+
+// syncRateTracker samples the head slot on every sync progress request, the rate the head
+// advances at is computed over the time elapsed since the sample starting the last sync rate
+// window, so it doesn't depend on how often the progress is requested.
+type syncRateTracker struct {
+	lock    sync.Mutex
+	samples []headSample
+}
+
+// This records the head slot and returns the number of slots per second the head advanced at
+// since the sample starting the window, or 0 if there is no earlier sample yet.
+func (t *syncRateTracker) record(now time.Time, slot uint64) float64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// The newest sample older than the window is kept as its start, requests spaced further
+	// apart than the window still get a rate over the time elapsed between them.
+	for len(t.samples) > 1 && now.Sub(t.samples[1].at) >= syncRateWindow {
+		t.samples = t.samples[1:]
+	}
+	var rate float64
+	if len(t.samples) > 0 {
+		first := t.samples[0]
+		if elapsed := now.Sub(first.at).Seconds(); elapsed > 0 && slot > first.slot {
+			rate = float64(slot-first.slot) / elapsed
+		}
+	}
+	// Samples closer than a second apart don't improve the estimate.
+	if n := len(t.samples); n == 0 || now.Sub(t.samples[n-1].at) >= time.Second {
+		t.samples = append(t.samples, headSample{at: now, slot: slot})
+	}
+	return rate
+}
+
+// GetSyncProgress reports how far the head is behind the wall clock and how fast it is catching
+// up, along with an estimate of the next finalization. The sync rate is sampled from the head slot
+// on every request, so the first requests report no rate until samples span some time. The
+// finality estimate assumes the participation of the previous epoch of the head state holds: with
+// at least 2/3 of the active balance voting for the target, the epoch preceding a justified epoch
+// is finalized on the next epoch transition.
+func (bs *Server) GetSyncProgress(ctx context.Context, _ *pbrpc.SyncProgressRequest) (*pbrpc.SyncProgress, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state is nil")
+	}
+
+	cfg := params.BeaconConfig()
+	headSlot := bs.HeadFetcher.HeadSlot()
+	currentSlot := slotutil.SlotsSinceGenesis(bs.GenesisTimeFetcher.GenesisTime())
+	res := &pbrpc.SyncProgress{
+		HeadSlot:           headSlot,
+		CurrentSlot:        currentSlot,
+		HeadSlotsPerSecond: bs.syncRateTracker.record(roughtime.Now(), headSlot),
+		FinalizedEpoch:     headState.FinalizedCheckpointEpoch(),
+	}
+	if bs.SyncChecker != nil {
+		res.Syncing = bs.SyncChecker.Syncing()
+	}
+	if currentSlot > headSlot {
+		res.SlotsBehind = currentSlot - headSlot
+		// The head has to catch up with the chain, which advances by a slot every slot duration.
+		if catchUpRate := res.HeadSlotsPerSecond - 1/float64(cfg.SecondsPerSlot); catchUpRate > 0 {
+			res.EstimatedSecondsToHead = uint64(float64(res.SlotsBehind) / catchUpRate)
+		}
+	}
+
+	prevEpoch := helpers.PrevEpoch(headState)
+	if p := bs.ParticipationFetcher.Participation(prevEpoch); p != nil && p.PrevEpoch > 0 {
+		res.PreviousEpochParticipation = float64(p.PrevEpochTargetAttesters) / float64(p.PrevEpoch)
+		res.FinalizationExpected = 3*p.PrevEpochTargetAttesters >= 2*p.PrevEpoch
+	}
+	if res.FinalizationExpected {
+		// The previous epoch being justified already, the current epoch justifies it as finalized
+		// on the next transition, otherwise the previous epoch first has to be justified.
+		currentEpoch := helpers.CurrentEpoch(headState)
+		res.EstimatedEpochsToFinality = 2
+		if currentEpoch > 0 && headState.CurrentJustifiedCheckpoint().Epoch+1 >= currentEpoch {
+			res.EstimatedEpochsToFinality = 1
+		}
+		slotsToTransition := cfg.SlotsPerEpoch - currentSlot%cfg.SlotsPerEpoch
+		slots := slotsToTransition + (res.EstimatedEpochsToFinality-1)*cfg.SlotsPerEpoch
+		res.EstimatedSecondsToFinality = slots * cfg.SecondsPerSlot
+	}
+	return res, nil
+}
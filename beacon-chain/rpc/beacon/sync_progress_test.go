@@ -0,0 +1,95 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSyncRateTracker_Record(t *testing.T) {
+	tracker := &syncRateTracker{}
+	start := time.Now()
+	if rate := tracker.record(start, 0); rate != 0 {
+		t.Errorf("Wanted no rate from a single sample, got %f", rate)
+	}
+	if rate := tracker.record(start.Add(10*time.Second), 50); rate != 5 {
+		t.Errorf("Wanted a rate of 5 slots per second, got %f", rate)
+	}
+	// Samples older than the window are dropped, but the newest of them.
+	now := start.Add(syncRateWindow + 20*time.Second)
+	want := 50.0 / now.Sub(start.Add(10*time.Second)).Seconds()
+	if rate := tracker.record(now, 100); rate != want {
+		t.Errorf("Wanted a rate of %f slots per second, got %f", want, rate)
+	}
+}
+
+func TestSyncRateTracker_Record_SparseRequests(t *testing.T) {
+	tracker := &syncRateTracker{}
+	start := time.Now()
+	tracker.record(start, 0)
+	// Requests far apart get the rate over the time elapsed between them.
+	if rate := tracker.record(start.Add(10*time.Minute), 1200); rate != 2 {
+		t.Errorf("Wanted a rate of 2 slots per second, got %f", rate)
+	}
+	if rate := tracker.record(start.Add(20*time.Minute), 1800); rate != 1 {
+		t.Errorf("Wanted a rate of 1 slot per second, got %f", rate)
+	}
+}
+
+func TestServer_GetSyncProgress(t *testing.T) {
+	cfg := params.BeaconConfig()
+	headSlot := 3*cfg.SlotsPerEpoch + 1
+	headState, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		Slot:                       headSlot,
+		CurrentJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 2},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Epoch: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := time.Now().Add(-time.Duration(2*headSlot*cfg.SecondsPerSlot) * time.Second)
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: headState},
+		GenesisTimeFetcher: &mock.ChainService{Genesis: genesis},
+		ParticipationFetcher: &mock.ChainService{
+			Balance: &precompute.Balance{PrevEpoch: 100, PrevEpochTargetAttesters: 80},
+		},
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+
+	res, err := bs.GetSyncProgress(context.Background(), &pbrpc.SyncProgressRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Syncing {
+		t.Error("Wanted node reported as syncing")
+	}
+	if res.HeadSlot != headSlot || res.SlotsBehind < headSlot {
+		t.Errorf("Wanted head slot %d at least %d slots behind, got %d slots behind", headSlot, headSlot, res.SlotsBehind)
+	}
+	if res.EstimatedSecondsToHead != 0 {
+		t.Error("Did not want a time to head estimate without a sync rate")
+	}
+	if res.FinalizedEpoch != 1 {
+		t.Errorf("Wanted finalized epoch 1, got %d", res.FinalizedEpoch)
+	}
+	if res.PreviousEpochParticipation != 0.8 || !res.FinalizationExpected {
+		t.Errorf("Wanted finalization expected with participation 0.8, got %f", res.PreviousEpochParticipation)
+	}
+	// The previous epoch is justified, it is finalized on the next epoch transition.
+	if res.EstimatedEpochsToFinality != 1 {
+		t.Errorf("Wanted 1 epoch to finality, got %d", res.EstimatedEpochsToFinality)
+	}
+	if res.EstimatedSecondsToFinality == 0 || res.EstimatedSecondsToFinality > cfg.SlotsPerEpoch*cfg.SecondsPerSlot {
+		t.Errorf("Wanted seconds to finality within an epoch, got %d", res.EstimatedSecondsToFinality)
+	}
+}
@@ -0,0 +1,122 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatorQueueCache keeps the queue positions computed for the current epoch, the queues
+// only change on epoch processing.
+type validatorQueueCache struct {
+	lock      sync.Mutex
+	positions *pbrpc.ValidatorQueuePositions
+}
+
+// GetValidatorQueuePositions lists the validators of the head state waiting in the activation
+// and exit queues, with their queue position and activation or exit epoch. The result is cached
+// for the epoch of the head state.
+func (bs *Server) GetValidatorQueuePositions(
+	ctx context.Context,
+	_ *pbrpc.ValidatorQueuePositionsRequest,
+) (*pbrpc.ValidatorQueuePositions, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state is nil")
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+
+	bs.validatorQueueCache.lock.Lock()
+	defer bs.validatorQueueCache.lock.Unlock()
+	if cached := bs.validatorQueueCache.positions; cached != nil && cached.Epoch == currentEpoch {
+		return cached, nil
+	}
+
+	activeValidatorCount, err := helpers.ActiveValidatorCount(headState, currentEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	churnLimit, err := helpers.ValidatorChurnLimit(activeValidatorCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute churn limit: %v", err)
+	}
+
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	vals := headState.Validators()
+	// Validators with an activation epoch assigned are ahead of the ones still waiting for one.
+	scheduled := make([]uint64, 0)
+	pending := make([]uint64, 0)
+	exiting := make([]uint64, 0)
+	for idx, val := range vals {
+		switch {
+		case val.ActivationEpoch != farFutureEpoch && val.ActivationEpoch > currentEpoch:
+			scheduled = append(scheduled, uint64(idx))
+		case val.ActivationEpoch == farFutureEpoch && val.ActivationEligibilityEpoch != farFutureEpoch:
+			pending = append(pending, uint64(idx))
+		}
+		if val.ExitEpoch != farFutureEpoch && val.ExitEpoch > currentEpoch {
+			exiting = append(exiting, uint64(idx))
+		}
+	}
+	sortQueue(scheduled, func(v *ethpb.Validator) uint64 { return v.ActivationEpoch }, vals)
+	sortQueue(pending, func(v *ethpb.Validator) uint64 { return v.ActivationEligibilityEpoch }, vals)
+	sortQueue(exiting, func(v *ethpb.Validator) uint64 { return v.ExitEpoch }, vals)
+
+	activationQueue := make([]*pbrpc.QueuedValidator, 0, len(scheduled)+len(pending))
+	for _, idx := range scheduled {
+		activationQueue = append(activationQueue, queuedValidator(vals[idx], idx, len(activationQueue), vals[idx].ActivationEpoch))
+	}
+	for i, idx := range pending {
+		// Validators are dequeued up to the churn limit on every epoch transition, once their
+		// eligibility epoch is reached.
+		dequeueEpoch := currentEpoch + uint64(i)/churnLimit
+		if eligibility := vals[idx].ActivationEligibilityEpoch; dequeueEpoch < eligibility {
+			dequeueEpoch = eligibility
+		}
+		activationEpoch := helpers.ActivationExitEpoch(dequeueEpoch)
+		activationQueue = append(activationQueue, queuedValidator(vals[idx], idx, len(activationQueue), activationEpoch))
+	}
+	exitQueue := make([]*pbrpc.QueuedValidator, len(exiting))
+	for i, idx := range exiting {
+		exitQueue[i] = queuedValidator(vals[idx], idx, i, vals[idx].ExitEpoch)
+	}
+
+	positions := &pbrpc.ValidatorQueuePositions{
+		Epoch:           currentEpoch,
+		ChurnLimit:      churnLimit,
+		ActivationQueue: activationQueue,
+		ExitQueue:       exitQueue,
+	}
+	bs.validatorQueueCache.positions = positions
+	return positions, nil
+}
+
+// sortQueue orders validator indices by the input epoch, then by index.
+func sortQueue(indices []uint64, epoch func(*ethpb.Validator) uint64, vals []*ethpb.Validator) {
+	sort.Slice(indices, func(i, j int) bool {
+		ei, ej := epoch(vals[indices[i]]), epoch(vals[indices[j]])
+		if ei == ej {
+			return indices[i] < indices[j]
+		}
+		return ei < ej
+	})
+}
+
+func queuedValidator(val *ethpb.Validator, idx uint64, position int, epoch uint64) *pbrpc.QueuedValidator {
+	return &pbrpc.QueuedValidator{
+		Index:          idx,
+		PublicKey:      val.PublicKey,
+		Position:       uint64(position),
+		EstimatedEpoch: epoch,
+	}
+}
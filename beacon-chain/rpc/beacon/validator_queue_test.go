@@ -0,0 +1,90 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_GetValidatorQueuePositions(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	validators := []*ethpb.Validator{
+		// Active validators.
+		{ActivationEpoch: 0, ExitEpoch: farFuture, PublicKey: pubKey(0)},
+		{ActivationEpoch: 0, ExitEpoch: farFuture, PublicKey: pubKey(1)},
+		// Exiting validators, sorted by exit epoch.
+		{ActivationEpoch: 0, ExitEpoch: 9, PublicKey: pubKey(2)},
+		{ActivationEpoch: 0, ExitEpoch: 7, PublicKey: pubKey(3)},
+		// Already exited.
+		{ActivationEpoch: 0, ExitEpoch: 1, PublicKey: pubKey(4)},
+		// Pending activation, sorted by eligibility epoch.
+		{ActivationEligibilityEpoch: 3, ActivationEpoch: farFuture, ExitEpoch: farFuture, PublicKey: pubKey(5)},
+		{ActivationEligibilityEpoch: 2, ActivationEpoch: farFuture, ExitEpoch: farFuture, PublicKey: pubKey(6)},
+		// Scheduled activation.
+		{ActivationEligibilityEpoch: 1, ActivationEpoch: 6, ExitEpoch: farFuture, PublicKey: pubKey(7)},
+		// Not eligible yet.
+		{ActivationEligibilityEpoch: farFuture, ActivationEpoch: farFuture, ExitEpoch: farFuture, PublicKey: pubKey(8)},
+	}
+	headState, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		Slot:       2 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: validators,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{State: headState},
+	}
+	res, err := bs.GetValidatorQueuePositions(context.Background(), &pbrpc.ValidatorQueuePositionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Epoch != 2 {
+		t.Errorf("Wanted epoch 2, received %d", res.Epoch)
+	}
+
+	wantActivation := []struct {
+		index uint64
+		epoch uint64
+	}{
+		{index: 7, epoch: 6},
+		{index: 6, epoch: helpers.ActivationExitEpoch(2)},
+		{index: 5, epoch: helpers.ActivationExitEpoch(3)},
+	}
+	if len(res.ActivationQueue) != len(wantActivation) {
+		t.Fatalf("Wanted %d validators in the activation queue, received %d", len(wantActivation), len(res.ActivationQueue))
+	}
+	for i, want := range wantActivation {
+		got := res.ActivationQueue[i]
+		if got.Index != want.index || got.Position != uint64(i) || got.EstimatedEpoch != want.epoch {
+			t.Errorf("Wanted validator %d at position %d activating at %d, received %v", want.index, i, want.epoch, got)
+		}
+	}
+
+	wantExit := []uint64{3, 2}
+	if len(res.ExitQueue) != len(wantExit) {
+		t.Fatalf("Wanted %d validators in the exit queue, received %d", len(wantExit), len(res.ExitQueue))
+	}
+	for i, idx := range wantExit {
+		got := res.ExitQueue[i]
+		if got.Index != idx || got.Position != uint64(i) || got.EstimatedEpoch != validators[idx].ExitEpoch {
+			t.Errorf("Wanted validator %d at position %d, received %v", idx, i, got)
+		}
+	}
+
+	// Requests in the same epoch are served from the cache.
+	again, err := bs.GetValidatorQueuePositions(context.Background(), &pbrpc.ValidatorQueuePositionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != res {
+		t.Error("Wanted the queue positions to be cached for the epoch")
+	}
+}
@@ -0,0 +1,55 @@
+package debug
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockArrivals returns the arrival timing of the most recently received blocks and whether
+// they were orphaned, along with the arrival delay percentiles and the orphan rate.
+func (ds *Server) GetBlockArrivals(
+	_ context.Context,
+	_ *pb.BlockArrivalsRequest,
+) (*pb.BlockArrivalsResponse, error) {
+	if ds.BlockArrivalFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Block arrivals are not available")
+	}
+	arrivals := ds.BlockArrivalFetcher.BlockArrivals()
+	res := &pb.BlockArrivalsResponse{
+		Arrivals: make([]*pb.BlockArrival, len(arrivals)),
+	}
+	delays := make([]int64, len(arrivals))
+	for i, arrival := range arrivals {
+		root := arrival.Root
+		delays[i] = int64(arrival.Delay / time.Millisecond)
+		res.Arrivals[i] = &pb.BlockArrival{
+			Slot:      arrival.Slot,
+			BlockRoot: root[:],
+			DelayMs:   delays[i],
+			Finalized: arrival.Finalized,
+			Orphaned:  arrival.Orphaned,
+		}
+		if arrival.Finalized {
+			res.FinalizedCount++
+			if arrival.Orphaned {
+				res.OrphanedCount++
+			}
+		}
+	}
+	if len(delays) > 0 {
+		sort.Slice(delays, func(i, j int) bool {
+			return delays[i] < delays[j]
+		})
+		res.MedianDelayMs = delays[len(delays)/2]
+		res.P95DelayMs = delays[len(delays)*95/100]
+	}
+	if res.FinalizedCount > 0 {
+		res.OrphanRate = float64(res.OrphanedCount) / float64(res.FinalizedCount)
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+type mockBlockArrivalFetcher struct {
+	arrivals []*blockchain.BlockArrival
+}
+
+func (m *mockBlockArrivalFetcher) BlockArrivals() []*blockchain.BlockArrival {
+	return m.arrivals
+}
+
+func TestServer_GetBlockArrivals(t *testing.T) {
+	ds := &Server{BlockArrivalFetcher: &mockBlockArrivalFetcher{arrivals: []*blockchain.BlockArrival{
+		{Slot: 1, Root: [32]byte{'a'}, Delay: 3 * time.Second, Finalized: true},
+		{Slot: 2, Root: [32]byte{'b'}, Delay: time.Second, Finalized: true, Orphaned: true},
+		{Slot: 2, Root: [32]byte{'c'}, Delay: 2 * time.Second, Finalized: true},
+		{Slot: 3, Root: [32]byte{'d'}, Delay: 500 * time.Millisecond},
+	}}}
+	res, err := ds.GetBlockArrivals(context.Background(), &pb.BlockArrivalsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Arrivals) != 4 {
+		t.Fatalf("Wanted 4 arrivals, received %d", len(res.Arrivals))
+	}
+	if res.Arrivals[1].DelayMs != 1000 || !res.Arrivals[1].Orphaned {
+		t.Errorf("Unexpected arrival %v", res.Arrivals[1])
+	}
+	if res.MedianDelayMs != 2000 {
+		t.Errorf("Wanted median delay 2000ms, received %d", res.MedianDelayMs)
+	}
+	if res.P95DelayMs != 3000 {
+		t.Errorf("Wanted 95th percentile delay 3000ms, received %d", res.P95DelayMs)
+	}
+	if res.FinalizedCount != 3 || res.OrphanedCount != 1 {
+		t.Errorf("Wanted 3 finalized and 1 orphaned blocks, received %d and %d", res.FinalizedCount, res.OrphanedCount)
+	}
+	if res.OrphanRate != 1.0/3 {
+		t.Errorf("Wanted orphan rate %f, received %f", 1.0/3, res.OrphanRate)
+	}
+}
+
+func TestServer_GetBlockArrivals_Unavailable(t *testing.T) {
+	ds := &Server{}
+	if _, err := ds.GetBlockArrivals(context.Background(), &pb.BlockArrivalsRequest{}); err == nil {
+		t.Error("Expected an error without a block arrival fetcher")
+	}
+}
@@ -0,0 +1,17 @@
+package debug
+
+import (
+	"context"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+// GetEffectiveConfig returns the value of every command line flag the node runs with, along
+// with its version. Flags holding credentials are redacted, see cmd.EffectiveFlags.
+func (ds *Server) GetEffectiveConfig(_ context.Context, _ *pb.EffectiveConfigRequest) (*pb.EffectiveConfig, error) {
+	return &pb.EffectiveConfig{
+		Version: version.GetVersion(),
+		Flags:   ds.EffectiveFlags,
+	}, nil
+}
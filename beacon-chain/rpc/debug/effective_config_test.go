@@ -0,0 +1,22 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestServer_GetEffectiveConfig(t *testing.T) {
+	ds := &Server{EffectiveFlags: map[string]string{"rpc-port": "4000"}}
+	res, err := ds.GetEffectiveConfig(context.Background(), &pb.EffectiveConfigRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Flags["rpc-port"] != "4000" {
+		t.Errorf("Wanted flag value %s, got %s", "4000", res.Flags["rpc-port"])
+	}
+	if res.Version == "" {
+		t.Error("Expected the node version")
+	}
+}
@@ -0,0 +1,89 @@
+// Package debug defines a gRPC server implementation of the beacon node debug service,
+// exposing node internals which are useful when debugging a beacon node.
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Debug service.
+type Server struct {
+	StateGen            *stategen.State
+	HeadFetcher         blockchain.HeadFetcher
+	BlockArrivalFetcher blockchain.BlockArrivalFetcher
+	// EffectiveFlags holds the value of every command line flag of the node, keyed by flag name.
+	EffectiveFlags map[string]string
+}
+
+// maxReplayBenchmarkBlocks bounds the number of blocks a replay benchmark can request, to keep
+// a single request from tying up the node.
+const maxReplayBenchmarkBlocks = 1024
+
+// TraceStateGeneration loads the state of the requested block root with tracing enabled and
+// returns the decision path taken by state generation as JSON.
+func (ds *Server) TraceStateGeneration(
+	ctx context.Context,
+	req *pb.StateGenerationTraceRequest,
+) (*pb.StateGenerationTraceResponse, error) {
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block root must be 32 bytes, received %d", len(req.BlockRoot))
+	}
+	if ds.StateGen == nil {
+		return nil, status.Error(codes.Unavailable, "State generation is not available")
+	}
+	trace := ds.StateGen.TraceStateByRoot(ctx, bytesutil.ToBytes32(req.BlockRoot))
+	enc, err := json.Marshal(trace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode state generation trace: %v", err)
+	}
+	return &pb.StateGenerationTraceResponse{Encoded: enc}, nil
+}
+
+// BenchmarkReplay replays the requested number of blocks up to the head block on top of the
+// state of their parent, without persisting anything, and returns the processing throughput.
+func (ds *Server) BenchmarkReplay(
+	ctx context.Context,
+	req *pb.ReplayBenchmarkRequest,
+) (*pb.ReplayBenchmarkResponse, error) {
+	if req.BlockCount == 0 || req.BlockCount > maxReplayBenchmarkBlocks {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Block count must be between 1 and %d, received %d",
+			maxReplayBenchmarkBlocks,
+			req.BlockCount,
+		)
+	}
+	if ds.StateGen == nil || ds.HeadFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "State generation is not available")
+	}
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	res, err := ds.StateGen.BenchmarkReplay(ctx, bytesutil.ToBytes32(headRoot), req.BlockCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not benchmark block replay: %v", err)
+	}
+	var blocksPerSecond float64
+	if res.ReplayTime > 0 {
+		blocksPerSecond = float64(res.BlocksReplayed) / res.ReplayTime.Seconds()
+	}
+	return &pb.ReplayBenchmarkResponse{
+		StartSlot:          res.StartSlot,
+		EndSlot:            res.EndSlot,
+		BlocksReplayed:     res.BlocksReplayed,
+		StateLoadTimeMs:    uint64(res.StateLoadTime / time.Millisecond),
+		ReplayTimeMs:       uint64(res.ReplayTime / time.Millisecond),
+		BlocksPerSecond:    blocksPerSecond,
+		SignaturesVerified: res.SignaturesVerified,
+	}, nil
+}
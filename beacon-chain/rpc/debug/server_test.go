@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestServer_TraceStateGeneration(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
+	if err := db.SaveGenesisBlockRoot(ctx, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: 3, Root: blkRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+
+	ds := &Server{StateGen: stategen.New(db)}
+	res, err := ds.TraceStateGeneration(ctx, &pb.StateGenerationTraceRequest{BlockRoot: blkRoot[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trace := &stategen.LoadTrace{}
+	if err := json.Unmarshal(res.Encoded, trace); err != nil {
+		t.Fatal(err)
+	}
+	if trace.Error != "" {
+		t.Fatalf("Unexpected load error: %s", trace.Error)
+	}
+	if trace.Section != "hot" || trace.StateSlot != 3 {
+		t.Errorf("Unexpected trace %+v", trace)
+	}
+}
+
+func TestServer_TraceStateGeneration_InvalidRoot(t *testing.T) {
+	ds := &Server{}
+	if _, err := ds.TraceStateGeneration(context.Background(), &pb.StateGenerationTraceRequest{BlockRoot: []byte{1}}); err == nil {
+		t.Error("Expected error for a malformed block root")
+	}
+}
+
+func TestServer_BenchmarkReplay_InvalidBlockCount(t *testing.T) {
+	ds := &Server{}
+	for _, count := range []uint64{0, maxReplayBenchmarkBlocks + 1} {
+		if _, err := ds.BenchmarkReplay(context.Background(), &pb.ReplayBenchmarkRequest{BlockCount: count}); err == nil {
+			t.Errorf("Expected error for block count %d", count)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package node
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unknownAgent is the client peers are counted under when they haven't reported an agent.
+const unknownAgent = "unknown"
+
+// ListPeerDetails lists the peers connected to this node with their agent, direction, protocol
+// scores and bandwidth, along with breakdowns of the peers by client and direction.
+func (ns *Server) ListPeerDetails(
+	ctx context.Context,
+	_ *pbrpc.ListPeerDetailsRequest,
+) (*pbrpc.ListPeerDetailsResponse, error) {
+	if ns.PeerInfoFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Peer information is not available")
+	}
+	peerStatus := ns.PeersFetcher.Peers()
+	clients := make(map[string]uint64)
+	directions := make(map[string]uint64)
+	res := make([]*pbrpc.PeerDetails, 0)
+	for _, pid := range peerStatus.Connected() {
+		multiaddr, err := peerStatus.Address(pid)
+		if err != nil {
+			continue
+		}
+		direction, err := peerStatus.Direction(pid)
+		if err != nil {
+			continue
+		}
+		details := &pbrpc.PeerDetails{
+			PeerId:          pid.Pretty(),
+			Address:         multiaddr.String(),
+			Agent:           ns.PeerInfoFetcher.PeerAgent(pid),
+			Direction:       directionToProto(direction),
+			MaxBadResponses: uint64(peerStatus.MaxBadResponses()),
+		}
+		if badResponses, err := peerStatus.BadResponses(pid); err == nil {
+			details.BadResponses = uint64(badResponses)
+		}
+		if chainState, err := peerStatus.ChainState(pid); err == nil && chainState != nil {
+			details.HeadSlot = chainState.HeadSlot
+			details.FinalizedEpoch = chainState.FinalizedEpoch
+		}
+		bandwidth := ns.PeerInfoFetcher.PeerBandwidth(pid)
+		details.BytesIn = uint64(bandwidth.TotalIn)
+		details.BytesOut = uint64(bandwidth.TotalOut)
+		details.RateIn = bandwidth.RateIn
+		details.RateOut = bandwidth.RateOut
+
+		clients[agentClient(details.Agent)]++
+		directions[strings.ToLower(details.Direction.String())]++
+		res = append(res, details)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].PeerId < res[j].PeerId })
+
+	total := ns.PeerInfoFetcher.TotalBandwidth()
+	return &pbrpc.ListPeerDetailsResponse{
+		Peers:         res,
+		Clients:       peerCounts(clients),
+		Directions:    peerCounts(directions),
+		TotalBytesIn:  uint64(total.TotalIn),
+		TotalBytesOut: uint64(total.TotalOut),
+	}, nil
+}
+
+func directionToProto(direction network.Direction) pbrpc.PeerConnectionDirection {
+	switch direction {
+	case network.DirInbound:
+		return pbrpc.PeerConnectionDirection_INBOUND
+	case network.DirOutbound:
+		return pbrpc.PeerConnectionDirection_OUTBOUND
+	default:
+		return pbrpc.PeerConnectionDirection_UNKNOWN_DIRECTION
+	}
+}
+
+// This returns the client of an agent such as "Prysm/v1.0.0/abcdef", the agent up to the first
+// slash.
+func agentClient(agent string) string {
+	if agent == "" {
+		return unknownAgent
+	}
+	return strings.SplitN(agent, "/", 2)[0]
+}
+
+// This returns the counts sorted by decreasing count, then by key.
+func peerCounts(counts map[string]uint64) []*pbrpc.PeerCount {
+	res := make([]*pbrpc.PeerCount, 0, len(counts))
+	for key, count := range counts {
+		res = append(res, &pbrpc.PeerCount{Key: key, Count: count})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Key < res[j].Key
+	})
+	return res
+}
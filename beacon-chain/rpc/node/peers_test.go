@@ -0,0 +1,81 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/peer"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestServer_ListPeerDetails(t *testing.T) {
+	id0, _ := peer.IDB58Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	id1, _ := peer.IDB58Decode("16Uiu2HAm4HgJ9N1o222xK61o7LSgToYWoAy1wNTJRkh9gLZapVAy")
+	peersProvider := &mockP2p.MockPeersProvider{}
+	peersProvider.Peers().IncrementBadResponses(id1)
+	ns := &Server{
+		PeersFetcher: peersProvider,
+		PeerInfoFetcher: &mockP2p.MockPeerInfoProvider{
+			Agents: map[peer.ID]string{id0: "Prysm/v0.3.0/abcdef"},
+			Bandwidth: map[peer.ID]metrics.Stats{
+				id0: {TotalIn: 100, TotalOut: 50},
+				id1: {TotalIn: 10, TotalOut: 5},
+			},
+		},
+	}
+
+	res, err := ns.ListPeerDetails(context.Background(), &pbrpc.ListPeerDetailsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Peers) != 2 {
+		t.Fatalf("Expected 2 peers, received %d", len(res.Peers))
+	}
+	details := make(map[string]*pbrpc.PeerDetails)
+	for _, p := range res.Peers {
+		details[p.PeerId] = p
+	}
+	p0 := details[id0.Pretty()]
+	if p0.Agent != "Prysm/v0.3.0/abcdef" || p0.Direction != pbrpc.PeerConnectionDirection_INBOUND {
+		t.Errorf("Unexpected details for first peer: %v", p0)
+	}
+	if p0.BytesIn != 100 || p0.BytesOut != 50 || p0.FinalizedEpoch != 10 {
+		t.Errorf("Unexpected details for first peer: %v", p0)
+	}
+	p1 := details[id1.Pretty()]
+	if p1.Direction != pbrpc.PeerConnectionDirection_OUTBOUND || p1.BadResponses != 1 || p1.MaxBadResponses != 5 {
+		t.Errorf("Unexpected details for second peer: %v", p1)
+	}
+
+	wantClients := map[string]uint64{"Prysm": 1, unknownAgent: 1}
+	if len(res.Clients) != len(wantClients) {
+		t.Fatalf("Expected %d clients, received %v", len(wantClients), res.Clients)
+	}
+	for _, c := range res.Clients {
+		if wantClients[c.Key] != c.Count {
+			t.Errorf("Expected %d peers for client %s, received %d", wantClients[c.Key], c.Key, c.Count)
+		}
+	}
+	if len(res.Directions) != 2 {
+		t.Errorf("Expected 2 directions, received %v", res.Directions)
+	}
+	if res.TotalBytesIn != 110 || res.TotalBytesOut != 55 {
+		t.Errorf("Unexpected total bandwidth, received %d in and %d out", res.TotalBytesIn, res.TotalBytesOut)
+	}
+}
+
+func TestAgentClient(t *testing.T) {
+	tests := map[string]string{
+		"":                      unknownAgent,
+		"Prysm/v0.3.0/abcdef":   "Prysm",
+		"Lighthouse/v0.1.0-abc": "Lighthouse",
+		"teku":                  "teku",
+	}
+	for agent, want := range tests {
+		if got := agentClient(agent); got != want {
+			t.Errorf("agentClient(%q) = %q, want %q", agent, got, want)
+		}
+	}
+}
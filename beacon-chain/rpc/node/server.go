@@ -26,7 +26,11 @@ type Server struct {
 	Server             *grpc.Server
 	BeaconDB           db.ReadOnlyDatabase
 	PeersFetcher       p2p.PeersProvider
+	PeerInfoFetcher    p2p.PeerInfoProvider
 	GenesisTimeFetcher blockchain.TimeFetcher
+	// AllowSubnetOverrides is only set on the server registered on the loopback admin endpoint,
+	// subnet overrides are rejected on the public RPC endpoint.
+	AllowSubnetOverrides bool
 }
 
 // GetSyncStatus checks the current network sync status of the node.
@@ -0,0 +1,80 @@
+package node
+
+import (
+	"context"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAttestationSubnets lists the attestation subnets the node is subscribed to or has an
+// operator override for, with the rate of messages received on each subnet.
+func (ns *Server) ListAttestationSubnets(
+	ctx context.Context,
+	_ *pbrpc.ListAttestationSubnetsRequest,
+) (*pbrpc.ListAttestationSubnetsResponse, error) {
+	subscribed := cache.AttestationSubnets.Subscribed()
+	subnets := sliceutil.UnionUint64(subscribed, cache.AttestationSubnets.Overridden())
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+	res := make([]*pbrpc.AttestationSubnet, 0, len(subnets))
+	for _, subnet := range subnets {
+		total, rate := cache.AttestationSubnets.MessageRate(subnet)
+		res = append(res, &pbrpc.AttestationSubnet{
+			Subnet:            subnet,
+			Subscribed:        sliceutil.IsInUint64(subnet, subscribed),
+			Override:          subnetOverrideToProto(cache.AttestationSubnets.Override(subnet)),
+			MessagesTotal:     total,
+			MessagesPerSecond: rate,
+		})
+	}
+	return &pbrpc.ListAttestationSubnetsResponse{Subnets: res}, nil
+}
+
+// SetAttestationSubnetOverride forces the node to subscribe to or unsubscribe from an
+// attestation subnet, or clears the override of the subnet. It is only allowed on the admin
+// endpoint.
+func (ns *Server) SetAttestationSubnetOverride(
+	ctx context.Context,
+	req *pbrpc.SetAttestationSubnetOverrideRequest,
+) (*pbrpc.SetAttestationSubnetOverrideResponse, error) {
+	if !ns.AllowSubnetOverrides {
+		return nil, status.Error(codes.PermissionDenied, "Subnet overrides are only allowed on the admin endpoint")
+	}
+	if req.Subnet >= params.BeaconConfig().MaxCommitteesPerSlot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Subnet %d out of range, there are %d attestation subnets",
+			req.Subnet,
+			params.BeaconConfig().MaxCommitteesPerSlot,
+		)
+	}
+	var override cache.SubnetOverride
+	switch req.Override {
+	case pbrpc.SubnetOverride_NONE:
+		override = cache.NoOverride
+	case pbrpc.SubnetOverride_FORCE_SUBSCRIBE:
+		override = cache.ForceSubscribe
+	case pbrpc.SubnetOverride_FORCE_UNSUBSCRIBE:
+		override = cache.ForceUnsubscribe
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown subnet override %v", req.Override)
+	}
+	cache.AttestationSubnets.SetOverride(req.Subnet, override)
+	return &pbrpc.SetAttestationSubnetOverrideResponse{}, nil
+}
+
+func subnetOverrideToProto(override cache.SubnetOverride) pbrpc.SubnetOverride {
+	switch override {
+	case cache.ForceSubscribe:
+		return pbrpc.SubnetOverride_FORCE_SUBSCRIBE
+	case cache.ForceUnsubscribe:
+		return pbrpc.SubnetOverride_FORCE_UNSUBSCRIBE
+	default:
+		return pbrpc.SubnetOverride_NONE
+	}
+}
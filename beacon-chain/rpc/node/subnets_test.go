@@ -0,0 +1,66 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNodeServer_AttestationSubnets(t *testing.T) {
+	ctx := context.Background()
+	ns := &Server{AllowSubnetOverrides: true}
+	cache.AttestationSubnets.SetSubscribed(3, true)
+	cache.AttestationSubnets.RecordMessage(3)
+	defer cache.AttestationSubnets.SetSubscribed(3, false)
+
+	if _, err := ns.SetAttestationSubnetOverride(ctx, &pbrpc.SetAttestationSubnetOverrideRequest{
+		Subnet:   1,
+		Override: pbrpc.SubnetOverride_FORCE_SUBSCRIBE,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer cache.AttestationSubnets.SetOverride(1, cache.NoOverride)
+
+	res, err := ns.ListAttestationSubnets(ctx, &pbrpc.ListAttestationSubnetsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Subnets) != 2 {
+		t.Fatalf("Wanted 2 subnets, received %d", len(res.Subnets))
+	}
+	forced, subscribed := res.Subnets[0], res.Subnets[1]
+	if forced.Subnet != 1 || forced.Subscribed || forced.Override != pbrpc.SubnetOverride_FORCE_SUBSCRIBE {
+		t.Errorf("Unexpected force subscribed subnet %v", forced)
+	}
+	if subscribed.Subnet != 3 || !subscribed.Subscribed || subscribed.Override != pbrpc.SubnetOverride_NONE {
+		t.Errorf("Unexpected subscribed subnet %v", subscribed)
+	}
+	if subscribed.MessagesTotal != 1 {
+		t.Errorf("Wanted 1 message on subnet 3, received %d", subscribed.MessagesTotal)
+	}
+
+	if _, err := ns.SetAttestationSubnetOverride(ctx, &pbrpc.SetAttestationSubnetOverrideRequest{
+		Subnet:   1000,
+		Override: pbrpc.SubnetOverride_FORCE_UNSUBSCRIBE,
+	}); err == nil {
+		t.Error("Expected error for an out of range subnet")
+	}
+}
+
+func TestNodeServer_SetAttestationSubnetOverride_RequiresAdmin(t *testing.T) {
+	ns := &Server{}
+	_, err := ns.SetAttestationSubnetOverride(context.Background(), &pbrpc.SetAttestationSubnetOverrideRequest{
+		Subnet:   1,
+		Override: pbrpc.SubnetOverride_FORCE_SUBSCRIBE,
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Wanted permission denied, received %v", err)
+	}
+	if cache.AttestationSubnets.Override(1) != cache.NoOverride {
+		t.Error("Did not want the override set")
+	}
+}
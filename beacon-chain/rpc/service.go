@@ -25,12 +25,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/admin"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -51,78 +54,101 @@ func init() {
 
 // Service defining an RPC server for a beacon node.
 type Service struct {
-	ctx                    context.Context
-	cancel                 context.CancelFunc
-	beaconDB               db.HeadAccessDatabase
-	headFetcher            blockchain.HeadFetcher
-	forkFetcher            blockchain.ForkFetcher
-	finalizationFetcher    blockchain.FinalizationFetcher
-	participationFetcher   blockchain.ParticipationFetcher
-	genesisTimeFetcher     blockchain.TimeFetcher
-	attestationReceiver    blockchain.AttestationReceiver
-	blockReceiver          blockchain.BlockReceiver
-	powChainService        powchain.Chain
-	chainStartFetcher      powchain.ChainStartFetcher
-	mockEth1Votes          bool
-	attestationsPool       attestations.Pool
-	exitPool               *voluntaryexits.Pool
-	slashingsPool          *slashings.Pool
-	syncService            sync.Checker
-	host                   string
-	port                   string
-	listener               net.Listener
-	withCert               string
-	withKey                string
-	grpcServer             *grpc.Server
-	canonicalStateChan     chan *pbp2p.BeaconState
-	incomingAttestation    chan *ethpb.Attestation
-	credentialError        error
-	p2p                    p2p.Broadcaster
-	peersFetcher           p2p.PeersProvider
-	depositFetcher         depositcache.DepositFetcher
-	pendingDepositFetcher  depositcache.PendingDepositsFetcher
-	stateNotifier          statefeed.Notifier
-	blockNotifier          blockfeed.Notifier
-	operationNotifier      opfeed.Notifier
-	slasherConn            *grpc.ClientConn
-	slasherProvider        string
-	slasherCert            string
-	slasherCredentialError error
-	slasherClient          slashpb.SlasherClient
-	stateGen               *stategen.State
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	beaconDB                db.HeadAccessDatabase
+	headFetcher             blockchain.HeadFetcher
+	forkFetcher             blockchain.ForkFetcher
+	finalizationFetcher     blockchain.FinalizationFetcher
+	participationFetcher    blockchain.ParticipationFetcher
+	blockArrivalFetcher     blockchain.BlockArrivalFetcher
+	genesisTimeFetcher      blockchain.TimeFetcher
+	attestationReceiver     blockchain.AttestationReceiver
+	blockReceiver           blockchain.BlockReceiver
+	powChainService         powchain.Chain
+	chainStartFetcher       powchain.ChainStartFetcher
+	mockEth1Votes           bool
+	attestationsPool        attestations.Pool
+	exitPool                *voluntaryexits.Pool
+	slashingsPool           *slashings.Pool
+	syncService             sync.Checker
+	host                    string
+	port                    string
+	listener                net.Listener
+	withCert                string
+	withKey                 string
+	grpcServer              *grpc.Server
+	canonicalStateChan      chan *pbp2p.BeaconState
+	incomingAttestation     chan *ethpb.Attestation
+	credentialError         error
+	p2p                     p2p.Broadcaster
+	peersFetcher            p2p.PeersProvider
+	peerInfoFetcher         p2p.PeerInfoProvider
+	depositFetcher          depositcache.DepositFetcher
+	pendingDepositFetcher   depositcache.PendingDepositsFetcher
+	stateNotifier           statefeed.Notifier
+	blockNotifier           blockfeed.Notifier
+	operationNotifier       opfeed.Notifier
+	slasherConn             *grpc.ClientConn
+	slasherProvider         string
+	slasherCert             string
+	slasherCredentialError  error
+	slasherClient           slashpb.SlasherClient
+	stateGen                *stategen.State
+	enableDebugRPCEndpoints bool
+	effectiveFlags          map[string]string
+	databaseBackuper        admin.DatabaseBackuper
+	backupOutputDir         string
+	stateRestorer           admin.StateRestorer
+	adminPort               string
+	adminListener           net.Listener
+	adminServer             *grpc.Server
 }
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Host                  string
-	Port                  string
-	CertFlag              string
-	KeyFlag               string
-	BeaconDB              db.HeadAccessDatabase
-	HeadFetcher           blockchain.HeadFetcher
-	ForkFetcher           blockchain.ForkFetcher
-	FinalizationFetcher   blockchain.FinalizationFetcher
-	ParticipationFetcher  blockchain.ParticipationFetcher
-	AttestationReceiver   blockchain.AttestationReceiver
-	BlockReceiver         blockchain.BlockReceiver
-	POWChainService       powchain.Chain
-	ChainStartFetcher     powchain.ChainStartFetcher
-	GenesisTimeFetcher    blockchain.TimeFetcher
-	MockEth1Votes         bool
-	AttestationsPool      attestations.Pool
-	ExitPool              *voluntaryexits.Pool
-	SlashingsPool         *slashings.Pool
-	SyncService           sync.Checker
-	Broadcaster           p2p.Broadcaster
-	PeersFetcher          p2p.PeersProvider
-	DepositFetcher        depositcache.DepositFetcher
-	PendingDepositFetcher depositcache.PendingDepositsFetcher
-	SlasherProvider       string
-	SlasherCert           string
-	StateNotifier         statefeed.Notifier
-	BlockNotifier         blockfeed.Notifier
-	OperationNotifier     opfeed.Notifier
-	StateGen              *stategen.State
+	Host                    string
+	Port                    string
+	CertFlag                string
+	KeyFlag                 string
+	BeaconDB                db.HeadAccessDatabase
+	HeadFetcher             blockchain.HeadFetcher
+	ForkFetcher             blockchain.ForkFetcher
+	FinalizationFetcher     blockchain.FinalizationFetcher
+	ParticipationFetcher    blockchain.ParticipationFetcher
+	BlockArrivalFetcher     blockchain.BlockArrivalFetcher
+	AttestationReceiver     blockchain.AttestationReceiver
+	BlockReceiver           blockchain.BlockReceiver
+	POWChainService         powchain.Chain
+	ChainStartFetcher       powchain.ChainStartFetcher
+	GenesisTimeFetcher      blockchain.TimeFetcher
+	MockEth1Votes           bool
+	AttestationsPool        attestations.Pool
+	ExitPool                *voluntaryexits.Pool
+	SlashingsPool           *slashings.Pool
+	SyncService             sync.Checker
+	Broadcaster             p2p.Broadcaster
+	PeersFetcher            p2p.PeersProvider
+	PeerInfoFetcher         p2p.PeerInfoProvider
+	DepositFetcher          depositcache.DepositFetcher
+	PendingDepositFetcher   depositcache.PendingDepositsFetcher
+	SlasherProvider         string
+	SlasherCert             string
+	StateNotifier           statefeed.Notifier
+	BlockNotifier           blockfeed.Notifier
+	OperationNotifier       opfeed.Notifier
+	StateGen                *stategen.State
+	EnableDebugRPCEndpoints bool
+	// EffectiveFlags holds the value of every command line flag of the node, it is exposed by
+	// the debug service.
+	EffectiveFlags map[string]string
+	// DatabaseBackuper writes the database snapshots requested through the admin service, which
+	// is only served when it is set. The admin service is served on AdminPort of the loopback
+	// interface, never on the public RPC server.
+	DatabaseBackuper admin.DatabaseBackuper
+	BackupOutputDir  string
+	StateRestorer    admin.StateRestorer
+	AdminPort        string
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -130,39 +156,47 @@ type Config struct {
 func NewService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
-		ctx:                   ctx,
-		cancel:                cancel,
-		beaconDB:              cfg.BeaconDB,
-		headFetcher:           cfg.HeadFetcher,
-		forkFetcher:           cfg.ForkFetcher,
-		finalizationFetcher:   cfg.FinalizationFetcher,
-		participationFetcher:  cfg.ParticipationFetcher,
-		genesisTimeFetcher:    cfg.GenesisTimeFetcher,
-		attestationReceiver:   cfg.AttestationReceiver,
-		blockReceiver:         cfg.BlockReceiver,
-		p2p:                   cfg.Broadcaster,
-		peersFetcher:          cfg.PeersFetcher,
-		powChainService:       cfg.POWChainService,
-		chainStartFetcher:     cfg.ChainStartFetcher,
-		mockEth1Votes:         cfg.MockEth1Votes,
-		attestationsPool:      cfg.AttestationsPool,
-		exitPool:              cfg.ExitPool,
-		slashingsPool:         cfg.SlashingsPool,
-		syncService:           cfg.SyncService,
-		host:                  cfg.Host,
-		port:                  cfg.Port,
-		withCert:              cfg.CertFlag,
-		withKey:               cfg.KeyFlag,
-		depositFetcher:        cfg.DepositFetcher,
-		pendingDepositFetcher: cfg.PendingDepositFetcher,
-		canonicalStateChan:    make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
-		incomingAttestation:   make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
-		stateNotifier:         cfg.StateNotifier,
-		blockNotifier:         cfg.BlockNotifier,
-		operationNotifier:     cfg.OperationNotifier,
-		slasherProvider:       cfg.SlasherProvider,
-		slasherCert:           cfg.SlasherCert,
-		stateGen:              cfg.StateGen,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		beaconDB:                cfg.BeaconDB,
+		headFetcher:             cfg.HeadFetcher,
+		forkFetcher:             cfg.ForkFetcher,
+		finalizationFetcher:     cfg.FinalizationFetcher,
+		participationFetcher:    cfg.ParticipationFetcher,
+		blockArrivalFetcher:     cfg.BlockArrivalFetcher,
+		genesisTimeFetcher:      cfg.GenesisTimeFetcher,
+		attestationReceiver:     cfg.AttestationReceiver,
+		blockReceiver:           cfg.BlockReceiver,
+		p2p:                     cfg.Broadcaster,
+		peersFetcher:            cfg.PeersFetcher,
+		peerInfoFetcher:         cfg.PeerInfoFetcher,
+		powChainService:         cfg.POWChainService,
+		chainStartFetcher:       cfg.ChainStartFetcher,
+		mockEth1Votes:           cfg.MockEth1Votes,
+		attestationsPool:        cfg.AttestationsPool,
+		exitPool:                cfg.ExitPool,
+		slashingsPool:           cfg.SlashingsPool,
+		syncService:             cfg.SyncService,
+		host:                    cfg.Host,
+		port:                    cfg.Port,
+		withCert:                cfg.CertFlag,
+		withKey:                 cfg.KeyFlag,
+		depositFetcher:          cfg.DepositFetcher,
+		pendingDepositFetcher:   cfg.PendingDepositFetcher,
+		canonicalStateChan:      make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
+		incomingAttestation:     make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		stateNotifier:           cfg.StateNotifier,
+		blockNotifier:           cfg.BlockNotifier,
+		operationNotifier:       cfg.OperationNotifier,
+		slasherProvider:         cfg.SlasherProvider,
+		slasherCert:             cfg.SlasherCert,
+		stateGen:                cfg.StateGen,
+		enableDebugRPCEndpoints: cfg.EnableDebugRPCEndpoints,
+		effectiveFlags:          cfg.EffectiveFlags,
+		databaseBackuper:        cfg.DatabaseBackuper,
+		backupOutputDir:         cfg.BackupOutputDir,
+		stateRestorer:           cfg.StateRestorer,
+		adminPort:               cfg.AdminPort,
 	}
 }
 
@@ -241,6 +275,7 @@ func (s *Service) Start() {
 		SyncChecker:        s.syncService,
 		GenesisTimeFetcher: s.genesisTimeFetcher,
 		PeersFetcher:       s.peersFetcher,
+		PeerInfoFetcher:    s.peerInfoFetcher,
 	}
 	beaconChainServer := &beacon.Server{
 		Ctx:                         s.ctx,
@@ -259,12 +294,29 @@ func (s *Service) Start() {
 		BlockNotifier:               s.blockNotifier,
 		AttestationNotifier:         s.operationNotifier,
 		Broadcaster:                 s.p2p,
+		SyncChecker:                 s.syncService,
 		ReceivedAttestationsBuffer:  make(chan *ethpb.Attestation, 100),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, 100),
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
+	pbrpc.RegisterAttestationSubnetsServer(s.grpcServer, nodeServer)
+	pbrpc.RegisterPeerExporterServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	if s.enableDebugRPCEndpoints {
+		log.Info("Enabled debug RPC endpoints")
+		debugServer := &debug.Server{
+			StateGen:            s.stateGen,
+			HeadFetcher:         s.headFetcher,
+			BlockArrivalFetcher: s.blockArrivalFetcher,
+			EffectiveFlags:      s.effectiveFlags,
+		}
+		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
+	}
+	if s.databaseBackuper != nil {
+		s.startAdminServer(opts)
+	}
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -281,6 +333,34 @@ func (s *Service) Start() {
 	}
 }
 
+// The admin service backs up and restores the database and changes the runtime configuration of
+// the node. It has no authentication, so it is served by its own server bound to the loopback
+// interface instead of the public RPC server.
+func (s *Service) startAdminServer(opts []grpc.ServerOption) {
+	address := fmt.Sprintf("127.0.0.1:%s", s.adminPort)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("Could not listen to admin port %s: %v", address, err)
+		return
+	}
+	s.adminListener = lis
+	s.adminServer = grpc.NewServer(opts...)
+	adminServer := &admin.Server{
+		BeaconDB:        s.databaseBackuper,
+		BackupOutputDir: s.backupOutputDir,
+		StateRestorer:   s.stateRestorer,
+	}
+	pbrpc.RegisterAdminServer(s.adminServer, adminServer)
+	pbrpc.RegisterAttestationSubnetsServer(s.adminServer, &node.Server{AllowSubnetOverrides: true})
+	log.WithField("address", address).Info("Admin RPC-API listening on loopback interface")
+
+	go func() {
+		if err := s.adminServer.Serve(lis); err != nil {
+			log.Errorf("Could not serve admin gRPC: %v", err)
+		}
+	}()
+}
+
 func (s *Service) startSlasherClient() {
 	var dialOpt grpc.DialOption
 	if s.slasherCert != "" {
@@ -323,6 +403,10 @@ func (s *Service) Stop() error {
 		s.grpcServer.GracefulStop()
 		log.Debug("Initiated graceful stop of gRPC server")
 	}
+	if s.adminListener != nil {
+		s.adminServer.GracefulStop()
+		log.Debug("Initiated graceful stop of admin gRPC server")
+	}
 	if s.slasherConn != nil {
 		s.slasherConn.Close()
 	}
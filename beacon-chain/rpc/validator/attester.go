@@ -93,10 +93,11 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 
 	// In the case that we receive an attestation request after a newer state/block has been
 	// processed, we walk up the chain until state.Slot <= req.Slot to prevent producing an
-	// attestation that violates processing constraints.
+	// attestation that violates processing constraints. The parent states are only read, they
+	// don't need to be copied out of the state cache.
 	fetchState := vs.BeaconDB.State
 	if featureconfig.Get().NewStateMgmt {
-		fetchState = vs.StateGen.StateByRoot
+		fetchState = vs.StateGen.ReadOnlyStateByRoot
 	}
 	for headState.Slot() > req.Slot {
 		if ctx.Err() != nil {
@@ -114,7 +115,7 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 	}
 
 	if helpers.CurrentEpoch(headState) < helpers.SlotToEpoch(req.Slot) {
-		headState, err = state.ProcessSlots(ctx, headState, helpers.StartSlot(helpers.SlotToEpoch(req.Slot)))
+		headState, err = state.ProcessSlots(ctx, headState.Copy(), helpers.StartSlot(helpers.SlotToEpoch(req.Slot)))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", req.Slot, err)
 		}
@@ -16,9 +16,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -212,18 +212,12 @@ func (vs *Server) randomETH1DataVote(ctx context.Context) (*ethpb.Eth1Data, erro
 // computeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (vs *Server) computeStateRoot(ctx context.Context, block *ethpb.SignedBeaconBlock) ([]byte, error) {
-	var beaconState *stateTrie.BeaconState
-	var err error
-	if featureconfig.Get().NewStateMgmt {
-		beaconState, err = vs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
-		if err != nil {
-			return nil, errors.Wrap(err, "could not retrieve beacon state")
-		}
-	} else {
-		beaconState, err = vs.BeaconDB.State(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
-		if err != nil {
-			return nil, errors.Wrap(err, "could not retrieve beacon state")
-		}
+	beaconState, err := stategen.NewStateReader(vs.BeaconDB, vs.StateGen).State(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve beacon state")
+	}
+	if beaconState == nil {
+		return nil, fmt.Errorf("no state found for parent root %#x", block.Block.ParentRoot)
 	}
 
 	root, err := state.CalculateStateRoot(
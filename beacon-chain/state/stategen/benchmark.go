@@ -0,0 +1,83 @@
+package stategen
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"go.opencensus.io/trace"
+)
+
+// ReplayBenchmark is the outcome of replaying recent blocks to measure block processing
+// throughput.
+type ReplayBenchmark struct {
+	StartSlot          uint64
+	EndSlot            uint64
+	BlocksReplayed     uint64
+	StateLoadTime      time.Duration
+	ReplayTime         time.Duration
+	SignaturesVerified bool
+}
+
+// BenchmarkReplay replays the block count blocks up to and including the input block root on
+// top of the state of their parent, and measures the time it takes. Nothing is persisted and
+// the hot state cache isn't populated, so the benchmark can run on a live node.
+func (s *State) BenchmarkReplay(ctx context.Context, endRoot [32]byte, blockCount uint64) (*ReplayBenchmark, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.BenchmarkReplay")
+	defer span.End()
+	if blockCount == 0 {
+		return nil, errors.New("block count must be positive")
+	}
+
+	// Blocks are collected by walking parent roots back, in the slot descending order
+	// ReplayBlocks expects. The walk stops at genesis, which has no parent to replay it on.
+	blks := make([]*ethpb.SignedBeaconBlock, 0, blockCount)
+	root := endRoot
+	for uint64(len(blks)) < blockCount {
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil || b.Block == nil {
+			return nil, errUnknownBlock
+		}
+		if b.Block.Slot == 0 {
+			break
+		}
+		blks = append(blks, b)
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+	if len(blks) == 0 {
+		return nil, errors.New("no block to replay after genesis")
+	}
+
+	start := time.Now()
+	startState, err := s.StateByRoot(WithoutCachePopulation(ctx), root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load start state")
+	}
+	if startState == nil {
+		return nil, errUnknownState
+	}
+	// The loaded state may be shared with the hot state cache, replay on a copy.
+	startState = startState.Copy()
+	loadTime := time.Since(start)
+
+	endSlot := blks[0].Block.Slot
+	startSlot := startState.Slot()
+	start = time.Now()
+	if _, err := s.ReplayBlocks(ctx, startState, blks, endSlot); err != nil {
+		return nil, errors.Wrap(err, "could not replay blocks")
+	}
+	return &ReplayBenchmark{
+		StartSlot:          startSlot,
+		EndSlot:            endSlot,
+		BlocksReplayed:     uint64(len(blks)),
+		StateLoadTime:      loadTime,
+		ReplayTime:         time.Since(start),
+		SignaturesVerified: featureconfig.Get().EnableStateGenSigVerify,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestBenchmarkReplay_ReplaysRecentBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	beaconState, privs := testutil.DeterministicGenesisState(t, 32)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock := blocks.NewGenesisBlock(stateRoot[:])
+	genRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, genRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState, genRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: genRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+
+	var lastBlock *ethpb.SignedBeaconBlock
+	for i := uint64(1); i <= 3; i++ {
+		blk, err := testutil.GenerateFullBlock(beaconState, privs, testutil.DefaultBlockGenConfig(), i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		lastBlock = blk
+	}
+	lastRoot, err := ssz.HashTreeRoot(lastBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Asking for more blocks than there are after genesis replays all of them.
+	res, err := service.BenchmarkReplay(ctx, lastRoot, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.BlocksReplayed != 3 {
+		t.Errorf("Wanted %d blocks replayed, got %d", 3, res.BlocksReplayed)
+	}
+	if res.StartSlot != 0 || res.EndSlot != 3 {
+		t.Errorf("Wanted to replay slots 0 to 3, got %d to %d", res.StartSlot, res.EndSlot)
+	}
+	if db.HasState(ctx, lastRoot) {
+		t.Error("Did not want the benchmark to save a state")
+	}
+}
+
+func TestBenchmarkReplay_NoBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	if _, err := service.BenchmarkReplay(ctx, [32]byte{'a'}, 0); err == nil {
+		t.Error("Expected an error for a zero block count")
+	}
+	if _, err := service.BenchmarkReplay(ctx, [32]byte{'a'}, 1); err != errUnknownBlock {
+		t.Errorf("Wanted error %v, got %v", errUnknownBlock, err)
+	}
+}
@@ -0,0 +1,55 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// HeadFetcher returns the root of the head of the canonical chain, as chosen by fork choice.
+type HeadFetcher interface {
+	HeadRoot(ctx context.Context) ([]byte, error)
+}
+
+// SetHeadFetcher sets the source of the canonical head CanonicalStateAtEpoch resolves the hot
+// section of the chain with. Fork choice runs on top of state gen, so the head fetcher is set
+// once the blockchain service exists.
+func (s *State) SetHeadFetcher(f HeadFetcher) {
+	s.headFetcher = f
+}
+
+// CanonicalStateAtEpoch returns the state at the start slot of the epoch on the canonical chain.
+// Below the split point the cold section only holds the finalized chain and the state is loaded
+// by slot. Above it the canonical block at or before the start slot is resolved by walking back
+// from the head, then its state is loaded from the boundary saves or replayed, and the empty
+// slots up to the start slot are processed.
+func (s *State) CanonicalStateAtEpoch(ctx context.Context, epoch uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.CanonicalStateAtEpoch")
+	defer span.End()
+
+	slot := helpers.StartSlot(epoch)
+	if slot < s.split().slot {
+		return s.StateBySlot(ctx, slot)
+	}
+
+	if s.headFetcher == nil {
+		return nil, errNoHeadFetcher
+	}
+	r, err := s.headFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	headRoot := bytesutil.ToBytes32(r)
+	headSlot, err := s.blockRootSlot(ctx, headRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head slot")
+	}
+	if headEpoch := helpers.SlotToEpoch(headSlot); epoch > headEpoch {
+		return nil, errors.Errorf("epoch %d is after the head epoch %d", epoch, headEpoch)
+	}
+	return s.StateByRootForSlot(ctx, headRoot, slot)
+}
@@ -0,0 +1,85 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+type mockHeadFetcher struct {
+	root [32]byte
+}
+
+func (m *mockHeadFetcher) HeadRoot(_ context.Context) ([]byte, error) {
+	return m.root[:], nil
+}
+
+func TestCanonicalStateAtEpoch_HotState(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+
+	// The head is on the chain of the ancestor, the fork block before the epoch start isn't canonical.
+	ancestor := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1}}
+	ancestorRoot, _ := ssz.HashTreeRoot(ancestor.Block)
+	fork := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 20, ParentRoot: ancestorRoot[:]}}
+	forkRoot, _ := ssz.HashTreeRoot(fork.Block)
+	head := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 40, ParentRoot: ancestorRoot[:]}}
+	headRoot, _ := ssz.HashTreeRoot(head.Block)
+	if err := db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{ancestor, fork, head}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateSummaries(ctx, []*pb.StateSummary{
+		{Slot: 1, Root: ancestorRoot[:]},
+		{Slot: 20, Root: forkRoot[:]},
+		{Slot: 40, Root: headRoot[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ancestorState, _ := testutil.DeterministicGenesisState(t, 32)
+	ancestorState.SetSlot(1)
+	if err := ancestorState.SetEth1DepositIndex(1); err != nil {
+		t.Fatal(err)
+	}
+	service.hotStateCache.Put(ancestorRoot, ancestorState)
+	forkState := ancestorState.Copy()
+	forkState.SetSlot(20)
+	if err := forkState.SetEth1DepositIndex(20); err != nil {
+		t.Fatal(err)
+	}
+	service.hotStateCache.Put(forkRoot, forkState)
+	service.SetHeadFetcher(&mockHeadFetcher{root: headRoot})
+
+	st, err := service.CanonicalStateAtEpoch(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Slot() != params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Wanted state slot %d, got %d", params.BeaconConfig().SlotsPerEpoch, st.Slot())
+	}
+	if st.Eth1DepositIndex() != 1 {
+		t.Error("Expected the state to be generated from the canonical ancestor, not the fork block")
+	}
+
+	if _, err := service.CanonicalStateAtEpoch(ctx, 2); err == nil {
+		t.Error("Expected an error for an epoch after the head epoch")
+	}
+}
+
+func TestCanonicalStateAtEpoch_NoHeadFetcher(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	if _, err := service.CanonicalStateAtEpoch(context.Background(), 1); err != errNoHeadFetcher {
+		t.Errorf("Wanted error %v, received %v", errNoHeadFetcher, err)
+	}
+}
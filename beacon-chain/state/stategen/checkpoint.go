@@ -0,0 +1,59 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// InitializeFromCheckpoint saves a trusted finalized state as the starting point of an empty DB.
+// The state is saved as the last archived state and the split point is set to it, so states are
+// generated from it onwards instead of being replayed from genesis. There is no state before the
+// checkpoint, states of earlier slots can't be generated. The checkpoint state must be on an
+// archived point, the archived point index would otherwise point at a state of a later slot.
+//
+// The writes go through the given batch, so the caller saves them along with the checkpoint block
+// and checkpoints in one transaction.
+func (s *State) InitializeFromCheckpoint(ctx context.Context, b db.WriteBatch, st *state.BeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.InitializeFromCheckpoint")
+	defer span.End()
+
+	if st.Slot()%s.slotsPerArchivedPoint != 0 {
+		return fmt.Errorf(
+			"checkpoint state slot %d is not a multiple of the slots per archived point %d",
+			st.Slot(),
+			s.slotsPerArchivedPoint,
+		)
+	}
+	if err := b.SaveState(st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := b.SaveStateSummary(&pb.StateSummary{Slot: st.Slot(), Root: blockRoot[:]}); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state summary")
+	}
+	archivedPointIndex := st.Slot() / s.slotsPerArchivedPoint
+	if err := b.SaveArchivedPointRoot(blockRoot, archivedPointIndex); err != nil {
+		return errors.Wrap(err, "could not save archived point root")
+	}
+	if err := b.SaveLastArchivedIndex(archivedPointIndex); err != nil {
+		return errors.Wrap(err, "could not save last archived index")
+	}
+	if err := b.SaveSplitInfo(st.Slot(), blockRoot); err != nil {
+		return errors.Wrap(err, "could not save split info")
+	}
+	s.setSplit(st.Slot(), blockRoot)
+
+	log.WithFields(logrus.Fields{
+		"slot": st.Slot(),
+		"root": hex.EncodeToString(bytesutil.Trunc(blockRoot[:])),
+	}).Info("Initialized state generation from checkpoint state")
+	return nil
+}
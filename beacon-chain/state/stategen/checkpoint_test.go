@@ -0,0 +1,74 @@
+package stategen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestInitializeFromCheckpoint_Resumes(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	root := [32]byte{'A'}
+	checkpointState, _ := testutil.DeterministicGenesisState(t, 32)
+	checkpointSlot := service.slotsPerArchivedPoint
+	checkpointState.SetSlot(checkpointSlot)
+	if err := db.Batch(ctx, func(b iface.WriteBatch) error {
+		return service.InitializeFromCheckpoint(ctx, b, checkpointState, root)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if service.splitInfo.slot != checkpointSlot || service.splitInfo.root != root {
+		t.Error("Did not set the split point to the checkpoint")
+	}
+
+	// A restarted node resumes from the checkpoint state.
+	restarted := New(db)
+	resumeState, err := restarted.Resume(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(checkpointState.InnerStateUnsafe(), resumeState.InnerStateUnsafe()) {
+		t.Error("Did not resume from the checkpoint state")
+	}
+	if restarted.splitInfo.slot != checkpointSlot || restarted.splitInfo.root != root {
+		t.Error("Did not restore the split point of the checkpoint")
+	}
+	loaded, err := restarted.StateByRoot(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Slot() != checkpointSlot {
+		t.Errorf("Wanted state slot %d, got %d", checkpointSlot, loaded.Slot())
+	}
+}
+
+func TestInitializeFromCheckpoint_RejectsNonArchivedPointSlot(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	checkpointState, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := checkpointState.SetSlot(service.slotsPerArchivedPoint + params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+	err := db.Batch(ctx, func(b iface.WriteBatch) error {
+		return service.InitializeFromCheckpoint(ctx, b, checkpointState, [32]byte{'A'})
+	})
+	if err == nil || !strings.Contains(err.Error(), "not a multiple of the slots per archived point") {
+		t.Errorf("Expected archived point slot error, received %v", err)
+	}
+	if service.split().slot != 0 {
+		t.Error("Did not want the split point moved")
+	}
+}
@@ -0,0 +1,35 @@
+package stategen
+
+import (
+	"time"
+)
+
+// Clock is the time source of state generation, deciding when soft deleted states are purged
+// and measuring the replay latencies of the adaptive save policy. Simulations inject a clock
+// they advance themselves, so the hot and cold state lifecycle is deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the clock of the system, used unless another clock is set.
+type systemClock struct{}
+
+// Now implements Clock.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SetClock replaces the system clock with the input clock. It must be set before the service is
+// used.
+func (s *State) SetClock(clock Clock) {
+	s.clock = clock
+}
+
+// This returns the current time of the clock of the service, the system time for services built
+// without New.
+func (s *State) now() time.Time {
+	if s.clock == nil {
+		return time.Now()
+	}
+	return s.clock.Now()
+}
@@ -3,11 +3,13 @@ package stategen
 import (
 	"context"
 	"encoding/hex"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -22,7 +24,7 @@ func (s *State) saveColdState(ctx context.Context, blockRoot [32]byte, state *st
 		return errSlotNonArchivedPoint
 	}
 
-	if err := s.beaconDB.SaveState(ctx, state, blockRoot); err != nil {
+	if err := s.saveArchivedPointState(ctx, blockRoot, state); err != nil {
 		return err
 	}
 	archivedIndex := state.Slot() / s.slotsPerArchivedPoint
@@ -32,11 +34,51 @@ func (s *State) saveColdState(ctx context.Context, blockRoot [32]byte, state *st
 
 	log.WithFields(logrus.Fields{
 		"slot":      state.Slot(),
-		"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info("Saved full state on archived point")
+		"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info("Saved state on archived point")
 
 	return nil
 }
 
+// This returns true if the state of the input archived point index is saved in full, the others
+// are saved as diffs against the full archived point state below them.
+func (s *State) isFullArchivedPoint(archivedIndex uint64) bool {
+	return s.archivedPointsPerFull == 0 || archivedIndex%s.archivedPointsPerFull == 0
+}
+
+// This saves the state of an archived point, in full or as a diff against the full archived point
+// state below it.
+func (s *State) saveArchivedPointState(ctx context.Context, blockRoot [32]byte, archivedState *state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveArchivedPointState")
+	defer span.End()
+
+	archivedIndex := archivedState.Slot() / s.slotsPerArchivedPoint
+	if s.isFullArchivedPoint(archivedIndex) {
+		return s.beaconDB.SaveColdState(ctx, archivedState, blockRoot)
+	}
+
+	baseIndex := archivedIndex - archivedIndex%s.archivedPointsPerFull
+	baseState, err := s.loadColdStateByArchivedPoint(ctx, baseIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get full archived state using index")
+	}
+	if baseState == nil || baseState.Slot() != baseIndex*s.slotsPerArchivedPoint {
+		return errUnknownArchivedState
+	}
+	diff, err := computeStateDiff(baseState, archivedState)
+	if err != nil {
+		return errors.Wrap(err, "could not compute archived state diff")
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, archivedState.Slot(), diff); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":     archivedState.Slot(),
+		"baseSlot": baseState.Slot(),
+		"root":     hex.EncodeToString(bytesutil.Trunc(blockRoot[:])),
+	}).Debug("Saved archived point state as a diff")
+	return nil
+}
+
 // This loads the cold state by block root, it decides whether to load from archived point (faster) or
 // somewhere between archived points (slower) because it requires replaying blocks.
 // This method is more efficient than load cold state by slot.
@@ -44,46 +86,156 @@ func (s *State) loadColdStateByRoot(ctx context.Context, blockRoot [32]byte) (*s
 	ctx, span := trace.StartSpan(ctx, "stateGen.loadColdStateByRoot")
 	defer span.End()
 
-	summary, err := s.beaconDB.StateSummary(ctx, blockRoot)
+	t := loadTraceFromContext(ctx)
+
+	start := time.Now()
+	summary, err := s.stateSummary(ctx, blockRoot)
 	if err != nil {
 		return nil, err
 	}
 	if summary == nil {
 		return nil, errUnknownStateSummary
 	}
+	t.step("load state summary", start)
+	t.setSummary(summary.Slot)
 
+	var coldState *state.BeaconState
 	// Use the archived point state if the summary slot lies on top of the archived point.
 	if summary.Slot%s.slotsPerArchivedPoint == 0 {
+		start = time.Now()
 		archivedPoint := summary.Slot / s.slotsPerArchivedPoint
-		s, err := s.loadColdStateByArchivedPoint(ctx, archivedPoint)
+		coldState, err = s.loadColdStateByArchivedPoint(ctx, archivedPoint)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get cold state using archived index")
 		}
-		if s == nil {
+		if coldState == nil {
 			return nil, errUnknownArchivedState
 		}
-		return s, nil
+		t.step("load archived point state", start)
+		t.setStartState(archivedPointSource, coldState)
+	} else {
+		coldState, err = s.loadColdIntermediateStateByRoot(ctx, summary.Slot, blockRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if featureconfig.Get().VerifyColdStates {
+		start = time.Now()
+		if err := s.verifyColdState(ctx, blockRoot, coldState); err != nil {
+			return nil, err
+		}
+		t.step("verify cold state", start)
+	}
+
+	return coldState, nil
+}
+
+// This recomputes the hash tree root of a loaded cold state and compares it against the state root
+// of the block it was loaded for. A mismatch means the state in the DB is corrupted, it's recorded
+// and surfaced as a ColdStateCorruptionError.
+func (s *State) verifyColdState(ctx context.Context, blockRoot [32]byte, coldState *state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.verifyColdState")
+	defer span.End()
+
+	b, err := s.beaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return err
+	}
+	if b == nil || b.Block == nil {
+		return errUnknownBlock
+	}
+	// The state root of the block is the root of the state at the block slot. A state processed
+	// through the empty slots after the block, such as an archived point state, can't be checked
+	// against it.
+	if coldState.Slot() != b.Block.Slot {
+		return nil
+	}
+
+	got, err := coldState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash cold state")
+	}
+	want := bytesutil.ToBytes32(b.Block.StateRoot)
+	if got != want {
+		coldStateRootMismatch.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":      coldState.Slot(),
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:])),
+			"wanted":    hex.EncodeToString(bytesutil.Trunc(want[:])),
+			"got":       hex.EncodeToString(bytesutil.Trunc(got[:])),
+		}).Error("Cold state root mismatch, state in DB may be corrupted")
+		return &ColdStateCorruptionError{
+			Slot:      coldState.Slot(),
+			BlockRoot: blockRoot,
+			Want:      want,
+			Got:       got,
+		}
 	}
 
-	return s.loadColdIntermediateStateByRoot(ctx, summary.Slot, blockRoot)
+	return nil
 }
 
-// This loads the cold state for the input archived point.
+// This loads the cold state for the input archived point, it's reconstructed from its diff when the
+// archived point state was saved as a diff.
 func (s *State) loadColdStateByArchivedPoint(ctx context.Context, archivedPoint uint64) (*state.BeaconState, error) {
-	states, err := s.beaconDB.HighestSlotStatesBelow(ctx, archivedPoint*s.slotsPerArchivedPoint+1)
+	archivedSlot := archivedPoint * s.slotsPerArchivedPoint
+	diff, err := s.beaconDB.StateDiff(ctx, archivedSlot)
+	if err != nil {
+		return nil, err
+	}
+	if diff != nil {
+		return s.applyArchivedPointDiff(ctx, diff)
+	}
+	states, err := s.beaconDB.HighestSlotStatesBelow(ctx, archivedSlot+1)
 	if err != nil {
 		return nil, err
 	}
 	return states[0], nil
 }
 
+// This loads the state of the archived point block root saved as a diff.
+func (s *State) loadArchivedPointDiff(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	slot, err := s.blockRootSlot(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get archived point slot")
+	}
+	diff, err := s.beaconDB.StateDiff(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if diff == nil {
+		return nil, errUnknownArchivedState
+	}
+	return s.applyArchivedPointDiff(ctx, diff)
+}
+
+// This reconstructs an archived point state from its diff against the full archived point state
+// the diff was computed against.
+func (s *State) applyArchivedPointDiff(ctx context.Context, diff *pb.StateDiff) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.applyArchivedPointDiff")
+	defer span.End()
+
+	states, err := s.beaconDB.HighestSlotStatesBelow(ctx, diff.BaseSlot+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 || states[0].Slot() != diff.BaseSlot {
+		return nil, errUnknownArchivedState
+	}
+	return applyStateDiff(states[0], diff)
+}
+
 // This loads a cold state by slot and block root combinations.
 // This is a faster implementation than by slot given the input block root is provided.
 func (s *State) loadColdIntermediateStateByRoot(ctx context.Context, slot uint64, blockRoot [32]byte) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.loadColdIntermediateStateByRoot")
 	defer span.End()
 
+	t := loadTraceFromContext(ctx)
+
 	// Load the archive point for lower side of the intermediate state.
+	start := time.Now()
 	lowArchivedPointIdx := slot / s.slotsPerArchivedPoint
 	lowArchivedPointState, err := s.archivedPointByIndex(ctx, lowArchivedPointIdx)
 	if err != nil {
@@ -92,13 +244,27 @@ func (s *State) loadColdIntermediateStateByRoot(ctx context.Context, slot uint64
 	if lowArchivedPointState == nil {
 		return nil, errUnknownArchivedState
 	}
+	t.step("load archived point state", start)
+	t.setStartState(archivedPointSource, lowArchivedPointState)
 
-	replayBlks, err := s.LoadBlocks(ctx, lowArchivedPointState.Slot()+1, slot, blockRoot)
+	start = time.Now()
+	startState, err := s.checkpointState(ctx, lowArchivedPointState, slot)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get load blocks for cold state using slot")
+		return nil, errors.Wrap(err, "could not get checkpoint state")
+	}
+	if startState != lowArchivedPointState {
+		t.step("load checkpoint state", start)
+		t.setStartState(checkpointSource, startState)
 	}
 
-	return s.ReplayBlocks(ctx, lowArchivedPointState, replayBlks, slot)
+	start = time.Now()
+	coldState, replayed, err := s.loadAndReplayBlocks(ctx, startState, slot, blockRoot, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not replay blocks for cold state using root")
+	}
+	t.step("load and replay blocks", start)
+	t.setBlocksReplayed(replayed)
+	return coldState, nil
 }
 
 // This loads a cold state by slot where the slot lies between the archived point.
@@ -124,9 +290,9 @@ func (s *State) loadColdIntermediateStateBySlot(ctx context.Context, slot uint64
 	// for the upper archived point.
 	var highArchivedPointRoot [32]byte
 	highArchivedPointSlot := highArchivedPointIdx * s.slotsPerArchivedPoint
-	if highArchivedPointSlot >= s.splitInfo.slot {
-		highArchivedPointRoot = s.splitInfo.root
-		highArchivedPointSlot = s.splitInfo.slot
+	if split := s.split(); highArchivedPointSlot >= split.slot {
+		highArchivedPointRoot = split.root
+		highArchivedPointSlot = split.slot
 	} else {
 		if _, err := s.archivedPointByIndex(ctx, highArchivedPointIdx); err != nil {
 			return nil, errors.Wrap(err, "could not get upper bound archived state using index")
@@ -139,12 +305,42 @@ func (s *State) loadColdIntermediateStateBySlot(ctx context.Context, slot uint64
 		highArchivedPointSlot = slot
 	}
 
-	replayBlks, err := s.LoadBlocks(ctx, lowArchivedPointState.Slot()+1, highArchivedPointSlot, highArchivedPointRoot)
+	startState, err := s.checkpointState(ctx, lowArchivedPointState, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get checkpoint state")
+	}
+
+	coldState, replayed, err := s.loadAndReplayBlocks(ctx, startState, highArchivedPointSlot, highArchivedPointRoot, slot)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not load block for cold state using slot")
+		return nil, errors.Wrap(err, "could not replay blocks for cold state using slot")
 	}
+	t := loadTraceFromContext(ctx)
+	t.setStartState(archivedPointSource, startState)
+	t.setBlocksReplayed(replayed)
+	return coldState, nil
+}
 
-	return s.ReplayBlocks(ctx, lowArchivedPointState, replayBlks, slot)
+// This returns the state of the highest checkpoint between the archived point state and the input
+// slot, reconstructed from its diff against the archived point state. The archived point state is
+// returned if checkpoints are disabled or none was saved in that range.
+func (s *State) checkpointState(ctx context.Context, archivedState *state.BeaconState, slot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.checkpointState")
+	defer span.End()
+
+	if s.slotsPerCheckpoint == 0 {
+		return archivedState, nil
+	}
+	for c := slot - slot%s.slotsPerCheckpoint; c > archivedState.Slot(); c -= s.slotsPerCheckpoint {
+		diff, err := s.beaconDB.StateDiff(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		if diff == nil || diff.BaseSlot != archivedState.Slot() {
+			continue
+		}
+		return applyStateDiff(archivedState, diff)
+	}
+	return archivedState, nil
 }
 
 // Given the archive index, this returns the archived cold state in the DB.
@@ -195,8 +391,8 @@ func (s *State) blockRootSlot(ctx context.Context, blockRoot [32]byte) (uint64,
 	ctx, span := trace.StartSpan(ctx, "stateGen.blockRootSlot")
 	defer span.End()
 
-	if s.beaconDB.HasStateSummary(ctx, blockRoot) {
-		summary, err := s.beaconDB.StateSummary(ctx, blockRoot)
+	if s.StateSummaryExists(ctx, blockRoot) {
+		summary, err := s.stateSummary(ctx, blockRoot)
 		if err != nil {
 			return 0, nil
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -26,6 +27,58 @@ func TestSaveColdState_NonArchivedPoint(t *testing.T) {
 	}
 }
 
+func TestSaveColdState_DiffArchivedPoint(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.slotsPerArchivedPoint = 32
+	service.archivedPointsPerFull = 2
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	genesisRoot := [32]byte{'g'}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	archivedState := genesisState.Copy()
+	if err := archivedState.SetSlot(32); err != nil {
+		t.Fatal(err)
+	}
+	if err := archivedState.UpdateBalancesAtIndex(1, 1); err != nil {
+		t.Fatal(err)
+	}
+	r := [32]byte{'a'}
+	if err := service.saveColdState(ctx, r, archivedState); err != nil {
+		t.Fatal(err)
+	}
+	if db.HasState(ctx, r) {
+		t.Error("Archived point state in between full states should be saved as a diff")
+	}
+	received, err := service.loadColdStateByArchivedPoint(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(received.InnerStateUnsafe(), archivedState.InnerStateUnsafe()) {
+		t.Error("Did not reconstruct the archived point state from its diff")
+	}
+
+	fullState := genesisState.Copy()
+	if err := fullState.SetSlot(64); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.saveColdState(ctx, [32]byte{'b'}, fullState); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasState(ctx, [32]byte{'b'}) {
+		t.Error("Archived point state should be saved in full")
+	}
+}
+
 //func TestSaveColdState_CanSave(t *testing.T) {
 //	ctx := context.Background()
 //	db := testDB.SetupDB(t)
@@ -336,7 +389,7 @@ func TestBlockRootSlot_CanRecoverAndSave(t *testing.T) {
 	bSlot := uint64(100)
 	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: bSlot}}
 	bRoot, _ := ssz.HashTreeRoot(b.Block)
-	if err := service.beaconDB.SaveBlock(ctx, b); err != nil {
+	if err := db.SaveBlock(ctx, b); err != nil {
 		t.Fatal(err)
 	}
 
@@ -353,3 +406,55 @@ func TestBlockRootSlot_CanRecoverAndSave(t *testing.T) {
 		t.Error("State summary not saved")
 	}
 }
+
+func TestVerifyColdState_RootMismatch(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := beaconState.SetSlot(1); err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	goodBlk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, StateRoot: stateRoot[:]}}
+	if err := db.SaveBlock(ctx, goodBlk); err != nil {
+		t.Fatal(err)
+	}
+	goodRoot, _ := ssz.HashTreeRoot(goodBlk.Block)
+	if err := service.verifyColdState(ctx, goodRoot, beaconState); err != nil {
+		t.Fatalf("Could not verify cold state: %v", err)
+	}
+
+	// The state is at a later slot than the block after processing empty slots, it isn't checked.
+	laterBlk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, StateRoot: []byte{'a'}}}
+	if err := db.SaveBlock(ctx, laterBlk); err != nil {
+		t.Fatal(err)
+	}
+	laterRoot, _ := ssz.HashTreeRoot(laterBlk.Block)
+	if err := service.verifyColdState(ctx, laterRoot, beaconState); err != nil {
+		t.Errorf("Wanted a state at another slot than the block to be skipped, got %v", err)
+	}
+
+	badBlk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, StateRoot: []byte{'a'}}}
+	if err := db.SaveBlock(ctx, badBlk); err != nil {
+		t.Fatal(err)
+	}
+	badRoot, _ := ssz.HashTreeRoot(badBlk.Block)
+	err = service.verifyColdState(ctx, badRoot, beaconState)
+	corruptionErr, ok := err.(*ColdStateCorruptionError)
+	if !ok {
+		t.Fatalf("Wanted corruption error, got %v", err)
+	}
+	if corruptionErr.Got != stateRoot {
+		t.Error("Did not get wanted computed root")
+	}
+	if corruptionErr.BlockRoot != badRoot {
+		t.Error("Did not get wanted block root")
+	}
+}
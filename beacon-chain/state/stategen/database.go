@@ -0,0 +1,53 @@
+package stategen
+
+import (
+	"context"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Database is the part of the beacon DB state generation reads and writes. The beacon DB
+// implements it, simulations wrap it to inject faults such as a crash in the middle of a
+// migration.
+type Database interface {
+	// Block related methods.
+	Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error)
+	Blocks(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.SignedBeaconBlock, error)
+	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
+	HasBlock(ctx context.Context, blockRoot [32]byte) bool
+	GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
+	HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*ethpb.SignedBeaconBlock, error)
+	// State related methods.
+	State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error)
+	GenesisState(ctx context.Context) (*state.BeaconState, error)
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+	HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*state.BeaconState, error)
+	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
+	SaveStateAndSummary(ctx context.Context, state *state.BeaconState, summary *pb.StateSummary) error
+	SaveColdState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
+	MoveStateToCold(ctx context.Context, blockRoot [32]byte) error
+	DeleteState(ctx context.Context, blockRoot [32]byte) error
+	SoftDeleteState(ctx context.Context, blockRoot [32]byte) error
+	PurgeSoftDeletedStates(ctx context.Context, deletedBefore time.Time) (int, error)
+	// State summary related methods.
+	StateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error)
+	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
+	SaveStateSummary(ctx context.Context, summary *pb.StateSummary) error
+	SaveStateSummaries(ctx context.Context, summaries []*pb.StateSummary) error
+	// Archived point related methods.
+	ArchivedPointRoot(ctx context.Context, index uint64) [32]byte
+	HasArchivedPoint(ctx context.Context, index uint64) bool
+	LastArchivedIndexRoot(ctx context.Context) [32]byte
+	SaveArchivedPointRoot(ctx context.Context, blockRoot [32]byte, index uint64) error
+	SaveLastArchivedIndex(ctx context.Context, index uint64) error
+	StateDiff(ctx context.Context, slot uint64) (*pb.StateDiff, error)
+	SaveStateDiff(ctx context.Context, slot uint64, diff *pb.StateDiff) error
+	// Split point related methods.
+	SplitInfo(ctx context.Context) (uint64, [32]byte, error)
+	HasSplitInfo(ctx context.Context) bool
+	SaveSplitInfo(ctx context.Context, slot uint64, blockRoot [32]byte) error
+}
@@ -0,0 +1,16 @@
+// +build !windows
+
+package stategen
+
+import (
+	"syscall"
+)
+
+// diskFreeSpace returns the disk space available to the node on the volume of the input path.
+func diskFreeSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
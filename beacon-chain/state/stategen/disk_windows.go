@@ -0,0 +1,10 @@
+package stategen
+
+import (
+	"errors"
+)
+
+// diskFreeSpace isn't supported on windows, the adaptive save policy ignores disk pressure.
+func diskFreeSpace(_ string) (uint64, error) {
+	return 0, errors.New("checking free disk space is not supported on windows")
+}
@@ -1,11 +1,29 @@
 package stategen
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var errUnknownStateSummary = errors.New("unknown state summary")
 var errUnknownArchivedState = errors.New("unknown archived state")
-var errUnknownBoundaryState = errors.New("unknown boundary state")
 var errUnknownBoundaryRoot = errors.New("unknown boundary root")
 var errUnknownState = errors.New("unknown state")
 var errUnknownBlock = errors.New("unknown block")
 var errSlotNonArchivedPoint = errors.New("slot is not an archived point index")
+var errUnexpectedStateRoot = errors.New("unexpected state root after replay")
+var errNoHeadFetcher = errors.New("no head fetcher to resolve the canonical chain")
+
+// ColdStateCorruptionError is returned when a cold state loaded from the DB does not hash to the
+// state root committed to by its block, which indicates the stored state is corrupted.
+type ColdStateCorruptionError struct {
+	Slot      uint64
+	BlockRoot [32]byte
+	Want      [32]byte
+	Got       [32]byte
+}
+
+func (e *ColdStateCorruptionError) Error() string {
+	return fmt.Sprintf("cold state at slot %d with block root %#x is corrupted: wanted state root %#x, got %#x",
+		e.Slot, e.BlockRoot, e.Want, e.Got)
+}
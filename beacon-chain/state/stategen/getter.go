@@ -2,22 +2,47 @@ package stategen
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
+type skipCachePopulationKey struct{}
+
+// WithoutCachePopulation returns a context under which state loads read through the hot state
+// cache without inserting the states they generate. Batch jobs loading many historical states
+// should use it so they don't evict the states block processing needs.
+func WithoutCachePopulation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCachePopulationKey{}, true)
+}
+
+// skipCachePopulation returns true if generated states must not be inserted in the hot state cache.
+func skipCachePopulation(ctx context.Context) bool {
+	skip, ok := ctx.Value(skipCachePopulationKey{}).(bool)
+	return ok && skip
+}
+
 // StateByRoot retrieves the state from DB using input block root.
 // It retrieves state from the hot section if the state summary slot
 // is below the split point cut off.
 func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateByRoot")
 	defer span.End()
+	atomic.AddInt64(&s.activeLoads, 1)
+	defer atomic.AddInt64(&s.activeLoads, -1)
+
+	ctx, t := withLoadTrace(ctx)
+	defer t.recordLoad(span, time.Now())
 
 	// Genesis case. If block root is zero hash, short circuit to use genesis state stored in DB.
 	if blockRoot == params.BeaconConfig().ZeroHash {
+		t.setSection(genesisSection)
 		return s.beaconDB.State(ctx, blockRoot)
 	}
 
@@ -26,13 +51,86 @@ func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (*state.Bea
 		return nil, errors.Wrap(err, "could not get state summary")
 	}
 
-	if slot < s.splitInfo.slot {
+	if slot < s.split().slot {
+		t.setSection(coldSection)
 		return s.loadColdStateByRoot(ctx, blockRoot)
 	}
 
+	t.setSection(hotSection)
 	return s.loadHotStateByRoot(ctx, blockRoot)
 }
 
+// ReadOnlyStateByRoot retrieves the state of the input block root like StateByRoot, except a state
+// in the hot state cache is returned without being copied. The returned state may be shared with
+// the cache and other callers so it must not be mutated, callers which need to modify it must Copy
+// it first. It's meant for consumers which only read fields of the state, such as RPC getters and
+// duty calculation, copying a state per request is costly.
+func (s *State) ReadOnlyStateByRoot(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.ReadOnlyStateByRoot")
+	defer span.End()
+
+	if cachedState := s.hotStateCache.GetWithoutCopy(blockRoot); cachedState != nil {
+		return cachedState, nil
+	}
+	return s.StateByRoot(ctx, blockRoot)
+}
+
+// StateByRootForSlot retrieves the state at the input slot of the chain ending at the input block
+// root. If the block is after the slot, the state of its latest ancestor at or before the slot is
+// used instead, and the empty slots up to the slot are then processed. This is the state an
+// attestation voting for the block with a target at the slot is verified against.
+func (s *State) StateByRootForSlot(ctx context.Context, blockRoot [32]byte, slot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.StateByRootForSlot")
+	defer span.End()
+
+	ancestorRoot, err := s.ancestorRoot(ctx, blockRoot, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get ancestor root")
+	}
+	st, err := s.StateByRoot(ctx, ancestorRoot)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, errUnknownState
+	}
+	if st.Slot() < slot {
+		// The slot may not have been reached by the chain yet, the regular slot processing is
+		// used so the skip slot cache applies.
+		st, err = transition.ProcessSlots(ctx, st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+	}
+	return st, nil
+}
+
+// This walks back the chain from the input block root to the latest block at or before the
+// input slot and returns its root.
+func (s *State) ancestorRoot(ctx context.Context, blockRoot [32]byte, slot uint64) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.ancestorRoot")
+	defer span.End()
+
+	for blockRoot != params.BeaconConfig().ZeroHash {
+		blockSlot, err := s.blockRootSlot(ctx, blockRoot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if blockSlot <= slot {
+			return blockRoot, nil
+		}
+		b, err := s.beaconDB.Block(ctx, blockRoot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if b == nil || b.Block == nil {
+			return [32]byte{}, errUnknownBlock
+		}
+		blockRoot = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+	return blockRoot, nil
+}
+
 // StateBySlot retrieves the state from DB using input slot.
 // It retrieves state from the cold section if the input slot
 // is below the split point cut off.
@@ -41,16 +139,23 @@ func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (*state.Bea
 func (s *State) StateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateBySlot")
 	defer span.End()
+	atomic.AddInt64(&s.activeLoads, 1)
+	defer atomic.AddInt64(&s.activeLoads, -1)
+
+	ctx, t := withLoadTrace(ctx)
+	defer t.recordLoad(span, time.Now())
 
-	if slot < s.splitInfo.slot {
+	if slot < s.split().slot {
+		t.setSection(coldSection)
 		return s.loadColdIntermediateStateBySlot(ctx, slot)
 	}
 
+	t.setSection(hotSection)
 	return s.loadHotStateBySlot(ctx, slot)
 }
 
 // StateSummaryExists returns true if the corresponding state of the input block either
 // exists in the DB or it can be generated by state gen.
 func (s *State) StateSummaryExists(ctx context.Context, blockRoot [32]byte) bool {
-	return s.beaconDB.HasStateSummary(ctx, blockRoot)
+	return s.stateSummaryCache.Has(blockRoot) || s.beaconDB.HasStateSummary(ctx, blockRoot)
 }
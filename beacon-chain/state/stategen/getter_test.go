@@ -58,7 +58,7 @@ func TestStateByRoot_HotStateDB(t *testing.T) {
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
 	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
 	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
-	service.beaconDB.SaveGenesisBlockRoot(ctx, blkRoot)
+	db.SaveGenesisBlockRoot(ctx, blkRoot)
 	if err := service.beaconDB.SaveState(ctx, beaconState, blkRoot); err != nil {
 		t.Fatal(err)
 	}
@@ -80,6 +80,49 @@ func TestStateByRoot_HotStateDB(t *testing.T) {
 	}
 }
 
+func TestStateByRoot_WithoutCachePopulation(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
+	if err := db.SaveGenesisBlockRoot(ctx, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveState(ctx, beaconState, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	targetSlot := uint64(10)
+	if err := service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: targetSlot,
+		Root: blkRoot[:],
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	loadedState, err := service.StateByRoot(WithoutCachePopulation(ctx), blkRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedState.Slot() != targetSlot {
+		t.Error("Did not correctly load state")
+	}
+	if service.hotStateCache.Has(blkRoot) {
+		t.Error("Should not have populated the hot state cache")
+	}
+
+	if _, err := service.StateByRoot(ctx, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if !service.hotStateCache.Has(blkRoot) {
+		t.Error("Should have populated the hot state cache")
+	}
+}
+
 func TestStateByRoot_HotStateCached(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
@@ -105,6 +148,68 @@ func TestStateByRoot_HotStateCached(t *testing.T) {
 	}
 }
 
+func TestReadOnlyStateByRoot(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	r := [32]byte{'A'}
+	if err := service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Root: r[:],
+	}); err != nil {
+		t.Fatal(err)
+	}
+	service.hotStateCache.Put(r, beaconState)
+
+	loadedState, err := service.ReadOnlyStateByRoot(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedState != beaconState {
+		t.Error("Expected the cached state to be returned without a copy")
+	}
+}
+
+func TestStateByRootForSlot_AncestorState(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+
+	ancestor := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1}}
+	if err := db.SaveBlock(ctx, ancestor); err != nil {
+		t.Fatal(err)
+	}
+	ancestorRoot, _ := ssz.HashTreeRoot(ancestor.Block)
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5, ParentRoot: ancestorRoot[:]}}
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	bRoot, _ := ssz.HashTreeRoot(b.Block)
+	if err := db.SaveStateSummaries(ctx, []*pb.StateSummary{
+		{Slot: 1, Root: ancestorRoot[:]},
+		{Slot: 5, Root: bRoot[:]},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(1)
+	service.hotStateCache.Put(ancestorRoot, beaconState)
+
+	// The block is after the slot, the state of its ancestor is advanced to the slot.
+	loadedState, err := service.StateByRootForSlot(ctx, bRoot, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedState.Slot() != 3 {
+		t.Errorf("Wanted state slot %d, got %d", 3, loadedState.Slot())
+	}
+}
+
 func TestStateBySlot_ColdState(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
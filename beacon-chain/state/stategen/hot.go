@@ -3,12 +3,16 @@ package stategen
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -25,22 +29,29 @@ func (s *State) saveHotState(ctx context.Context, blockRoot [32]byte, state *sta
 		return nil
 	}
 
-	// Only on an epoch boundary slot, saves the whole state.
-	if helpers.IsEpochStart(state.Slot()) {
-		if err := s.beaconDB.SaveState(ctx, state, blockRoot); err != nil {
+	summary := &pb.StateSummary{
+		Slot: state.Slot(),
+		Root: blockRoot[:],
+	}
+
+	// Only on an epoch boundary slot, or the slots picked by the adaptive save policy, saves the
+	// whole state. The full state and its summary are written atomically so an unclean shutdown
+	// can't leave a summary without its boundary state.
+	if s.saveFullState(state.Slot()) {
+		if err := s.beaconDB.SaveStateAndSummary(ctx, state, summary); err != nil {
 			return err
 		}
+		msg := "Saved full state on epoch boundary"
+		if !helpers.IsEpochStart(state.Slot()) {
+			msg = "Saved full state picked by the adaptive save policy"
+		}
 		log.WithFields(logrus.Fields{
 			"slot":      state.Slot(),
-			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info("Saved full state on epoch boundary")
-	}
-
-	// On an intermediate slots, save the hot state summary.
-	if err := s.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
-		Slot: state.Slot(),
-		Root: blockRoot[:],
-	}); err != nil {
-		return err
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))}).Info(msg)
+	} else {
+		// On an intermediate slots, cache the hot state summary. It is written to the DB along
+		// with the other summaries of the slot by the state summary flush.
+		s.stateSummaryCache.Put(blockRoot, summary)
 	}
 
 	// Store the copied state in the cache.
@@ -56,50 +67,182 @@ func (s *State) loadHotStateByRoot(ctx context.Context, blockRoot [32]byte) (*st
 	ctx, span := trace.StartSpan(ctx, "stateGen.loadHotStateByRoot")
 	defer span.End()
 
+	t := loadTraceFromContext(ctx)
+
 	// Load the hot state cache.
 	cachedState := s.hotStateCache.Get(blockRoot)
 	if cachedState != nil {
+		t.setCacheHit()
 		return cachedState, nil
 	}
 
-	summary, err := s.beaconDB.StateSummary(ctx, blockRoot)
+	start := time.Now()
+	summary, err := s.stateSummary(ctx, blockRoot)
 	if err != nil {
 		return nil, err
 	}
 	if summary == nil {
 		return nil, errUnknownStateSummary
 	}
+	t.step("load state summary", start)
+	t.setSummary(summary.Slot)
 
-	startState, err := s.lastSavedState(ctx, helpers.StartSlot(helpers.SlotToEpoch(summary.Slot)))
+	// The epoch boundary state may have been generated ahead of time for the canonical head.
+	boundarySlot := helpers.StartSlot(helpers.SlotToEpoch(summary.Slot))
+	start = time.Now()
+	hotState, replayed, err := s.replayFromBoundaryState(ctx, boundarySlot, blockRoot, summary.Slot)
 	if err != nil {
+		return nil, errors.Wrap(err, "could not replay blocks from pregenerated boundary state")
+	}
+	if hotState != nil {
+		t.step("replay blocks from pregenerated boundary state", start)
+		t.setBlocksReplayed(replayed)
+		if !skipCachePopulation(ctx) {
+			s.hotStateCache.Put(blockRoot, hotState.Copy())
+		}
+		return hotState, nil
+	}
+
+	start = time.Now()
+	startState, err := s.replayStartState(ctx, blockRoot, summary.Slot, boundarySlot)
+	if err != nil && err != errUnknownState {
 		return nil, err
 	}
-	if startState == nil {
-		return nil, errUnknownBoundaryState
+	// Nodes restored from partial backups can miss the boundary state, it is then regenerated
+	// from the last archived state instead of failing the load or replaying from an older state.
+	boundaryMissing := startState == nil
+	if !boundaryMissing && startState.Slot() < boundarySlot {
+		boundaryMissing, err = s.boundaryStateMissing(ctx, boundarySlot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check boundary state")
+		}
 	}
+	if boundaryMissing {
+		log.WithFields(logrus.Fields{
+			"slot":      boundarySlot,
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blockRoot[:])),
+		}).Warn("Epoch boundary state missing from the DB, recovering it from the last archived state")
+		hotState, replayed, err = s.recoverBoundaryState(ctx, boundarySlot, blockRoot, summary.Slot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not recover unknown boundary state")
+		}
+		boundaryStatesRecovered.Inc()
+		t.step("recover epoch boundary state", start)
+		t.setBlocksReplayed(replayed)
+		if !skipCachePopulation(ctx) {
+			s.hotStateCache.Put(blockRoot, hotState.Copy())
+		}
+		return hotState, nil
+	}
+	t.step("load epoch boundary state", start)
+	t.setStartState(epochBoundarySource, startState)
 
 	// Don't need to replay the blocks if start state is the same state for the block root.
-	var hotState *state.BeaconState
 	targetSlot := summary.Slot
 	if targetSlot == startState.Slot() {
 		hotState = startState
 	} else {
-		blks, err := s.LoadBlocks(ctx, startState.Slot()+1, targetSlot, bytesutil.ToBytes32(summary.Root))
-		if err != nil {
-			return nil, errors.Wrap(err, "could not load blocks for hot state using root")
-		}
-		hotState, err = s.ReplayBlocks(ctx, startState, blks, targetSlot)
+		replayStart := s.now()
+		hotState, replayed, err = s.loadAndReplayBlocks(ctx, startState, targetSlot, bytesutil.ToBytes32(summary.Root), targetSlot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not replay blocks for hot state using root")
 		}
+		replayTime := s.now().Sub(replayStart)
+		t.stepTook("load and replay blocks", replayTime)
+		t.setBlocksReplayed(replayed)
+		s.observeReplay(replayTime)
 	}
 
 	// Save the copied state because the reference also returned in the end.
-	s.hotStateCache.Put(blockRoot, hotState.Copy())
+	if !skipCachePopulation(ctx) {
+		s.hotStateCache.Put(blockRoot, hotState.Copy())
+	}
 
 	return hotState, nil
 }
 
+// This reports whether a block on the epoch boundary slot has its state summary saved but its
+// state missing from the DB. States of epoch boundary blocks are always saved along with their
+// summary, a summary without its state means the state was lost.
+func (s *State) boundaryStateMissing(ctx context.Context, boundarySlot uint64) (bool, error) {
+	// The block roots filter treats an end slot of 0 as unbounded.
+	if boundarySlot == 0 {
+		return false, nil
+	}
+	roots, err := s.beaconDB.BlockRoots(ctx, filters.NewFilter().SetStartSlot(boundarySlot).SetEndSlot(boundarySlot))
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roots {
+		if !s.beaconDB.HasStateSummary(ctx, r) && !s.stateSummaryCache.Has(r) {
+			continue
+		}
+		if !s.beaconDB.HasState(ctx, r) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// This regenerates the state of the input block root from the last archived cold state, when the
+// epoch boundary state to replay it from is missing. If the last block up to the boundary slot is
+// on the epoch boundary, its state is saved again so the following loads of the epoch replay from
+// it. It returns the state and the number of blocks replayed.
+func (s *State) recoverBoundaryState(
+	ctx context.Context,
+	boundarySlot uint64,
+	blockRoot [32]byte,
+	targetSlot uint64,
+) (*state.BeaconState, int, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.recoverBoundaryState")
+	defer span.End()
+
+	archivedSlot := boundarySlot
+	if splitSlot := s.split().slot; splitSlot < archivedSlot {
+		archivedSlot = splitSlot
+	}
+	archivedState, err := s.archivedPointByIndex(ctx, archivedSlot/s.slotsPerArchivedPoint)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get last archived state")
+	}
+	if archivedState == nil {
+		return nil, 0, errUnknownArchivedState
+	}
+	startState, err := s.checkpointState(ctx, archivedState, boundarySlot)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get checkpoint state")
+	}
+	loadTraceFromContext(ctx).setStartState(archivedPointSource, startState)
+
+	blks, err := s.LoadBlocks(ctx, startState.Slot()+1, targetSlot, blockRoot)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not load blocks")
+	}
+	// The blocks are in decreasing slots order, the ones up to the boundary slot are at the end.
+	split := len(blks)
+	for split > 0 && blks[split-1].Block.Slot <= boundarySlot {
+		split--
+	}
+	boundaryState, err := s.ReplayBlocks(ctx, startState, blks[split:], boundarySlot)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not replay blocks up to boundary slot")
+	}
+	if split < len(blks) && blks[split].Block.Slot == boundarySlot {
+		boundaryRoot := blockRoot
+		if split > 0 {
+			boundaryRoot = bytesutil.ToBytes32(blks[split-1].Block.ParentRoot)
+		}
+		if err := s.beaconDB.SaveState(ctx, boundaryState, boundaryRoot); err != nil {
+			return nil, 0, errors.Wrap(err, "could not save recovered boundary state")
+		}
+	}
+	hotState, err := s.ReplayBlocks(ctx, boundaryState, blks[:split], targetSlot)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not replay blocks from boundary slot")
+	}
+	return hotState, len(blks), nil
+}
+
 // This loads a hot state by slot where the slot lies between the epoch boundary points.
 // This is a slower implementation (versus ByRoot) as slot is the only argument. It require fetching
 // all the blocks between the epoch boundary points for playback.
@@ -109,7 +252,10 @@ func (s *State) loadHotStateBySlot(ctx context.Context, slot uint64) (*state.Bea
 	defer span.End()
 
 	// Gather last saved state, that is where node starts to replay the blocks.
-	startState, err := s.lastSavedState(ctx, slot)
+	startState, err := s.lastSavedHotState(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last saved state for hot state using slot")
+	}
 
 	// Gather the last saved block root and the slot number.
 	lastValidRoot, lastValidSlot, err := s.lastSavedBlock(ctx, slot)
@@ -117,11 +263,56 @@ func (s *State) loadHotStateBySlot(ctx context.Context, slot uint64) (*state.Bea
 		return nil, errors.Wrap(err, "could not get last valid block for hot state using slot")
 	}
 
-	// Load and replay blocks to get the intermediate state.
-	replayBlks, err := s.LoadBlocks(ctx, startState.Slot()+1, lastValidSlot, lastValidRoot)
+	t := loadTraceFromContext(ctx)
+	t.setStartState(epochBoundarySource, startState)
+
+	var hotState *state.BeaconState
+	if lastValidSlot <= startState.Slot() {
+		// There is no block between the saved state and the input slot, the state only has to be
+		// advanced through the empty slots.
+		hotState, err = replaySkipSlots(ctx, startState, slot, featureconfig.Get().EnableStateGenSigVerify)
+	} else {
+		// Load and replay blocks to get the intermediate state.
+		var replayed int
+		hotState, replayed, err = s.loadAndReplayBlocks(ctx, startState, lastValidSlot, lastValidRoot, slot)
+		t.setBlocksReplayed(replayed)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if hotState.Slot() != slot {
+		return nil, fmt.Errorf("loaded hot state at slot %d, wanted slot %d", hotState.Slot(), slot)
+	}
+	return hotState, nil
+}
+
+// This finds the last saved state to replay the blocks up to the input slot from. When there is
+// no single state saved at the highest slot, as when states of forks were saved at the same
+// epoch boundary, it falls back to the states saved at the previous epoch boundaries, down to
+// the genesis state.
+func (s *State) lastSavedHotState(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.lastSavedHotState")
+	defer span.End()
 
-	return s.ReplayBlocks(ctx, startState, replayBlks, slot)
+	searchSlot := slot
+	for {
+		st, err := s.lastSavedState(ctx, searchSlot)
+		if err == nil && st == nil {
+			err = errUnknownState
+		}
+		if err == nil {
+			return st, nil
+		}
+		// Only a missing or ambiguous state is looked for lower, database errors are returned.
+		if errors.Cause(err) != errUnknownState || searchSlot == 0 {
+			return nil, err
+		}
+		// Falls back to the last epoch boundary strictly below the searched slot.
+		prevSlot := helpers.StartSlot(helpers.SlotToEpoch(searchSlot - 1))
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":         searchSlot,
+			"fallbackSlot": prevSlot,
+		}).Debug("Could not get last saved state, falling back to the previous epoch boundary")
+		searchSlot = prevSlot
+	}
 }
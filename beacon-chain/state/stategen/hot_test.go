@@ -2,12 +2,16 @@ package stategen
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 
 	//pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -68,6 +72,29 @@ func TestSaveHotState_CanSaveOnEpochBoundary(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Saved full state on epoch boundary")
 }
 
+func TestSaveHotState_AdaptiveSaveWithinEpoch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+	service.savePolicy = newSavePolicy(func() (uint64, error) { return 100, nil }, 0, 0)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	service.savePolicy.interval = slotsPerEpoch / 2
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(slotsPerEpoch + slotsPerEpoch/2)
+	r := [32]byte{'A'}
+	if err := service.saveHotState(ctx, r, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if !service.beaconDB.HasState(ctx, r) {
+		t.Error("Should have saved the state")
+	}
+	testutil.AssertLogsContain(t, hook, "Saved full state picked by the adaptive save policy")
+	testutil.AssertLogsDoNotContain(t, hook, "Saved full state on epoch boundary")
+}
+
 func TestSaveHotState_NoSaveNotEpochBoundary(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
@@ -91,13 +118,28 @@ func TestSaveHotState_NoSaveNotEpochBoundary(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	// Should only save state summary.
+	// Should only cache the state summary until it's flushed.
 	if service.beaconDB.HasState(ctx, r) {
 		t.Error("Should not have saved the state")
 	}
+	if !service.stateSummaryCache.Has(r) {
+		t.Error("Should have cached the state summary")
+	}
+	if service.beaconDB.HasStateSummary(ctx, r) {
+		t.Error("Should not have saved the state summary before flushing")
+	}
+	if !service.StateSummaryExists(ctx, r) {
+		t.Error("Cached state summary should exist")
+	}
+	if err := service.SaveStateSummariesToDB(ctx); err != nil {
+		t.Fatal(err)
+	}
 	if !service.beaconDB.HasStateSummary(ctx, r) {
 		t.Error("Should have saved the state summary")
 	}
+	if service.stateSummaryCache.Has(r) {
+		t.Error("Flushed state summary should not remain cached")
+	}
 	testutil.AssertLogsDoNotContain(t, hook, "Saved full state on epoch boundary")
 }
 
@@ -131,7 +173,7 @@ func TestLoadHoteStateByRoot_FromDBCanProcess(t *testing.T) {
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
 	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
 	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
-	service.beaconDB.SaveGenesisBlockRoot(ctx, blkRoot)
+	db.SaveGenesisBlockRoot(ctx, blkRoot)
 	if err := service.beaconDB.SaveState(ctx, beaconState, blkRoot); err != nil {
 		t.Fatal(err)
 	}
@@ -163,7 +205,7 @@ func TestLoadHoteStateByRoot_FromDBBoundaryCase(t *testing.T) {
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
 	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
 	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
-	service.beaconDB.SaveGenesisBlockRoot(ctx, blkRoot)
+	db.SaveGenesisBlockRoot(ctx, blkRoot)
 	if err := service.beaconDB.SaveState(ctx, beaconState, blkRoot); err != nil {
 		t.Fatal(err)
 	}
@@ -194,11 +236,11 @@ func TestLoadHoteStateBySlot_CanAdvanceSlotUsingDB(t *testing.T) {
 	service := New(db)
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
 	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
-	if err := service.beaconDB.SaveBlock(ctx, b); err != nil {
+	if err := db.SaveBlock(ctx, b); err != nil {
 		t.Fatal(err)
 	}
 	gRoot, _ := ssz.HashTreeRoot(b.Block)
-	if err := service.beaconDB.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
 		t.Fatal(err)
 	}
 	if err := service.beaconDB.SaveState(ctx, beaconState, gRoot); err != nil {
@@ -214,3 +256,185 @@ func TestLoadHoteStateBySlot_CanAdvanceSlotUsingDB(t *testing.T) {
 		t.Error("Did not correctly load state")
 	}
 }
+
+func TestLoadHotStateBySlot_FallsBackAcrossEpochBoundaries(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	gRoot, _ := ssz.HashTreeRoot(b.Block)
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveState(ctx, beaconState, gRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	// The boundary states of the next two epochs have no block at their slot to be found by.
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		boundaryState, err := processSlotsStateGen(ctx, beaconState.Copy(), epoch*params.BeaconConfig().SlotsPerEpoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := service.beaconDB.SaveState(ctx, boundaryState, [32]byte{byte(epoch)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	slot := 2*params.BeaconConfig().SlotsPerEpoch + 3
+	loadedState, err := service.loadHotStateBySlot(ctx, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedState.Slot() != slot {
+		t.Errorf("Wanted state at slot %d, received %d", slot, loadedState.Slot())
+	}
+}
+
+func TestRecoverBoundaryState_ReplaysFromArchivedState(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	beaconState, privs := testutil.DeterministicGenesisState(t, 32)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock := blocks.NewGenesisBlock(stateRoot[:])
+	genRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, genRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState, genRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedPointRoot(ctx, genRoot, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The state of the block on the epoch boundary slot is missing from the DB.
+	boundarySlot := params.BeaconConfig().SlotsPerEpoch
+	roots := make([][32]byte, 0, 2)
+	for _, slot := range []uint64{boundarySlot, boundarySlot + 1} {
+		blk, err := testutil.GenerateFullBlock(beaconState, privs, testutil.DefaultBlockGenConfig(), slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, root)
+	}
+
+	hotState, replayed, err := service.recoverBoundaryState(ctx, boundarySlot, roots[1], boundarySlot+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed != 2 {
+		t.Errorf("Wanted %d blocks replayed, got %d", 2, replayed)
+	}
+	if !proto.Equal(hotState.InnerStateUnsafe(), beaconState.InnerStateUnsafe()) {
+		t.Error("Recovered state does not match the state of the block")
+	}
+	if !db.HasState(ctx, roots[0]) {
+		t.Error("Wanted the recovered boundary state to be saved")
+	}
+}
+
+func TestBoundaryStateMissing(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	boundarySlot := params.BeaconConfig().SlotsPerEpoch
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: boundarySlot}}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A block without a state summary was never processed, its state is not expected.
+	missing, err := service.boundaryStateMissing(ctx, boundarySlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing {
+		t.Error("Did not want the boundary state reported missing without a state summary")
+	}
+
+	if err := db.SaveStateSummary(ctx, &pb.StateSummary{Slot: boundarySlot, Root: root[:]}); err != nil {
+		t.Fatal(err)
+	}
+	missing, err = service.boundaryStateMissing(ctx, boundarySlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !missing {
+		t.Error("Wanted the boundary state reported missing")
+	}
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := beaconState.SetSlot(boundarySlot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState, root); err != nil {
+		t.Fatal(err)
+	}
+	missing, err = service.boundaryStateMissing(ctx, boundarySlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing {
+		t.Error("Did not want the boundary state reported missing once saved")
+	}
+}
+
+type failingStatesDB struct {
+	Database
+	genesis *stateTrie.BeaconState
+}
+
+func (f failingStatesDB) GenesisState(_ context.Context) (*stateTrie.BeaconState, error) {
+	return f.genesis, nil
+}
+
+func (failingStatesDB) HighestSlotStatesBelow(_ context.Context, _ uint64) ([]*stateTrie.BeaconState, error) {
+	return nil, errors.New("database is closed")
+}
+
+func TestLastSavedHotState_ReturnsDatabaseErrors(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	genesis, _ := testutil.DeterministicGenesisState(t, 32)
+	service := New(failingStatesDB{Database: db, genesis: genesis})
+
+	if _, err := service.lastSavedHotState(ctx, 2*params.BeaconConfig().SlotsPerEpoch+3); err == nil {
+		t.Error("Wanted the database error instead of a fall back to a lower epoch boundary")
+	}
+}
@@ -0,0 +1,65 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"go.opencensus.io/trace"
+)
+
+// StateReader is the state access subset of the DB interface, as used by the code paths which
+// read states from the DB directly.
+type StateReader interface {
+	State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error)
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+	GenesisState(ctx context.Context) (*state.BeaconState, error)
+}
+
+var _ = StateReader(&LegacyAdapter{})
+
+// LegacyAdapter serves states through state generation behind the DB state access methods, so
+// the callers reading states from the DB can be moved to the hot and cold sections one at a time.
+type LegacyAdapter struct {
+	s *State
+}
+
+// NewLegacyAdapter returns a state reader backed by state generation.
+func NewLegacyAdapter(s *State) *LegacyAdapter {
+	return &LegacyAdapter{s: s}
+}
+
+// NewStateReader returns a state reader backed by state generation when the new state
+// management is enabled, and the DB otherwise.
+func NewStateReader(beaconDB db.ReadOnlyDatabase, s *State) StateReader {
+	if featureconfig.Get().NewStateMgmt && s != nil {
+		return NewLegacyAdapter(s)
+	}
+	return beaconDB
+}
+
+// State returns the state of the input block root, loaded from the hot or cold section. Like the
+// DB, it returns a nil state without an error when the block root is unknown.
+func (a *LegacyAdapter) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.LegacyAdapter.State")
+	defer span.End()
+
+	if !a.HasState(ctx, blockRoot) {
+		return nil, nil
+	}
+	return a.s.StateByRoot(ctx, blockRoot)
+}
+
+// HasState returns true if the state of the input block root is either saved in the DB or can
+// be generated by state gen.
+func (a *LegacyAdapter) HasState(ctx context.Context, blockRoot [32]byte) bool {
+	return a.s.StateSummaryExists(ctx, blockRoot) ||
+		a.s.beaconDB.HasBlock(ctx, blockRoot) ||
+		a.s.beaconDB.HasState(ctx, blockRoot)
+}
+
+// GenesisState returns the genesis state saved in the DB.
+func (a *LegacyAdapter) GenesisState(ctx context.Context) (*state.BeaconState, error) {
+	return a.s.beaconDB.GenesisState(ctx)
+}
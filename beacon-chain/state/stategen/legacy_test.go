@@ -0,0 +1,77 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestLegacyAdapter_State(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	adapter := NewLegacyAdapter(service)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
+	db.SaveGenesisBlockRoot(ctx, blkRoot)
+	if err := service.beaconDB.SaveState(ctx, beaconState, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	// The state at slot 10 isn't saved, it's generated from the boundary state.
+	if err := service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: 10,
+		Root: blkRoot[:],
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !adapter.HasState(ctx, blkRoot) {
+		t.Error("Expected state to be available")
+	}
+	loadedState, err := adapter.State(ctx, blkRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedState.Slot() != 10 {
+		t.Errorf("Wanted state at slot 10, received %d", loadedState.Slot())
+	}
+
+	unknownRoot := [32]byte{'a'}
+	if adapter.HasState(ctx, unknownRoot) {
+		t.Error("Expected unknown state to be unavailable")
+	}
+	loadedState, err = adapter.State(ctx, unknownRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedState != nil {
+		t.Error("Expected nil state for unknown block root")
+	}
+}
+
+func TestNewStateReader(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	featureconfig.Init(&featureconfig.Flags{NewStateMgmt: true})
+	defer featureconfig.Init(&featureconfig.Flags{})
+	if _, ok := NewStateReader(db, service).(*LegacyAdapter); !ok {
+		t.Error("Expected state gen backed reader with new state management enabled")
+	}
+
+	featureconfig.Init(&featureconfig.Flags{})
+	if _, ok := NewStateReader(db, service).(*LegacyAdapter); ok {
+		t.Error("Expected DB reader with new state management disabled")
+	}
+}
@@ -0,0 +1,170 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// Sections of the DB a traced state was loaded from.
+const (
+	genesisSection = "genesis"
+	hotSection     = "hot"
+	coldSection    = "cold"
+)
+
+// Sources of the state blocks are replayed on top of.
+const (
+	epochBoundarySource = "epoch_boundary"
+	archivedPointSource = "archived_point"
+	checkpointSource    = "checkpoint"
+	pregeneratedSource  = "pregenerated_boundary"
+)
+
+// LoadTrace records the decisions taken by state generation while loading a single state.
+type LoadTrace struct {
+	BlockRoot        string       `json:"block_root"`
+	Section          string       `json:"section"`
+	SplitSlot        uint64       `json:"split_slot"`
+	CacheHit         bool         `json:"cache_hit"`
+	SummaryFound     bool         `json:"summary_found"`
+	SummarySlot      uint64       `json:"summary_slot"`
+	StartStateSource string       `json:"start_state_source,omitempty"`
+	StartStateSlot   uint64       `json:"start_state_slot"`
+	BlocksReplayed   int          `json:"blocks_replayed"`
+	StateSlot        uint64       `json:"state_slot"`
+	Steps            []*TraceStep `json:"steps"`
+	TotalTime        string       `json:"total_time"`
+	Error            string       `json:"error,omitempty"`
+}
+
+// TraceStep is a timed step of a traced state load.
+type TraceStep struct {
+	Name     string `json:"name"`
+	Duration string `json:"duration"`
+}
+
+type loadTraceKey struct{}
+
+// loadTraceFromContext returns the trace being recorded for the request, or nil when the load
+// isn't traced. All recording methods are no-ops on a nil trace.
+func loadTraceFromContext(ctx context.Context) *LoadTrace {
+	t, ok := ctx.Value(loadTraceKey{}).(*LoadTrace)
+	if !ok {
+		return nil
+	}
+	return t
+}
+
+// TraceStateByRoot loads the state of the input block root like StateByRoot, with the
+// opencensus spans of the load always sampled, and returns the path taken to generate it.
+// A failed load is reported in the trace rather than as an error.
+func (s *State) TraceStateByRoot(ctx context.Context, blockRoot [32]byte) *LoadTrace {
+	ctx, span := trace.StartSpan(ctx, "stateGen.TraceStateByRoot", trace.WithSampler(trace.AlwaysSample()))
+	defer span.End()
+
+	t := &LoadTrace{
+		BlockRoot: hex.EncodeToString(blockRoot[:]),
+		SplitSlot: s.split().slot,
+		Steps:     make([]*TraceStep, 0),
+	}
+	ctx = context.WithValue(ctx, loadTraceKey{}, t)
+
+	start := time.Now()
+	st, err := s.StateByRoot(ctx, blockRoot)
+	t.TotalTime = time.Since(start).String()
+	if err != nil {
+		t.Error = err.Error()
+		return t
+	}
+	if st != nil {
+		t.StateSlot = st.Slot()
+	}
+	return t
+}
+
+// withLoadTrace returns the trace being recorded for the request, a new trace is recorded in the
+// returned context when the load isn't traced so that the metrics of the load can be collected.
+func withLoadTrace(ctx context.Context) (context.Context, *LoadTrace) {
+	if t := loadTraceFromContext(ctx); t != nil {
+		return ctx, t
+	}
+	t := &LoadTrace{}
+	return context.WithValue(ctx, loadTraceKey{}, t), t
+}
+
+// recordLoad records the latency, the cache hit and the number of blocks replayed of a state load
+// in the state load metrics, and as attributes of the span of the load. Loads which failed before
+// the section of the state was known are not recorded.
+func (t *LoadTrace) recordLoad(span *trace.Span, start time.Time) {
+	if t.Section == "" {
+		return
+	}
+	latency := time.Since(start)
+	stateLoads.WithLabelValues(t.Section).Inc()
+	if t.CacheHit {
+		stateLoadCacheHits.WithLabelValues(t.Section).Inc()
+	}
+	stateLoadLatency.WithLabelValues(t.Section).Observe(latency.Seconds())
+	stateLoadBlocksReplayed.WithLabelValues(t.Section).Observe(float64(t.BlocksReplayed))
+	span.AddAttributes(
+		trace.StringAttribute("section", t.Section),
+		trace.BoolAttribute("cacheHit", t.CacheHit),
+		trace.Int64Attribute("blocksReplayed", int64(t.BlocksReplayed)),
+		trace.Int64Attribute("startStateSlot", int64(t.StartStateSlot)),
+		trace.Int64Attribute("latencyMs", int64(latency/time.Millisecond)),
+	)
+}
+
+func (t *LoadTrace) setSection(section string) {
+	if t == nil {
+		return
+	}
+	t.Section = section
+}
+
+func (t *LoadTrace) setCacheHit() {
+	if t == nil {
+		return
+	}
+	t.CacheHit = true
+}
+
+func (t *LoadTrace) setSummary(slot uint64) {
+	if t == nil {
+		return
+	}
+	t.SummaryFound = true
+	t.SummarySlot = slot
+}
+
+func (t *LoadTrace) setStartState(source string, st *state.BeaconState) {
+	if t == nil || st == nil {
+		return
+	}
+	t.StartStateSource = source
+	t.StartStateSlot = st.Slot()
+}
+
+func (t *LoadTrace) setBlocksReplayed(n int) {
+	if t == nil {
+		return
+	}
+	t.BlocksReplayed = n
+}
+
+// step records how long the named step took since start.
+func (t *LoadTrace) step(name string, start time.Time) {
+	t.stepTook(name, time.Since(start))
+}
+
+// stepTook records the named step took d, for steps timed by the clock of state generation.
+func (t *LoadTrace) stepTook(name string, d time.Duration) {
+	if t == nil {
+		return
+	}
+	t.Steps = append(t.Steps, &TraceStep{Name: name, Duration: d.String()})
+}
@@ -0,0 +1,125 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestTraceStateByRoot_HotStateDB(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
+	if err := db.SaveGenesisBlockRoot(ctx, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveState(ctx, beaconState, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	targetSlot := uint64(10)
+	if err := service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: targetSlot,
+		Root: blkRoot[:],
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tr := service.TraceStateByRoot(ctx, blkRoot)
+	if tr.Error != "" {
+		t.Fatal(tr.Error)
+	}
+	if tr.Section != hotSection {
+		t.Errorf("Wanted section %s, got %s", hotSection, tr.Section)
+	}
+	if tr.CacheHit {
+		t.Error("Did not want a cache hit")
+	}
+	if !tr.SummaryFound || tr.SummarySlot != targetSlot {
+		t.Errorf("Wanted summary at slot %d, got found=%v slot=%d", targetSlot, tr.SummaryFound, tr.SummarySlot)
+	}
+	if tr.StartStateSource != epochBoundarySource {
+		t.Errorf("Wanted start state source %s, got %s", epochBoundarySource, tr.StartStateSource)
+	}
+	if tr.StateSlot != targetSlot {
+		t.Errorf("Wanted state slot %d, got %d", targetSlot, tr.StateSlot)
+	}
+	if len(tr.Steps) == 0 {
+		t.Error("Wanted timed steps in the trace")
+	}
+
+	// The state is now cached, tracing it again short circuits on the cache.
+	tr = service.TraceStateByRoot(ctx, blkRoot)
+	if !tr.CacheHit {
+		t.Error("Wanted a cache hit")
+	}
+	if tr.SummaryFound {
+		t.Error("Did not want the summary to be looked up on a cache hit")
+	}
+}
+
+func TestTraceStateByRoot_UnknownRoot(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	tr := New(db).TraceStateByRoot(context.Background(), [32]byte{'a'})
+	if tr.Error == "" {
+		t.Error("Wanted the failed load to be reported in the trace")
+	}
+}
+
+func TestLoadTrace_NilIsNoop(t *testing.T) {
+	var tr *LoadTrace
+	tr.setSection(hotSection)
+	tr.setCacheHit()
+	tr.setSummary(1)
+	tr.setBlocksReplayed(1)
+	if loadTraceFromContext(context.Background()) != nil {
+		t.Error("Wanted no trace in a plain context")
+	}
+}
+
+func TestStateBySlot_RecordsLoadTrace(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	blkRoot, _ := ssz.HashTreeRoot(blk.Block)
+	if err := db.SaveGenesisBlockRoot(ctx, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState, blkRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	tr := &LoadTrace{}
+	tracedCtx, recorded := withLoadTrace(context.WithValue(ctx, loadTraceKey{}, tr))
+	if recorded != tr {
+		t.Fatal("Wanted the trace of the context to be reused")
+	}
+	if _, err := service.StateBySlot(tracedCtx, 10); err != nil {
+		t.Fatal(err)
+	}
+	if tr.Section != hotSection {
+		t.Errorf("Wanted section %s, got %s", hotSection, tr.Section)
+	}
+	if tr.StartStateSource != epochBoundarySource || tr.StartStateSlot != 0 {
+		t.Errorf("Wanted start state %s at slot 0, got %s at slot %d", epochBoundarySource, tr.StartStateSource, tr.StartStateSlot)
+	}
+}
@@ -0,0 +1,67 @@
+package stategen
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	coldStateRootMismatch = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cold_state_root_mismatch_total",
+		Help: "The total number of cold states loaded from the DB whose hash tree root did not match the block's state root.",
+	})
+	coldStatesPregenerated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cold_states_pregenerated_total",
+		Help: "The total number of archived point states generated ahead of time by the idle worker.",
+	})
+	boundaryStatesPregenerated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "boundary_states_pregenerated_total",
+		Help: "The total number of upcoming epoch boundary states generated ahead of time for the head.",
+	})
+	boundaryStatesRecovered = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "boundary_states_recovered_total",
+		Help: "The total number of hot states regenerated from the last archived state because their epoch boundary state was missing.",
+	})
+	replayPrefetchFallbacks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "replay_prefetch_fallbacks_total",
+		Help: "The total number of streamed block replays restarted from loaded blocks because the blocks had forks.",
+	})
+	replayUnexpectedStateRoots = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "replay_unexpected_state_roots_total",
+		Help: "The total number of replayed blocks whose post state root did not match the block's state root.",
+	})
+	migrationDeletedStates = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "state_migration_deleted_states_total",
+		Help: "The total number of hot states deleted when migrating finalized states to the cold section.",
+	})
+	softDeletedStatesPurged = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "state_migration_soft_deleted_states_purged_total",
+		Help: "The total number of soft deleted hot states permanently deleted once their undo window expired.",
+	})
+	migrationFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "state_migration_failures_total",
+		Help: "The total number of failed migrations of finalized states to the cold section.",
+	})
+	fullStateSaveInterval = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_state_full_save_interval_slots",
+		Help: "The number of slots between hot states saved in full, set by the adaptive save policy.",
+	})
+	stateLoads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_loads_total",
+		Help: "The total number of states loaded by root or slot, per section of the DB.",
+	}, []string{"section"})
+	stateLoadCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_load_cache_hits_total",
+		Help: "The total number of states loaded by root or slot served from the hot state cache, per section of the DB.",
+	}, []string{"section"})
+	stateLoadLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "state_load_latency_seconds",
+		Help:    "The time taken to load a state by root or slot, per section of the DB.",
+		Buckets: []float64{0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+	}, []string{"section"})
+	stateLoadBlocksReplayed = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "state_load_blocks_replayed",
+		Help:    "The number of blocks replayed to load a state by root or slot, per section of the DB.",
+		Buckets: []float64{0, 1, 4, 16, 32, 64, 128, 256, 512, 1024, 2048},
+	}, []string{"section"})
+)
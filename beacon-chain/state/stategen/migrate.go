@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -12,6 +13,61 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// RequestMigration queues the migration of the hot states up to the input finalized block root to
+// the cold section, it is carried out by RunStateMigration. Only the latest request is kept while a
+// migration is in progress, a migration always covers the states from the current split point so
+// the skipped requests are caught up with.
+func (s *State) RequestMigration(finalizedRoot [32]byte) {
+	select {
+	case <-s.migrationRequests:
+	default:
+	}
+	select {
+	case s.migrationRequests <- finalizedRoot:
+	default:
+	}
+}
+
+// RunStateMigration migrates the hot states below the finalized point to the cold section as
+// finality advances, off the block processing path. Hot states in between the split point and
+// the new finalized point are deleted, except the ones on archived points which are kept as cold
+// states, so the hot section of the DB doesn't grow forever. A failed migration is retried with
+// the next request.
+func (s *State) RunStateMigration(ctx context.Context) {
+	for {
+		select {
+		case finalizedRoot := <-s.migrationRequests:
+			if err := s.migrate(ctx, finalizedRoot); err != nil {
+				migrationFailures.Inc()
+				log.WithError(err).WithField(
+					"root", hex.EncodeToString(bytesutil.Trunc(finalizedRoot[:])),
+				).Error("Could not migrate finalized states to the cold section")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting state migration routine")
+			return
+		}
+	}
+}
+
+// This migrates the hot states up to the state of the finalized block root.
+func (s *State) migrate(ctx context.Context, finalizedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.migrate")
+	defer span.End()
+
+	finalizedState, err := s.StateByRoot(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	if finalizedState == nil {
+		return errUnknownState
+	}
+	if err := s.MigrateToCold(ctx, finalizedState, finalizedRoot); err != nil {
+		return err
+	}
+	return s.purgeSoftDeletedStates(ctx)
+}
+
 // MigrateToCold advances the split point in between the cold and hot state sections.
 // It moves the recent finalized states from the hot section to the cold section and
 // only preserve the ones that's on archived point.
@@ -21,7 +77,7 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedState *state.BeaconS
 
 	// Verify migration is sensible. The new finalized point must increase the current split slot, and
 	// on an epoch boundary for hot state summary scheme to work.
-	currentSplitSlot := s.splitInfo.slot
+	currentSplitSlot := s.split().slot
 	if currentSplitSlot > finalizedState.Slot() {
 		return nil
 	}
@@ -36,8 +92,16 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedState *state.BeaconS
 		return err
 	}
 
+	// States on checkpoints are kept until every archived point of the range has been saved, their
+	// diffs are computed against the archived point states. Diffs are keyed by slot, only the
+	// checkpoint states of the finalized chain are saved, states of forks are deleted.
+	canonical, err := s.canonicalRoots(ctx, finalizedRoot, currentSplitSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not get canonical block roots")
+	}
+	checkpointRoots := make([][32]byte, 0)
 	for _, r := range blockRoots {
-		stateSummary, err := s.beaconDB.StateSummary(ctx, r)
+		stateSummary, err := s.stateSummary(ctx, r)
 		if err != nil {
 			return err
 		}
@@ -47,14 +111,8 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedState *state.BeaconS
 
 		archivedPointIndex := stateSummary.Slot / s.slotsPerArchivedPoint
 		if stateSummary.Slot%s.slotsPerArchivedPoint == 0 {
-			if !s.beaconDB.HasState(ctx, r) {
-				recoveredArchivedState, err := s.ComputeStateUpToSlot(ctx, stateSummary.Slot)
-				if err != nil {
-					return err
-				}
-				if err := s.beaconDB.SaveState(ctx, recoveredArchivedState.Copy(), r); err != nil {
-					return err
-				}
+			if err := s.migrateArchivedPoint(ctx, r, stateSummary.Slot, r == finalizedRoot); err != nil {
+				return err
 			}
 			if err := s.beaconDB.SaveArchivedPointRoot(ctx, r, archivedPointIndex); err != nil {
 				return err
@@ -67,14 +125,17 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedState *state.BeaconS
 				"archiveIndex": archivedPointIndex,
 				"root":         hex.EncodeToString(bytesutil.Trunc(r[:])),
 			}).Info("Saved archived point during state migration")
+		} else if s.isCheckpointSlot(stateSummary.Slot) && canonical[r] && s.beaconDB.HasState(ctx, r) {
+			checkpointRoots = append(checkpointRoots, r)
 		} else {
 			// Do not delete the current finalized state in case user wants to
 			// switch back to old state service, deleting the recent finalized state
 			// could cause issue switching back.
 			if s.beaconDB.HasState(ctx, r) && r != finalizedRoot {
-				if err := s.beaconDB.DeleteState(ctx, r); err != nil {
+				if err := s.deleteHotState(ctx, r); err != nil {
 					return err
 				}
+				migrationDeletedStates.Inc()
 				log.WithFields(logrus.Fields{
 					"slot": stateSummary.Slot,
 					"root": hex.EncodeToString(bytesutil.Trunc(r[:])),
@@ -83,12 +144,151 @@ func (s *State) MigrateToCold(ctx context.Context, finalizedState *state.BeaconS
 		}
 	}
 
-	// Update the split slot and root.
-	s.splitInfo = &splitSlotAndRoot{slot: finalizedState.Slot(), root: finalizedRoot}
+	for _, r := range checkpointRoots {
+		if err := s.saveCheckpointDiff(ctx, r); err != nil {
+			return err
+		}
+		if r != finalizedRoot {
+			if err := s.deleteHotState(ctx, r); err != nil {
+				return err
+			}
+			migrationDeletedStates.Inc()
+		}
+	}
+
+	// Update the split slot and root, it's persisted so the split point is restored on restart.
+	s.setSplit(finalizedState.Slot(), finalizedRoot)
+	if err := s.beaconDB.SaveSplitInfo(ctx, finalizedState.Slot(), finalizedRoot); err != nil {
+		return errors.Wrap(err, "could not save split info")
+	}
 	log.WithFields(logrus.Fields{
-		"slot": s.splitInfo.slot,
-		"root": hex.EncodeToString(bytesutil.Trunc(s.splitInfo.root[:])),
+		"slot": finalizedState.Slot(),
+		"root": hex.EncodeToString(bytesutil.Trunc(finalizedRoot[:])),
 	}).Info("Set hot and cold state split point")
 
 	return nil
 }
+
+// This saves the state of the archived point block root in the cold section, in full or as a diff
+// against the full archived point state below it. The hot state of an archived point saved as a diff
+// is deleted, unless it's the finalized state.
+func (s *State) migrateArchivedPoint(ctx context.Context, blockRoot [32]byte, slot uint64, finalized bool) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.migrateArchivedPoint")
+	defer span.End()
+
+	archivedState, err := s.beaconDB.State(ctx, blockRoot)
+	if err != nil {
+		return err
+	}
+	hasHotState := archivedState != nil
+	full := s.isFullArchivedPoint(slot / s.slotsPerArchivedPoint)
+	if hasHotState && full {
+		// The hot state is kept as the archived point state, it's moved to the cold state database
+		// when there's one. The finalized state stays hot until the next migration.
+		if finalized {
+			return nil
+		}
+		return s.beaconDB.MoveStateToCold(ctx, blockRoot)
+	}
+	if !hasHotState {
+		archivedState, err = s.ComputeStateUpToSlot(ctx, slot)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.saveArchivedPointState(ctx, blockRoot, archivedState.Copy()); err != nil {
+		return err
+	}
+	if hasHotState && !full && !finalized {
+		if err := s.deleteHotState(ctx, blockRoot); err != nil {
+			return err
+		}
+		migrationDeletedStates.Inc()
+	}
+	return nil
+}
+
+// This deletes the hot state of the input block root during a migration. With a soft delete window,
+// the state is moved aside instead, so it can be restored through the admin service if it turns
+// out to be needed after a late reorg.
+func (s *State) deleteHotState(ctx context.Context, blockRoot [32]byte) error {
+	if s.softDeleteWindow > 0 {
+		return s.beaconDB.SoftDeleteState(ctx, blockRoot)
+	}
+	return s.beaconDB.DeleteState(ctx, blockRoot)
+}
+
+// This permanently deletes the states soft deleted by migrations for longer than the soft delete
+// window. States left over from a previous run with a longer window are purged as well.
+func (s *State) purgeSoftDeletedStates(ctx context.Context) error {
+	purged, err := s.beaconDB.PurgeSoftDeletedStates(ctx, s.now().Add(-s.softDeleteWindow))
+	if err != nil {
+		return errors.Wrap(err, "could not purge soft deleted states")
+	}
+	if purged > 0 {
+		softDeletedStatesPurged.Add(float64(purged))
+		log.WithField("count", purged).Debug("Purged soft deleted states")
+	}
+	return nil
+}
+
+// This returns the roots of the blocks from the input slot up to the finalized block root, walking
+// back the parent roots of the finalized block.
+func (s *State) canonicalRoots(ctx context.Context, finalizedRoot [32]byte, startSlot uint64) (map[[32]byte]bool, error) {
+	roots := make(map[[32]byte]bool)
+	root := finalizedRoot
+	for {
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil || b.Block == nil || b.Block.Slot < startSlot {
+			return roots, nil
+		}
+		roots[root] = true
+		if b.Block.Slot == 0 {
+			return roots, nil
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+}
+
+// This returns true if the slot lies on a cold state checkpoint in between archived points.
+func (s *State) isCheckpointSlot(slot uint64) bool {
+	return s.slotsPerCheckpoint != 0 && slot%s.slotsPerCheckpoint == 0 && slot%s.slotsPerArchivedPoint != 0
+}
+
+// This saves the state of the input checkpoint block root as a diff against the state of the
+// archived point below it.
+func (s *State) saveCheckpointDiff(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveCheckpointDiff")
+	defer span.End()
+
+	checkpointState, err := s.beaconDB.State(ctx, blockRoot)
+	if err != nil {
+		return err
+	}
+	if checkpointState == nil {
+		return errUnknownState
+	}
+	archivedState, err := s.archivedPointByIndex(ctx, checkpointState.Slot()/s.slotsPerArchivedPoint)
+	if err != nil {
+		return errors.Wrap(err, "could not get archived state using index")
+	}
+	if archivedState == nil {
+		return errUnknownArchivedState
+	}
+	diff, err := computeStateDiff(archivedState, checkpointState)
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint state diff")
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, checkpointState.Slot(), diff); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":     checkpointState.Slot(),
+		"baseSlot": archivedState.Slot(),
+		"root":     hex.EncodeToString(bytesutil.Trunc(blockRoot[:])),
+	}).Info("Saved checkpoint state diff during state migration")
+	return nil
+}
@@ -3,6 +3,7 @@ package stategen
 import (
 	"context"
 	"testing"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -28,6 +29,14 @@ func TestMigrateToCold_NoBlock(t *testing.T) {
 	}
 
 	testutil.AssertLogsContain(t, hook, "Set hot and cold state split point")
+
+	slot, _, err := service.beaconDB.SplitInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != beaconState.Slot() {
+		t.Errorf("Wanted saved split slot %d, received %d", beaconState.Slot(), slot)
+	}
 }
 
 func TestMigrateToCold_HigherSplitSlot(t *testing.T) {
@@ -79,7 +88,7 @@ func TestMigrateToCold_MigrationCompletes(t *testing.T) {
 	b := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{Slot: 2},
 	}
-	if err := service.beaconDB.SaveBlock(ctx, b); err != nil {
+	if err := db.SaveBlock(ctx, b); err != nil {
 		t.Fatal(err)
 	}
 	bRoot, _ := ssz.HashTreeRoot(b.Block)
@@ -95,7 +104,7 @@ func TestMigrateToCold_MigrationCompletes(t *testing.T) {
 	b = &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{Slot: 3},
 	}
-	if err := service.beaconDB.SaveBlock(ctx, b); err != nil {
+	if err := db.SaveBlock(ctx, b); err != nil {
 		t.Fatal(err)
 	}
 	bRoot, _ = ssz.HashTreeRoot(b.Block)
@@ -117,4 +126,183 @@ func TestMigrateToCold_MigrationCompletes(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Saved archived point during state migration")
 	testutil.AssertLogsContain(t, hook, "Deleted state during migration")
 	testutil.AssertLogsContain(t, hook, "Set hot and cold state split point")
+
+	slot, _, err := service.beaconDB.SplitInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != beaconState.Slot() {
+		t.Errorf("Wanted saved split slot %d, received %d", beaconState.Slot(), slot)
+	}
+}
+
+func TestMigrateToCold_SoftDeletesStates(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.slotsPerArchivedPoint = 2
+	service.softDeleteWindow = time.Hour
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch)
+	b := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{Slot: 3},
+	}
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	bRoot, _ := ssz.HashTreeRoot(b.Block)
+	if err := service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:], Slot: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveState(ctx, beaconState, bRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.MigrateToCold(ctx, beaconState, [32]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.purgeSoftDeletedStates(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if service.beaconDB.HasState(ctx, bRoot) {
+		t.Fatal("Expected migrated state to be deleted")
+	}
+	if err := db.RestoreSoftDeletedState(ctx, bRoot); err != nil {
+		t.Fatalf("Could not restore state within the soft delete window: %v", err)
+	}
+	if !service.beaconDB.HasState(ctx, bRoot) {
+		t.Error("Expected restored state to be saved")
+	}
+}
+
+func TestRequestMigration_KeepsLatestRequest(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.RequestMigration([32]byte{'a'})
+	service.RequestMigration([32]byte{'b'})
+
+	if len(service.migrationRequests) != 1 {
+		t.Fatalf("Wanted 1 queued migration, got %d", len(service.migrationRequests))
+	}
+	if root := <-service.migrationRequests; root != [32]byte{'b'} {
+		t.Errorf("Wanted latest requested root %#x, got %#x", [32]byte{'b'}, root)
+	}
+}
+
+func TestRunStateMigration_MigratesRequestedRoot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch)
+	if err := service.beaconDB.SaveState(ctx, beaconState, params.BeaconConfig().ZeroHash); err != nil {
+		t.Fatal(err)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		service.RunStateMigration(ctx)
+		close(exited)
+	}()
+	service.RequestMigration(params.BeaconConfig().ZeroHash)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		slot, _, err := service.beaconDB.SplitInfo(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slot == beaconState.Slot() {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the migration")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-exited
+}
+
+func TestMigrateToCold_SkipsCheckpointDiffOfForks(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.slotsPerArchivedPoint = 8
+	service.slotsPerCheckpoint = 2
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch)
+	// The block on the checkpoint slot is not an ancestor of the finalized block.
+	b := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: []byte{'F'}},
+	}
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	bRoot, _ := ssz.HashTreeRoot(b.Block)
+	if err := service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:], Slot: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveState(ctx, beaconState, bRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.MigrateToCold(ctx, beaconState, [32]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	diff, err := db.StateDiff(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil {
+		t.Error("Did not want a checkpoint diff saved for a fork")
+	}
+	if service.beaconDB.HasState(ctx, bRoot) {
+		t.Error("Expected the fork state to be deleted")
+	}
+}
+
+func TestCanonicalRoots(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	saveBlock := func(slot uint64, parent [32]byte) [32]byte {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parent[:]}}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	genesis := saveBlock(0, [32]byte{})
+	a := saveBlock(1, genesis)
+	b := saveBlock(2, a)
+	fork := saveBlock(2, genesis)
+	c := saveBlock(3, b)
+
+	roots, err := service.canonicalRoots(ctx, c, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roots) != 3 || !roots[a] || !roots[b] || !roots[c] {
+		t.Errorf("Wanted the blocks from slot 1 up to the finalized block, received %d roots", len(roots))
+	}
+	if roots[fork] || roots[genesis] {
+		t.Error("Did not want forks or blocks below the start slot")
+	}
 }
@@ -0,0 +1,175 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// errNonLinearBlocks is returned when the prefetched blocks don't form a single chain ending at
+// the end block root, the canonical blocks then have to be picked out by LoadBlocks.
+var errNonLinearBlocks = errors.New("prefetched blocks are not a single chain")
+
+// prefetchedBlock is a block streamed from the DB to the replay loop along with its root.
+type prefetchedBlock struct {
+	block *ethpb.SignedBeaconBlock
+	root  [32]byte
+}
+
+// loadAndReplayBlocks loads the blocks from the slot after the start state up to the end block
+// root and replays them on the start state until the target slot is reached. It returns the
+// replayed state and the number of blocks loaded.
+//
+// Ranges longer than the replay prefetch depth are streamed: the blocks are read from the DB in
+// the background, at most the prefetch depth ahead of the state transitions, so disk reads
+// overlap with the CPU bound replay. Streaming requires the blocks of the range to form a single
+// chain, which is the case unless the range has forks. When it doesn't, the blocks are loaded
+// and filtered by LoadBlocks and replayed again from the start state.
+func (s *State) loadAndReplayBlocks(
+	ctx context.Context,
+	startState *state.BeaconState,
+	endSlot uint64,
+	endBlockRoot [32]byte,
+	targetSlot uint64,
+) (*state.BeaconState, int, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadAndReplayBlocks")
+	defer span.End()
+
+	if s.replayPrefetchDepth > 0 && endSlot > startState.Slot()+s.replayPrefetchDepth {
+		replayed, n, err := s.replayPrefetchedBlocks(ctx, startState.Copy(), endSlot, endBlockRoot, targetSlot)
+		if err != errNonLinearBlocks {
+			return replayed, n, err
+		}
+		replayPrefetchFallbacks.Inc()
+		log.WithFields(logrus.Fields{
+			"startSlot": startState.Slot(),
+			"endSlot":   endSlot,
+		}).Debug("Blocks to replay have forks, loading the canonical blocks before replaying them")
+	}
+
+	blks, err := s.LoadBlocks(ctx, startState.Slot()+1, endSlot, endBlockRoot)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not load blocks")
+	}
+	replayed, err := s.ReplayBlocks(ctx, startState, blks, targetSlot)
+	if err != nil {
+		return nil, 0, err
+	}
+	return replayed, len(blks), nil
+}
+
+// This replays the blocks streamed by prefetchBlocks on the input state. It returns
+// errNonLinearBlocks as soon as a block doesn't descend from the previous one, the input state
+// is then left partially replayed.
+func (s *State) replayPrefetchedBlocks(
+	ctx context.Context,
+	st *state.BeaconState,
+	endSlot uint64,
+	endBlockRoot [32]byte,
+	targetSlot uint64,
+) (*state.BeaconState, int, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.replayPrefetchedBlocks")
+	defer span.End()
+
+	// Canceling stops the prefetching when the replay returns early.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	blks, errs := s.prefetchBlocks(ctx, st.Slot()+1, endSlot)
+
+	rec, err := s.newReplayRecorder(ctx, st)
+	if err != nil {
+		return nil, 0, err
+	}
+	verify := featureconfig.Get().EnableStateGenSigVerify
+	verifier := newBlockSigVerifier(ctx, verify)
+	var lastRoot [32]byte
+	loaded := 0
+	for b := range blks {
+		if loaded > 0 && bytesutil.ToBytes32(b.block.Block.ParentRoot) != lastRoot {
+			verifier.abort()
+			return nil, 0, errNonLinearBlocks
+		}
+		lastRoot = b.root
+		loaded++
+		if st.Slot() >= targetSlot {
+			continue
+		}
+		st, err = replayBlock(ctx, st, b.block, verifier)
+		if err != nil {
+			verifier.abort()
+			return nil, 0, err
+		}
+		if err := rec.verify(ctx, st, b.block); err != nil {
+			verifier.abort()
+			return nil, 0, err
+		}
+	}
+	if err := <-errs; err != nil {
+		verifier.abort()
+		return nil, 0, err
+	}
+	// Same as LoadBlocks, the end block root is only checked if there are blocks to replay.
+	if loaded > 0 && lastRoot != endBlockRoot {
+		verifier.abort()
+		return nil, 0, errNonLinearBlocks
+	}
+	if err := verifier.wait(); err != nil {
+		return nil, 0, err
+	}
+
+	st, err = replaySkipSlots(ctx, st, targetSlot, verify)
+	if err != nil {
+		return nil, 0, err
+	}
+	return st, loaded, nil
+}
+
+// This streams the blocks between start slot and end slot in increasing slots order. The blocks
+// are read from the DB in batches of the prefetch depth number of slots and buffered up to the
+// prefetch depth. The error channel receives the read error, if any, once the block channel
+// is closed.
+func (s *State) prefetchBlocks(ctx context.Context, startSlot uint64, endSlot uint64) (<-chan *prefetchedBlock, <-chan error) {
+	blks := make(chan *prefetchedBlock, s.replayPrefetchDepth)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(blks)
+		for slot := startSlot; slot <= endSlot; slot += s.replayPrefetchDepth {
+			batchEndSlot := slot + s.replayPrefetchDepth - 1
+			if batchEndSlot > endSlot {
+				batchEndSlot = endSlot
+			}
+			filter := filters.NewFilter().SetStartSlot(slot).SetEndSlot(batchEndSlot)
+			blocks, err := s.beaconDB.Blocks(ctx, filter)
+			if err != nil {
+				errs <- err
+				return
+			}
+			blockRoots, err := s.beaconDB.BlockRoots(ctx, filter)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if len(blocks) != len(blockRoots) {
+				errs <- errors.New("length of blocks and roots don't match")
+				return
+			}
+			for i := range blocks {
+				select {
+				case blks <- &prefetchedBlock{block: blocks[i], root: blockRoots[i]}:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+	return blks, errs
+}
@@ -0,0 +1,133 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// This saves a chain of full blocks from slot 1 to the end slot and returns the genesis state
+// with the root of the last block.
+func saveReplayChain(t *testing.T, beaconDB db.Database, endSlot uint64) (*stateTrie.BeaconState, [32]byte) {
+	ctx := context.Background()
+	genesisState, privs := testutil.DeterministicGenesisState(t, 32)
+	beaconState := genesisState.Copy()
+	var lastRoot [32]byte
+	for i := uint64(1); i <= endSlot; i++ {
+		blk, err := testutil.GenerateFullBlock(beaconState, privs, testutil.DefaultBlockGenConfig(), i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		lastRoot, err = ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return genesisState, lastRoot
+}
+
+func TestLoadAndReplayBlocks_PrefetchMatchesLoad(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	genesisState, lastRoot := saveReplayChain(t, db, 5)
+	service := New(db)
+
+	service.replayPrefetchDepth = 0
+	want, wantReplayed, err := service.loadAndReplayBlocks(ctx, genesisState.Copy(), 5, lastRoot, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.replayPrefetchDepth = 2
+	got, replayed, err := service.loadAndReplayBlocks(ctx, genesisState.Copy(), 5, lastRoot, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replayed != 5 || wantReplayed != 5 {
+		t.Errorf("Wanted 5 blocks replayed, got %d prefetched and %d loaded", replayed, wantReplayed)
+	}
+	if got.Slot() != 6 {
+		t.Errorf("Wanted state at slot 6, got %d", got.Slot())
+	}
+	wantRoot, err := want.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotRoot, err := got.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotRoot != wantRoot {
+		t.Error("Prefetched replay did not produce the same state as the loaded replay")
+	}
+}
+
+func TestLoadAndReplayBlocks_ForkFallsBackToLoad(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	genesisState, lastRoot := saveReplayChain(t, db, 4)
+	// A fork block at slot 3 sharing the parent of the canonical block.
+	canonical, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(3).SetEndSlot(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+		Slot:       3,
+		ParentRoot: canonical[0].Block.ParentRoot,
+		Body:       &ethpb.BeaconBlockBody{Graffiti: []byte("fork")},
+	}}
+	fork.Block.Body.RandaoReveal = canonical[0].Block.Body.RandaoReveal
+	fork.Block.Body.Eth1Data = canonical[0].Block.Body.Eth1Data
+	if err := db.SaveBlock(ctx, fork); err != nil {
+		t.Fatal(err)
+	}
+
+	service := New(db)
+	service.replayPrefetchDepth = 1
+	if _, _, err := service.replayPrefetchedBlocks(ctx, genesisState.Copy(), 4, lastRoot, 4); err != errNonLinearBlocks {
+		t.Fatalf("Wanted error %v, got %v", errNonLinearBlocks, err)
+	}
+
+	got, replayed, err := service.loadAndReplayBlocks(ctx, genesisState.Copy(), 4, lastRoot, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed != 4 {
+		t.Errorf("Wanted the 4 canonical blocks replayed, got %d", replayed)
+	}
+	service.replayPrefetchDepth = 0
+	want, _, err := service.loadAndReplayBlocks(ctx, genesisState.Copy(), 4, lastRoot, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRoot, err := want.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotRoot, err := got.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotRoot != wantRoot {
+		t.Error("Fallback replay did not produce the canonical state")
+	}
+}
@@ -0,0 +1,110 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// RunColdStatePregeneration generates the archived point states of the recently finalized epochs
+// ahead of time, instead of lazily on the first request reaching them. Archived points are
+// missing from the DB when no block was proposed on their slot. The worker checks once per
+// epoch and only generates states while no state load is in progress, one archived point at a
+// time, so it doesn't compete with the loads it is meant to speed up.
+func (s *State) RunColdStatePregeneration(ctx context.Context) {
+	interval := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Only archived points finalized from now on are generated, older ones are left to be
+	// recovered on request.
+	nextIndex := s.split().slot / s.slotsPerArchivedPoint
+	for {
+		select {
+		case <-ticker.C:
+			next, err := s.pregenerateArchivedPoints(ctx, nextIndex)
+			if err != nil {
+				log.WithError(err).Error("Could not pregenerate archived point state")
+				continue
+			}
+			nextIndex = next
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting cold state pregeneration routine")
+			return
+		}
+	}
+}
+
+// This generates the missing archived point states from the input archive index up to the
+// split point while the node is idle. It returns the index to resume from.
+func (s *State) pregenerateArchivedPoints(ctx context.Context, fromIndex uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.pregenerateArchivedPoints")
+	defer span.End()
+
+	splitIndex := s.split().slot / s.slotsPerArchivedPoint
+	for idx := fromIndex; idx < splitIndex; idx++ {
+		if !s.isIdle() || ctx.Err() != nil {
+			return idx, nil
+		}
+		if err := s.pregenerateArchivedPoint(ctx, idx); err != nil {
+			return idx, err
+		}
+	}
+	return splitIndex, nil
+}
+
+// This generates and saves the state of an archived point missing from the DB. The state is
+// saved under the root of the last block before the archived point slot, the archived point
+// is skipped if that block already has its own state saved.
+func (s *State) pregenerateArchivedPoint(ctx context.Context, archiveIndex uint64) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.pregenerateArchivedPoint")
+	defer span.End()
+
+	if s.beaconDB.HasArchivedPoint(ctx, archiveIndex) &&
+		s.beaconDB.HasState(ctx, s.beaconDB.ArchivedPointRoot(ctx, archiveIndex)) {
+		return nil
+	}
+	archivedSlot := archiveIndex * s.slotsPerArchivedPoint
+	lastRoot, _, err := s.lastSavedBlock(ctx, archivedSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not get last valid block up to archived index slot")
+	}
+	if s.beaconDB.HasState(ctx, lastRoot) {
+		return nil
+	}
+
+	start := time.Now()
+	archivedState, err := s.ComputeStateUpToSlot(ctx, archivedSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state up to archived index slot")
+	}
+	if archivedState == nil {
+		return errUnknownArchivedState
+	}
+	if err := s.beaconDB.SaveColdState(ctx, archivedState, lastRoot); err != nil {
+		return err
+	}
+	if err := s.beaconDB.SaveArchivedPointRoot(ctx, lastRoot, archiveIndex); err != nil {
+		return err
+	}
+	coldStatesPregenerated.Inc()
+	log.WithFields(logrus.Fields{
+		"slot":         archivedSlot,
+		"archiveIndex": archiveIndex,
+		"root":         hex.EncodeToString(bytesutil.Trunc(lastRoot[:])),
+		"duration":     time.Since(start),
+	}).Debug("Pregenerated archived point state")
+	return nil
+}
+
+// This returns true if no state load is in progress.
+func (s *State) isIdle() bool {
+	return atomic.LoadInt64(&s.activeLoads) == 0
+}
@@ -0,0 +1,140 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// This is the head state advanced to the first slot of the following epoch, along with the root
+// of the head block it was generated from.
+type boundaryState struct {
+	slot      uint64
+	blockRoot [32]byte
+	state     *state.BeaconState
+}
+
+// RequestBoundaryPregeneration queues the generation of the upcoming epoch boundary state of the
+// input head, it is carried out by RunBoundaryStatePregeneration. Only heads in the last quarter
+// of an epoch are considered, as earlier heads are likely to be replaced before the boundary, and
+// only the latest request is kept.
+func (s *State) RequestBoundaryPregeneration(headRoot [32]byte, headSlot uint64) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	if headSlot%slotsPerEpoch < slotsPerEpoch-slotsPerEpoch/4 {
+		return
+	}
+	select {
+	case <-s.boundaryRequests:
+	default:
+	}
+	select {
+	case s.boundaryRequests <- headRoot:
+	default:
+	}
+}
+
+// RunBoundaryStatePregeneration generates the epoch boundary state of the canonical head ahead
+// of the epoch transition. The hot states of the blocks of the new epoch are then replayed from
+// it, instead of every request at the start of the epoch replaying the blocks of the previous
+// epoch from the last saved boundary state.
+func (s *State) RunBoundaryStatePregeneration(ctx context.Context) {
+	for {
+		select {
+		case headRoot := <-s.boundaryRequests:
+			if err := s.pregenerateBoundaryState(ctx, headRoot); err != nil {
+				log.WithError(err).WithField(
+					"root", hex.EncodeToString(bytesutil.Trunc(headRoot[:])),
+				).Error("Could not pregenerate epoch boundary state")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting boundary state pregeneration routine")
+			return
+		}
+	}
+}
+
+// This advances the state of the input head block root to the start slot of the next epoch.
+func (s *State) pregenerateBoundaryState(ctx context.Context, headRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.pregenerateBoundaryState")
+	defer span.End()
+
+	headState, err := s.StateByRoot(ctx, headRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return errUnknownState
+	}
+	boundarySlot := helpers.StartSlot(helpers.SlotToEpoch(headState.Slot()) + 1)
+
+	s.boundaryStateLock.RLock()
+	pregenerated := s.boundaryState
+	s.boundaryStateLock.RUnlock()
+	if pregenerated != nil && pregenerated.slot == boundarySlot && pregenerated.blockRoot == headRoot {
+		return nil
+	}
+
+	start := time.Now()
+	st, err := replaySkipSlots(ctx, headState.Copy(), boundarySlot, featureconfig.Get().EnableStateGenSigVerify)
+	if err != nil {
+		return errors.Wrap(err, "could not process slots up to epoch boundary")
+	}
+
+	s.boundaryStateLock.Lock()
+	s.boundaryState = &boundaryState{slot: boundarySlot, blockRoot: headRoot, state: st}
+	s.boundaryStateLock.Unlock()
+
+	boundaryStatesPregenerated.Inc()
+	log.WithFields(logrus.Fields{
+		"slot":     boundarySlot,
+		"headRoot": hex.EncodeToString(bytesutil.Trunc(headRoot[:])),
+		"duration": time.Since(start),
+	}).Debug("Pregenerated epoch boundary state")
+	return nil
+}
+
+// This replays the hot state of the input block root from the pregenerated state of its epoch
+// boundary. It returns a nil state if no boundary state was pregenerated for the epoch or if
+// the block doesn't descend from the head the boundary state was generated from.
+func (s *State) replayFromBoundaryState(
+	ctx context.Context,
+	boundarySlot uint64,
+	blockRoot [32]byte,
+	targetSlot uint64,
+) (*state.BeaconState, int, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.replayFromBoundaryState")
+	defer span.End()
+
+	s.boundaryStateLock.RLock()
+	pregenerated := s.boundaryState
+	s.boundaryStateLock.RUnlock()
+	if pregenerated == nil || pregenerated.slot != boundarySlot || targetSlot <= boundarySlot {
+		return nil, 0, nil
+	}
+
+	// Blocks that can't be loaded are left to the regular replay path to report.
+	blks, err := s.LoadBlocks(ctx, boundarySlot+1, targetSlot, blockRoot)
+	if err != nil {
+		return nil, 0, nil
+	}
+	// The blocks are in decreasing slots order, the oldest one has to be a child of the head the
+	// boundary state was generated from.
+	if len(blks) == 0 || bytesutil.ToBytes32(blks[len(blks)-1].Block.ParentRoot) != pregenerated.blockRoot {
+		return nil, 0, nil
+	}
+	loadTraceFromContext(ctx).setStartState(pregeneratedSource, pregenerated.state)
+	replayed, err := s.ReplayBlocks(ctx, pregenerated.state.Copy(), blks, targetSlot)
+	if err != nil {
+		return nil, 0, err
+	}
+	return replayed, len(blks), nil
+}
@@ -0,0 +1,76 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestRequestBoundaryPregeneration_OnlyLateHeads(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	service.RequestBoundaryPregeneration([32]byte{'A'}, 1)
+	if len(service.boundaryRequests) != 0 {
+		t.Fatal("Did not want a request for a head early in the epoch")
+	}
+	r := [32]byte{'B'}
+	service.RequestBoundaryPregeneration(r, params.BeaconConfig().SlotsPerEpoch-1)
+	if got := <-service.boundaryRequests; got != r {
+		t.Errorf("Wanted request for root %#x, got %#x", r, got)
+	}
+}
+
+func TestPregenerateBoundaryState_AdvancesHeadState(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	r := [32]byte{'A'}
+	if err := db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: r[:]}); err != nil {
+		t.Fatal(err)
+	}
+	service.hotStateCache.Put(r, beaconState)
+
+	if err := service.pregenerateBoundaryState(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	boundarySlot := params.BeaconConfig().SlotsPerEpoch
+	if service.boundaryState == nil {
+		t.Fatal("Wanted a pregenerated boundary state")
+	}
+	if service.boundaryState.slot != boundarySlot || service.boundaryState.state.Slot() != boundarySlot {
+		t.Errorf("Wanted boundary state at slot %d, got %d", boundarySlot, service.boundaryState.state.Slot())
+	}
+	if service.boundaryState.blockRoot != r {
+		t.Errorf("Wanted boundary state generated from %#x, got %#x", r, service.boundaryState.blockRoot)
+	}
+	if beaconState.Slot() != 0 {
+		t.Error("Did not want the cached head state to be advanced")
+	}
+}
+
+func TestReplayFromBoundaryState_OtherEpoch(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	service.boundaryState = &boundaryState{slot: 64, blockRoot: [32]byte{'A'}, state: beaconState}
+
+	st, _, err := service.replayFromBoundaryState(ctx, 32, [32]byte{'B'}, 33)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != nil {
+		t.Error("Did not want a state replayed from the boundary state of another epoch")
+	}
+}
@@ -0,0 +1,68 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPregenerateArchivedPoints_SkipsWhenBusy(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.slotsPerArchivedPoint = 32
+	service.splitInfo = &splitSlotAndRoot{slot: 128}
+	service.activeLoads = 1
+
+	next, err := service.pregenerateArchivedPoints(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 1 {
+		t.Errorf("Wanted to resume from index %d, got %d", 1, next)
+	}
+}
+
+func TestPregenerateArchivedPoints_SkipsSavedStates(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.slotsPerArchivedPoint = 32
+	service.splitInfo = &splitSlotAndRoot{slot: 96}
+
+	gBlk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	gRoot, err := ssz.HashTreeRoot(gBlk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, gBlk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+		t.Fatal(err)
+	}
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	if err := db.SaveState(ctx, beaconState, gRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	// The last block below every archived point is the genesis block, which has its own state.
+	next, err := service.pregenerateArchivedPoints(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 3 {
+		t.Errorf("Wanted to resume from the split index %d, got %d", 3, next)
+	}
+	if db.HasArchivedPoint(ctx, 1) || db.HasArchivedPoint(ctx, 2) {
+		t.Error("Did not want archived points to be saved over the genesis state")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -47,11 +48,7 @@ func (s *State) ComputeStateUpToSlot(ctx context.Context, targetSlot uint64) (*s
 		return lastState, nil
 	}
 
-	blks, err := s.LoadBlocks(ctx, lastState.Slot()+1, lastBlockSlot, lastBlockRoot)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not load blocks")
-	}
-	lastState, err = s.ReplayBlocks(ctx, lastState, blks, targetSlot)
+	lastState, _, err = s.loadAndReplayBlocks(ctx, lastState, lastBlockSlot, lastBlockRoot, targetSlot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not replay blocks")
 	}
@@ -60,48 +57,106 @@ func (s *State) ComputeStateUpToSlot(ctx context.Context, targetSlot uint64) (*s
 }
 
 // ReplayBlocks replays the input blocks on the input state until the target slot is reached.
+// The input block list is sorted in decreasing slots order, as returned by LoadBlocks. When state
+// gen signature verification is enabled, the signatures of the blocks are verified in parallel
+// with the sequential state transitions.
 func (s *State) ReplayBlocks(ctx context.Context, state *state.BeaconState, signed []*ethpb.SignedBeaconBlock, targetSlot uint64) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.ReplayBlocks")
 	defer span.End()
 
-	var err error
-	// The input block list is sorted in decreasing slots order.
-	if len(signed) > 0 {
-		for i := len(signed) - 1; i >= 0; i-- {
-			if state.Slot() >= targetSlot {
-				break
-			}
+	rec, err := s.newReplayRecorder(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	ordered := make([]*ethpb.SignedBeaconBlock, len(signed))
+	for i, b := range signed {
+		ordered[len(signed)-1-i] = b
+	}
+	return Replay(ctx, state, ordered, targetSlot, &ReplayOptions{
+		VerifySignatures: featureconfig.Get().EnableStateGenSigVerify,
+		OnBlock:          rec.verify,
+	})
+}
+
+// ReplayOptions configures a replay by Replay.
+type ReplayOptions struct {
+	// VerifySignatures verifies the proposer, randao and operation signatures of the replayed
+	// blocks on a pool of workers, in parallel with the state transitions.
+	VerifySignatures bool
+	// OnBlock is called with the post state of every replayed block, an error aborts the replay.
+	// The post state must not be modified.
+	OnBlock func(ctx context.Context, postState *state.BeaconState, signed *ethpb.SignedBeaconBlock) error
+}
 
-			if featureconfig.Get().EnableStateGenSigVerify {
-				state, err = transition.ExecuteStateTransition(ctx, state, signed[i])
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				state, err = executeStateTransitionStateGen(ctx, state, signed[i])
-				if err != nil {
-					return nil, err
-				}
+// Replay applies the input blocks, sorted in increasing slots order, to the start state and
+// processes the skip slots up to the target slot. Blocks past the target slot are ignored. It
+// doesn't depend on a DB, so tooling can replay blocks decoded from SSZ on any state. Blocks are
+// expected to have been verified before, the state roots they commit to aren't checked and their
+// signatures are only verified when requested. Nil options replay without verification.
+func Replay(
+	ctx context.Context,
+	startState *state.BeaconState,
+	signed []*ethpb.SignedBeaconBlock,
+	targetSlot uint64,
+	opts *ReplayOptions,
+) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.Replay")
+	defer span.End()
+
+	if opts == nil {
+		opts = &ReplayOptions{}
+	}
+	st := startState
+	verifier := newBlockSigVerifier(ctx, opts.VerifySignatures)
+	for _, b := range signed {
+		if st.Slot() >= targetSlot {
+			break
+		}
+		var err error
+		st, err = replayBlock(ctx, st, b, verifier)
+		if err != nil {
+			verifier.abort()
+			return nil, err
+		}
+		if opts.OnBlock != nil {
+			if err := opts.OnBlock(ctx, st, b); err != nil {
+				verifier.abort()
+				return nil, err
 			}
 		}
 	}
+	if err := verifier.wait(); err != nil {
+		return nil, err
+	}
 
 	// If there is skip slots at the end.
-	if targetSlot > state.Slot() {
-		if featureconfig.Get().EnableStateGenSigVerify {
-			state, err = transition.ProcessSlots(ctx, state, targetSlot)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			state, err = processSlotsStateGen(ctx, state, targetSlot)
-			if err != nil {
-				return nil, err
-			}
-		}
+	return replaySkipSlots(ctx, st, targetSlot, opts.VerifySignatures)
+}
+
+// This applies the state transition of a single replayed block. The signatures of the block
+// are verified by the verifier, if any.
+func replayBlock(
+	ctx context.Context,
+	state *state.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+	verifier *blockSigVerifier,
+) (*state.BeaconState, error) {
+	if verifier != nil {
+		return executeStateTransitionVerified(ctx, state, signed, verifier)
 	}
+	return executeStateTransitionStateGen(ctx, state, signed)
+}
 
-	return state, nil
+// This processes the skip slots after the last replayed block up to the target slot. Verified
+// replays process them with the regular slot processing.
+func replaySkipSlots(ctx context.Context, state *state.BeaconState, targetSlot uint64, verify bool) (*state.BeaconState, error) {
+	if targetSlot <= state.Slot() {
+		return state, nil
+	}
+	if verify {
+		return transition.ProcessSlots(ctx, state, targetSlot)
+	}
+	return processSlotsStateGen(ctx, state, targetSlot)
 }
 
 // LoadBlocks loads the blocks between start slot and end slot by recursively fetching from end block root.
@@ -277,13 +332,16 @@ func (s *State) lastSavedState(ctx context.Context, slot uint64) (*state.BeaconS
 	}
 
 	lastSaved, err := s.beaconDB.HighestSlotStatesBelow(ctx, slot+1)
-	if err != nil {
+	if err == db.ErrStateNotFound || (err == nil && len(lastSaved) == 0) {
 		return nil, errUnknownState
 	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get highest saved states")
+	}
 
 	// Given this is used to query canonical state. There should only be one saved canonical block of a given slot.
 	if len(lastSaved) != 1 {
-		return nil, fmt.Errorf("highest saved state does not equal to 1, it equals to %d", len(lastSaved))
+		return nil, errors.Wrapf(errUnknownState, "highest saved state does not equal to 1, it equals to %d", len(lastSaved))
 	}
 
 	return lastSaved[0], nil
@@ -295,5 +353,8 @@ func (s *State) genesisRoot(ctx context.Context) ([32]byte, error) {
 	if err != nil {
 		return [32]byte{}, err
 	}
+	if b == nil || b.Block == nil {
+		return [32]byte{}, errors.New("no genesis block in db")
+	}
 	return ssz.HashTreeRoot(b.Block)
 }
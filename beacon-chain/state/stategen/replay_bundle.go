@@ -0,0 +1,144 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// replayManifestFile is the file of a replay bundle describing the replay.
+const replayManifestFile = "replay.json"
+
+// replayRecorder keeps track of the blocks replayed on a start state when replay bundles are enabled.
+// The post state root of every replayed block is verified against the state root committed to by the
+// block, on a mismatch the replay is written to disk as a bundle which can be replayed offline.
+type replayRecorder struct {
+	dir        string
+	startSlot  uint64
+	startRoot  [32]byte
+	blocks     []*ethpb.SignedBeaconBlock
+	blockRoots [][32]byte
+}
+
+// replayBundle is the manifest of a replay bundle. The replayed blocks are written next to it as
+// block_<slot>.ssz files.
+type replayBundle struct {
+	StartSlot         uint64                    `json:"start_slot"`
+	StartStateRoot    string                    `json:"start_state_root"`
+	BlockRoots        []string                  `json:"block_roots"`
+	FailedSlot        uint64                    `json:"failed_slot"`
+	FailedBlockRoot   string                    `json:"failed_block_root"`
+	ExpectedStateRoot string                    `json:"expected_state_root"`
+	ActualStateRoot   string                    `json:"actual_state_root"`
+	BeaconConfig      *params.BeaconChainConfig `json:"beacon_config"`
+	FeatureConfig     *featureconfig.Flags      `json:"feature_config"`
+}
+
+// This returns a recorder for a replay on the start state, or nil if replay bundles are disabled.
+func (s *State) newReplayRecorder(ctx context.Context, startState *state.BeaconState) (*replayRecorder, error) {
+	if s.replayBundleDir == "" {
+		return nil, nil
+	}
+	startRoot, err := startState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash replay start state")
+	}
+	return &replayRecorder{
+		dir:       s.replayBundleDir,
+		startSlot: startState.Slot(),
+		startRoot: startRoot,
+	}, nil
+}
+
+// This records the replayed block and verifies the state it produced. When the state root doesn't
+// match the block's state root, the replay bundle is written and an error wrapping
+// errUnexpectedStateRoot is returned. A nil recorder verifies nothing.
+func (r *replayRecorder) verify(ctx context.Context, postState *state.BeaconState, signed *ethpb.SignedBeaconBlock) error {
+	if r == nil {
+		return nil
+	}
+	blockRoot, err := ssz.HashTreeRoot(signed.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not hash replayed block")
+	}
+	r.blocks = append(r.blocks, signed)
+	r.blockRoots = append(r.blockRoots, blockRoot)
+
+	got, err := postState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash replayed state")
+	}
+	want := bytesutil.ToBytes32(signed.Block.StateRoot)
+	if got == want {
+		return nil
+	}
+
+	replayUnexpectedStateRoots.Inc()
+	fields := logrus.Fields{
+		"slot":              signed.Block.Slot,
+		"blockRoot":         hex.EncodeToString(bytesutil.Trunc(blockRoot[:])),
+		"expectedStateRoot": hex.EncodeToString(bytesutil.Trunc(want[:])),
+		"actualStateRoot":   hex.EncodeToString(bytesutil.Trunc(got[:])),
+	}
+	bundleDir, err := r.writeBundle(signed.Block.Slot, blockRoot, want, got)
+	if err != nil {
+		log.WithError(err).WithFields(fields).Error("Replay produced an unexpected state root, could not write replay bundle")
+	} else {
+		log.WithFields(fields).WithField("bundle", bundleDir).Error("Replay produced an unexpected state root, wrote replay bundle")
+	}
+	return errors.Wrapf(errUnexpectedStateRoot, "block at slot %d wanted state root %#x, got %#x", signed.Block.Slot, want, got)
+}
+
+// This writes the recorded replay to a new directory of the bundle directory and returns its path.
+func (r *replayRecorder) writeBundle(failedSlot uint64, failedBlockRoot [32]byte, want [32]byte, got [32]byte) (string, error) {
+	dir := filepath.Join(r.dir, fmt.Sprintf("replay_%d_%x", failedSlot, failedBlockRoot[:4]))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Wrap(err, "could not create replay bundle directory")
+	}
+
+	bundle := &replayBundle{
+		StartSlot:         r.startSlot,
+		StartStateRoot:    fmt.Sprintf("%#x", r.startRoot),
+		BlockRoots:        make([]string, len(r.blockRoots)),
+		FailedSlot:        failedSlot,
+		FailedBlockRoot:   fmt.Sprintf("%#x", failedBlockRoot),
+		ExpectedStateRoot: fmt.Sprintf("%#x", want),
+		ActualStateRoot:   fmt.Sprintf("%#x", got),
+		BeaconConfig:      params.BeaconConfig(),
+		FeatureConfig:     featureconfig.Get(),
+	}
+	for i, root := range r.blockRoots {
+		bundle.BlockRoots[i] = fmt.Sprintf("%#x", root)
+	}
+	for _, b := range r.blocks {
+		enc, err := ssz.Marshal(b)
+		if err != nil {
+			return "", errors.Wrap(err, "could not encode replayed block")
+		}
+		fp := filepath.Join(dir, fmt.Sprintf("block_%d.ssz", b.Block.Slot))
+		if err := ioutil.WriteFile(fp, enc, 0600); err != nil {
+			return "", errors.Wrap(err, "could not write replayed block")
+		}
+	}
+	enc, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "could not encode replay manifest")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, replayManifestFile), enc, 0600); err != nil {
+		return "", errors.Wrap(err, "could not write replay manifest")
+	}
+	return dir, nil
+}
@@ -0,0 +1,140 @@
+package stategen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+)
+
+func TestReplayBlocks_BundleDirVerifiesStateRoots(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	dir, err := ioutil.TempDir("", "replay-bundles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	genesisState, lastRoot := saveReplayChain(t, db, 3)
+	service := New(db)
+	service.replayBundleDir = dir
+	blks, err := service.LoadBlocks(ctx, 1, 3, lastRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.ReplayBlocks(ctx, genesisState.Copy(), blks, 3); err != nil {
+		t.Fatalf("Could not replay blocks with matching state roots: %v", err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Wanted no replay bundle, got %d", len(files))
+	}
+}
+
+func TestReplayBlocks_UnexpectedStateRootWritesBundle(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	dir, err := ioutil.TempDir("", "replay-bundles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	genesisState, lastRoot := saveReplayChain(t, db, 3)
+	service := New(db)
+	service.replayBundleDir = dir
+	blks, err := service.LoadBlocks(ctx, 1, 3, lastRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Blocks are in decreasing slots order, corrupt the state root of the block at slot 2.
+	bad := proto.Clone(blks[1]).(*ethpb.SignedBeaconBlock)
+	bad.Block.StateRoot = make([]byte, 32)
+	blks[1] = bad
+	badRoot, err := ssz.HashTreeRoot(bad.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startRoot, err := genesisState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = service.ReplayBlocks(ctx, genesisState.Copy(), blks, 3)
+	if errors.Cause(err) != errUnexpectedStateRoot {
+		t.Fatalf("Wanted %v, got %v", errUnexpectedStateRoot, err)
+	}
+
+	bundles, err := filepath.Glob(filepath.Join(dir, "replay_2_*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bundles) != 1 {
+		t.Fatalf("Wanted 1 replay bundle, got %d", len(bundles))
+	}
+	enc, err := ioutil.ReadFile(filepath.Join(bundles[0], replayManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle := &replayBundle{}
+	if err := json.Unmarshal(enc, bundle); err != nil {
+		t.Fatal(err)
+	}
+	if bundle.StartSlot != 0 || bundle.FailedSlot != 2 {
+		t.Errorf("Wanted replay from slot 0 failing at slot 2, got %d and %d", bundle.StartSlot, bundle.FailedSlot)
+	}
+	if bundle.StartStateRoot != fmt32(startRoot) {
+		t.Errorf("Wanted start state root %s, got %s", fmt32(startRoot), bundle.StartStateRoot)
+	}
+	if len(bundle.BlockRoots) != 2 || bundle.BlockRoots[1] != fmt32(badRoot) || bundle.FailedBlockRoot != fmt32(badRoot) {
+		t.Errorf("Unexpected replayed block roots %v", bundle.BlockRoots)
+	}
+	if bundle.ExpectedStateRoot != fmt32([32]byte{}) || bundle.ActualStateRoot == bundle.ExpectedStateRoot {
+		t.Errorf("Unexpected state roots, expected %s and actual %s", bundle.ExpectedStateRoot, bundle.ActualStateRoot)
+	}
+	if bundle.BeaconConfig == nil || bundle.BeaconConfig.SlotsPerEpoch == 0 {
+		t.Error("Wanted the beacon config in the bundle")
+	}
+
+	enc, err = ioutil.ReadFile(filepath.Join(bundles[0], "block_2.ssz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(enc, blk); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(blk, bad) {
+		t.Error("Written block does not match the replayed block")
+	}
+}
+
+func fmt32(root [32]byte) string {
+	return fmt.Sprintf("%#x", root)
+}
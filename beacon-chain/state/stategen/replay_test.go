@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -30,10 +31,10 @@ func TestComputeStateUpToSlot_GenesisState(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := service.beaconDB.SaveBlock(ctx, gBlk); err != nil {
+	if err := db.SaveBlock(ctx, gBlk); err != nil {
 		t.Fatal(err)
 	}
-	if err := service.beaconDB.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
 		t.Fatal(err)
 	}
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
@@ -63,10 +64,10 @@ func TestComputeStateUpToSlot_CanProcessUpTo(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := service.beaconDB.SaveBlock(ctx, gBlk); err != nil {
+	if err := db.SaveBlock(ctx, gBlk); err != nil {
 		t.Fatal(err)
 	}
-	if err := service.beaconDB.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
 		t.Fatal(err)
 	}
 	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
@@ -157,6 +158,44 @@ func TestReplayBlocks_SameSlot(t *testing.T) {
 	}
 }
 
+func TestReplay_CallsOnBlock(t *testing.T) {
+	ctx := context.Background()
+	genesisState, endState, blks := generateReplayBlocks(t, 3)
+	ordered := make([]*ethpb.SignedBeaconBlock, len(blks))
+	for i, b := range blks {
+		ordered[len(blks)-1-i] = b
+	}
+
+	var slots []uint64
+	replayed, err := Replay(ctx, genesisState.Copy(), ordered, 3, &ReplayOptions{
+		OnBlock: func(_ context.Context, postState *stateTrie.BeaconState, signed *ethpb.SignedBeaconBlock) error {
+			if postState.Slot() != signed.Block.Slot {
+				t.Errorf("Wanted post state at slot %d, got %d", signed.Block.Slot, postState.Slot())
+			}
+			slots = append(slots, signed.Block.Slot)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(replayed.InnerStateUnsafe(), endState.InnerStateUnsafe()) {
+		t.Error("Replayed state does not match the state transition")
+	}
+	if !reflect.DeepEqual(slots, []uint64{1, 2, 3}) {
+		t.Errorf("Wanted callbacks for slots 1 to 3, got %v", slots)
+	}
+
+	wanted := errors.New("stop")
+	if _, err := Replay(ctx, genesisState.Copy(), ordered, 3, &ReplayOptions{
+		OnBlock: func(context.Context, *stateTrie.BeaconState, *ethpb.SignedBeaconBlock) error {
+			return wanted
+		},
+	}); err != wanted {
+		t.Errorf("Wanted the callback error, got %v", err)
+	}
+}
+
 func TestLoadBlocks_FirstBranch(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
@@ -364,10 +403,10 @@ func TestLastSavedBlock_Genesis(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := s.beaconDB.SaveBlock(ctx, gBlk); err != nil {
+	if err := db.SaveBlock(ctx, gBlk); err != nil {
 		t.Fatal(err)
 	}
-	if err := s.beaconDB.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
 		t.Fatal(err)
 	}
 
@@ -393,15 +432,15 @@ func TestLastSavedBlock_CanGet(t *testing.T) {
 	}
 
 	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: s.splitInfo.slot + 5}}
-	if err := s.beaconDB.SaveBlock(ctx, b1); err != nil {
+	if err := db.SaveBlock(ctx, b1); err != nil {
 		t.Fatal(err)
 	}
 	b2 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: s.splitInfo.slot + 10}}
-	if err := s.beaconDB.SaveBlock(ctx, b2); err != nil {
+	if err := db.SaveBlock(ctx, b2); err != nil {
 		t.Fatal(err)
 	}
 	b3 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: s.splitInfo.slot + 20}}
-	if err := s.beaconDB.SaveBlock(ctx, b3); err != nil {
+	if err := db.SaveBlock(ctx, b3); err != nil {
 		t.Fatal(err)
 	}
 
@@ -446,10 +485,10 @@ func TestLastSavedState_Genesis(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := s.beaconDB.SaveBlock(ctx, gBlk); err != nil {
+	if err := db.SaveBlock(ctx, gBlk); err != nil {
 		t.Fatal(err)
 	}
-	if err := s.beaconDB.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
+	if err := db.SaveGenesisBlockRoot(ctx, gRoot); err != nil {
 		t.Fatal(err)
 	}
 
@@ -472,11 +511,11 @@ func TestLastSavedState_CanGet(t *testing.T) {
 	}
 
 	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: s.splitInfo.slot + 5}}
-	if err := s.beaconDB.SaveBlock(ctx, b1); err != nil {
+	if err := db.SaveBlock(ctx, b1); err != nil {
 		t.Fatal(err)
 	}
 	b2 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: s.splitInfo.slot + 10}}
-	if err := s.beaconDB.SaveBlock(ctx, b2); err != nil {
+	if err := db.SaveBlock(ctx, b2); err != nil {
 		t.Fatal(err)
 	}
 	b2Root, _ := ssz.HashTreeRoot(b2.Block)
@@ -488,7 +527,7 @@ func TestLastSavedState_CanGet(t *testing.T) {
 		t.Fatal(err)
 	}
 	b3 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: s.splitInfo.slot + 20}}
-	if err := s.beaconDB.SaveBlock(ctx, b3); err != nil {
+	if err := db.SaveBlock(ctx, b3); err != nil {
 		t.Fatal(err)
 	}
 
@@ -511,7 +550,7 @@ func TestLastSavedState_NoSavedBlockState(t *testing.T) {
 	}
 
 	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 127}}
-	if err := s.beaconDB.SaveBlock(ctx, b1); err != nil {
+	if err := db.SaveBlock(ctx, b1); err != nil {
 		t.Fatal(err)
 	}
 
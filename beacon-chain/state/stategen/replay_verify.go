@@ -0,0 +1,207 @@
+package stategen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// maxSigVerifyWorkers bounds the workers verifying replayed block signatures. Every worker holds a
+// copy of a pre-state, and the fields it shares with the replayed state are copied on their next
+// write, so the workers are bounded regardless of the number of CPUs.
+const maxSigVerifyWorkers = 4
+
+// sigVerifyJob is a replayed block to verify the signatures of, along with the state at the
+// block's slot before the block is processed.
+type sigVerifyJob struct {
+	preState *state.BeaconState
+	block    *ethpb.SignedBeaconBlock
+}
+
+// blockSigVerifier verifies the signatures of the replayed blocks on a pool of workers. The
+// signatures of a block only depend on the state before the block, so the replay hands over a
+// copy of that state and moves on to the next block without waiting for the BLS verifications,
+// which take most of the time of a verified replay.
+type blockSigVerifier struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	jobs      chan *sigVerifyJob
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+	errLock   sync.Mutex
+	err       error
+}
+
+// This returns a verifier running a worker per CPU, up to the max sig verify workers, if signatures
+// are to be verified, or nil otherwise. All methods are no-ops on a nil verifier.
+func newBlockSigVerifier(ctx context.Context, verify bool) *blockSigVerifier {
+	if !verify {
+		return nil
+	}
+	workers := runtime.NumCPU()
+	if workers > maxSigVerifyWorkers {
+		workers = maxSigVerifyWorkers
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	v := &blockSigVerifier{
+		ctx:    ctx,
+		cancel: cancel,
+		// Jobs aren't queued, the replay waits for a free worker before moving on.
+		jobs: make(chan *sigVerifyJob),
+	}
+	v.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go v.run()
+	}
+	return v
+}
+
+func (v *blockSigVerifier) run() {
+	defer v.wg.Done()
+	for job := range v.jobs {
+		if v.ctx.Err() != nil {
+			continue
+		}
+		if err := verifyBlockSignatures(v.ctx, job.preState, job.block); err != nil {
+			v.errLock.Lock()
+			if v.err == nil {
+				v.err = errors.Wrapf(err, "could not verify signatures of block at slot %d", job.block.Block.Slot)
+			}
+			v.errLock.Unlock()
+			// The remaining jobs are skipped, the replay fails regardless of their outcome.
+			v.cancel()
+		}
+	}
+}
+
+// This hands the verification of the block's signatures over to a worker. It blocks while the
+// workers are busy, so at most a pre-state copy per worker, and the one handed over, is alive at once.
+func (v *blockSigVerifier) submit(preState *state.BeaconState, signed *ethpb.SignedBeaconBlock) {
+	if v == nil {
+		return
+	}
+	select {
+	case v.jobs <- &sigVerifyJob{preState: preState, block: signed}:
+	case <-v.ctx.Done():
+	}
+}
+
+// This waits for the queued verifications and returns the first failure, if any.
+func (v *blockSigVerifier) wait() error {
+	if v == nil {
+		return nil
+	}
+	v.closeOnce.Do(func() { close(v.jobs) })
+	v.wg.Wait()
+	defer v.cancel()
+	v.errLock.Lock()
+	defer v.errLock.Unlock()
+	if v.err != nil {
+		return v.err
+	}
+	// The jobs were skipped if the replay context was canceled.
+	return v.ctx.Err()
+}
+
+// This stops the verifications when the replay fails.
+func (v *blockSigVerifier) abort() {
+	if v == nil {
+		return
+	}
+	v.cancel()
+	v.closeOnce.Do(func() { close(v.jobs) })
+	v.wg.Wait()
+}
+
+// This verifies the proposer, randao, slashing, attestation and exit signatures of the block
+// against the state at the block's slot before the block is processed. The input state isn't
+// modified.
+func verifyBlockSignatures(ctx context.Context, preState *state.BeaconState, signed *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.verifyBlockSignatures")
+	defer span.End()
+
+	if err := blocks.VerifyBlockHeaderSignature(preState, signed); err != nil {
+		return errors.Wrap(err, "could not verify proposer signature")
+	}
+	body := signed.Block.Body
+	if err := blocks.VerifyRandao(preState, body); err != nil {
+		return err
+	}
+	for i, slashing := range body.ProposerSlashings {
+		if err := blocks.VerifyProposerSlashing(preState, slashing); err != nil {
+			return errors.Wrapf(err, "could not verify proposer slashing %d", i)
+		}
+	}
+	for i, slashing := range body.AttesterSlashings {
+		if err := blocks.VerifyAttesterSlashing(ctx, preState, slashing); err != nil {
+			return errors.Wrapf(err, "could not verify attester slashing %d", i)
+		}
+	}
+	for i, att := range body.Attestations {
+		if err := blocks.VerifyAttestation(ctx, preState, att); err != nil {
+			return errors.Wrapf(err, "could not verify attestation %d", i)
+		}
+	}
+	for i, exit := range body.VoluntaryExits {
+		if exit == nil || exit.Exit == nil {
+			return errors.New("nil voluntary exit in block body")
+		}
+		val, err := preState.ValidatorAtIndex(exit.Exit.ValidatorIndex)
+		if err != nil {
+			return err
+		}
+		if err := blocks.VerifyExit(val, preState.Slot(), preState.Fork(), exit); err != nil {
+			return errors.Wrapf(err, "could not verify exit %d", i)
+		}
+	}
+	return nil
+}
+
+// This applies the state transition of a replayed block like ExecuteStateTransition, with the
+// signature verifications handed over to the verifier.
+func executeStateTransitionVerified(
+	ctx context.Context,
+	st *state.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+	v *blockSigVerifier,
+) (*state.BeaconState, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return nil, errUnknownBlock
+	}
+
+	blocks.ClearEth1DataVoteCache()
+	ctx, span := trace.StartSpan(ctx, "stateGen.executeStateTransitionVerified")
+	defer span.End()
+
+	st, err := transition.ProcessSlots(ctx, st, signed.Block.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slot")
+	}
+	v.submit(st.Copy(), signed)
+	st, err = transition.ProcessBlockForStateRoot(ctx, st, signed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
+	}
+
+	postStateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(postStateRoot[:], signed.Block.StateRoot) {
+		return nil, fmt.Errorf("validate state root failed, wanted: %#x, received: %#x",
+			postStateRoot[:], signed.Block.StateRoot)
+	}
+	return st, nil
+}
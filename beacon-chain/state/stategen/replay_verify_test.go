@@ -0,0 +1,86 @@
+package stategen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// This generates a chain of full blocks from slot 1 to the end slot, in decreasing slots order
+// like LoadBlocks returns them, along with the genesis state and the state at the end slot.
+func generateReplayBlocks(t *testing.T, endSlot uint64) (*stateTrie.BeaconState, *stateTrie.BeaconState, []*ethpb.SignedBeaconBlock) {
+	ctx := context.Background()
+	genesisState, privs := testutil.DeterministicGenesisState(t, 32)
+	beaconState := genesisState.Copy()
+	blks := make([]*ethpb.SignedBeaconBlock, endSlot)
+	for i := uint64(1); i <= endSlot; i++ {
+		blk, err := testutil.GenerateFullBlock(beaconState, privs, testutil.DefaultBlockGenConfig(), i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blks[endSlot-i] = blk
+	}
+	return genesisState, beaconState, blks
+}
+
+func TestReplayBlocks_VerifiesSignatures(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableStateGenSigVerify: true})
+	defer featureconfig.Init(&featureconfig.Flags{})
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	genesisState, endState, blks := generateReplayBlocks(t, 4)
+	replayed, err := service.ReplayBlocks(ctx, genesisState.Copy(), blks, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(replayed.InnerStateUnsafe(), endState.InnerStateUnsafe()) {
+		t.Error("Replayed state does not match the state transition")
+	}
+
+	// The signature isn't part of the block root, only the signature verification catches it.
+	tampered := proto.Clone(blks[1]).(*ethpb.SignedBeaconBlock)
+	tampered.Signature = blks[0].Signature
+	blks[1] = tampered
+	if _, err := service.ReplayBlocks(ctx, genesisState.Copy(), blks, 4); err == nil ||
+		!strings.Contains(err.Error(), "could not verify signatures of block at slot 3") {
+		t.Errorf("Wanted signature verification failure, got %v", err)
+	}
+}
+
+func TestVerifyBlockSignatures_DoesNotModifyState(t *testing.T) {
+	ctx := context.Background()
+	genesisState, _, blks := generateReplayBlocks(t, 1)
+	preState, err := state.ProcessSlots(ctx, genesisState.Copy(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRoot, err := preState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyBlockSignatures(ctx, preState, blks[0]); err != nil {
+		t.Fatal(err)
+	}
+	gotRoot, err := preState.HashTreeRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotRoot != wantRoot {
+		t.Error("Verifying the block signatures modified the state")
+	}
+}
@@ -0,0 +1,181 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// replayLatencyWeight is the weight of the latest replay in the moving average of replay latencies.
+const replayLatencyWeight = 0.2
+
+// savePolicy decides which hot states are saved in full. A full state is always saved on every
+// epoch boundary, hot states are replayed from it. The adaptive policy also saves full states
+// within epochs and adjusts the number of slots between them once per epoch: it halves it while
+// the average replay latency of hot state loads is above the target, down to a quarter of an
+// epoch, and doubles it while the free disk space of the DB is below the minimum, up to an epoch.
+// Disk pressure takes precedence, a full disk crashes the node. Once replays are fast again the
+// interval goes back to an epoch.
+type savePolicy struct {
+	lock             sync.Mutex
+	interval         uint64
+	minInterval      uint64
+	maxInterval      uint64
+	replayTarget     time.Duration
+	replayLatency    time.Duration
+	minFreeDiskSpace uint64
+	freeDiskSpace    func() (uint64, error)
+	lastAdjustSlot   uint64
+}
+
+func newSavePolicy(freeDiskSpace func() (uint64, error), replayTarget time.Duration, minFreeDiskSpace uint64) *savePolicy {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	minInterval := slotsPerEpoch / 4
+	if minInterval == 0 {
+		minInterval = 1
+	}
+	return &savePolicy{
+		interval:         slotsPerEpoch,
+		minInterval:      minInterval,
+		maxInterval:      slotsPerEpoch,
+		replayTarget:     replayTarget,
+		minFreeDiskSpace: minFreeDiskSpace,
+		freeDiskSpace:    freeDiskSpace,
+	}
+}
+
+// EnableAdaptiveSaves replaces the epoch boundary rule deciding which hot states are saved in full
+// with the adaptive save policy, using the free disk space of the input DB directory and the
+// replay latency target and minimum free disk space flags.
+func (s *State) EnableAdaptiveSaves(dbPath string) {
+	s.savePolicy = newSavePolicy(
+		func() (uint64, error) { return diskFreeSpace(dbPath) },
+		flags.Get().AdaptiveSaveReplayTarget,
+		flags.Get().AdaptiveSaveMinFreeDiskSpace,
+	)
+	log.WithFields(logrus.Fields{
+		"replayTarget":       flags.Get().AdaptiveSaveReplayTarget,
+		"minFreeDiskSpaceMB": flags.Get().AdaptiveSaveMinFreeDiskSpace / (1024 * 1024),
+	}).Info("Enabled adaptive hot state saves")
+}
+
+// This returns true if the hot state of the input slot has to be saved in full.
+func (s *State) saveFullState(slot uint64) bool {
+	if s.savePolicy == nil {
+		return helpers.IsEpochStart(slot)
+	}
+	return s.savePolicy.shouldSave(slot)
+}
+
+// This returns the last saved state the hot state of the input block root is replayed from: the
+// state of its epoch boundary. With adaptive saves, full states may also be saved within the epoch,
+// the state of the closest ancestor of the block above the epoch boundary is used when saved.
+func (s *State) replayStartState(ctx context.Context, blockRoot [32]byte, slot uint64, boundarySlot uint64) (*state.BeaconState, error) {
+	if s.savePolicy != nil {
+		st, err := s.lastSavedAncestorState(ctx, blockRoot, slot, boundarySlot)
+		if err != nil {
+			return nil, err
+		}
+		if st != nil {
+			return st, nil
+		}
+	}
+	return s.lastSavedState(ctx, boundarySlot)
+}
+
+// This walks back the ancestors of the input block root down to the epoch boundary slot, it returns
+// the first saved state of an ancestor, or nil when none of them has a saved state.
+func (s *State) lastSavedAncestorState(ctx context.Context, blockRoot [32]byte, slot uint64, boundarySlot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.lastSavedAncestorState")
+	defer span.End()
+
+	root := blockRoot
+	for {
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil || b.Block == nil || b.Block.Slot <= boundarySlot {
+			return nil, nil
+		}
+		if s.beaconDB.HasState(ctx, root) {
+			st, err := s.beaconDB.State(ctx, root)
+			if err != nil {
+				return nil, err
+			}
+			if st != nil && st.Slot() <= slot {
+				return st, nil
+			}
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+}
+
+// This records the latency of a hot state load which replayed blocks.
+func (s *State) observeReplay(latency time.Duration) {
+	if s.savePolicy != nil {
+		s.savePolicy.observeReplay(latency)
+	}
+}
+
+func (p *savePolicy) shouldSave(slot uint64) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if helpers.IsEpochStart(slot) && slot > p.lastAdjustSlot {
+		p.lastAdjustSlot = slot
+		p.adjust()
+	}
+	return helpers.IsEpochStart(slot) || slot%p.interval == 0
+}
+
+func (p *savePolicy) observeReplay(latency time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.replayLatency == 0 {
+		p.replayLatency = latency
+		return
+	}
+	p.replayLatency = time.Duration(replayLatencyWeight*float64(latency) + (1-replayLatencyWeight)*float64(p.replayLatency))
+}
+
+// This adjusts the save interval to the disk pressure and the replay latencies, the lock must be held.
+func (p *savePolicy) adjust() {
+	diskPressure := false
+	free, err := p.freeDiskSpace()
+	if err == nil {
+		diskPressure = free < p.minFreeDiskSpace
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	interval := p.interval
+	switch {
+	case diskPressure:
+		if interval < p.maxInterval {
+			interval *= 2
+		}
+	case p.replayLatency > p.replayTarget:
+		if interval > p.minInterval {
+			interval /= 2
+		}
+	case p.replayLatency < p.replayTarget/2 && interval < slotsPerEpoch:
+		interval *= 2
+	}
+	fullStateSaveInterval.Set(float64(interval))
+	if interval == p.interval {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"previousInterval": p.interval,
+		"interval":         interval,
+		"replayLatency":    p.replayLatency,
+		"diskPressure":     diskPressure,
+	}).Info("Adjusted the number of slots between full hot state saves")
+	p.interval = interval
+}
@@ -0,0 +1,111 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestSavePolicy_DefaultsToEpochBoundaries(t *testing.T) {
+	s := &State{}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	if !s.saveFullState(slotsPerEpoch) || s.saveFullState(slotsPerEpoch+1) {
+		t.Error("Expected full states to be saved on epoch boundaries only")
+	}
+}
+
+func TestSavePolicy_Adjusts(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	free := uint64(100)
+	p := newSavePolicy(func() (uint64, error) { return free, nil }, time.Second, 10)
+
+	// Slow replays save full states more often.
+	p.observeReplay(3 * time.Second)
+	p.shouldSave(slotsPerEpoch)
+	if p.interval != slotsPerEpoch/2 {
+		t.Errorf("Wanted interval %d, got %d", slotsPerEpoch/2, p.interval)
+	}
+	if !p.shouldSave(slotsPerEpoch + slotsPerEpoch/2) {
+		t.Error("Expected a full state save within the epoch")
+	}
+
+	// Disk pressure takes precedence over replay latencies.
+	free = 1
+	p.shouldSave(2 * slotsPerEpoch)
+	if p.interval != slotsPerEpoch {
+		t.Errorf("Wanted interval %d, got %d", slotsPerEpoch, p.interval)
+	}
+	// Epoch boundary states are saved whatever the disk pressure.
+	if !p.shouldSave(3 * slotsPerEpoch) {
+		t.Error("Expected a full state save on the epoch boundary")
+	}
+	if p.interval != slotsPerEpoch {
+		t.Errorf("Wanted interval %d, got %d", slotsPerEpoch, p.interval)
+	}
+
+	// The interval is only adjusted once per epoch.
+	free = 100
+	p.observeReplay(10 * time.Second)
+	p.shouldSave(3 * slotsPerEpoch)
+	if p.interval != slotsPerEpoch {
+		t.Errorf("Wanted interval %d, got %d", slotsPerEpoch, p.interval)
+	}
+}
+
+func TestLastSavedAncestorState_IgnoresForks(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := New(db)
+
+	boundarySlot := params.BeaconConfig().SlotsPerEpoch
+	saveBlock := func(slot uint64, parent [32]byte) [32]byte {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parent[:]}}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	saveState := func(slot uint64, root [32]byte) {
+		st, _ := testutil.DeterministicGenesisState(t, 1)
+		if err := st.SetSlot(slot); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, st, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	boundary := saveBlock(boundarySlot, [32]byte{})
+	ancestor := saveBlock(boundarySlot+1, boundary)
+	target := saveBlock(boundarySlot+3, ancestor)
+	// The highest saved state below the target slot is on a fork.
+	fork := saveBlock(boundarySlot+2, boundary)
+	saveState(boundarySlot+2, fork)
+
+	st, err := service.lastSavedAncestorState(ctx, target, boundarySlot+3, boundarySlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != nil {
+		t.Errorf("Did not want the state of a fork, received state at slot %d", st.Slot())
+	}
+
+	saveState(boundarySlot+1, ancestor)
+	st, err = service.lastSavedAncestorState(ctx, target, boundarySlot+3, boundarySlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st == nil || st.Slot() != boundarySlot+1 {
+		t.Error("Wanted the saved state of the ancestor")
+	}
+}
@@ -2,13 +2,18 @@ package stategen
 
 import (
 	"context"
+	"encoding/hex"
 	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -17,12 +22,27 @@ const archivedInterval = 256
 // State represents a management object that handles the internal
 // logic of maintaining both hot and cold states in DB.
 type State struct {
-	beaconDB                db.NoHeadAccessDatabase
+	beaconDB                Database
 	slotsPerArchivedPoint   uint64
+	slotsPerCheckpoint      uint64
+	archivedPointsPerFull   uint64
 	epochBoundarySlotToRoot map[uint64][32]byte
 	epochBoundaryLock       sync.RWMutex
 	hotStateCache           *cache.HotStateCache
+	stateSummaryCache       *cache.StateSummaryCache
+	savePolicy              *savePolicy // Nil unless adaptive saves are enabled.
 	splitInfo               *splitSlotAndRoot
+	splitInfoLock           sync.RWMutex
+	activeLoads             int64 // Number of state loads in progress, accessed atomically.
+	replayPrefetchDepth     uint64
+	replayBundleDir         string
+	migrationRequests       chan [32]byte
+	boundaryRequests        chan [32]byte
+	boundaryState           *boundaryState
+	boundaryStateLock       sync.RWMutex
+	softDeleteWindow        time.Duration // States deleted by migrations are restorable for this long.
+	headFetcher             HeadFetcher
+	clock                   Clock
 }
 
 // This tracks the split point. The point where slot and the block root of
@@ -32,15 +52,60 @@ type splitSlotAndRoot struct {
 	root [32]byte
 }
 
+// This returns the current split point. The split point is moved by the migration routine while
+// states are loaded, it's only read through the lock.
+func (s *State) split() splitSlotAndRoot {
+	s.splitInfoLock.RLock()
+	defer s.splitInfoLock.RUnlock()
+	return *s.splitInfo
+}
+
+// This moves the split point to the input slot and root.
+func (s *State) setSplit(slot uint64, root [32]byte) {
+	s.splitInfoLock.Lock()
+	defer s.splitInfoLock.Unlock()
+	s.splitInfo = &splitSlotAndRoot{slot: slot, root: root}
+}
+
 // New returns a new state management object.
-func New(db db.NoHeadAccessDatabase) *State {
-	return &State{
-		beaconDB:                db,
+func New(beaconDB Database) *State {
+	s := &State{
+		beaconDB:                beaconDB,
 		epochBoundarySlotToRoot: make(map[uint64][32]byte),
-		hotStateCache:           cache.NewHotStateCache(),
+		hotStateCache:           cache.NewHotStateCache(flags.Get().HotStateCacheSize),
+		stateSummaryCache:       cache.NewStateSummaryCache(),
 		splitInfo:               &splitSlotAndRoot{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   archivedInterval,
+		replayPrefetchDepth:     uint64(flags.Get().ReplayPrefetchDepth),
+		replayBundleDir:         flags.Get().ReplayBundleDir,
+		migrationRequests:       make(chan [32]byte, 1),
+		boundaryRequests:        make(chan [32]byte, 1),
+		softDeleteWindow:        flags.Get().StateSoftDeleteWindow,
+		clock:                   systemClock{},
+	}
+	if slotsPerArchivedPoint := uint64(flags.Get().SlotsPerArchivedPoint); slotsPerArchivedPoint != 0 {
+		if verifySlotsPerArchivePoint(slotsPerArchivedPoint) {
+			s.slotsPerArchivedPoint = slotsPerArchivedPoint
+		} else {
+			log.WithFields(logrus.Fields{
+				"slotsPerArchivedPoint": slotsPerArchivedPoint,
+				"default":               archivedInterval,
+			}).Warn("Using the default archived point interval, interval must be a multiple of the slots per epoch")
+		}
 	}
+	if slotsPerCheckpoint := uint64(flags.Get().SlotsPerColdCheckpoint); slotsPerCheckpoint != 0 {
+		if verifySlotsPerCheckpoint(slotsPerCheckpoint, s.slotsPerArchivedPoint) {
+			s.slotsPerCheckpoint = slotsPerCheckpoint
+		} else {
+			log.WithField("slotsPerCheckpoint", slotsPerCheckpoint).Warn(
+				"Cold state checkpoints disabled, interval must be a multiple of the slots per epoch " +
+					"dividing the slots per archived point")
+		}
+	}
+	if archivedPointsPerFull := uint64(flags.Get().ArchivedPointsPerFullState); archivedPointsPerFull > 1 {
+		s.archivedPointsPerFull = archivedPointsPerFull
+	}
+	return s
 }
 
 // Resume resumes a new state management object from previously saved finalized check point in DB.
@@ -53,13 +118,28 @@ func (s *State) Resume(ctx context.Context) (*state.BeaconState, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The last archived state may have been saved as a diff.
+	if lastArchivedState == nil && lastArchivedRoot != params.BeaconConfig().ZeroHash {
+		lastArchivedState, err = s.loadArchivedPointDiff(ctx, lastArchivedRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Resume as genesis state if there's no last archived state.
 	if lastArchivedState == nil {
+		if err := s.recoverStateSummaries(ctx); err != nil {
+			return nil, errors.Wrap(err, "could not recover state summaries")
+		}
 		return s.beaconDB.GenesisState(ctx)
 	}
 
-	s.splitInfo = &splitSlotAndRoot{slot: lastArchivedState.Slot(), root: lastArchivedRoot}
+	if err := s.restoreSplitInfo(ctx, lastArchivedState.Slot(), lastArchivedRoot); err != nil {
+		return nil, err
+	}
+	if err := s.recoverStateSummaries(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not recover state summaries")
+	}
 
 	// In case the finalized state slot was skipped.
 	slot := lastArchivedState.Slot()
@@ -70,6 +150,43 @@ func (s *State) Resume(ctx context.Context) (*state.BeaconState, error) {
 	return lastArchivedState, nil
 }
 
+// This restores the split point saved by the last migration. When it's missing, as with a DB
+// written before the split point was persisted, or behind the last archived state, the split point
+// is recovered from the last archived state and saved.
+func (s *State) restoreSplitInfo(ctx context.Context, lastArchivedSlot uint64, lastArchivedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.restoreSplitInfo")
+	defer span.End()
+
+	if s.beaconDB.HasSplitInfo(ctx) {
+		slot, root, err := s.beaconDB.SplitInfo(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve split info")
+		}
+		if slot >= lastArchivedSlot && s.beaconDB.HasState(ctx, root) {
+			s.setSplit(slot, root)
+			log.WithFields(logrus.Fields{
+				"slot": slot,
+				"root": hex.EncodeToString(bytesutil.Trunc(root[:])),
+			}).Info("Restored hot and cold state split point")
+			return nil
+		}
+		log.WithFields(logrus.Fields{
+			"slot":             slot,
+			"lastArchivedSlot": lastArchivedSlot,
+		}).Warn("Saved split point is inconsistent with the last archived state")
+	}
+
+	s.setSplit(lastArchivedSlot, lastArchivedRoot)
+	log.WithFields(logrus.Fields{
+		"slot": lastArchivedSlot,
+		"root": hex.EncodeToString(bytesutil.Trunc(lastArchivedRoot[:])),
+	}).Info("Recovered hot and cold state split point from the last archived state")
+	if err := s.beaconDB.SaveSplitInfo(ctx, lastArchivedSlot, lastArchivedRoot); err != nil {
+		return errors.Wrap(err, "could not save split info")
+	}
+	return nil
+}
+
 // This verifies the archive point frequency is valid. It checks the interval
 // is a divisor of the number of slots per epoch. This ensures we have at least one
 // archive point within range of our state root history when iterating
@@ -79,3 +196,13 @@ func verifySlotsPerArchivePoint(slotsPerArchivePoint uint64) bool {
 	return slotsPerArchivePoint > 0 &&
 		slotsPerArchivePoint%params.BeaconConfig().SlotsPerEpoch == 0
 }
+
+// This verifies the cold state checkpoint frequency is valid. Checkpoints are taken on epoch
+// boundaries in between archived points, so the interval has to be a multiple of the number of
+// slots per epoch and a proper divisor of the archived point interval.
+func verifySlotsPerCheckpoint(slotsPerCheckpoint uint64, slotsPerArchivePoint uint64) bool {
+	return slotsPerCheckpoint > 0 &&
+		slotsPerCheckpoint%params.BeaconConfig().SlotsPerEpoch == 0 &&
+		slotsPerCheckpoint < slotsPerArchivePoint &&
+		slotsPerArchivePoint%slotsPerCheckpoint == 0
+}
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
@@ -37,6 +40,89 @@ func TestResume(t *testing.T) {
 	if root != service.splitInfo.root {
 		t.Errorf("Did not get wanted root")
 	}
+	slot, savedRoot, err := service.beaconDB.SplitInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != params.BeaconConfig().SlotsPerEpoch || savedRoot != root {
+		t.Error("Did not save recovered split info")
+	}
+}
+
+func TestResume_RecoversStateSummaries(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	// The summary of the block was cached but not flushed before an unclean shutdown.
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	if err := db.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(b.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := New(db)
+	if _, err := service.Resume(ctx); err != nil {
+		t.Fatal(err)
+	}
+	summary, err := db.StateSummary(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary == nil || summary.Slot != 5 {
+		t.Errorf("Wanted recovered summary of slot 5, got %v", summary)
+	}
+}
+
+func TestResume_RestoresSplitInfo(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	archivedRoot := [32]byte{'A'}
+	archivedState, _ := testutil.DeterministicGenesisState(t, 32)
+	archivedState.SetSlot(params.BeaconConfig().SlotsPerEpoch)
+	if err := service.beaconDB.SaveState(ctx, archivedState, archivedRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveArchivedPointRoot(ctx, archivedRoot, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveLastArchivedIndex(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	splitRoot := [32]byte{'B'}
+	splitSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	splitState, _ := testutil.DeterministicGenesisState(t, 32)
+	splitState.SetSlot(splitSlot)
+	if err := service.beaconDB.SaveState(ctx, splitState, splitRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.beaconDB.SaveSplitInfo(ctx, splitSlot, splitRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Resume(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if service.splitInfo.slot != splitSlot || service.splitInfo.root != splitRoot {
+		t.Errorf("Wanted split slot %d, received %d", splitSlot, service.splitInfo.slot)
+	}
+
+	// A split point behind the last archived state is recovered from the last archived state.
+	if err := service.beaconDB.SaveSplitInfo(ctx, 0, splitRoot); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Resume(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if service.splitInfo.slot != params.BeaconConfig().SlotsPerEpoch || service.splitInfo.root != archivedRoot {
+		t.Errorf("Wanted split slot %d, received %d", params.BeaconConfig().SlotsPerEpoch, service.splitInfo.slot)
+	}
 }
 
 func TestVerifySlotsPerArchivePoint(t *testing.T) {
@@ -58,3 +144,25 @@ func TestVerifySlotsPerArchivePoint(t *testing.T) {
 		}
 	}
 }
+
+func TestNew_SlotsPerArchivedPoint(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	defer flags.Init(&flags.GlobalFlags{})
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		flag int
+		want uint64
+	}{
+		{flag: 0, want: archivedInterval},
+		{flag: int(2 * slotsPerEpoch), want: 2 * slotsPerEpoch},
+		{flag: int(slotsPerEpoch + 1), want: archivedInterval},
+	}
+	for _, tt := range tests {
+		flags.Init(&flags.GlobalFlags{SlotsPerArchivedPoint: tt.flag})
+		if got := New(db).slotsPerArchivedPoint; got != tt.want {
+			t.Errorf("Slots per archived point flag %d: got interval %d, wanted %d", tt.flag, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package stategen
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// fakeClock is a clock only moving forward when advanced by the simulation.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// crashingDB fails to persist the split point, as if the node crashed at the end of a migration.
+type crashingDB struct {
+	Database
+}
+
+func (c *crashingDB) SaveSplitInfo(_ context.Context, _ uint64, _ [32]byte) error {
+	return errors.New("crashed")
+}
+
+// This saves a hot state at slot 3 and returns the finalized state of the first epoch boundary,
+// migrating it moves the hot state out of the hot section.
+func setupMigrationSimulation(t *testing.T, beaconDB db.Database) (*state.BeaconState, [32]byte) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch)
+	b := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{Slot: 3},
+	}
+	if err := beaconDB.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	bRoot, _ := ssz.HashTreeRoot(b.Block)
+	if err := beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:], Slot: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, beaconState, bRoot); err != nil {
+		t.Fatal(err)
+	}
+	return beaconState, bRoot
+}
+
+func TestSimulation_PurgeFollowsClock(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	finalizedState, bRoot := setupMigrationSimulation(t, db)
+
+	clock := &fakeClock{now: time.Now()}
+	service := New(db)
+	service.SetClock(clock)
+	service.slotsPerArchivedPoint = 2
+	service.softDeleteWindow = time.Hour
+
+	if err := service.MigrateToCold(ctx, finalizedState, [32]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.purgeSoftDeletedStates(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RestoreSoftDeletedState(ctx, bRoot); err != nil {
+		t.Fatalf("Could not restore state within the soft delete window: %v", err)
+	}
+
+	if err := service.deleteHotState(ctx, bRoot); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(2 * time.Hour)
+	if err := service.purgeSoftDeletedStates(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RestoreSoftDeletedState(ctx, bRoot); err == nil {
+		t.Error("Expected state to be purged after the soft delete window")
+	}
+	if db.HasState(ctx, bRoot) {
+		t.Error("Expected purged state to be deleted")
+	}
+}
+
+func TestSimulation_RestartAfterCrashedMigration(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	finalizedState, bRoot := setupMigrationSimulation(t, db)
+
+	service := New(&crashingDB{Database: db})
+	service.slotsPerArchivedPoint = 2
+	if err := service.MigrateToCold(ctx, finalizedState, [32]byte{}); err == nil {
+		t.Fatal("Expected migration to fail")
+	}
+	if db.HasSplitInfo(ctx) {
+		t.Fatal("Expected split info to not be saved")
+	}
+	if db.HasState(ctx, bRoot) {
+		t.Error("Expected migrated state to be deleted before the crash")
+	}
+
+	// The restarted service migrates again from the persisted split point.
+	service = New(db)
+	service.slotsPerArchivedPoint = 2
+	if err := service.MigrateToCold(ctx, finalizedState, [32]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	slot, _, err := db.SplitInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != finalizedState.Slot() {
+		t.Errorf("Wanted split slot %d, got %d", finalizedState.Slot(), slot)
+	}
+}
@@ -0,0 +1,134 @@
+package stategen
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// computeStateDiff returns the difference of the target state against the base state. Only the
+// validators, balances and roots which changed are kept, the other fields are stored as is.
+func computeStateDiff(base *state.BeaconState, target *state.BeaconState) (*pb.StateDiff, error) {
+	baseState := base.InnerStateUnsafe()
+	remainder := target.CloneInnerState()
+
+	diff := &pb.StateDiff{
+		BaseSlot:         base.Slot(),
+		ValidatorsLength: uint64(len(remainder.Validators)),
+		BalancesLength:   uint64(len(remainder.Balances)),
+	}
+	for i, val := range remainder.Validators {
+		if i < len(baseState.Validators) && proto.Equal(baseState.Validators[i], val) {
+			continue
+		}
+		diff.Validators = append(diff.Validators, &pb.IndexedValidator{Index: uint64(i), Validator: val})
+	}
+	for i, bal := range remainder.Balances {
+		if i < len(baseState.Balances) && baseState.Balances[i] == bal {
+			continue
+		}
+		diff.Balances = append(diff.Balances, &pb.IndexedBalance{Index: uint64(i), Balance: bal})
+	}
+	var err error
+	if diff.BlockRoots, err = diffRoots("block roots", baseState.BlockRoots, remainder.BlockRoots); err != nil {
+		return nil, err
+	}
+	if diff.StateRoots, err = diffRoots("state roots", baseState.StateRoots, remainder.StateRoots); err != nil {
+		return nil, err
+	}
+	if diff.RandaoMixes, err = diffRoots("randao mixes", baseState.RandaoMixes, remainder.RandaoMixes); err != nil {
+		return nil, err
+	}
+
+	remainder.Validators = nil
+	remainder.Balances = nil
+	remainder.BlockRoots = nil
+	remainder.StateRoots = nil
+	remainder.RandaoMixes = nil
+	diff.Remainder = remainder
+	return diff, nil
+}
+
+// applyStateDiff reconstructs the state the diff was computed for, from the base state it was
+// computed against.
+func applyStateDiff(base *state.BeaconState, diff *pb.StateDiff) (*state.BeaconState, error) {
+	if diff.Remainder == nil {
+		return nil, fmt.Errorf("state diff at base slot %d has no remainder", diff.BaseSlot)
+	}
+	if base.Slot() != diff.BaseSlot {
+		return nil, fmt.Errorf("state diff applies to slot %d, base state is at slot %d", diff.BaseSlot, base.Slot())
+	}
+	baseState := base.CloneInnerState()
+	st := proto.Clone(diff.Remainder).(*pb.BeaconState)
+
+	st.Validators = make([]*ethpb.Validator, diff.ValidatorsLength)
+	copy(st.Validators, baseState.Validators)
+	for _, v := range diff.Validators {
+		if v.Index >= diff.ValidatorsLength {
+			return nil, fmt.Errorf("validator index %d out of range", v.Index)
+		}
+		st.Validators[v.Index] = v.Validator
+	}
+	for i, v := range st.Validators {
+		if v == nil {
+			return nil, fmt.Errorf("state diff is missing validator %d", i)
+		}
+	}
+
+	st.Balances = make([]uint64, diff.BalancesLength)
+	copy(st.Balances, baseState.Balances)
+	// Balances appended after the base state must all be part of the diff.
+	appended := uint64(0)
+	for _, b := range diff.Balances {
+		if b.Index >= diff.BalancesLength {
+			return nil, fmt.Errorf("balance index %d out of range", b.Index)
+		}
+		if b.Index >= uint64(len(baseState.Balances)) {
+			appended++
+		}
+		st.Balances[b.Index] = b.Balance
+	}
+	if baseLen := uint64(len(baseState.Balances)); diff.BalancesLength > baseLen && appended != diff.BalancesLength-baseLen {
+		return nil, fmt.Errorf("state diff is missing balances")
+	}
+
+	var err error
+	if st.BlockRoots, err = applyRoots(baseState.BlockRoots, diff.BlockRoots); err != nil {
+		return nil, err
+	}
+	if st.StateRoots, err = applyRoots(baseState.StateRoots, diff.StateRoots); err != nil {
+		return nil, err
+	}
+	if st.RandaoMixes, err = applyRoots(baseState.RandaoMixes, diff.RandaoMixes); err != nil {
+		return nil, err
+	}
+	return state.InitializeFromProtoUnsafe(st)
+}
+
+// diffRoots returns the roots of the target vector which differ from the base vector.
+func diffRoots(name string, base [][]byte, target [][]byte) ([]*pb.IndexedRoot, error) {
+	if len(base) != len(target) {
+		return nil, fmt.Errorf("can't diff %s of different lengths %d and %d", name, len(base), len(target))
+	}
+	var changed []*pb.IndexedRoot
+	for i, r := range target {
+		if !bytes.Equal(base[i], r) {
+			changed = append(changed, &pb.IndexedRoot{Index: uint64(i), Root: r})
+		}
+	}
+	return changed, nil
+}
+
+func applyRoots(base [][]byte, changed []*pb.IndexedRoot) ([][]byte, error) {
+	for _, r := range changed {
+		if r.Index >= uint64(len(base)) {
+			return nil, fmt.Errorf("root index %d out of range", r.Index)
+		}
+		base[r.Index] = r.Root
+	}
+	return base, nil
+}
@@ -0,0 +1,121 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestStateDiff_RoundTrip(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	target := base.Copy()
+	if err := target.SetSlot(64); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.UpdateBalancesAtIndex(3, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.UpdateBlockRootAtIndex(5, [32]byte{'a'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.AppendValidator(&ethpb.Validator{PublicKey: []byte{'b'}, WithdrawalCredentials: make([]byte, 32)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.AppendBalance(32); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := computeStateDiff(base, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Balances) != 2 || len(diff.Validators) != 1 || len(diff.BlockRoots) != 1 {
+		t.Errorf("Wanted only the changed fields in the diff, received %d balances, %d validators and %d block roots",
+			len(diff.Balances), len(diff.Validators), len(diff.BlockRoots))
+	}
+
+	applied, err := applyStateDiff(base, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(applied.InnerStateUnsafe(), target.InnerStateUnsafe()) {
+		t.Error("Did not reconstruct the target state from the diff")
+	}
+
+	if _, err := applyStateDiff(target, diff); err == nil {
+		t.Error("Expected error applying a diff to a state of another slot")
+	}
+}
+
+func TestCheckpointState(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	service := New(db)
+	service.slotsPerArchivedPoint = 256
+	service.slotsPerCheckpoint = 64
+
+	archivedState, _ := testutil.DeterministicGenesisState(t, 32)
+	checkpoint := archivedState.Copy()
+	if err := checkpoint.SetSlot(128); err != nil {
+		t.Fatal(err)
+	}
+	diff, err := computeStateDiff(archivedState, checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateDiff(ctx, 128, diff); err != nil {
+		t.Fatal(err)
+	}
+
+	// The highest checkpoint below the slot is used.
+	st, err := service.checkpointState(ctx, archivedState, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Slot() != 128 {
+		t.Errorf("Wanted checkpoint state at slot 128, received slot %d", st.Slot())
+	}
+
+	// Without a checkpoint below the slot, the archived point state is used.
+	st, err = service.checkpointState(ctx, archivedState, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != archivedState {
+		t.Error("Wanted the archived point state")
+	}
+
+	service.slotsPerCheckpoint = 0
+	st, err = service.checkpointState(ctx, archivedState, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != archivedState {
+		t.Error("Wanted the archived point state with checkpoints disabled")
+	}
+}
+
+func TestVerifySlotsPerCheckpoint(t *testing.T) {
+	tests := []struct {
+		checkpoint uint64
+		archived   uint64
+		valid      bool
+	}{
+		{checkpoint: 0, archived: 256, valid: false},
+		{checkpoint: 64, archived: 256, valid: true},
+		{checkpoint: 96, archived: 256, valid: false},
+		{checkpoint: 256, archived: 256, valid: false},
+		{checkpoint: 65, archived: 260, valid: false},
+	}
+	for _, tt := range tests {
+		if got := verifySlotsPerCheckpoint(tt.checkpoint, tt.archived); got != tt.valid {
+			t.Errorf("verifySlotsPerCheckpoint(%d, %d) = %v, wanted %v", tt.checkpoint, tt.archived, got, tt.valid)
+		}
+	}
+}
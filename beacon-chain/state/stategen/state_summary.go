@@ -0,0 +1,97 @@
+package stategen
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// This returns the state summary of the input block root from the state summary cache, or from
+// the DB if it was already written.
+func (s *State) stateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error) {
+	if summary := s.stateSummaryCache.Get(blockRoot); summary != nil {
+		return summary, nil
+	}
+	return s.beaconDB.StateSummary(ctx, blockRoot)
+}
+
+// SaveStateSummariesToDB writes the state summaries of the state summary cache to the DB in a
+// single transaction, and removes them from the cache.
+func (s *State) SaveStateSummariesToDB(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.SaveStateSummariesToDB")
+	defer span.End()
+
+	summaries := s.stateSummaryCache.GetAll()
+	if len(summaries) == 0 {
+		return nil
+	}
+	if err := s.beaconDB.SaveStateSummaries(ctx, summaries); err != nil {
+		return err
+	}
+	// Summaries cached while writing are kept for the next flush.
+	roots := make([][32]byte, len(summaries))
+	for i, summary := range summaries {
+		roots[i] = bytesutil.ToBytes32(summary.Root)
+	}
+	s.stateSummaryCache.Delete(roots)
+	return nil
+}
+
+// This saves the state summaries missing for the blocks past the split point. The summaries cached
+// by saveHotState are lost on an unclean shutdown before the next flush, they are rebuilt from
+// their blocks: a block is only saved once its state transition succeeded.
+func (s *State) recoverStateSummaries(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.recoverStateSummaries")
+	defer span.End()
+
+	roots, err := s.beaconDB.BlockRoots(ctx, filters.NewFilter().SetStartSlot(s.split().slot+1))
+	if err != nil {
+		return err
+	}
+	var summaries []*pb.StateSummary
+	for _, r := range roots {
+		if s.beaconDB.HasStateSummary(ctx, r) {
+			continue
+		}
+		b, err := s.beaconDB.Block(ctx, r)
+		if err != nil {
+			return err
+		}
+		if b == nil || b.Block == nil {
+			continue
+		}
+		summaries = append(summaries, &pb.StateSummary{Slot: b.Block.Slot, Root: r[:]})
+	}
+	if len(summaries) == 0 {
+		return nil
+	}
+	if err := s.beaconDB.SaveStateSummaries(ctx, summaries); err != nil {
+		return err
+	}
+	log.WithField("count", len(summaries)).Info("Recovered state summaries lost on shutdown")
+	return nil
+}
+
+// RunStateSummaryFlush writes the cached state summaries to the DB once per slot. Saving a hot
+// state off an epoch boundary only caches its summary, so the summaries of all the blocks
+// processed in a slot, which can be many while syncing, are written in a single transaction.
+func (s *State) RunStateSummaryFlush(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.SaveStateSummariesToDB(ctx); err != nil {
+				log.WithError(err).Error("Could not save state summaries to DB")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting state summary flush routine")
+			return
+		}
+	}
+}
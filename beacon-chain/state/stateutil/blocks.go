@@ -62,22 +62,31 @@ func BlockBodyRoot(body *ethpb.BeaconBlockBody) ([32]byte, error) {
 	if !featureconfig.Get().EnableBlockHTR {
 		return ssz.HashTreeRoot(body)
 	}
-	fieldRoots := make([][32]byte, 8)
+	fieldRoots, err := blockBodyFieldRoots(body)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bitwiseMerkleizeArrays(fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
+}
+
+// blockBodyFieldRoots returns the hash tree roots of each field of the block body, in field order.
+func blockBodyFieldRoots(body *ethpb.BeaconBlockBody) ([][32]byte, error) {
+	fieldRoots := make([][32]byte, blockBodyFieldCount)
 	if body != nil {
 		rawRandao := bytesutil.ToBytes96(body.RandaoReveal)
 		packedRandao, err := pack([][]byte{rawRandao[:]})
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		randaoRoot, err := bitwiseMerkleize(packedRandao, uint64(len(packedRandao)), uint64(len(packedRandao)))
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		fieldRoots[0] = randaoRoot
 
 		eth1Root, err := Eth1Root(body.Eth1Data)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		fieldRoots[1] = eth1Root
 
@@ -86,33 +95,33 @@ func BlockBodyRoot(body *ethpb.BeaconBlockBody) ([32]byte, error) {
 
 		proposerSlashingsRoot, err := ssz.HashTreeRootWithCapacity(body.ProposerSlashings, 16)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		fieldRoots[3] = proposerSlashingsRoot
 		attesterSlashingsRoot, err := ssz.HashTreeRootWithCapacity(body.AttesterSlashings, 1)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		fieldRoots[4] = attesterSlashingsRoot
 		attsRoot, err := blockAttestationRoot(body.Attestations)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
-		fieldRoots[5] = attsRoot
+		fieldRoots[blockBodyAttestationsField] = attsRoot
 
 		depositRoot, err := ssz.HashTreeRootWithCapacity(body.Deposits, 16)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		fieldRoots[6] = depositRoot
 
 		exitRoot, err := ssz.HashTreeRootWithCapacity(body.VoluntaryExits, 16)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 		fieldRoots[7] = exitRoot
 	}
-	return bitwiseMerkleizeArrays(fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
+	return fieldRoots, nil
 }
 
 // Eth1Root computes the HashTreeRoot Merkleization of
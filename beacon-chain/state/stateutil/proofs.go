@@ -0,0 +1,84 @@
+package stateutil
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/protolambda/zssz/merkle"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+const (
+	// blockBodyFieldCount is the number of fields of a beacon block body.
+	blockBodyFieldCount = 8
+	// blockBodyAttestationsField is the position of the attestations in the block body fields.
+	blockBodyAttestationsField = 5
+)
+
+// AttestationInclusionProof is a Merkle proof of an attestation being part of a block body.
+type AttestationInclusionProof struct {
+	// Leaf is the hash tree root of the attestation.
+	Leaf [32]byte
+	// Branch lists the sibling nodes from the leaf up to the block body root.
+	Branch [][]byte
+	// GeneralizedIndex is the generalized index of the attestation in the block body tree.
+	GeneralizedIndex uint64
+}
+
+// BlockBodyAttestationProof returns the Merkle proof of the attestation at the input index of the
+// block body attestations, against the hash tree root of the block body.
+func BlockBodyAttestationProof(body *ethpb.BeaconBlockBody, index uint64) (*AttestationInclusionProof, error) {
+	if body == nil || index >= uint64(len(body.Attestations)) {
+		return nil, fmt.Errorf("attestation index %d out of range", index)
+	}
+	limit := params.BeaconConfig().MaxAttestations
+	depth := merkle.GetDepth(limit)
+
+	leaves := make([][32]byte, len(body.Attestations))
+	for i, att := range body.Attestations {
+		r, err := attestationRoot(att)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute attestation root")
+		}
+		leaves[i] = r
+	}
+
+	branch := make([][]byte, 0, int(depth)+1+blockBodyFieldCount/2)
+	// Siblings within the attestations list, padded with zero hashes up to the list limit.
+	layers := ReturnTrieLayerVariable(leaves, limit)
+	for i := uint8(0); i < depth; i++ {
+		siblingIdx := (index >> i) ^ 1
+		sibling := trieutil.ZeroHashes[i]
+		if siblingIdx < uint64(len(layers[i])) {
+			sibling = *layers[i][siblingIdx]
+		}
+		branch = append(branch, sibling[:])
+	}
+	// The list length is mixed in to the right of the list data root.
+	lengthRoot := make([]byte, 32)
+	binary.LittleEndian.PutUint64(lengthRoot[:8], uint64(len(body.Attestations)))
+	branch = append(branch, lengthRoot)
+
+	// Siblings within the block body fields.
+	fieldRoots, err := blockBodyFieldRoots(body)
+	if err != nil {
+		return nil, err
+	}
+	fieldLayers := ReturnTrieLayer(fieldRoots, blockBodyFieldCount)
+	for i := 0; i < len(fieldLayers)-1; i++ {
+		siblingIdx := (blockBodyAttestationsField >> uint(i)) ^ 1
+		branch = append(branch, fieldLayers[i][siblingIdx][:])
+	}
+
+	// Generalized index of the attestations data root is 2 * (fieldCount + field), the leaf is
+	// found depth levels below it.
+	gIndex := (2*(blockBodyFieldCount+blockBodyAttestationsField))<<depth + index
+	return &AttestationInclusionProof{
+		Leaf:             leaves[index],
+		Branch:           branch,
+		GeneralizedIndex: gIndex,
+	}, nil
+}
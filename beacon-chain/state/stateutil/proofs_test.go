@@ -0,0 +1,66 @@
+package stateutil_test
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestBlockBodyAttestationProof(t *testing.T) {
+	atts := make([]*ethpb.Attestation, 3)
+	for i := range atts {
+		atts[i] = &ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0b1101},
+			Data: &ethpb.AttestationData{
+				Slot:            uint64(i),
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	body := &ethpb.BeaconBlockBody{
+		RandaoReveal: make([]byte, 96),
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		},
+		Graffiti:     make([]byte, 32),
+		Attestations: atts,
+	}
+	bodyRoot, err := ssz.HashTreeRoot(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, att := range atts {
+		proof, err := stateutil.BlockBodyAttestationProof(body, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		attRoot, err := ssz.HashTreeRoot(att)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proof.Leaf != attRoot {
+			t.Errorf("Wanted leaf %#x, got %#x", attRoot, proof.Leaf)
+		}
+		depth := uint64(len(proof.Branch))
+		if proof.GeneralizedIndex>>depth != 1 {
+			t.Fatalf("Generalized index %d does not match branch length %d", proof.GeneralizedIndex, depth)
+		}
+		merkleIndex := int(proof.GeneralizedIndex - 1<<depth)
+		if !trieutil.VerifyMerkleBranch(bodyRoot[:], proof.Leaf[:], merkleIndex, proof.Branch) {
+			t.Errorf("Proof of attestation %d does not verify against the body root", i)
+		}
+	}
+
+	if _, err := stateutil.BlockBodyAttestationProof(body, uint64(len(atts))); err == nil {
+		t.Error("Expected error for an out of range attestation index")
+	}
+}
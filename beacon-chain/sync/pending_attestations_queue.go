@@ -6,11 +6,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -62,9 +64,12 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 		attestations := s.blkRootToPendingAtts[bRoot]
 		s.pendingAttsLock.RUnlock()
 		// Has the pending attestation's missing block arrived and the node processed block yet?
-		hasStateSummary := featureconfig.Get().NewStateMgmt && s.db.HasStateSummary(ctx, bRoot)
+		hasStateSummary := featureconfig.Get().NewStateMgmt && s.stateGen.StateSummaryExists(ctx, bRoot)
 		if s.db.HasBlock(ctx, bRoot) && (s.db.HasState(ctx, bRoot) || hasStateSummary) {
 			numberOfBlocksRecoveredFromAtt.Inc()
+			// The same attestation is often received from several peers while the block is missing,
+			// it only needs to be validated once.
+			attestations = dedupPendingAtts(attestations)
 			for _, att := range attestations {
 				// The pending attestations can arrive in both aggregated and unaggregated forms,
 				// each from has distinct validation steps.
@@ -158,6 +163,24 @@ func (s *Service) savePendingAtt(att *ethpb.AggregateAttestationAndProof) {
 	s.blkRootToPendingAtts[root] = append(s.blkRootToPendingAtts[root], att)
 }
 
+// This removes the duplicate pending attestations of a block root, keeping the first occurrence.
+// The attestations are returned as is if one can't be encoded.
+func dedupPendingAtts(atts []*ethpb.AggregateAttestationAndProof) []*ethpb.AggregateAttestationAndProof {
+	msgs := make([]proto.Message, len(atts))
+	for i, att := range atts {
+		msgs[i] = att
+	}
+	unique, err := hashutil.UniqueProtoIndices(msgs)
+	if err != nil {
+		return atts
+	}
+	deduped := make([]*ethpb.AggregateAttestationAndProof, len(unique))
+	for i, j := range unique {
+		deduped[i] = atts[j]
+	}
+	return deduped
+}
+
 // This validates the pending attestations in the queue are still valid.
 // If not valid, a node will remove it in the queue in place. The validity
 // check specifies the pending attestation could not fall one epoch behind
@@ -171,8 +171,22 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 	return err
 }
 
+// knownForkVersion returns true if the version is the genesis fork version or the version of a
+// scheduled fork, peers with a head on either side of a fork are of the same network.
+func knownForkVersion(version []byte) bool {
+	if bytes.Equal(params.BeaconConfig().GenesisForkVersion, version) {
+		return true
+	}
+	for _, scheduled := range params.BeaconConfig().ForkVersionSchedule {
+		if bytes.Equal(scheduled, version) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Service) validateStatusMessage(msg *pb.Status, stream network.Stream) error {
-	if !bytes.Equal(params.BeaconConfig().GenesisForkVersion, msg.HeadForkVersion) {
+	if !knownForkVersion(msg.HeadForkVersion) {
 		return errWrongForkVersion
 	}
 	genesis := r.chain.GenesisTime()
@@ -416,3 +416,20 @@ func TestStatusRPCRequest_BadPeerHandshake(t *testing.T) {
 		t.Errorf("Bad response was not bumped to one, instead it is %d", badResponses)
 	}
 }
+
+func TestKnownForkVersion(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	cfg := *params.BeaconConfig()
+	cfg.ForkVersionSchedule = map[uint64][]byte{10: {1, 0, 0, 0}}
+	params.OverrideBeaconConfig(&cfg)
+
+	if !knownForkVersion(cfg.GenesisForkVersion) {
+		t.Error("Wanted the genesis fork version to be known")
+	}
+	if !knownForkVersion([]byte{1, 0, 0, 0}) {
+		t.Error("Wanted the version of a scheduled fork to be known")
+	}
+	if knownForkVersion([]byte{2, 0, 0, 0}) {
+		t.Error("Did not want an unscheduled fork version to be known")
+	}
+}
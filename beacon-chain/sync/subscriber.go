@@ -11,6 +11,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -245,6 +246,7 @@ func (r *Service) subscribeDynamicWithSubnets(
 						v.Cancel()
 						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, k))
 						delete(subscriptions, k)
+						cache.AttestationSubnets.SetSubscribed(k, false)
 					}
 				}
 				for _, idx := range wantedSubs {
@@ -268,10 +270,12 @@ func (r *Service) subscribeDynamicWithSubnets(
 									return
 								}
 								subscriptions[idx] = r.subscribeWithBase(base, subnetTopic, validate, handle)
+								cache.AttestationSubnets.SetSubscribed(idx, true)
 							}(idx)
 							continue
 						}
 						subscriptions[idx] = r.subscribeWithBase(base, subnetTopic, validate, handle)
+						cache.AttestationSubnets.SetSubscribed(idx, true)
 					}
 				}
 			}
@@ -313,11 +317,13 @@ func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() i
 					for i, sub := range cancelSubs {
 						sub.Cancel()
 						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, i+wantedSubs))
+						cache.AttestationSubnets.SetSubscribed(uint64(i+wantedSubs), false)
 					}
 				} else if len(subscriptions) < wantedSubs { // Increase topics
 					for i := len(subscriptions); i < wantedSubs; i++ {
 						sub := r.subscribeWithBase(base, fmt.Sprintf(topicFormat, i), validate, handle)
 						subscriptions = append(subscriptions, sub)
+						cache.AttestationSubnets.SetSubscribed(uint64(i), true)
 					}
 				}
 			}
@@ -45,6 +45,7 @@ func (r *Service) committeesCount() int {
 
 func (r *Service) committeeIndices() []uint64 {
 	currentEpoch := helpers.SlotToEpoch(r.chain.HeadSlot())
-	return sliceutil.UnionUint64(cache.CommitteeIDs.GetIDs(currentEpoch),
+	wanted := sliceutil.UnionUint64(cache.CommitteeIDs.GetIDs(currentEpoch),
 		cache.CommitteeIDs.GetIDs(currentEpoch+1))
+	return cache.AttestationSubnets.ApplyOverrides(wanted)
 }
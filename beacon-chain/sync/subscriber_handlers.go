@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
@@ -30,7 +31,7 @@ func (r *Service) attesterSlashingSubscriber(ctx context.Context, msg proto.Mess
 	}
 	// Do some nil checks to prevent easy DoS'ing of this handler.
 	if as != nil && as.Attestation_1 != nil && as.Attestation_1.Data != nil {
-		s, err := r.db.State(ctx, bytesutil.ToBytes32(as.Attestation_1.Data.BeaconBlockRoot))
+		s, err := stategen.NewStateReader(r.db, r.stateGen).State(ctx, bytesutil.ToBytes32(as.Attestation_1.Data.BeaconBlockRoot))
 		if err != nil {
 			return err
 		}
@@ -50,7 +51,7 @@ func (r *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Mess
 	// Do some nil checks to prevent easy DoS'ing of this handler.
 	if ps.Header_1 != nil && ps.Header_1.Header != nil {
 		root, err := ssz.HashTreeRoot(ps.Header_1.Header)
-		s, err := r.db.State(ctx, root)
+		s, err := stategen.NewStateReader(r.db, r.stateGen).State(ctx, root)
 		if err != nil {
 			return err
 		}
@@ -128,7 +128,7 @@ func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateA
 
 func (r *Service) validateBlockInAttestation(ctx context.Context, a *ethpb.AggregateAttestationAndProof) bool {
 	// Verify the block being voted and the processed state is in DB. The block should have passed validation if it's in the DB.
-	hasStateSummary := featureconfig.Get().NewStateMgmt && r.db.HasStateSummary(ctx, bytesutil.ToBytes32(a.Aggregate.Data.BeaconBlockRoot))
+	hasStateSummary := featureconfig.Get().NewStateMgmt && r.stateGen.StateSummaryExists(ctx, bytesutil.ToBytes32(a.Aggregate.Data.BeaconBlockRoot))
 	hasState := r.db.HasState(ctx, bytesutil.ToBytes32(a.Aggregate.Data.BeaconBlockRoot)) || hasStateSummary
 	hasBlock := r.db.HasBlock(ctx, bytesutil.ToBytes32(a.Aggregate.Data.BeaconBlockRoot))
 	if !(hasState && hasBlock) {
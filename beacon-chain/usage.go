@@ -79,20 +79,44 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.InteropMockEth1DataVotesFlag,
 			flags.InteropGenesisStateFlag,
+			flags.NetworkFlag,
+			flags.NetworkConfigFileFlag,
 			flags.DepositContractFlag,
 			flags.ContractDeploymentBlock,
 			flags.Web3ProviderFlag,
 			flags.RPCHost,
 			flags.RPCPort,
 			flags.RPCMaxPageSize,
+			flags.EnableDebugRPCEndpoints,
+			flags.EnableAdminRPC,
+			flags.AdminRPCPort,
+			flags.DatabaseBackupOutputDir,
+			flags.DatabaseRestoreFile,
+			flags.ColdStateDataDir,
 			flags.CertFlag,
 			flags.KeyFlag,
 			flags.GRPCGatewayPort,
 			flags.HTTPWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.UnsafeSync,
+			flags.CheckpointStateFlag,
+			flags.CheckpointBlockFlag,
+			flags.CheckpointBlockRootFlag,
 			flags.SlotsPerArchivedPoint,
+			flags.SlotsPerColdCheckpoint,
+			flags.ArchivedPointsPerFullState,
+			flags.ReplayPrefetchDepth,
+			flags.ReplayBundleDir,
+			flags.HotStateCacheSize,
+			flags.AdaptiveSaveReplayTarget,
+			flags.AdaptiveSaveMinFreeDiskSpace,
+			flags.StateSoftDeleteWindow,
+			flags.HashSelfTestFlag,
+			flags.DumpConfigFlag,
 			flags.EnableDiscv5,
+			flags.TrackValidatorFlag,
+			flags.ValidatorStatusWebhookFlag,
+			flags.ValidatorBalanceAlertThresholdFlag,
 		},
 	},
 	{
@@ -101,6 +125,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PIP,
 			cmd.P2PHost,
 			cmd.P2PHostDNS,
+			cmd.P2PIPMode,
+			cmd.P2PIP6,
+			cmd.P2PHost6,
 			cmd.P2PMaxPeers,
 			cmd.P2PPrivKey,
 			cmd.P2PWhitelist,
@@ -136,6 +163,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ArchiveValidatorSetChangesFlag,
 			flags.ArchiveBlocksFlag,
 			flags.ArchiveAttestationsFlag,
+			flags.ArchiveMinFreeDiskSpaceFlag,
 		},
 	},
 }
@@ -0,0 +1,99 @@
+package validatorstatus
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	valfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/sirupsen/logrus"
+)
+
+// leakingEpochs is the number of observed epochs in a row the balance of a tracked validator has
+// to decrease for it to be reported as leaking.
+const leakingEpochs = 3
+
+// balanceRecord is the balance of a tracked validator observed at an epoch.
+type balanceRecord struct {
+	epoch      uint64
+	balance    uint64
+	decreasing uint64 // Number of observed epochs in a row the balance decreased.
+}
+
+// checkBalances compares the balance of every tracked validator in the head state against the balance
+// observed at an earlier epoch and sends a balance alert event for each validator losing more than the
+// threshold per epoch or leaking. Balances are only compared once per epoch.
+func (s *Service) checkBalances(headState *state.BeaconState) {
+	s.trackedLock.Lock()
+	defer s.trackedLock.Unlock()
+
+	epoch := helpers.CurrentEpoch(headState)
+	for pubKey := range s.tracked {
+		idx, ok := headState.ValidatorIndexByPubkey(pubKey)
+		if !ok {
+			continue
+		}
+		balance, err := headState.BalanceAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey[:8])).Error("Could not get validator balance")
+			continue
+		}
+		label := fmt.Sprintf("%#x", pubKey[:8])
+		trackedValidatorBalance.WithLabelValues(label).Set(float64(balance))
+
+		previous, ok := s.balances[pubKey]
+		if ok && epoch <= previous.epoch {
+			continue
+		}
+		current := &balanceRecord{epoch: epoch, balance: balance}
+		s.balances[pubKey] = current
+		if !ok {
+			continue
+		}
+		trackedValidatorBalanceDelta.WithLabelValues(label).Set(float64(int64(balance) - int64(previous.balance)))
+		if balance >= previous.balance {
+			continue
+		}
+		current.decreasing = previous.decreasing + 1
+
+		// Head states can skip epochs, the loss is compared to the threshold of every elapsed epoch.
+		loss := previous.balance - balance
+		if s.lossThreshold != 0 && loss > s.lossThreshold*(epoch-previous.epoch) {
+			s.sendBalanceAlert(pubKey, idx, epoch, valfeed.Penalized, previous.balance, balance)
+		}
+		if current.decreasing == leakingEpochs {
+			s.sendBalanceAlert(pubKey, idx, epoch, valfeed.Leaking, previous.balance, balance)
+		}
+	}
+}
+
+func (s *Service) sendBalanceAlert(
+	pubKey [48]byte,
+	idx uint64,
+	epoch uint64,
+	reason valfeed.BalanceAlertReason,
+	previous uint64,
+	current uint64,
+) {
+	balanceAlerts.WithLabelValues(reason.String()).Inc()
+	log.WithFields(logrus.Fields{
+		"pubKey":          fmt.Sprintf("%#x", pubKey[:8]),
+		"index":           idx,
+		"epoch":           epoch,
+		"reason":          reason,
+		"previousBalance": previous,
+		"currentBalance":  current,
+	}).Warn("Tracked validator balance decreased")
+	s.statusFeed.Send(&feed.Event{
+		Type: valfeed.BalanceAlert,
+		Data: &valfeed.BalanceAlertData{
+			PublicKey:       pubKey,
+			ValidatorIndex:  idx,
+			Epoch:           epoch,
+			Reason:          reason,
+			PreviousBalance: previous,
+			CurrentBalance:  current,
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package validatorstatus
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	valfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/validator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestCheckBalances_SendsAlerts(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 8)
+	pubKey := bytesutil.ToBytes48(beaconState.Validators()[0].PublicKey)
+	svc := NewService(context.Background(), &Config{
+		HeadFetcher:      &mock.ChainService{State: beaconState},
+		StateNotifier:    &mock.MockStateNotifier{},
+		TrackedPubkeys:   [][48]byte{pubKey},
+		BalanceThreshold: 1000,
+	})
+
+	events := make(chan *feed.Event, leakingEpochs+1)
+	sub := svc.ValidatorStatusFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	setEpochBalance := func(epoch uint64, balance uint64) {
+		if err := beaconState.SetSlot(epoch * params.BeaconConfig().SlotsPerEpoch); err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconState.UpdateBalancesAtIndex(0, balance); err != nil {
+			t.Fatal(err)
+		}
+		svc.checkBalances(beaconState)
+	}
+
+	balance := params.BeaconConfig().MaxEffectiveBalance
+	setEpochBalance(0, balance)
+	// A loss below the threshold isn't a penalty.
+	balance -= 500
+	setEpochBalance(1, balance)
+	if len(events) != 0 {
+		t.Fatal("Received balance alert below the threshold")
+	}
+
+	// Balances are only compared once per epoch.
+	setEpochBalance(1, balance-5000)
+	if len(events) != 0 {
+		t.Fatal("Received balance alert within an epoch")
+	}
+
+	// The balance loss is compared to the threshold of every elapsed epoch.
+	balance -= 5000
+	setEpochBalance(3, balance)
+	event := <-events
+	data, ok := event.Data.(*valfeed.BalanceAlertData)
+	if !ok {
+		t.Fatal("Did not receive balance alert data")
+	}
+	if data.Reason != valfeed.Penalized || data.Epoch != 3 || data.CurrentBalance != balance {
+		t.Errorf("Unexpected balance alert %+v", data)
+	}
+
+	// Third epoch in a row the balance decreased.
+	balance -= 10
+	setEpochBalance(4, balance)
+	event = <-events
+	if data := event.Data.(*valfeed.BalanceAlertData); data.Reason != valfeed.Leaking {
+		t.Errorf("Wanted LEAKING, got %s", data.Reason)
+	}
+
+	// An increase resets the leak.
+	setEpochBalance(5, balance+100)
+	setEpochBalance(6, balance)
+	setEpochBalance(7, balance-10)
+	if len(events) != 0 {
+		t.Error("Received balance alert after the balance increased")
+	}
+}
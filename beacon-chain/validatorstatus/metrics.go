@@ -0,0 +1,21 @@
+package validatorstatus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	trackedValidatorBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tracked_validator_balance_gwei",
+		Help: "The balance in Gwei of a tracked validator in the head state.",
+	}, []string{"pubkey"})
+	trackedValidatorBalanceDelta = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tracked_validator_balance_delta_gwei",
+		Help: "The balance change in Gwei of a tracked validator since the last observed epoch.",
+	}, []string{"pubkey"})
+	balanceAlerts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tracked_validator_balance_alerts_total",
+		Help: "The number of balance alerts of tracked validators, by reason.",
+	}, []string{"reason"})
+)
@@ -0,0 +1,191 @@
+// Package validatorstatus tracks the lifecycle and balance of a configured set of validators and
+// notifies subscribers, such as HTTP webhooks, whenever their status changes or their balance
+// is penalized or leaking.
+package validatorstatus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	valfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "validatorstatus")
+
+// webhookTimeout is the maximum time spent delivering a single webhook.
+const webhookTimeout = 10 * time.Second
+
+// Service watches the head state for lifecycle changes and balance losses of tracked validators,
+// emitting an event on its validator status feed for every change observed.
+type Service struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	headFetcher   blockchain.HeadFetcher
+	stateNotifier statefeed.Notifier
+	statusFeed    *event.Feed
+	webhookURLs   []string
+	httpClient    *http.Client
+	trackedLock   sync.RWMutex
+	tracked       map[[48]byte]valfeed.Lifecycle
+	seeded        bool // Whether the lifecycles were seeded from a first head state.
+	balances      map[[48]byte]*balanceRecord
+	lossThreshold uint64 // Balance loss in Gwei per epoch alerted as a penalty, 0 disables the alerts.
+}
+
+// Config options for the validator status service.
+type Config struct {
+	HeadFetcher      blockchain.HeadFetcher
+	StateNotifier    statefeed.Notifier
+	TrackedPubkeys   [][48]byte
+	WebhookEndpoints []string
+	BalanceThreshold uint64
+}
+
+// NewService initializes the service from configuration options.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	tracked := make(map[[48]byte]valfeed.Lifecycle, len(cfg.TrackedPubkeys))
+	for _, pubKey := range cfg.TrackedPubkeys {
+		tracked[pubKey] = valfeed.Unknown
+	}
+	return &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		headFetcher:   cfg.HeadFetcher,
+		stateNotifier: cfg.StateNotifier,
+		statusFeed:    new(event.Feed),
+		webhookURLs:   cfg.WebhookEndpoints,
+		httpClient:    &http.Client{Timeout: webhookTimeout},
+		tracked:       tracked,
+		balances:      make(map[[48]byte]*balanceRecord, len(cfg.TrackedPubkeys)),
+		lossThreshold: cfg.BalanceThreshold,
+	}
+}
+
+// Start the validator status service event loops.
+func (s *Service) Start() {
+	if len(s.webhookURLs) > 0 {
+		go s.runWebhooks(s.ctx)
+	}
+	go s.run(s.ctx)
+}
+
+// Stop the validator status service event loops.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	return nil
+}
+
+// Status reports the healthy status of the service. Returning nil means service
+// is correctly running without error.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ValidatorStatusFeed implements valfeed.Notifier.
+func (s *Service) ValidatorStatusFeed() *event.Feed {
+	return s.statusFeed
+}
+
+func (s *Service) run(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			headState, err := s.headFetcher.HeadState(ctx)
+			if err != nil {
+				log.WithError(err).Error("Head state is not available")
+				continue
+			}
+			s.checkStatuses(headState)
+			s.checkBalances(headState)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed notifier failed")
+			return
+		}
+	}
+}
+
+// checkStatuses compares the lifecycle of every tracked validator in the head state against the last
+// observed lifecycle and sends a status changed event for each validator that moved. The first head
+// state only seeds the lifecycles, a restarted node would otherwise notify every tracked validator.
+func (s *Service) checkStatuses(headState *state.BeaconState) {
+	s.trackedLock.Lock()
+	defer s.trackedLock.Unlock()
+
+	epoch := helpers.CurrentEpoch(headState)
+	notify := s.seeded
+	s.seeded = true
+	for pubKey, previous := range s.tracked {
+		current := valfeed.Unknown
+		idx, ok := headState.ValidatorIndexByPubkey(pubKey)
+		if ok {
+			val, err := headState.ValidatorAtIndexReadOnly(idx)
+			if err != nil {
+				log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey[:8])).Error("Could not get validator")
+				continue
+			}
+			current = lifecycle(val, epoch)
+		}
+		if current == previous {
+			continue
+		}
+		s.tracked[pubKey] = current
+		if !notify {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"pubKey":   fmt.Sprintf("%#x", pubKey[:8]),
+			"index":    idx,
+			"epoch":    epoch,
+			"previous": previous,
+			"current":  current,
+		}).Info("Tracked validator changed status")
+		s.statusFeed.Send(&feed.Event{
+			Type: valfeed.StatusChanged,
+			Data: &valfeed.StatusChangedData{
+				PublicKey:      pubKey,
+				ValidatorIndex: idx,
+				Epoch:          epoch,
+				Previous:       previous,
+				Current:        current,
+			},
+		})
+	}
+}
+
+// lifecycle maps a validator record to the lifecycle stage it is in at the given epoch.
+func lifecycle(val *state.ReadOnlyValidator, epoch uint64) valfeed.Lifecycle {
+	if val.Slashed() {
+		return valfeed.Slashed
+	}
+	if val.ExitEpoch() <= epoch {
+		return valfeed.Exited
+	}
+	if val.ActivationEpoch() <= epoch {
+		return valfeed.Activated
+	}
+	if val.ActivationEligibilityEpoch() != params.BeaconConfig().FarFutureEpoch {
+		return valfeed.ActivationQueued
+	}
+	return valfeed.Unknown
+}
@@ -0,0 +1,115 @@
+package validatorstatus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	valfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/validator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestCheckStatuses_SendsEventOnChange(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 8)
+	pubKey := bytesutil.ToBytes48(beaconState.Validators()[0].PublicKey)
+	svc := NewService(context.Background(), &Config{
+		HeadFetcher:    &mock.ChainService{State: beaconState},
+		StateNotifier:  &mock.MockStateNotifier{},
+		TrackedPubkeys: [][48]byte{pubKey},
+	})
+
+	events := make(chan *feed.Event, 2)
+	sub := svc.ValidatorStatusFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	// The first head state seeds the statuses without sending events.
+	svc.checkStatuses(beaconState)
+	if len(events) != 0 {
+		t.Error("Received event when seeding the statuses")
+	}
+	if svc.tracked[pubKey] != valfeed.Activated {
+		t.Errorf("Wanted status seeded to ACTIVATED, got %s", svc.tracked[pubKey])
+	}
+
+	// Nothing changed, no event should be sent.
+	svc.checkStatuses(beaconState)
+	if len(events) != 0 {
+		t.Error("Received event without a status change")
+	}
+
+	vals := beaconState.Validators()
+	vals[0].Slashed = true
+	if err := beaconState.SetValidators(vals); err != nil {
+		t.Fatal(err)
+	}
+	svc.checkStatuses(beaconState)
+	event := <-events
+	data, ok := event.Data.(*valfeed.StatusChangedData)
+	if !ok {
+		t.Fatal("Did not receive status changed data")
+	}
+	if data.Previous != valfeed.Activated || data.Current != valfeed.Slashed {
+		t.Errorf("Wanted transition ACTIVATED -> SLASHED, got %s -> %s", data.Previous, data.Current)
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	beaconState, _ := testutil.DeterministicGenesisState(t, 1)
+	vals := beaconState.Validators()
+	vals[0].ActivationEligibilityEpoch = 1
+	vals[0].ActivationEpoch = 5
+	vals[0].ExitEpoch = farFuture
+	if err := beaconState.SetValidators(vals); err != nil {
+		t.Fatal(err)
+	}
+	val, err := beaconState.ValidatorAtIndexReadOnly(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		epoch uint64
+		want  valfeed.Lifecycle
+	}{
+		{epoch: 2, want: valfeed.ActivationQueued},
+		{epoch: 5, want: valfeed.Activated},
+	}
+	for _, tt := range tests {
+		if got := lifecycle(val, tt.epoch); got != tt.want {
+			t.Errorf("lifecycle(%d) = %s, want %s", tt.epoch, got, tt.want)
+		}
+	}
+}
+
+func TestPostWebhook(t *testing.T) {
+	received := make(chan *webhookPayload, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &webhookPayload{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			t.Error(err)
+		}
+		received <- payload
+	}))
+	defer srv.Close()
+
+	svc := NewService(context.Background(), &Config{WebhookEndpoints: []string{srv.URL}})
+	data := &valfeed.StatusChangedData{
+		ValidatorIndex: 3,
+		Epoch:          10,
+		Previous:       valfeed.Activated,
+		Current:        valfeed.Exited,
+	}
+	if err := svc.postWebhook(context.Background(), srv.URL, data); err != nil {
+		t.Fatal(err)
+	}
+	payload := <-received
+	if payload.ValidatorIndex != 3 || payload.Current != "EXITED" || payload.Previous != "ACTIVATED" {
+		t.Errorf("Unexpected payload %+v", payload)
+	}
+}
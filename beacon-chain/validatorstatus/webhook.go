@@ -0,0 +1,111 @@
+package validatorstatus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	valfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/validator"
+)
+
+// webhookPayload is the JSON body posted to each configured webhook endpoint.
+type webhookPayload struct {
+	PublicKey      string `json:"public_key"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	Epoch          uint64 `json:"epoch"`
+	Previous       string `json:"previous_status"`
+	Current        string `json:"current_status"`
+}
+
+// balanceAlertPayload is the JSON body posted to each configured webhook endpoint on balance alerts.
+type balanceAlertPayload struct {
+	PublicKey       string `json:"public_key"`
+	ValidatorIndex  uint64 `json:"validator_index"`
+	Epoch           uint64 `json:"epoch"`
+	Alert           string `json:"balance_alert"`
+	PreviousBalance uint64 `json:"previous_balance"`
+	CurrentBalance  uint64 `json:"current_balance"`
+}
+
+// runWebhooks subscribes to the validator status feed and delivers every status change and
+// balance alert to the configured webhook endpoints.
+func (s *Service) runWebhooks(ctx context.Context) {
+	statusChannel := make(chan *feed.Event, 1)
+	statusSub := s.statusFeed.Subscribe(statusChannel)
+	defer statusSub.Unsubscribe()
+	for {
+		select {
+		case event := <-statusChannel:
+			for _, url := range s.webhookURLs {
+				var err error
+				switch data := event.Data.(type) {
+				case *valfeed.StatusChangedData:
+					err = s.postWebhook(ctx, url, data)
+				case *valfeed.BalanceAlertData:
+					err = s.postBalanceAlertWebhook(ctx, url, data)
+				default:
+					continue
+				}
+				if err != nil {
+					log.WithError(err).WithField("url", url).Error("Could not deliver validator status webhook")
+				}
+			}
+		case <-s.ctx.Done():
+			return
+		case err := <-statusSub.Err():
+			log.WithError(err).Error("Subscription to validator status feed failed")
+			return
+		}
+	}
+}
+
+func (s *Service) postWebhook(ctx context.Context, url string, data *valfeed.StatusChangedData) error {
+	return s.postJSON(ctx, url, &webhookPayload{
+		PublicKey:      fmt.Sprintf("%#x", data.PublicKey),
+		ValidatorIndex: data.ValidatorIndex,
+		Epoch:          data.Epoch,
+		Previous:       data.Previous.String(),
+		Current:        data.Current.String(),
+	})
+}
+
+func (s *Service) postBalanceAlertWebhook(ctx context.Context, url string, data *valfeed.BalanceAlertData) error {
+	return s.postJSON(ctx, url, &balanceAlertPayload{
+		PublicKey:       fmt.Sprintf("%#x", data.PublicKey),
+		ValidatorIndex:  data.ValidatorIndex,
+		Epoch:           data.Epoch,
+		Alert:           data.Reason.String(),
+		PreviousBalance: data.PreviousBalance,
+		CurrentBalance:  data.CurrentBalance,
+	})
+}
+
+func (s *Service) postJSON(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal webhook payload")
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create webhook request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close webhook response body")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
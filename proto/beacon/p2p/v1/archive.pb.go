@@ -174,41 +174,134 @@ func (m *ArchivedCommitteeInfo) GetAttesterSeed() []byte {
 	return nil
 }
 
+type ArchivedCheckpoint struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty" ssz-size:"32"`
+	Justified            bool     `protobuf:"varint,3,opt,name=justified,proto3" json:"justified,omitempty"`
+	JustifiedSlot        uint64   `protobuf:"varint,4,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`
+	Finalized            bool     `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	FinalizedSlot        uint64   `protobuf:"varint,6,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchivedCheckpoint) Reset()         { *m = ArchivedCheckpoint{} }
+func (m *ArchivedCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ArchivedCheckpoint) ProtoMessage()    {}
+func (*ArchivedCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{2}
+}
+func (m *ArchivedCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedCheckpoint.Merge(m, src)
+}
+func (m *ArchivedCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedCheckpoint proto.InternalMessageInfo
+
+func (m *ArchivedCheckpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ArchivedCheckpoint) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ArchivedCheckpoint) GetJustified() bool {
+	if m != nil {
+		return m.Justified
+	}
+	return false
+}
+
+func (m *ArchivedCheckpoint) GetJustifiedSlot() uint64 {
+	if m != nil {
+		return m.JustifiedSlot
+	}
+	return 0
+}
+
+func (m *ArchivedCheckpoint) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *ArchivedCheckpoint) GetFinalizedSlot() uint64 {
+	if m != nil {
+		return m.FinalizedSlot
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ArchivedActiveSetChanges)(nil), "ethereum.beacon.p2p.v1.ArchivedActiveSetChanges")
 	proto.RegisterType((*ArchivedCommitteeInfo)(nil), "ethereum.beacon.p2p.v1.ArchivedCommitteeInfo")
+	proto.RegisterType((*ArchivedCheckpoint)(nil), "ethereum.beacon.p2p.v1.ArchivedCheckpoint")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/archive.proto", fileDescriptor_289929478e9672a3) }
 
 var fileDescriptor_289929478e9672a3 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x27, 0x66, 0xe9, 0xea, 0xd8, 0x55, 0x76, 0xc0, 0x25, 0x2c, 0xd2, 0x5d, 0x83, 0x60, 0x2f,
-	0x3b, 0x21, 0x59, 0xf0, 0xe0, 0xad, 0x2d, 0x1e, 0x3c, 0x08, 0x92, 0x42, 0xaf, 0x65, 0x92, 0xbc,
-	0x66, 0x46, 0x93, 0xcc, 0x90, 0x99, 0x84, 0xda, 0xab, 0x07, 0xbf, 0x9a, 0x47, 0x3f, 0x81, 0x48,
-	0x3f, 0x82, 0x9f, 0x40, 0x32, 0x49, 0x1a, 0x2d, 0x74, 0x6f, 0xf9, 0xbd, 0xdf, 0x9f, 0xf7, 0x5e,
-	0xde, 0xa0, 0x57, 0xb2, 0x14, 0x5a, 0x78, 0x11, 0xd0, 0x58, 0x14, 0x9e, 0x0c, 0xa4, 0x57, 0xfb,
-	0x1e, 0x2d, 0x63, 0xc6, 0x6b, 0x20, 0x86, 0xc3, 0x57, 0xa0, 0x19, 0x94, 0x50, 0xe5, 0xa4, 0x55,
-	0x11, 0x19, 0x48, 0x52, 0xfb, 0xd7, 0x77, 0x29, 0xd7, 0xac, 0x8a, 0x48, 0x2c, 0x72, 0x2f, 0x15,
-	0xa9, 0xf0, 0x8c, 0x3c, 0xaa, 0x36, 0x06, 0xb5, 0xb9, 0xcd, 0x57, 0x1b, 0x73, 0x7d, 0x03, 0x9a,
-	0x79, 0xb5, 0x4f, 0x33, 0xc9, 0xa8, 0xdf, 0x35, 0x5c, 0x47, 0x99, 0x88, 0xbf, 0xb4, 0x02, 0xf7,
-	0x9b, 0x8d, 0x9c, 0x59, 0xdb, 0x39, 0x99, 0xc5, 0x9a, 0xd7, 0xb0, 0x04, 0xbd, 0x60, 0xb4, 0x48,
-	0x41, 0xe1, 0x97, 0xe8, 0x09, 0x6d, 0x6a, 0x54, 0x43, 0xe2, 0x58, 0xb7, 0xf6, 0xf4, 0x2c, 0x1c,
-	0x0a, 0xf8, 0x0a, 0x8d, 0x60, 0xcb, 0x1b, 0xea, 0x91, 0xa1, 0x3a, 0x84, 0x1d, 0x74, 0xae, 0x32,
-	0xaa, 0x18, 0x24, 0x8e, 0x6d, 0x88, 0x1e, 0x36, 0x0c, 0x7c, 0x86, 0xb8, 0xb1, 0x9c, 0xb5, 0x4c,
-	0x07, 0xf1, 0x47, 0xf4, 0xbc, 0x16, 0x59, 0x55, 0x68, 0x5a, 0x7e, 0x5d, 0x37, 0x39, 0xca, 0x19,
-	0xdd, 0xda, 0xd3, 0xa7, 0xc1, 0x6b, 0x72, 0xf8, 0x11, 0xa0, 0x19, 0xe9, 0x57, 0x21, 0xab, 0x5e,
-	0xfd, 0x7e, 0xcb, 0x75, 0xf8, 0xac, 0xfe, 0x17, 0x2a, 0xbc, 0x42, 0x58, 0x96, 0x42, 0x0a, 0x05,
-	0xe5, 0xda, 0x34, 0xe7, 0x45, 0xaa, 0x9c, 0x73, 0x93, 0xf8, 0xe6, 0x44, 0xe2, 0xa7, 0xce, 0xb0,
-	0xec, 0xf4, 0xe1, 0xa5, 0x3c, 0xaa, 0x98, 0x5c, 0xaa, 0x35, 0x28, 0xfd, 0x5f, 0xee, 0xe3, 0x07,
-	0x73, 0x67, 0x9d, 0x61, 0xc8, 0xa5, 0x47, 0x15, 0xe5, 0x7e, 0xb7, 0xd0, 0x8b, 0xfe, 0x0a, 0x0b,
-	0x91, 0xe7, 0x5c, 0x6b, 0x80, 0x0f, 0xc5, 0x46, 0xe0, 0xb7, 0xe8, 0x62, 0xd8, 0x04, 0xcc, 0x19,
-	0xac, 0xe9, 0x78, 0x7e, 0xf9, 0xe7, 0xd7, 0xcd, 0x85, 0x52, 0xbb, 0x3b, 0xc5, 0x77, 0xf0, 0xce,
-	0xbd, 0x0f, 0xdc, 0x70, 0x7c, 0x18, 0x17, 0x20, 0x69, 0x7c, 0xc3, 0xa4, 0x60, 0x6e, 0x74, 0xca,
-	0x77, 0x18, 0x07, 0x20, 0x99, 0x8f, 0x7f, 0xec, 0x27, 0xd6, 0xcf, 0xfd, 0xc4, 0xfa, 0xbd, 0x9f,
-	0x58, 0xd1, 0xc8, 0x3c, 0x92, 0xfb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0x29, 0x1c, 0xa1,
-	0xb1, 0x02, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x15, 0x9a, 0x75, 0xc3, 0xb4, 0x43, 0xb3, 0x60, 0x8a, 0x26, 0xd4, 0x8d, 0x88, 0x89,
+	0x5e, 0x96, 0xa8, 0x9d, 0xc4, 0x81, 0x5b, 0x37, 0x71, 0xe0, 0x80, 0x84, 0x52, 0x69, 0xd7, 0xca,
+	0x49, 0x5e, 0x63, 0x6f, 0x69, 0x6c, 0xc5, 0x4e, 0x34, 0x7a, 0xe5, 0xc0, 0x57, 0xe3, 0xc8, 0x1d,
+	0x09, 0xa1, 0x7e, 0x04, 0x3e, 0x01, 0xb2, 0x9d, 0x26, 0x30, 0xa9, 0xbb, 0xf5, 0xff, 0xff, 0xbf,
+	0xf7, 0x7b, 0xcf, 0xb5, 0x83, 0x5e, 0x8b, 0x92, 0x2b, 0x1e, 0xc6, 0x40, 0x12, 0x5e, 0x84, 0x62,
+	0x2a, 0xc2, 0x7a, 0x12, 0x92, 0x32, 0xa1, 0xac, 0x86, 0xc0, 0x64, 0xf8, 0x18, 0x14, 0x85, 0x12,
+	0xaa, 0x55, 0x60, 0xab, 0x02, 0x31, 0x15, 0x41, 0x3d, 0x39, 0xb9, 0xc8, 0x98, 0xa2, 0x55, 0x1c,
+	0x24, 0x7c, 0x15, 0x66, 0x3c, 0xe3, 0xa1, 0x29, 0x8f, 0xab, 0xa5, 0x51, 0x96, 0xab, 0x7f, 0x59,
+	0xcc, 0xc9, 0x29, 0x28, 0x1a, 0xd6, 0x13, 0x92, 0x0b, 0x4a, 0x26, 0xcd, 0xc0, 0x45, 0x9c, 0xf3,
+	0xe4, 0xce, 0x16, 0xf8, 0x5f, 0x7b, 0xc8, 0x9b, 0xd9, 0xc9, 0xe9, 0x2c, 0x51, 0xac, 0x86, 0x39,
+	0xa8, 0x6b, 0x4a, 0x8a, 0x0c, 0x24, 0x7e, 0x85, 0x9e, 0x12, 0xed, 0x11, 0x05, 0xa9, 0xe7, 0x9c,
+	0xf5, 0xc6, 0x6e, 0xd4, 0x19, 0xf8, 0x18, 0xf5, 0xe1, 0x9e, 0xe9, 0xe8, 0x89, 0x89, 0x1a, 0x85,
+	0x3d, 0xb4, 0x2f, 0x73, 0x22, 0x29, 0xa4, 0x5e, 0xcf, 0x04, 0x5b, 0xa9, 0x13, 0xb8, 0x85, 0x44,
+	0xb7, 0xb8, 0x36, 0x69, 0x24, 0xfe, 0x84, 0x9e, 0xd7, 0x3c, 0xaf, 0x0a, 0x45, 0xca, 0x2f, 0x0b,
+	0xcd, 0x91, 0x5e, 0xff, 0xac, 0x37, 0x7e, 0x36, 0x7d, 0x13, 0xb4, 0x7f, 0x04, 0x28, 0x1a, 0x6c,
+	0x8f, 0x12, 0xdc, 0x6c, 0xab, 0x3f, 0xdc, 0x33, 0x15, 0x1d, 0xd6, 0xff, 0x4a, 0x89, 0x6f, 0x10,
+	0x16, 0x25, 0x17, 0x5c, 0x42, 0xb9, 0x30, 0xc3, 0x59, 0x91, 0x49, 0x6f, 0xdf, 0x10, 0xdf, 0xee,
+	0x20, 0x7e, 0x6e, 0x1a, 0xe6, 0x4d, 0x7d, 0x74, 0x24, 0x1e, 0x38, 0x86, 0x4b, 0x94, 0x02, 0xa9,
+	0xfe, 0xe3, 0x1e, 0x3c, 0xca, 0x9d, 0x35, 0x0d, 0x1d, 0x97, 0x3c, 0x70, 0xa4, 0xff, 0xcd, 0x41,
+	0x2f, 0xb7, 0xb7, 0x70, 0xcd, 0x57, 0x2b, 0xa6, 0x14, 0xc0, 0xc7, 0x62, 0xc9, 0xf1, 0x3b, 0x34,
+	0xec, 0x4e, 0x02, 0xe6, 0x1a, 0x9c, 0xf1, 0xe0, 0xea, 0xe8, 0xcf, 0xaf, 0xd3, 0xa1, 0x94, 0xeb,
+	0x0b, 0xc9, 0xd6, 0xf0, 0xde, 0xbf, 0x9c, 0xfa, 0xd1, 0xa0, 0x5d, 0x17, 0x20, 0xd5, 0x7d, 0xdd,
+	0xa6, 0x60, 0xee, 0x68, 0x57, 0x5f, 0xbb, 0x0e, 0x40, 0xea, 0xff, 0x74, 0x10, 0x6e, 0x37, 0xa1,
+	0x90, 0xdc, 0x09, 0xce, 0x0a, 0x85, 0x5f, 0xa0, 0x3d, 0x10, 0x3c, 0xa1, 0x66, 0xbc, 0x1b, 0x59,
+	0x81, 0xcf, 0x91, 0x5b, 0x72, 0xae, 0x76, 0xb3, 0x4d, 0xac, 0x9f, 0xd1, 0x6d, 0x25, 0x15, 0x5b,
+	0x32, 0xf3, 0x24, 0x9c, 0xf1, 0x41, 0xd4, 0x19, 0xf8, 0x1c, 0x1d, 0xb6, 0x62, 0x21, 0x73, 0xae,
+	0x3c, 0xd7, 0xcc, 0x18, 0xb6, 0xee, 0x3c, 0xb7, 0x90, 0x25, 0x2b, 0x48, 0xce, 0xd6, 0x90, 0x7a,
+	0x7b, 0x16, 0xd2, 0x1a, 0x1a, 0xd2, 0x0a, 0x0b, 0xe9, 0x5b, 0x48, 0xeb, 0x6a, 0xc8, 0xd5, 0xe0,
+	0xfb, 0x66, 0xe4, 0xfc, 0xd8, 0x8c, 0x9c, 0xdf, 0x9b, 0x91, 0x13, 0xf7, 0xcd, 0x27, 0x70, 0xf9,
+	0x77, 0x00, 0x04, 0x2b, 0x5d, 0x08, 0x8f, 0x03, 0x00, 0x00,
 }
 
 func (m *ArchivedActiveSetChanges) Marshal() (dAtA []byte, err error) {
@@ -393,6 +486,75 @@ func (m *ArchivedCommitteeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArchivedCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinalizedSlot != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.FinalizedSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedSlot != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.JustifiedSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Justified {
+		i--
+		if m.Justified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
 	offset -= sovArchive(v)
 	base := offset
@@ -482,6 +644,37 @@ func (m *ArchivedCommitteeInfo) Size() (n int) {
 	return n
 }
 
+func (m *ArchivedCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Justified {
+		n += 2
+	}
+	if m.JustifiedSlot != 0 {
+		n += 1 + sovArchive(uint64(m.JustifiedSlot))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.FinalizedSlot != 0 {
+		n += 1 + sovArchive(uint64(m.FinalizedSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1070,6 +1263,191 @@ func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArchivedCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Justified = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+			}
+			m.JustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+			}
+			m.FinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,7386 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/beacon_chain.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AttestationInclusionProofRequest struct {
+	AttestationDataRoot  []byte   `protobuf:"bytes,1,opt,name=attestation_data_root,json=attestationDataRoot,proto3" json:"attestation_data_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationInclusionProofRequest) Reset()         { *m = AttestationInclusionProofRequest{} }
+func (m *AttestationInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationInclusionProofRequest) ProtoMessage()    {}
+func (*AttestationInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{0}
+}
+func (m *AttestationInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInclusionProofRequest.Merge(m, src)
+}
+func (m *AttestationInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationInclusionProofRequest proto.InternalMessageInfo
+
+func (m *AttestationInclusionProofRequest) GetAttestationDataRoot() []byte {
+	if m != nil {
+		return m.AttestationDataRoot
+	}
+	return nil
+}
+
+type AttestationInclusionProof struct {
+	BlockRoot            []byte                      `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Block                *v1alpha1.SignedBeaconBlock `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	AttestationIndex     uint64                      `protobuf:"varint,3,opt,name=attestation_index,json=attestationIndex,proto3" json:"attestation_index,omitempty"`
+	AttestationRoot      []byte                      `protobuf:"bytes,4,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
+	BodyRoot             []byte                      `protobuf:"bytes,5,opt,name=body_root,json=bodyRoot,proto3" json:"body_root,omitempty"`
+	Proof                [][]byte                    `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+	GeneralizedIndex     uint64                      `protobuf:"varint,7,opt,name=generalized_index,json=generalizedIndex,proto3" json:"generalized_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AttestationInclusionProof) Reset()         { *m = AttestationInclusionProof{} }
+func (m *AttestationInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*AttestationInclusionProof) ProtoMessage()    {}
+func (*AttestationInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{1}
+}
+func (m *AttestationInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInclusionProof.Merge(m, src)
+}
+func (m *AttestationInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationInclusionProof proto.InternalMessageInfo
+
+func (m *AttestationInclusionProof) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *AttestationInclusionProof) GetBlock() *v1alpha1.SignedBeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *AttestationInclusionProof) GetAttestationIndex() uint64 {
+	if m != nil {
+		return m.AttestationIndex
+	}
+	return 0
+}
+
+func (m *AttestationInclusionProof) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
+	}
+	return nil
+}
+
+func (m *AttestationInclusionProof) GetBodyRoot() []byte {
+	if m != nil {
+		return m.BodyRoot
+	}
+	return nil
+}
+
+func (m *AttestationInclusionProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *AttestationInclusionProof) GetGeneralizedIndex() uint64 {
+	if m != nil {
+		return m.GeneralizedIndex
+	}
+	return 0
+}
+
+type ValidatorQueuePositionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorQueuePositionsRequest) Reset()         { *m = ValidatorQueuePositionsRequest{} }
+func (m *ValidatorQueuePositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueuePositionsRequest) ProtoMessage()    {}
+func (*ValidatorQueuePositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{2}
+}
+func (m *ValidatorQueuePositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorQueuePositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorQueuePositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorQueuePositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorQueuePositionsRequest.Merge(m, src)
+}
+func (m *ValidatorQueuePositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorQueuePositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorQueuePositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorQueuePositionsRequest proto.InternalMessageInfo
+
+type ValidatorQueuePositions struct {
+	Epoch                uint64             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChurnLimit           uint64             `protobuf:"varint,2,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
+	ActivationQueue      []*QueuedValidator `protobuf:"bytes,3,rep,name=activation_queue,json=activationQueue,proto3" json:"activation_queue,omitempty"`
+	ExitQueue            []*QueuedValidator `protobuf:"bytes,4,rep,name=exit_queue,json=exitQueue,proto3" json:"exit_queue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ValidatorQueuePositions) Reset()         { *m = ValidatorQueuePositions{} }
+func (m *ValidatorQueuePositions) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueuePositions) ProtoMessage()    {}
+func (*ValidatorQueuePositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{3}
+}
+func (m *ValidatorQueuePositions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorQueuePositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorQueuePositions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorQueuePositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorQueuePositions.Merge(m, src)
+}
+func (m *ValidatorQueuePositions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorQueuePositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorQueuePositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorQueuePositions proto.InternalMessageInfo
+
+func (m *ValidatorQueuePositions) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorQueuePositions) GetChurnLimit() uint64 {
+	if m != nil {
+		return m.ChurnLimit
+	}
+	return 0
+}
+
+func (m *ValidatorQueuePositions) GetActivationQueue() []*QueuedValidator {
+	if m != nil {
+		return m.ActivationQueue
+	}
+	return nil
+}
+
+func (m *ValidatorQueuePositions) GetExitQueue() []*QueuedValidator {
+	if m != nil {
+		return m.ExitQueue
+	}
+	return nil
+}
+
+type QueuedValidator struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Position             uint64   `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	EstimatedEpoch       uint64   `protobuf:"varint,4,opt,name=estimated_epoch,json=estimatedEpoch,proto3" json:"estimated_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueuedValidator) Reset()         { *m = QueuedValidator{} }
+func (m *QueuedValidator) String() string { return proto.CompactTextString(m) }
+func (*QueuedValidator) ProtoMessage()    {}
+func (*QueuedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{4}
+}
+func (m *QueuedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedValidator.Merge(m, src)
+}
+func (m *QueuedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedValidator proto.InternalMessageInfo
+
+func (m *QueuedValidator) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueuedValidator) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *QueuedValidator) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *QueuedValidator) GetEstimatedEpoch() uint64 {
+	if m != nil {
+		return m.EstimatedEpoch
+	}
+	return 0
+}
+
+type Eth1VotingStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1VotingStatusRequest) Reset()         { *m = Eth1VotingStatusRequest{} }
+func (m *Eth1VotingStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*Eth1VotingStatusRequest) ProtoMessage()    {}
+func (*Eth1VotingStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{5}
+}
+func (m *Eth1VotingStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1VotingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1VotingStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1VotingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1VotingStatusRequest.Merge(m, src)
+}
+func (m *Eth1VotingStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1VotingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1VotingStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1VotingStatusRequest proto.InternalMessageInfo
+
+type Eth1VotingStatus struct {
+	Slot                 uint64               `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	PeriodStartSlot      uint64               `protobuf:"varint,2,opt,name=period_start_slot,json=periodStartSlot,proto3" json:"period_start_slot,omitempty"`
+	SlotsUntilPeriodEnd  uint64               `protobuf:"varint,3,opt,name=slots_until_period_end,json=slotsUntilPeriodEnd,proto3" json:"slots_until_period_end,omitempty"`
+	CurrentEth1Data      *v1alpha1.Eth1Data   `protobuf:"bytes,4,opt,name=current_eth1_data,json=currentEth1Data,proto3" json:"current_eth1_data,omitempty"`
+	TotalVotes           uint64               `protobuf:"varint,5,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	VotesRequired        uint64               `protobuf:"varint,6,opt,name=votes_required,json=votesRequired,proto3" json:"votes_required,omitempty"`
+	Candidates           []*Eth1DataCandidate `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Eth1VotingStatus) Reset()         { *m = Eth1VotingStatus{} }
+func (m *Eth1VotingStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1VotingStatus) ProtoMessage()    {}
+func (*Eth1VotingStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{6}
+}
+func (m *Eth1VotingStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1VotingStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1VotingStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1VotingStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1VotingStatus.Merge(m, src)
+}
+func (m *Eth1VotingStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1VotingStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1VotingStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1VotingStatus proto.InternalMessageInfo
+
+func (m *Eth1VotingStatus) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *Eth1VotingStatus) GetPeriodStartSlot() uint64 {
+	if m != nil {
+		return m.PeriodStartSlot
+	}
+	return 0
+}
+
+func (m *Eth1VotingStatus) GetSlotsUntilPeriodEnd() uint64 {
+	if m != nil {
+		return m.SlotsUntilPeriodEnd
+	}
+	return 0
+}
+
+func (m *Eth1VotingStatus) GetCurrentEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.CurrentEth1Data
+	}
+	return nil
+}
+
+func (m *Eth1VotingStatus) GetTotalVotes() uint64 {
+	if m != nil {
+		return m.TotalVotes
+	}
+	return 0
+}
+
+func (m *Eth1VotingStatus) GetVotesRequired() uint64 {
+	if m != nil {
+		return m.VotesRequired
+	}
+	return 0
+}
+
+func (m *Eth1VotingStatus) GetCandidates() []*Eth1DataCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type Eth1DataCandidate struct {
+	Eth1Data             *v1alpha1.Eth1Data `protobuf:"bytes,1,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	VoteCount            uint64             `protobuf:"varint,2,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	BlockNumber          uint64             `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockKnown           bool               `protobuf:"varint,4,opt,name=block_known,json=blockKnown,proto3" json:"block_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Eth1DataCandidate) Reset()         { *m = Eth1DataCandidate{} }
+func (m *Eth1DataCandidate) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataCandidate) ProtoMessage()    {}
+func (*Eth1DataCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{7}
+}
+func (m *Eth1DataCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1DataCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataCandidate.Merge(m, src)
+}
+func (m *Eth1DataCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1DataCandidate proto.InternalMessageInfo
+
+func (m *Eth1DataCandidate) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
+}
+
+func (m *Eth1DataCandidate) GetVoteCount() uint64 {
+	if m != nil {
+		return m.VoteCount
+	}
+	return 0
+}
+
+func (m *Eth1DataCandidate) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Eth1DataCandidate) GetBlockKnown() bool {
+	if m != nil {
+		return m.BlockKnown
+	}
+	return false
+}
+
+type CheckpointTimelineRequest struct {
+	StartEpoch           uint64   `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTimelineRequest) Reset()         { *m = CheckpointTimelineRequest{} }
+func (m *CheckpointTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTimelineRequest) ProtoMessage()    {}
+func (*CheckpointTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{8}
+}
+func (m *CheckpointTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTimelineRequest.Merge(m, src)
+}
+func (m *CheckpointTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTimelineRequest proto.InternalMessageInfo
+
+func (m *CheckpointTimelineRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *CheckpointTimelineRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+type CheckpointTimeline struct {
+	Checkpoints          []*CheckpointTimelineEntry `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CheckpointTimeline) Reset()         { *m = CheckpointTimeline{} }
+func (m *CheckpointTimeline) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTimeline) ProtoMessage()    {}
+func (*CheckpointTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{9}
+}
+func (m *CheckpointTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTimeline.Merge(m, src)
+}
+func (m *CheckpointTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTimeline proto.InternalMessageInfo
+
+func (m *CheckpointTimeline) GetCheckpoints() []*CheckpointTimelineEntry {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type CheckpointTimelineEntry struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Justified            bool     `protobuf:"varint,3,opt,name=justified,proto3" json:"justified,omitempty"`
+	JustifiedSlot        uint64   `protobuf:"varint,4,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`
+	Finalized            bool     `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	FinalizedSlot        uint64   `protobuf:"varint,6,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTimelineEntry) Reset()         { *m = CheckpointTimelineEntry{} }
+func (m *CheckpointTimelineEntry) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTimelineEntry) ProtoMessage()    {}
+func (*CheckpointTimelineEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{10}
+}
+func (m *CheckpointTimelineEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointTimelineEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointTimelineEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointTimelineEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTimelineEntry.Merge(m, src)
+}
+func (m *CheckpointTimelineEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointTimelineEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTimelineEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTimelineEntry proto.InternalMessageInfo
+
+func (m *CheckpointTimelineEntry) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CheckpointTimelineEntry) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *CheckpointTimelineEntry) GetJustified() bool {
+	if m != nil {
+		return m.Justified
+	}
+	return false
+}
+
+func (m *CheckpointTimelineEntry) GetJustifiedSlot() uint64 {
+	if m != nil {
+		return m.JustifiedSlot
+	}
+	return 0
+}
+
+func (m *CheckpointTimelineEntry) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *CheckpointTimelineEntry) GetFinalizedSlot() uint64 {
+	if m != nil {
+		return m.FinalizedSlot
+	}
+	return 0
+}
+
+type SyncProgressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncProgressRequest) Reset()         { *m = SyncProgressRequest{} }
+func (m *SyncProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncProgressRequest) ProtoMessage()    {}
+func (*SyncProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{11}
+}
+func (m *SyncProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncProgressRequest.Merge(m, src)
+}
+func (m *SyncProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncProgressRequest proto.InternalMessageInfo
+
+type SyncProgress struct {
+	Syncing                    bool     `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	HeadSlot                   uint64   `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	CurrentSlot                uint64   `protobuf:"varint,3,opt,name=current_slot,json=currentSlot,proto3" json:"current_slot,omitempty"`
+	SlotsBehind                uint64   `protobuf:"varint,4,opt,name=slots_behind,json=slotsBehind,proto3" json:"slots_behind,omitempty"`
+	HeadSlotsPerSecond         float64  `protobuf:"fixed64,5,opt,name=head_slots_per_second,json=headSlotsPerSecond,proto3" json:"head_slots_per_second,omitempty"`
+	EstimatedSecondsToHead     uint64   `protobuf:"varint,6,opt,name=estimated_seconds_to_head,json=estimatedSecondsToHead,proto3" json:"estimated_seconds_to_head,omitempty"`
+	FinalizedEpoch             uint64   `protobuf:"varint,7,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	PreviousEpochParticipation float64  `protobuf:"fixed64,8,opt,name=previous_epoch_participation,json=previousEpochParticipation,proto3" json:"previous_epoch_participation,omitempty"`
+	FinalizationExpected       bool     `protobuf:"varint,9,opt,name=finalization_expected,json=finalizationExpected,proto3" json:"finalization_expected,omitempty"`
+	EstimatedEpochsToFinality  uint64   `protobuf:"varint,10,opt,name=estimated_epochs_to_finality,json=estimatedEpochsToFinality,proto3" json:"estimated_epochs_to_finality,omitempty"`
+	EstimatedSecondsToFinality uint64   `protobuf:"varint,11,opt,name=estimated_seconds_to_finality,json=estimatedSecondsToFinality,proto3" json:"estimated_seconds_to_finality,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *SyncProgress) Reset()         { *m = SyncProgress{} }
+func (m *SyncProgress) String() string { return proto.CompactTextString(m) }
+func (*SyncProgress) ProtoMessage()    {}
+func (*SyncProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{12}
+}
+func (m *SyncProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncProgress.Merge(m, src)
+}
+func (m *SyncProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncProgress proto.InternalMessageInfo
+
+func (m *SyncProgress) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncProgress) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetCurrentSlot() uint64 {
+	if m != nil {
+		return m.CurrentSlot
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetSlotsBehind() uint64 {
+	if m != nil {
+		return m.SlotsBehind
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetHeadSlotsPerSecond() float64 {
+	if m != nil {
+		return m.HeadSlotsPerSecond
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetEstimatedSecondsToHead() uint64 {
+	if m != nil {
+		return m.EstimatedSecondsToHead
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetPreviousEpochParticipation() float64 {
+	if m != nil {
+		return m.PreviousEpochParticipation
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetFinalizationExpected() bool {
+	if m != nil {
+		return m.FinalizationExpected
+	}
+	return false
+}
+
+func (m *SyncProgress) GetEstimatedEpochsToFinality() uint64 {
+	if m != nil {
+		return m.EstimatedEpochsToFinality
+	}
+	return 0
+}
+
+func (m *SyncProgress) GetEstimatedSecondsToFinality() uint64 {
+	if m != nil {
+		return m.EstimatedSecondsToFinality
+	}
+	return 0
+}
+
+type StateRootsRequest struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateRootsRequest) Reset()         { *m = StateRootsRequest{} }
+func (m *StateRootsRequest) String() string { return proto.CompactTextString(m) }
+func (*StateRootsRequest) ProtoMessage()    {}
+func (*StateRootsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{13}
+}
+func (m *StateRootsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateRootsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateRootsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateRootsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRootsRequest.Merge(m, src)
+}
+func (m *StateRootsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateRootsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRootsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRootsRequest proto.InternalMessageInfo
+
+func (m *StateRootsRequest) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *StateRootsRequest) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+type StateRoots struct {
+	Roots                []*SlotStateRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StateRoots) Reset()         { *m = StateRoots{} }
+func (m *StateRoots) String() string { return proto.CompactTextString(m) }
+func (*StateRoots) ProtoMessage()    {}
+func (*StateRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{14}
+}
+func (m *StateRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRoots.Merge(m, src)
+}
+func (m *StateRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRoots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRoots proto.InternalMessageInfo
+
+func (m *StateRoots) GetRoots() []*SlotStateRoot {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type SlotStateRoot struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Skipped              bool     `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotStateRoot) Reset()         { *m = SlotStateRoot{} }
+func (m *SlotStateRoot) String() string { return proto.CompactTextString(m) }
+func (*SlotStateRoot) ProtoMessage()    {}
+func (*SlotStateRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{15}
+}
+func (m *SlotStateRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotStateRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotStateRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotStateRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotStateRoot.Merge(m, src)
+}
+func (m *SlotStateRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotStateRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotStateRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotStateRoot proto.InternalMessageInfo
+
+func (m *SlotStateRoot) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SlotStateRoot) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *SlotStateRoot) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *SlotStateRoot) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+type BlockOperationsRequest struct {
+	// Types that are valid to be assigned to Query:
+	//	*BlockOperationsRequest_BlockRoot
+	//	*BlockOperationsRequest_Slot
+	Query                isBlockOperationsRequest_Query `protobuf_oneof:"query"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *BlockOperationsRequest) Reset()         { *m = BlockOperationsRequest{} }
+func (m *BlockOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockOperationsRequest) ProtoMessage()    {}
+func (*BlockOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{16}
+}
+func (m *BlockOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockOperationsRequest.Merge(m, src)
+}
+func (m *BlockOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockOperationsRequest proto.InternalMessageInfo
+
+type isBlockOperationsRequest_Query interface {
+	isBlockOperationsRequest_Query()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BlockOperationsRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3,oneof" json:"block_root,omitempty"`
+}
+type BlockOperationsRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+}
+
+func (*BlockOperationsRequest_BlockRoot) isBlockOperationsRequest_Query() {}
+func (*BlockOperationsRequest_Slot) isBlockOperationsRequest_Query()      {}
+
+func (m *BlockOperationsRequest) GetQuery() isBlockOperationsRequest_Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *BlockOperationsRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQuery().(*BlockOperationsRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (m *BlockOperationsRequest) GetSlot() uint64 {
+	if x, ok := m.GetQuery().(*BlockOperationsRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BlockOperationsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BlockOperationsRequest_BlockRoot)(nil),
+		(*BlockOperationsRequest_Slot)(nil),
+	}
+}
+
+type BlockOperations struct {
+	BlockRoot            []byte                       `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Slot                 uint64                       `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Canonical            bool                         `protobuf:"varint,3,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Deposits             []*DepositOperation          `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	VoluntaryExits       []*VoluntaryExitOperation    `protobuf:"bytes,5,rep,name=voluntary_exits,json=voluntaryExits,proto3" json:"voluntary_exits,omitempty"`
+	ProposerSlashings    []*ProposerSlashingOperation `protobuf:"bytes,6,rep,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+	AttesterSlashings    []*AttesterSlashingOperation `protobuf:"bytes,7,rep,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *BlockOperations) Reset()         { *m = BlockOperations{} }
+func (m *BlockOperations) String() string { return proto.CompactTextString(m) }
+func (*BlockOperations) ProtoMessage()    {}
+func (*BlockOperations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{17}
+}
+func (m *BlockOperations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockOperations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockOperations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockOperations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockOperations.Merge(m, src)
+}
+func (m *BlockOperations) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockOperations) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockOperations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockOperations proto.InternalMessageInfo
+
+func (m *BlockOperations) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *BlockOperations) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BlockOperations) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
+func (m *BlockOperations) GetDeposits() []*DepositOperation {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *BlockOperations) GetVoluntaryExits() []*VoluntaryExitOperation {
+	if m != nil {
+		return m.VoluntaryExits
+	}
+	return nil
+}
+
+func (m *BlockOperations) GetProposerSlashings() []*ProposerSlashingOperation {
+	if m != nil {
+		return m.ProposerSlashings
+	}
+	return nil
+}
+
+func (m *BlockOperations) GetAttesterSlashings() []*AttesterSlashingOperation {
+	if m != nil {
+		return m.AttesterSlashings
+	}
+	return nil
+}
+
+type DepositOperation struct {
+	Index                uint64            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Deposit              *v1alpha1.Deposit `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	ValidatorIndex       uint64            `protobuf:"varint,3,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ValidatorKnown       bool              `protobuf:"varint,4,opt,name=validator_known,json=validatorKnown,proto3" json:"validator_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DepositOperation) Reset()         { *m = DepositOperation{} }
+func (m *DepositOperation) String() string { return proto.CompactTextString(m) }
+func (*DepositOperation) ProtoMessage()    {}
+func (*DepositOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{18}
+}
+func (m *DepositOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositOperation.Merge(m, src)
+}
+func (m *DepositOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositOperation proto.InternalMessageInfo
+
+func (m *DepositOperation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositOperation) GetDeposit() *v1alpha1.Deposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *DepositOperation) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *DepositOperation) GetValidatorKnown() bool {
+	if m != nil {
+		return m.ValidatorKnown
+	}
+	return false
+}
+
+type VoluntaryExitOperation struct {
+	Index                uint64                        `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Exit                 *v1alpha1.SignedVoluntaryExit `protobuf:"bytes,2,opt,name=exit,proto3" json:"exit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *VoluntaryExitOperation) Reset()         { *m = VoluntaryExitOperation{} }
+func (m *VoluntaryExitOperation) String() string { return proto.CompactTextString(m) }
+func (*VoluntaryExitOperation) ProtoMessage()    {}
+func (*VoluntaryExitOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{19}
+}
+func (m *VoluntaryExitOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoluntaryExitOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoluntaryExitOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoluntaryExitOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoluntaryExitOperation.Merge(m, src)
+}
+func (m *VoluntaryExitOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoluntaryExitOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoluntaryExitOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoluntaryExitOperation proto.InternalMessageInfo
+
+func (m *VoluntaryExitOperation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *VoluntaryExitOperation) GetExit() *v1alpha1.SignedVoluntaryExit {
+	if m != nil {
+		return m.Exit
+	}
+	return nil
+}
+
+type ProposerSlashingOperation struct {
+	Index                uint64                     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Slashing             *v1alpha1.ProposerSlashing `protobuf:"bytes,2,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	SlashedIndex         uint64                     `protobuf:"varint,3,opt,name=slashed_index,json=slashedIndex,proto3" json:"slashed_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ProposerSlashingOperation) Reset()         { *m = ProposerSlashingOperation{} }
+func (m *ProposerSlashingOperation) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashingOperation) ProtoMessage()    {}
+func (*ProposerSlashingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{20}
+}
+func (m *ProposerSlashingOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSlashingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSlashingOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSlashingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashingOperation.Merge(m, src)
+}
+func (m *ProposerSlashingOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSlashingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashingOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlashingOperation proto.InternalMessageInfo
+
+func (m *ProposerSlashingOperation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ProposerSlashingOperation) GetSlashing() *v1alpha1.ProposerSlashing {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *ProposerSlashingOperation) GetSlashedIndex() uint64 {
+	if m != nil {
+		return m.SlashedIndex
+	}
+	return 0
+}
+
+type AttesterSlashingOperation struct {
+	Index                uint64                     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Slashing             *v1alpha1.AttesterSlashing `protobuf:"bytes,2,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	SlashedIndices       []uint64                   `protobuf:"varint,3,rep,packed,name=slashed_indices,json=slashedIndices,proto3" json:"slashed_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AttesterSlashingOperation) Reset()         { *m = AttesterSlashingOperation{} }
+func (m *AttesterSlashingOperation) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashingOperation) ProtoMessage()    {}
+func (*AttesterSlashingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{21}
+}
+func (m *AttesterSlashingOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterSlashingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterSlashingOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterSlashingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashingOperation.Merge(m, src)
+}
+func (m *AttesterSlashingOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterSlashingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashingOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterSlashingOperation proto.InternalMessageInfo
+
+func (m *AttesterSlashingOperation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AttesterSlashingOperation) GetSlashing() *v1alpha1.AttesterSlashing {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *AttesterSlashingOperation) GetSlashedIndices() []uint64 {
+	if m != nil {
+		return m.SlashedIndices
+	}
+	return nil
+}
+
+type AttestationStreamFilter struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	CommitteeIndices     []uint64 `protobuf:"varint,3,rep,packed,name=committee_indices,json=committeeIndices,proto3" json:"committee_indices,omitempty"`
+	ValidatorIndices     []uint64 `protobuf:"varint,4,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationStreamFilter) Reset()         { *m = AttestationStreamFilter{} }
+func (m *AttestationStreamFilter) String() string { return proto.CompactTextString(m) }
+func (*AttestationStreamFilter) ProtoMessage()    {}
+func (*AttestationStreamFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c971531c2e12206, []int{22}
+}
+func (m *AttestationStreamFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationStreamFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationStreamFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationStreamFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationStreamFilter.Merge(m, src)
+}
+func (m *AttestationStreamFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationStreamFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationStreamFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationStreamFilter proto.InternalMessageInfo
+
+func (m *AttestationStreamFilter) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *AttestationStreamFilter) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+func (m *AttestationStreamFilter) GetCommitteeIndices() []uint64 {
+	if m != nil {
+		return m.CommitteeIndices
+	}
+	return nil
+}
+
+func (m *AttestationStreamFilter) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AttestationInclusionProofRequest)(nil), "ethereum.beacon.rpc.v1.AttestationInclusionProofRequest")
+	proto.RegisterType((*AttestationInclusionProof)(nil), "ethereum.beacon.rpc.v1.AttestationInclusionProof")
+	proto.RegisterType((*ValidatorQueuePositionsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorQueuePositionsRequest")
+	proto.RegisterType((*ValidatorQueuePositions)(nil), "ethereum.beacon.rpc.v1.ValidatorQueuePositions")
+	proto.RegisterType((*QueuedValidator)(nil), "ethereum.beacon.rpc.v1.QueuedValidator")
+	proto.RegisterType((*Eth1VotingStatusRequest)(nil), "ethereum.beacon.rpc.v1.Eth1VotingStatusRequest")
+	proto.RegisterType((*Eth1VotingStatus)(nil), "ethereum.beacon.rpc.v1.Eth1VotingStatus")
+	proto.RegisterType((*Eth1DataCandidate)(nil), "ethereum.beacon.rpc.v1.Eth1DataCandidate")
+	proto.RegisterType((*CheckpointTimelineRequest)(nil), "ethereum.beacon.rpc.v1.CheckpointTimelineRequest")
+	proto.RegisterType((*CheckpointTimeline)(nil), "ethereum.beacon.rpc.v1.CheckpointTimeline")
+	proto.RegisterType((*CheckpointTimelineEntry)(nil), "ethereum.beacon.rpc.v1.CheckpointTimelineEntry")
+	proto.RegisterType((*SyncProgressRequest)(nil), "ethereum.beacon.rpc.v1.SyncProgressRequest")
+	proto.RegisterType((*SyncProgress)(nil), "ethereum.beacon.rpc.v1.SyncProgress")
+	proto.RegisterType((*StateRootsRequest)(nil), "ethereum.beacon.rpc.v1.StateRootsRequest")
+	proto.RegisterType((*StateRoots)(nil), "ethereum.beacon.rpc.v1.StateRoots")
+	proto.RegisterType((*SlotStateRoot)(nil), "ethereum.beacon.rpc.v1.SlotStateRoot")
+	proto.RegisterType((*BlockOperationsRequest)(nil), "ethereum.beacon.rpc.v1.BlockOperationsRequest")
+	proto.RegisterType((*BlockOperations)(nil), "ethereum.beacon.rpc.v1.BlockOperations")
+	proto.RegisterType((*DepositOperation)(nil), "ethereum.beacon.rpc.v1.DepositOperation")
+	proto.RegisterType((*VoluntaryExitOperation)(nil), "ethereum.beacon.rpc.v1.VoluntaryExitOperation")
+	proto.RegisterType((*ProposerSlashingOperation)(nil), "ethereum.beacon.rpc.v1.ProposerSlashingOperation")
+	proto.RegisterType((*AttesterSlashingOperation)(nil), "ethereum.beacon.rpc.v1.AttesterSlashingOperation")
+	proto.RegisterType((*AttestationStreamFilter)(nil), "ethereum.beacon.rpc.v1.AttestationStreamFilter")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/beacon_chain.proto", fileDescriptor_6c971531c2e12206)
+}
+
+var fileDescriptor_6c971531c2e12206 = []byte{
+	// 1750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6f, 0x23, 0x49,
+	0x11, 0xbf, 0xd9, 0x38, 0x1b, 0xbb, 0x9c, 0xc4, 0x49, 0xef, 0x26, 0xeb, 0x98, 0x6c, 0x92, 0x1b,
+	0x38, 0x92, 0xdb, 0x93, 0x1c, 0x9c, 0x95, 0xd0, 0x21, 0xd0, 0xc1, 0x26, 0x9b, 0xcd, 0xae, 0x96,
+	0x3f, 0xb9, 0xc9, 0x12, 0xe0, 0x85, 0x61, 0x32, 0x53, 0x1b, 0x37, 0xb1, 0xbb, 0x27, 0x33, 0x6d,
+	0x13, 0xc3, 0x33, 0x4f, 0xf7, 0x11, 0x10, 0xe2, 0x13, 0xf0, 0x82, 0x90, 0xf8, 0x04, 0x48, 0xbc,
+	0x20, 0xf1, 0x11, 0xd0, 0xbe, 0xf0, 0xc2, 0x87, 0x40, 0x5d, 0xdd, 0x33, 0x1e, 0x3b, 0x99, 0x5c,
+	0x16, 0xe9, 0xde, 0xdc, 0xbf, 0xfa, 0xd3, 0x55, 0x35, 0x55, 0xd5, 0x55, 0x86, 0x6f, 0xc6, 0x89,
+	0x54, 0x72, 0xf7, 0x0c, 0x83, 0x50, 0x8a, 0xdd, 0x24, 0x0e, 0x77, 0x87, 0x1d, 0x7b, 0xf2, 0xc3,
+	0x6e, 0xc0, 0x45, 0x9b, 0x18, 0xd8, 0x2a, 0xaa, 0x2e, 0x26, 0x38, 0xe8, 0xb7, 0x0d, 0xb1, 0x9d,
+	0xc4, 0x61, 0x7b, 0xd8, 0x69, 0x6d, 0xa0, 0xea, 0xee, 0x0e, 0x3b, 0x41, 0x2f, 0xee, 0x06, 0x9d,
+	0xdd, 0x40, 0x29, 0x4c, 0x55, 0xa0, 0xb8, 0xb4, 0x72, 0xad, 0xcd, 0x09, 0xba, 0x55, 0x7c, 0xd6,
+	0x93, 0xe1, 0x85, 0x61, 0x70, 0x4f, 0x61, 0xeb, 0xd9, 0x58, 0xea, 0x95, 0x08, 0x7b, 0x83, 0x94,
+	0x4b, 0x71, 0x9c, 0x48, 0xf9, 0xd6, 0xc3, 0xcb, 0x01, 0xa6, 0x8a, 0xed, 0xc1, 0x4a, 0x41, 0xb3,
+	0x1f, 0x05, 0x2a, 0xf0, 0x13, 0x29, 0x55, 0xd3, 0xd9, 0x72, 0x76, 0xe6, 0xbd, 0x07, 0x05, 0xe2,
+	0xf3, 0x40, 0x05, 0x9e, 0x94, 0xca, 0xfd, 0xcb, 0x3d, 0x58, 0x2b, 0x55, 0xcc, 0x1e, 0x03, 0x90,
+	0x11, 0x45, 0x35, 0x35, 0x42, 0xb4, 0x30, 0xfb, 0x0c, 0x66, 0xe9, 0xd0, 0xbc, 0xb7, 0xe5, 0xec,
+	0xd4, 0xf7, 0x76, 0xda, 0xb9, 0xf7, 0xa8, 0xba, 0xed, 0xcc, 0x9d, 0xf6, 0x09, 0x3f, 0x17, 0x18,
+	0xed, 0x93, 0x53, 0xfb, 0x24, 0x6c, 0xc4, 0xd8, 0x27, 0xb0, 0x5c, 0x34, 0x98, 0x8b, 0x08, 0xaf,
+	0x9a, 0x33, 0x5b, 0xce, 0x4e, 0xc5, 0x5b, 0x0a, 0x8a, 0x46, 0x45, 0x78, 0xc5, 0x3e, 0x86, 0x22,
+	0x66, 0x2c, 0xaa, 0x90, 0x45, 0x8d, 0x02, 0x4e, 0x76, 0x7d, 0x0d, 0x6a, 0x67, 0x32, 0x1a, 0x19,
+	0x9e, 0x59, 0xe2, 0xa9, 0x6a, 0x80, 0x88, 0x0f, 0x61, 0x36, 0xd6, 0xce, 0x35, 0xef, 0x6f, 0xcd,
+	0xec, 0xcc, 0x7b, 0xe6, 0xa0, 0x4d, 0x39, 0x47, 0x81, 0x49, 0xd0, 0xe3, 0xbf, 0xc5, 0xc8, 0x9a,
+	0x32, 0x67, 0x4c, 0x29, 0x10, 0xc8, 0x14, 0x77, 0x0b, 0x36, 0x4e, 0x83, 0x1e, 0x8f, 0x02, 0x25,
+	0x93, 0xcf, 0x07, 0x38, 0xc0, 0x63, 0x99, 0x72, 0x7d, 0x7b, 0x6a, 0x3f, 0x85, 0xfb, 0x5f, 0x07,
+	0x1e, 0x95, 0xb0, 0x68, 0x03, 0x30, 0x96, 0x61, 0x97, 0xe2, 0x59, 0xf1, 0xcc, 0x81, 0x6d, 0x42,
+	0x3d, 0xec, 0x0e, 0x12, 0xe1, 0xf7, 0x78, 0x9f, 0x2b, 0x8a, 0x68, 0xc5, 0x03, 0x82, 0x7e, 0xa8,
+	0x11, 0xe6, 0xc1, 0x52, 0x10, 0x2a, 0x3e, 0x34, 0xee, 0x5f, 0x6a, 0x9d, 0xcd, 0x99, 0xad, 0x99,
+	0x9d, 0xfa, 0xde, 0x76, 0xfb, 0xe6, 0xac, 0x6b, 0xd3, 0xc5, 0x51, 0x6e, 0x87, 0xd7, 0x18, 0x2b,
+	0x20, 0x12, 0x7b, 0x01, 0x80, 0x57, 0x5c, 0x59, 0x6d, 0x95, 0xf7, 0xd3, 0x56, 0xd3, 0xa2, 0x04,
+	0xba, 0x5f, 0x38, 0xd0, 0x98, 0x22, 0x6b, 0x37, 0x4d, 0x14, 0xad, 0x9b, 0x74, 0xd0, 0x19, 0x15,
+	0x0f, 0xce, 0x7a, 0x3c, 0xf4, 0x2f, 0x70, 0x44, 0x5e, 0xce, 0x7b, 0x35, 0x83, 0xbc, 0xc6, 0x11,
+	0x6b, 0x41, 0x35, 0xb6, 0x81, 0xb2, 0x89, 0x90, 0x9f, 0xd9, 0x36, 0x34, 0x30, 0x55, 0xbc, 0x1f,
+	0x28, 0x8c, 0x7c, 0x13, 0xc1, 0x0a, 0xb1, 0x2c, 0xe6, 0xf0, 0xa1, 0x46, 0xdd, 0x35, 0x78, 0x74,
+	0xa8, 0xba, 0x9d, 0x53, 0xa9, 0xb8, 0x38, 0x3f, 0x51, 0x81, 0x1a, 0xe4, 0xdf, 0xe5, 0x3f, 0xf7,
+	0x60, 0x69, 0x9a, 0xc6, 0x18, 0x54, 0xd2, 0x9e, 0xcd, 0xef, 0x8a, 0x47, 0xbf, 0xd9, 0x13, 0x58,
+	0x8e, 0x31, 0xe1, 0x32, 0xf2, 0x53, 0x15, 0x24, 0xca, 0x27, 0x06, 0xf3, 0x51, 0x1a, 0x86, 0x70,
+	0xa2, 0xf1, 0x13, 0xcd, 0xfb, 0x14, 0x56, 0x35, 0x39, 0xf5, 0x07, 0x42, 0xf1, 0x9e, 0x6f, 0xe5,
+	0x50, 0x44, 0xd6, 0x85, 0x07, 0x44, 0xfd, 0xa9, 0x26, 0x1e, 0x13, 0xed, 0x50, 0x44, 0xec, 0x35,
+	0x2c, 0x87, 0x83, 0x24, 0x41, 0xa1, 0x7c, 0x54, 0xdd, 0x0e, 0x55, 0x2b, 0xf9, 0x53, 0xdf, 0xdb,
+	0x2c, 0xa9, 0x23, 0x6d, 0x38, 0x15, 0x6e, 0xc3, 0x4a, 0x66, 0x80, 0x4e, 0x1e, 0x25, 0x55, 0xd0,
+	0xf3, 0x87, 0x52, 0x61, 0x4a, 0x29, 0x5f, 0xf1, 0x80, 0xa0, 0x53, 0x8d, 0xb0, 0x8f, 0x60, 0x91,
+	0x48, 0x7e, 0x82, 0x97, 0x03, 0x9e, 0x60, 0xd4, 0xbc, 0x4f, 0x3c, 0x0b, 0x84, 0x7a, 0x16, 0x64,
+	0xaf, 0x00, 0xc2, 0x40, 0x44, 0xfa, 0x0b, 0x62, 0xda, 0x9c, 0xa3, 0x7c, 0xf8, 0xb8, 0x2c, 0x1f,
+	0xb2, 0xdb, 0x0f, 0x32, 0x09, 0xaf, 0x20, 0xec, 0xfe, 0xd5, 0x81, 0xe5, 0x6b, 0x1c, 0xec, 0x7b,
+	0x50, 0x1b, 0x7b, 0xeb, 0xdc, 0xcd, 0xdb, 0x2a, 0x66, 0x6e, 0x3e, 0x06, 0xd0, 0xf6, 0xfa, 0xa1,
+	0x1c, 0x88, 0xec, 0x6b, 0xd4, 0x34, 0x72, 0xa0, 0x01, 0xf6, 0x21, 0xcc, 0x9b, 0x6e, 0x25, 0x06,
+	0xfd, 0x33, 0x4c, 0x6c, 0xf4, 0xeb, 0x84, 0xfd, 0x98, 0x20, 0x1d, 0x28, 0xc3, 0x72, 0x21, 0xe4,
+	0x6f, 0x04, 0xc5, 0xbb, 0xea, 0x99, 0x1e, 0xf7, 0x5a, 0x23, 0xee, 0x2f, 0x60, 0xed, 0xa0, 0x8b,
+	0xe1, 0x45, 0x2c, 0xb9, 0x50, 0x6f, 0x78, 0x1f, 0x7b, 0x5c, 0x60, 0xd6, 0x60, 0x37, 0xa1, 0x6e,
+	0xb2, 0xa1, 0x58, 0xbf, 0x40, 0x10, 0x65, 0x9e, 0x6e, 0x3c, 0x28, 0xb2, 0xe4, 0x34, 0xf6, 0x55,
+	0x51, 0xd8, 0xb4, 0x3c, 0x07, 0x76, 0x5d, 0x35, 0xfb, 0x5c, 0xd7, 0x7d, 0x86, 0xa6, 0x4d, 0x87,
+	0x62, 0xbe, 0x5b, 0x16, 0xf3, 0xeb, 0x0a, 0x0e, 0x85, 0x4a, 0x46, 0x5e, 0x51, 0x87, 0xfb, 0x4f,
+	0x07, 0x1e, 0x95, 0x30, 0x96, 0x34, 0x1f, 0x06, 0x15, 0xea, 0x95, 0xa6, 0x1e, 0xe9, 0x37, 0x5b,
+	0x87, 0xda, 0xaf, 0x07, 0xa9, 0xe2, 0x6f, 0x39, 0x9a, 0x44, 0xae, 0x7a, 0x63, 0x40, 0x27, 0x54,
+	0x7e, 0x30, 0xc5, 0x61, 0x6a, 0x71, 0x21, 0x47, 0xa9, 0x34, 0xd6, 0xa1, 0xf6, 0x96, 0x0b, 0xd3,
+	0x3b, 0x29, 0x2d, 0xab, 0xde, 0x18, 0xd0, 0x4a, 0xf2, 0x83, 0x51, 0x62, 0xb3, 0x32, 0x47, 0xb5,
+	0x12, 0x77, 0x05, 0x1e, 0x9c, 0x8c, 0x44, 0x78, 0x9c, 0xc8, 0xf3, 0x04, 0xd3, 0xbc, 0x96, 0xff,
+	0x58, 0x81, 0xf9, 0x22, 0xce, 0x9a, 0x30, 0x97, 0x8e, 0x44, 0xc8, 0xc5, 0x39, 0x79, 0x57, 0xf5,
+	0xb2, 0xa3, 0xfe, 0x2e, 0x5d, 0x0c, 0xa2, 0x62, 0x15, 0x57, 0x35, 0x40, 0x36, 0x7e, 0x08, 0xf3,
+	0x59, 0x25, 0x12, 0xdd, 0xa6, 0x8d, 0xc5, 0x32, 0x16, 0x53, 0xe1, 0x67, 0xd8, 0xe5, 0x22, 0xb2,
+	0xbe, 0xd6, 0x09, 0xdb, 0x27, 0x88, 0x75, 0x60, 0x25, 0xbf, 0x22, 0xd5, 0x3d, 0xc0, 0x4f, 0x31,
+	0x94, 0xc2, 0x78, 0xed, 0x78, 0x2c, 0xbb, 0x2e, 0x3d, 0xc6, 0xe4, 0x84, 0x28, 0xec, 0x3b, 0xb0,
+	0x36, 0x6e, 0x68, 0x86, 0x3b, 0xf5, 0x95, 0xf4, 0x35, 0xab, 0x8d, 0xc4, 0x6a, 0xce, 0x60, 0x64,
+	0xd2, 0x37, 0xf2, 0x25, 0x06, 0x91, 0xee, 0x85, 0xe3, 0xc8, 0x99, 0x0f, 0x6a, 0x1e, 0xab, 0x71,
+	0x40, 0x4d, 0x46, 0xfe, 0x00, 0xd6, 0xe3, 0x04, 0x87, 0x5c, 0x0e, 0x52, 0xc3, 0xe7, 0xc7, 0x41,
+	0xa2, 0x78, 0xc8, 0x63, 0x7a, 0x06, 0x9a, 0x55, 0xb2, 0xae, 0x95, 0xf1, 0x90, 0xd0, 0x71, 0x91,
+	0x83, 0x3d, 0x85, 0x15, 0xab, 0x93, 0xce, 0x3e, 0x5e, 0xc5, 0x18, 0x2a, 0x8c, 0x9a, 0x35, 0x8a,
+	0xf1, 0xc3, 0x22, 0xf1, 0xd0, 0xd2, 0xd8, 0xf7, 0x61, 0x7d, 0xaa, 0x57, 0x93, 0x67, 0x86, 0x57,
+	0x8d, 0x9a, 0x40, 0xc6, 0xae, 0x4d, 0x36, 0xee, 0xf4, 0x8d, 0x7c, 0x61, 0x19, 0xd8, 0x33, 0x78,
+	0x7c, 0x63, 0x6c, 0x72, 0x0d, 0x75, 0xd2, 0xd0, 0xba, 0x1e, 0x9f, 0x4c, 0x85, 0xfb, 0x23, 0x58,
+	0xd6, 0x0d, 0x1e, 0xf5, 0xab, 0x9f, 0x25, 0x8d, 0x6e, 0x21, 0x85, 0x86, 0x6e, 0x8a, 0xa0, 0x96,
+	0xe6, 0xad, 0x7c, 0x0d, 0x74, 0xbd, 0x16, 0xf3, 0x64, 0x0e, 0x85, 0xc9, 0xc2, 0x57, 0x00, 0x63,
+	0x75, 0xec, 0xbb, 0x30, 0xab, 0xab, 0x24, 0x2b, 0xd8, 0x8f, 0xca, 0x0a, 0x56, 0x8b, 0xe6, 0x62,
+	0x9e, 0x91, 0x71, 0x7f, 0x07, 0x0b, 0x13, 0xf8, 0x8d, 0x2f, 0x90, 0xce, 0xe6, 0x0b, 0x1e, 0xc7,
+	0x18, 0x35, 0xef, 0xd9, 0x6c, 0x36, 0xc7, 0xa9, 0xa9, 0x6c, 0x66, 0x7a, 0x2a, 0x33, 0x2e, 0x2a,
+	0x2c, 0x8e, 0x48, 0xb5, 0x34, 0xbb, 0xcb, 0xfd, 0x39, 0xac, 0xd2, 0x10, 0xf6, 0x93, 0x18, 0x93,
+	0xa0, 0x38, 0xb4, 0xb0, 0xcd, 0xeb, 0xd3, 0xde, 0xcb, 0x0f, 0x8a, 0x9a, 0x1f, 0x5a, 0x33, 0x29,
+	0x32, 0x2f, 0x3f, 0x30, 0x86, 0xee, 0xcf, 0xc1, 0xec, 0xe5, 0x00, 0x93, 0x91, 0xfb, 0xf7, 0x19,
+	0x68, 0x4c, 0xa9, 0xfe, 0xb2, 0x09, 0x92, 0x15, 0x35, 0x5a, 0xc7, 0xd7, 0xa1, 0x16, 0x06, 0x42,
+	0x0a, 0x1e, 0x06, 0xbd, 0xac, 0xf1, 0xe4, 0x00, 0x7b, 0x0e, 0xd5, 0x08, 0x69, 0x26, 0x48, 0xed,
+	0xc0, 0xb2, 0x53, 0x16, 0xfb, 0xe7, 0x86, 0x2f, 0xb7, 0xc6, 0xcb, 0x25, 0xd9, 0xcf, 0xa0, 0x31,
+	0x94, 0xbd, 0x81, 0x50, 0x41, 0x32, 0xf2, 0xf5, 0x1c, 0xa3, 0x1f, 0x4d, 0xad, 0xac, 0x5d, 0xa6,
+	0xec, 0x34, 0x63, 0x3f, 0xbc, 0x2a, 0xaa, 0x5c, 0x1c, 0x16, 0xf1, 0x94, 0xfd, 0x0a, 0x58, 0x9c,
+	0xc8, 0x58, 0xa6, 0xba, 0x01, 0xf4, 0x82, 0xb4, 0xcb, 0xc5, 0x79, 0x4a, 0xa3, 0x66, 0x7d, 0xaf,
+	0x53, 0xa6, 0xfb, 0xd8, 0x4a, 0x9c, 0x58, 0x81, 0xb1, 0xfa, 0xe5, 0x78, 0x8a, 0x44, 0x37, 0x98,
+	0x79, 0x77, 0xe2, 0x86, 0xb9, 0xdb, 0x6f, 0x78, 0x66, 0x25, 0x6e, 0xb8, 0x21, 0x98, 0x22, 0xd1,
+	0xd3, 0xbd, 0x34, 0x1d, 0xbb, 0x92, 0x71, 0xee, 0x53, 0x98, 0xb3, 0x31, 0xb5, 0x3b, 0xc0, 0x46,
+	0xc9, 0x6b, 0x6e, 0xf5, 0x79, 0x19, 0xbb, 0xee, 0x60, 0xc3, 0x6c, 0x56, 0x9c, 0x98, 0xfc, 0x17,
+	0x73, 0xd8, 0xcc, 0xfd, 0x13, 0x8c, 0xc5, 0x67, 0x7b, 0xcc, 0x68, 0x9e, 0x6e, 0x01, 0xab, 0x37,
+	0x7f, 0xa4, 0x12, 0xdb, 0x3f, 0x83, 0x0a, 0x5e, 0xe5, 0x86, 0x3f, 0xb9, 0x75, 0x79, 0x99, 0x50,
+	0xec, 0x91, 0x9c, 0xfb, 0x07, 0x07, 0xd6, 0x4a, 0xbf, 0x5c, 0xc9, 0x9d, 0x07, 0x50, 0xcd, 0xbe,
+	0x99, 0xbd, 0x77, 0xbb, 0xe4, 0xde, 0x69, 0xcd, 0x5e, 0x2e, 0xc8, 0xbe, 0x0e, 0x0b, 0xf4, 0x1b,
+	0xa3, 0x89, 0xc0, 0xcd, 0x5b, 0xd0, 0xec, 0x28, 0x7f, 0x72, 0xb2, 0xc5, 0xee, 0xab, 0xb0, 0x6e,
+	0x5a, 0x73, 0xc1, 0xba, 0x6d, 0x68, 0x14, 0xac, 0xe3, 0x21, 0xa6, 0xb4, 0xa6, 0x54, 0xbc, 0xc5,
+	0xb1, 0x7d, 0x1a, 0x75, 0xff, 0xec, 0xc0, 0xa3, 0xc2, 0xea, 0x79, 0xa2, 0x12, 0x0c, 0xfa, 0x2f,
+	0x78, 0x4f, 0x61, 0xf2, 0xff, 0xb7, 0x69, 0xbd, 0xc8, 0x85, 0xb2, 0xdf, 0xe7, 0x4a, 0x21, 0x4e,
+	0x19, 0xb0, 0x94, 0x13, 0xac, 0x09, 0x9a, 0x79, 0x22, 0x09, 0x89, 0xb9, 0x62, 0x98, 0x8b, 0x69,
+	0xa8, 0xf1, 0xbd, 0xbf, 0xcd, 0x41, 0xdd, 0x2c, 0xb1, 0x07, 0x7a, 0xe3, 0x67, 0x5f, 0x38, 0xb0,
+	0x7e, 0x84, 0xaa, 0x7c, 0x7b, 0xfe, 0xf4, 0xf6, 0x6a, 0x2c, 0xdf, 0xe4, 0x5b, 0x9d, 0xf7, 0x96,
+	0x64, 0xbf, 0x77, 0xa0, 0x75, 0x84, 0xaa, 0x6c, 0xe9, 0xfc, 0x76, 0x69, 0x5f, 0xbb, 0x75, 0x91,
+	0x6d, 0xed, 0xbe, 0xa7, 0x1c, 0x8b, 0xe1, 0xc1, 0x11, 0xaa, 0x6b, 0x3b, 0xd6, 0xee, 0x6d, 0x5b,
+	0xc4, 0x0d, 0x9b, 0x5a, 0x6b, 0xe7, 0xae, 0x02, 0x6c, 0x08, 0x2b, 0x47, 0xa8, 0x6e, 0x18, 0xad,
+	0x3b, 0x77, 0x9f, 0xa2, 0xb3, 0x5b, 0x9f, 0xdc, 0x5d, 0x84, 0x45, 0xd0, 0x38, 0x42, 0x35, 0x31,
+	0x81, 0x7e, 0x52, 0x3a, 0x06, 0x5c, 0x9f, 0x5f, 0x5b, 0xdf, 0xb8, 0x0b, 0x33, 0xfb, 0x25, 0x2c,
+	0xe8, 0x5b, 0xc6, 0x93, 0x47, 0xe9, 0x3e, 0x76, 0x6d, 0xd8, 0x69, 0xb9, 0x5f, 0xce, 0xca, 0xfa,
+	0xc0, 0x8e, 0x50, 0x4d, 0x3f, 0xdb, 0xa5, 0xcf, 0xe0, 0xcd, 0xa3, 0x43, 0x6b, 0xfb, 0x8e, 0xfc,
+	0x2c, 0x85, 0x56, 0xb1, 0xd0, 0x31, 0x2a, 0x64, 0xf4, 0x2d, 0x59, 0x52, 0xd2, 0x27, 0x5a, 0xee,
+	0xad, 0xfd, 0x89, 0xf8, 0xbf, 0xe5, 0xec, 0xcf, 0xff, 0xe3, 0xdd, 0x86, 0xf3, 0xaf, 0x77, 0x1b,
+	0xce, 0xbf, 0xdf, 0x6d, 0x38, 0x67, 0xf7, 0xe9, 0x1f, 0xb5, 0xa7, 0xff, 0x1b, 0x00, 0xc2, 0xa4,
+	0xf5, 0x43, 0xd4, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconChainClient is the client API for BeaconChain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainClient interface {
+	GetAttestationInclusionProof(ctx context.Context, in *AttestationInclusionProofRequest, opts ...grpc.CallOption) (*AttestationInclusionProof, error)
+	GetValidatorQueuePositions(ctx context.Context, in *ValidatorQueuePositionsRequest, opts ...grpc.CallOption) (*ValidatorQueuePositions, error)
+	GetEth1VotingStatus(ctx context.Context, in *Eth1VotingStatusRequest, opts ...grpc.CallOption) (*Eth1VotingStatus, error)
+	GetCheckpointTimeline(ctx context.Context, in *CheckpointTimelineRequest, opts ...grpc.CallOption) (*CheckpointTimeline, error)
+	GetSyncProgress(ctx context.Context, in *SyncProgressRequest, opts ...grpc.CallOption) (*SyncProgress, error)
+	GetStateRoots(ctx context.Context, in *StateRootsRequest, opts ...grpc.CallOption) (*StateRoots, error)
+	GetBlockOperations(ctx context.Context, in *BlockOperationsRequest, opts ...grpc.CallOption) (*BlockOperations, error)
+	StreamFilteredAttestations(ctx context.Context, in *AttestationStreamFilter, opts ...grpc.CallOption) (BeaconChain_StreamFilteredAttestationsClient, error)
+}
+
+type beaconChainClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
+	return &beaconChainClient{cc}
+}
+
+func (c *beaconChainClient) GetAttestationInclusionProof(ctx context.Context, in *AttestationInclusionProofRequest, opts ...grpc.CallOption) (*AttestationInclusionProof, error) {
+	out := new(AttestationInclusionProof)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetAttestationInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorQueuePositions(ctx context.Context, in *ValidatorQueuePositionsRequest, opts ...grpc.CallOption) (*ValidatorQueuePositions, error) {
+	out := new(ValidatorQueuePositions)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetValidatorQueuePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetEth1VotingStatus(ctx context.Context, in *Eth1VotingStatusRequest, opts ...grpc.CallOption) (*Eth1VotingStatus, error) {
+	out := new(Eth1VotingStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetEth1VotingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetCheckpointTimeline(ctx context.Context, in *CheckpointTimelineRequest, opts ...grpc.CallOption) (*CheckpointTimeline, error) {
+	out := new(CheckpointTimeline)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetCheckpointTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetSyncProgress(ctx context.Context, in *SyncProgressRequest, opts ...grpc.CallOption) (*SyncProgress, error) {
+	out := new(SyncProgress)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetSyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetStateRoots(ctx context.Context, in *StateRootsRequest, opts ...grpc.CallOption) (*StateRoots, error) {
+	out := new(StateRoots)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetStateRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetBlockOperations(ctx context.Context, in *BlockOperationsRequest, opts ...grpc.CallOption) (*BlockOperations, error) {
+	out := new(BlockOperations)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconChain/GetBlockOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) StreamFilteredAttestations(ctx context.Context, in *AttestationStreamFilter, opts ...grpc.CallOption) (BeaconChain_StreamFilteredAttestationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconChain/StreamFilteredAttestations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamFilteredAttestationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChain_StreamFilteredAttestationsClient interface {
+	Recv() (*v1alpha1.Attestation, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamFilteredAttestationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamFilteredAttestationsClient) Recv() (*v1alpha1.Attestation, error) {
+	m := new(v1alpha1.Attestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BeaconChainServer is the server API for BeaconChain service.
+type BeaconChainServer interface {
+	GetAttestationInclusionProof(context.Context, *AttestationInclusionProofRequest) (*AttestationInclusionProof, error)
+	GetValidatorQueuePositions(context.Context, *ValidatorQueuePositionsRequest) (*ValidatorQueuePositions, error)
+	GetEth1VotingStatus(context.Context, *Eth1VotingStatusRequest) (*Eth1VotingStatus, error)
+	GetCheckpointTimeline(context.Context, *CheckpointTimelineRequest) (*CheckpointTimeline, error)
+	GetSyncProgress(context.Context, *SyncProgressRequest) (*SyncProgress, error)
+	GetStateRoots(context.Context, *StateRootsRequest) (*StateRoots, error)
+	GetBlockOperations(context.Context, *BlockOperationsRequest) (*BlockOperations, error)
+	StreamFilteredAttestations(*AttestationStreamFilter, BeaconChain_StreamFilteredAttestationsServer) error
+}
+
+// UnimplementedBeaconChainServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconChainServer struct {
+}
+
+func (*UnimplementedBeaconChainServer) GetAttestationInclusionProof(ctx context.Context, req *AttestationInclusionProofRequest) (*AttestationInclusionProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestationInclusionProof not implemented")
+}
+func (*UnimplementedBeaconChainServer) GetValidatorQueuePositions(ctx context.Context, req *ValidatorQueuePositionsRequest) (*ValidatorQueuePositions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueuePositions not implemented")
+}
+func (*UnimplementedBeaconChainServer) GetEth1VotingStatus(ctx context.Context, req *Eth1VotingStatusRequest) (*Eth1VotingStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1VotingStatus not implemented")
+}
+func (*UnimplementedBeaconChainServer) GetCheckpointTimeline(ctx context.Context, req *CheckpointTimelineRequest) (*CheckpointTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpointTimeline not implemented")
+}
+func (*UnimplementedBeaconChainServer) GetSyncProgress(ctx context.Context, req *SyncProgressRequest) (*SyncProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncProgress not implemented")
+}
+func (*UnimplementedBeaconChainServer) GetStateRoots(ctx context.Context, req *StateRootsRequest) (*StateRoots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateRoots not implemented")
+}
+func (*UnimplementedBeaconChainServer) GetBlockOperations(ctx context.Context, req *BlockOperationsRequest) (*BlockOperations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockOperations not implemented")
+}
+func (*UnimplementedBeaconChainServer) StreamFilteredAttestations(req *AttestationStreamFilter, srv BeaconChain_StreamFilteredAttestationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFilteredAttestations not implemented")
+}
+
+func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
+	s.RegisterService(&_BeaconChain_serviceDesc, srv)
+}
+
+func _BeaconChain_GetAttestationInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetAttestationInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetAttestationInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetAttestationInclusionProof(ctx, req.(*AttestationInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetValidatorQueuePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorQueuePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorQueuePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetValidatorQueuePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorQueuePositions(ctx, req.(*ValidatorQueuePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetEth1VotingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Eth1VotingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetEth1VotingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetEth1VotingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetEth1VotingStatus(ctx, req.(*Eth1VotingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetCheckpointTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetCheckpointTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetCheckpointTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetCheckpointTimeline(ctx, req.(*CheckpointTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetSyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetSyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetSyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetSyncProgress(ctx, req.(*SyncProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetStateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetStateRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetStateRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetStateRoots(ctx, req.(*StateRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetBlockOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetBlockOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconChain/GetBlockOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetBlockOperations(ctx, req.(*BlockOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_StreamFilteredAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttestationStreamFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamFilteredAttestations(m, &beaconChainStreamFilteredAttestationsServer{stream})
+}
+
+type BeaconChain_StreamFilteredAttestationsServer interface {
+	Send(*v1alpha1.Attestation) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamFilteredAttestationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamFilteredAttestationsServer) Send(m *v1alpha1.Attestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BeaconChain_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconChain",
+	HandlerType: (*BeaconChainServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttestationInclusionProof",
+			Handler:    _BeaconChain_GetAttestationInclusionProof_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueuePositions",
+			Handler:    _BeaconChain_GetValidatorQueuePositions_Handler,
+		},
+		{
+			MethodName: "GetEth1VotingStatus",
+			Handler:    _BeaconChain_GetEth1VotingStatus_Handler,
+		},
+		{
+			MethodName: "GetCheckpointTimeline",
+			Handler:    _BeaconChain_GetCheckpointTimeline_Handler,
+		},
+		{
+			MethodName: "GetSyncProgress",
+			Handler:    _BeaconChain_GetSyncProgress_Handler,
+		},
+		{
+			MethodName: "GetStateRoots",
+			Handler:    _BeaconChain_GetStateRoots_Handler,
+		},
+		{
+			MethodName: "GetBlockOperations",
+			Handler:    _BeaconChain_GetBlockOperations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFilteredAttestations",
+			Handler:       _BeaconChain_StreamFilteredAttestations_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/beacon_chain.proto",
+}
+
+func (m *AttestationInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AttestationDataRoot) > 0 {
+		i -= len(m.AttestationDataRoot)
+		copy(dAtA[i:], m.AttestationDataRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.AttestationDataRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GeneralizedIndex != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.GeneralizedIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BodyRoot) > 0 {
+		i -= len(m.BodyRoot)
+		copy(dAtA[i:], m.BodyRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BodyRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AttestationIndex != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.AttestationIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorQueuePositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueuePositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorQueuePositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorQueuePositions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueuePositions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorQueuePositions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExitQueue) > 0 {
+		for iNdEx := len(m.ExitQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ActivationQueue) > 0 {
+		for iNdEx := len(m.ActivationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActivationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChurnLimit != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedEpoch != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EstimatedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Position != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1VotingStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1VotingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1VotingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1VotingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1VotingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1VotingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.VotesRequired != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.VotesRequired))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalVotes != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalVotes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CurrentEth1Data != nil {
+		{
+			size, err := m.CurrentEth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SlotsUntilPeriodEnd != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.SlotsUntilPeriodEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PeriodStartSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PeriodStartSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1DataCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1DataCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BlockKnown {
+		i--
+		if m.BlockKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VoteCount != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.VoteCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Eth1Data != nil {
+		{
+			size, err := m.Eth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointTimelineEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointTimelineEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointTimelineEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinalizedSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.JustifiedSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Justified {
+		i--
+		if m.Justified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedSecondsToFinality != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EstimatedSecondsToFinality))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EstimatedEpochsToFinality != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EstimatedEpochsToFinality))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FinalizationExpected {
+		i--
+		if m.FinalizationExpected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PreviousEpochParticipation != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PreviousEpochParticipation))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EstimatedSecondsToHead != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EstimatedSecondsToHead))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HeadSlotsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HeadSlotsPerSecond))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.SlotsBehind != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.SlotsBehind))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CurrentSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.CurrentSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateRootsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRootsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRootsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EndSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlotStateRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotStateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlotStateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Query != nil {
+		{
+			size := m.Query.Size()
+			i -= size
+			if _, err := m.Query.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockOperationsRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockOperationsRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockOperationsRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockOperationsRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *BlockOperations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockOperations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AttesterSlashings) > 0 {
+		for iNdEx := len(m.AttesterSlashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttesterSlashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ProposerSlashings) > 0 {
+		for iNdEx := len(m.ProposerSlashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerSlashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoluntaryExits) > 0 {
+		for iNdEx := len(m.VoluntaryExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoluntaryExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Canonical {
+		i--
+		if m.Canonical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ValidatorKnown {
+		i--
+		if m.ValidatorKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoluntaryExitOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoluntaryExitOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoluntaryExitOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Exit != nil {
+		{
+			size, err := m.Exit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerSlashingOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSlashingOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSlashingOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlashedIndex != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.SlashedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttesterSlashingOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterSlashingOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterSlashingOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SlashedIndices) > 0 {
+		dAtA8 := make([]byte, len(m.SlashedIndices)*10)
+		var j7 int
+		for _, num := range m.SlashedIndices {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationStreamFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationStreamFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationStreamFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA11 := make([]byte, len(m.ValidatorIndices)*10)
+		var j10 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CommitteeIndices) > 0 {
+		dAtA13 := make([]byte, len(m.CommitteeIndices)*10)
+		var j12 int
+		for _, num := range m.CommitteeIndices {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EndSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBeaconChain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttestationDataRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.AttestationIndex != 0 {
+		n += 1 + sovBeaconChain(uint64(m.AttestationIndex))
+	}
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	l = len(m.BodyRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.GeneralizedIndex != 0 {
+		n += 1 + sovBeaconChain(uint64(m.GeneralizedIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueuePositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueuePositions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.ChurnLimit != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
+	}
+	if len(m.ActivationQueue) > 0 {
+		for _, e := range m.ActivationQueue {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitQueue) > 0 {
+		for _, e := range m.ExitQueue {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueuedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Position))
+	}
+	if m.EstimatedEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EstimatedEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1VotingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1VotingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if m.PeriodStartSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PeriodStartSlot))
+	}
+	if m.SlotsUntilPeriodEnd != 0 {
+		n += 1 + sovBeaconChain(uint64(m.SlotsUntilPeriodEnd))
+	}
+	if m.CurrentEth1Data != nil {
+		l = m.CurrentEth1Data.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalVotes))
+	}
+	if m.VotesRequired != 0 {
+		n += 1 + sovBeaconChain(uint64(m.VotesRequired))
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.VoteCount != 0 {
+		n += 1 + sovBeaconChain(uint64(m.VoteCount))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovBeaconChain(uint64(m.BlockNumber))
+	}
+	if m.BlockKnown {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckpointTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EndEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckpointTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckpointTimelineEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Justified {
+		n += 2
+	}
+	if m.JustifiedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.JustifiedSlot))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.FinalizedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.FinalizedSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.HeadSlot))
+	}
+	if m.CurrentSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.CurrentSlot))
+	}
+	if m.SlotsBehind != 0 {
+		n += 1 + sovBeaconChain(uint64(m.SlotsBehind))
+	}
+	if m.HeadSlotsPerSecond != 0 {
+		n += 9
+	}
+	if m.EstimatedSecondsToHead != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EstimatedSecondsToHead))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.FinalizedEpoch))
+	}
+	if m.PreviousEpochParticipation != 0 {
+		n += 9
+	}
+	if m.FinalizationExpected {
+		n += 2
+	}
+	if m.EstimatedEpochsToFinality != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EstimatedEpochsToFinality))
+	}
+	if m.EstimatedSecondsToFinality != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EstimatedSecondsToFinality))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateRootsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EndSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, e := range m.Roots {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlotStateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if m.Skipped {
+		n += 2
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		n += m.Query.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockOperationsRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *BlockOperationsRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Slot))
+	return n
+}
+func (m *BlockOperations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if m.Canonical {
+		n += 2
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.VoluntaryExits) > 0 {
+		for _, e := range m.VoluntaryExits {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ProposerSlashings) > 0 {
+		for _, e := range m.ProposerSlashings {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.AttesterSlashings) > 0 {
+		for _, e := range m.AttesterSlashings {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DepositOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ValidatorIndex))
+	}
+	if m.ValidatorKnown {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VoluntaryExitOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Exit != nil {
+		l = m.Exit.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerSlashingOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.SlashedIndex != 0 {
+		n += 1 + sovBeaconChain(uint64(m.SlashedIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttesterSlashingOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if len(m.SlashedIndices) > 0 {
+		l = 0
+		for _, e := range m.SlashedIndices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationStreamFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EndSlot))
+	}
+	if len(m.CommitteeIndices) > 0 {
+		l = 0
+		for _, e := range m.CommitteeIndices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBeaconChain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBeaconChain(x uint64) (n int) {
+	return sovBeaconChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationDataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationDataRoot = append(m.AttestationDataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationDataRoot == nil {
+				m.AttestationDataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1alpha1.SignedBeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationIndex", wireType)
+			}
+			m.AttestationIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyRoot = append(m.BodyRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyRoot == nil {
+				m.BodyRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralizedIndex", wireType)
+			}
+			m.GeneralizedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GeneralizedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorQueuePositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorQueuePositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorQueuePositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorQueuePositions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorQueuePositions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorQueuePositions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
+			}
+			m.ChurnLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChurnLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationQueue = append(m.ActivationQueue, &QueuedValidator{})
+			if err := m.ActivationQueue[len(m.ActivationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitQueue = append(m.ExitQueue, &QueuedValidator{})
+			if err := m.ExitQueue[len(m.ExitQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedEpoch", wireType)
+			}
+			m.EstimatedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eth1VotingStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eth1VotingStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eth1VotingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eth1VotingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eth1VotingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eth1VotingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartSlot", wireType)
+			}
+			m.PeriodStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsUntilPeriodEnd", wireType)
+			}
+			m.SlotsUntilPeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsUntilPeriodEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentEth1Data == nil {
+				m.CurrentEth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.CurrentEth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			m.TotalVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesRequired", wireType)
+			}
+			m.VotesRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesRequired |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &Eth1DataCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eth1DataCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eth1DataCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eth1DataCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCount", wireType)
+			}
+			m.VoteCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockKnown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, &CheckpointTimelineEntry{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointTimelineEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointTimelineEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointTimelineEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Justified = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+			}
+			m.JustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+			}
+			m.FinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlot", wireType)
+			}
+			m.CurrentSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsBehind", wireType)
+			}
+			m.SlotsBehind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsBehind |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlotsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HeadSlotsPerSecond = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSecondsToHead", wireType)
+			}
+			m.EstimatedSecondsToHead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedSecondsToHead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochParticipation", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PreviousEpochParticipation = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationExpected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FinalizationExpected = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedEpochsToFinality", wireType)
+			}
+			m.EstimatedEpochsToFinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedEpochsToFinality |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSecondsToFinality", wireType)
+			}
+			m.EstimatedSecondsToFinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedSecondsToFinality |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRootsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRootsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRootsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRoots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRoots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, &SlotStateRoot{})
+			if err := m.Roots[len(m.Roots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotStateRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotStateRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotStateRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Query = &BlockOperationsRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Query = &BlockOperationsRequest_Slot{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockOperations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockOperations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canonical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canonical = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &DepositOperation{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoluntaryExits = append(m.VoluntaryExits, &VoluntaryExitOperation{})
+			if err := m.VoluntaryExits[len(m.VoluntaryExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSlashings = append(m.ProposerSlashings, &ProposerSlashingOperation{})
+			if err := m.ProposerSlashings[len(m.ProposerSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterSlashings = append(m.AttesterSlashings, &AttesterSlashingOperation{})
+			if err := m.AttesterSlashings[len(m.AttesterSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &v1alpha1.Deposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorKnown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoluntaryExitOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoluntaryExitOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoluntaryExitOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exit == nil {
+				m.Exit = &v1alpha1.SignedVoluntaryExit{}
+			}
+			if err := m.Exit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSlashingOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSlashingOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSlashingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &v1alpha1.ProposerSlashing{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedIndex", wireType)
+			}
+			m.SlashedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterSlashingOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterSlashingOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterSlashingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &v1alpha1.AttesterSlashing{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SlashedIndices = append(m.SlashedIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SlashedIndices) == 0 {
+					m.SlashedIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SlashedIndices = append(m.SlashedIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationStreamFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationStreamFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationStreamFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CommitteeIndices = append(m.CommitteeIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CommitteeIndices) == 0 {
+					m.CommitteeIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CommitteeIndices = append(m.CommitteeIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndices", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBeaconChain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBeaconChain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBeaconChain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBeaconChain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBeaconChain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBeaconChain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBeaconChain = fmt.Errorf("proto: unexpected end of group")
+)
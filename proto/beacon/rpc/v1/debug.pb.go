@@ -0,0 +1,2606 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/debug.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StateGenerationTraceRequest struct {
+	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateGenerationTraceRequest) Reset()         { *m = StateGenerationTraceRequest{} }
+func (m *StateGenerationTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*StateGenerationTraceRequest) ProtoMessage()    {}
+func (*StateGenerationTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{0}
+}
+func (m *StateGenerationTraceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateGenerationTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateGenerationTraceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateGenerationTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateGenerationTraceRequest.Merge(m, src)
+}
+func (m *StateGenerationTraceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateGenerationTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateGenerationTraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateGenerationTraceRequest proto.InternalMessageInfo
+
+func (m *StateGenerationTraceRequest) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+type StateGenerationTraceResponse struct {
+	Encoded              []byte   `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateGenerationTraceResponse) Reset()         { *m = StateGenerationTraceResponse{} }
+func (m *StateGenerationTraceResponse) String() string { return proto.CompactTextString(m) }
+func (*StateGenerationTraceResponse) ProtoMessage()    {}
+func (*StateGenerationTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{1}
+}
+func (m *StateGenerationTraceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateGenerationTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateGenerationTraceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateGenerationTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateGenerationTraceResponse.Merge(m, src)
+}
+func (m *StateGenerationTraceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateGenerationTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateGenerationTraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateGenerationTraceResponse proto.InternalMessageInfo
+
+func (m *StateGenerationTraceResponse) GetEncoded() []byte {
+	if m != nil {
+		return m.Encoded
+	}
+	return nil
+}
+
+type ReplayBenchmarkRequest struct {
+	BlockCount           uint64   `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayBenchmarkRequest) Reset()         { *m = ReplayBenchmarkRequest{} }
+func (m *ReplayBenchmarkRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayBenchmarkRequest) ProtoMessage()    {}
+func (*ReplayBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+}
+func (m *ReplayBenchmarkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayBenchmarkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayBenchmarkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayBenchmarkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayBenchmarkRequest.Merge(m, src)
+}
+func (m *ReplayBenchmarkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayBenchmarkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayBenchmarkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayBenchmarkRequest proto.InternalMessageInfo
+
+func (m *ReplayBenchmarkRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+type ReplayBenchmarkResponse struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	BlocksReplayed       uint64   `protobuf:"varint,3,opt,name=blocks_replayed,json=blocksReplayed,proto3" json:"blocks_replayed,omitempty"`
+	StateLoadTimeMs      uint64   `protobuf:"varint,4,opt,name=state_load_time_ms,json=stateLoadTimeMs,proto3" json:"state_load_time_ms,omitempty"`
+	ReplayTimeMs         uint64   `protobuf:"varint,5,opt,name=replay_time_ms,json=replayTimeMs,proto3" json:"replay_time_ms,omitempty"`
+	BlocksPerSecond      float64  `protobuf:"fixed64,6,opt,name=blocks_per_second,json=blocksPerSecond,proto3" json:"blocks_per_second,omitempty"`
+	SignaturesVerified   bool     `protobuf:"varint,7,opt,name=signatures_verified,json=signaturesVerified,proto3" json:"signatures_verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayBenchmarkResponse) Reset()         { *m = ReplayBenchmarkResponse{} }
+func (m *ReplayBenchmarkResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayBenchmarkResponse) ProtoMessage()    {}
+func (*ReplayBenchmarkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+}
+func (m *ReplayBenchmarkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayBenchmarkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayBenchmarkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayBenchmarkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayBenchmarkResponse.Merge(m, src)
+}
+func (m *ReplayBenchmarkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayBenchmarkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayBenchmarkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayBenchmarkResponse proto.InternalMessageInfo
+
+func (m *ReplayBenchmarkResponse) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *ReplayBenchmarkResponse) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+func (m *ReplayBenchmarkResponse) GetBlocksReplayed() uint64 {
+	if m != nil {
+		return m.BlocksReplayed
+	}
+	return 0
+}
+
+func (m *ReplayBenchmarkResponse) GetStateLoadTimeMs() uint64 {
+	if m != nil {
+		return m.StateLoadTimeMs
+	}
+	return 0
+}
+
+func (m *ReplayBenchmarkResponse) GetReplayTimeMs() uint64 {
+	if m != nil {
+		return m.ReplayTimeMs
+	}
+	return 0
+}
+
+func (m *ReplayBenchmarkResponse) GetBlocksPerSecond() float64 {
+	if m != nil {
+		return m.BlocksPerSecond
+	}
+	return 0
+}
+
+func (m *ReplayBenchmarkResponse) GetSignaturesVerified() bool {
+	if m != nil {
+		return m.SignaturesVerified
+	}
+	return false
+}
+
+type BlockArrivalsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockArrivalsRequest) Reset()         { *m = BlockArrivalsRequest{} }
+func (m *BlockArrivalsRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockArrivalsRequest) ProtoMessage()    {}
+func (*BlockArrivalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+}
+func (m *BlockArrivalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockArrivalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockArrivalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockArrivalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockArrivalsRequest.Merge(m, src)
+}
+func (m *BlockArrivalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockArrivalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockArrivalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockArrivalsRequest proto.InternalMessageInfo
+
+type BlockArrival struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	DelayMs              int64    `protobuf:"varint,3,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	Finalized            bool     `protobuf:"varint,4,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	Orphaned             bool     `protobuf:"varint,5,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockArrival) Reset()         { *m = BlockArrival{} }
+func (m *BlockArrival) String() string { return proto.CompactTextString(m) }
+func (*BlockArrival) ProtoMessage()    {}
+func (*BlockArrival) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+}
+func (m *BlockArrival) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockArrival) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockArrival.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockArrival) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockArrival.Merge(m, src)
+}
+func (m *BlockArrival) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockArrival) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockArrival.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockArrival proto.InternalMessageInfo
+
+func (m *BlockArrival) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BlockArrival) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *BlockArrival) GetDelayMs() int64 {
+	if m != nil {
+		return m.DelayMs
+	}
+	return 0
+}
+
+func (m *BlockArrival) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *BlockArrival) GetOrphaned() bool {
+	if m != nil {
+		return m.Orphaned
+	}
+	return false
+}
+
+type BlockArrivalsResponse struct {
+	Arrivals             []*BlockArrival `protobuf:"bytes,1,rep,name=arrivals,proto3" json:"arrivals,omitempty"`
+	MedianDelayMs        int64           `protobuf:"varint,2,opt,name=median_delay_ms,json=medianDelayMs,proto3" json:"median_delay_ms,omitempty"`
+	P95DelayMs           int64           `protobuf:"varint,3,opt,name=p95_delay_ms,json=p95DelayMs,proto3" json:"p95_delay_ms,omitempty"`
+	FinalizedCount       uint64          `protobuf:"varint,4,opt,name=finalized_count,json=finalizedCount,proto3" json:"finalized_count,omitempty"`
+	OrphanedCount        uint64          `protobuf:"varint,5,opt,name=orphaned_count,json=orphanedCount,proto3" json:"orphaned_count,omitempty"`
+	OrphanRate           float64         `protobuf:"fixed64,6,opt,name=orphan_rate,json=orphanRate,proto3" json:"orphan_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BlockArrivalsResponse) Reset()         { *m = BlockArrivalsResponse{} }
+func (m *BlockArrivalsResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockArrivalsResponse) ProtoMessage()    {}
+func (*BlockArrivalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+}
+func (m *BlockArrivalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockArrivalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockArrivalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockArrivalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockArrivalsResponse.Merge(m, src)
+}
+func (m *BlockArrivalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockArrivalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockArrivalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockArrivalsResponse proto.InternalMessageInfo
+
+func (m *BlockArrivalsResponse) GetArrivals() []*BlockArrival {
+	if m != nil {
+		return m.Arrivals
+	}
+	return nil
+}
+
+func (m *BlockArrivalsResponse) GetMedianDelayMs() int64 {
+	if m != nil {
+		return m.MedianDelayMs
+	}
+	return 0
+}
+
+func (m *BlockArrivalsResponse) GetP95DelayMs() int64 {
+	if m != nil {
+		return m.P95DelayMs
+	}
+	return 0
+}
+
+func (m *BlockArrivalsResponse) GetFinalizedCount() uint64 {
+	if m != nil {
+		return m.FinalizedCount
+	}
+	return 0
+}
+
+func (m *BlockArrivalsResponse) GetOrphanedCount() uint64 {
+	if m != nil {
+		return m.OrphanedCount
+	}
+	return 0
+}
+
+func (m *BlockArrivalsResponse) GetOrphanRate() float64 {
+	if m != nil {
+		return m.OrphanRate
+	}
+	return 0
+}
+
+type EffectiveConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EffectiveConfigRequest) Reset()         { *m = EffectiveConfigRequest{} }
+func (m *EffectiveConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveConfigRequest) ProtoMessage()    {}
+func (*EffectiveConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+}
+func (m *EffectiveConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveConfigRequest.Merge(m, src)
+}
+func (m *EffectiveConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveConfigRequest proto.InternalMessageInfo
+
+type EffectiveConfig struct {
+	Version              string            `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Flags                map[string]string `protobuf:"bytes,2,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EffectiveConfig) Reset()         { *m = EffectiveConfig{} }
+func (m *EffectiveConfig) String() string { return proto.CompactTextString(m) }
+func (*EffectiveConfig) ProtoMessage()    {}
+func (*EffectiveConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+}
+func (m *EffectiveConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveConfig.Merge(m, src)
+}
+func (m *EffectiveConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveConfig proto.InternalMessageInfo
+
+func (m *EffectiveConfig) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *EffectiveConfig) GetFlags() map[string]string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StateGenerationTraceRequest)(nil), "ethereum.beacon.rpc.v1.StateGenerationTraceRequest")
+	proto.RegisterType((*StateGenerationTraceResponse)(nil), "ethereum.beacon.rpc.v1.StateGenerationTraceResponse")
+	proto.RegisterType((*ReplayBenchmarkRequest)(nil), "ethereum.beacon.rpc.v1.ReplayBenchmarkRequest")
+	proto.RegisterType((*ReplayBenchmarkResponse)(nil), "ethereum.beacon.rpc.v1.ReplayBenchmarkResponse")
+	proto.RegisterType((*BlockArrivalsRequest)(nil), "ethereum.beacon.rpc.v1.BlockArrivalsRequest")
+	proto.RegisterType((*BlockArrival)(nil), "ethereum.beacon.rpc.v1.BlockArrival")
+	proto.RegisterType((*BlockArrivalsResponse)(nil), "ethereum.beacon.rpc.v1.BlockArrivalsResponse")
+	proto.RegisterType((*EffectiveConfigRequest)(nil), "ethereum.beacon.rpc.v1.EffectiveConfigRequest")
+	proto.RegisterType((*EffectiveConfig)(nil), "ethereum.beacon.rpc.v1.EffectiveConfig")
+	proto.RegisterMapType((map[string]string)(nil), "ethereum.beacon.rpc.v1.EffectiveConfig.FlagsEntry")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0x93, 0x66, 0x93, 0x9c, 0x66, 0x93, 0x65, 0x28, 0xc1, 0x84, 0xdd, 0x36, 0xb2, 0x16,
+	0x1a, 0xf1, 0xe3, 0x68, 0xbb, 0xac, 0xd4, 0x22, 0x2e, 0xa0, 0xbb, 0x4b, 0xb9, 0x60, 0x25, 0x34,
+	0xad, 0xb8, 0xb5, 0x26, 0xf6, 0x49, 0x6a, 0xd5, 0x9e, 0x31, 0x33, 0x93, 0x48, 0xe5, 0x8e, 0x77,
+	0x40, 0x3c, 0x05, 0x12, 0xaf, 0xc1, 0x25, 0x6f, 0x00, 0xea, 0x93, 0x20, 0xcf, 0x8c, 0xd3, 0x60,
+	0x5a, 0x14, 0xee, 0x7c, 0xbe, 0xf3, 0x9d, 0xff, 0x33, 0xc7, 0x70, 0x50, 0x48, 0xa1, 0xc5, 0x74,
+	0x86, 0x2c, 0x16, 0x7c, 0x2a, 0x8b, 0x78, 0xba, 0x7a, 0x36, 0x4d, 0x70, 0xb6, 0x5c, 0x84, 0x46,
+	0x43, 0x86, 0xa8, 0x2f, 0x51, 0xe2, 0x32, 0x0f, 0x2d, 0x27, 0x94, 0x45, 0x1c, 0xae, 0x9e, 0x05,
+	0x5f, 0xc0, 0xfb, 0xe7, 0x9a, 0x69, 0x3c, 0x43, 0x8e, 0x92, 0xe9, 0x54, 0xf0, 0x0b, 0xc9, 0x62,
+	0xa4, 0xf8, 0xc3, 0x12, 0x95, 0x26, 0x4f, 0x00, 0x66, 0x99, 0x88, 0xaf, 0x22, 0x29, 0x84, 0xf6,
+	0xbd, 0xb1, 0x37, 0xe9, 0xd1, 0xae, 0x41, 0xa8, 0x10, 0x3a, 0x38, 0x86, 0xc7, 0x77, 0x5b, 0xab,
+	0x42, 0x70, 0x85, 0xc4, 0x87, 0x36, 0xf2, 0x58, 0x24, 0x98, 0x38, 0xdb, 0x4a, 0x0c, 0x4e, 0x60,
+	0x48, 0xb1, 0xc8, 0xd8, 0xf5, 0x29, 0xf2, 0xf8, 0x32, 0x67, 0xf2, 0xaa, 0x0a, 0x79, 0x00, 0xbb,
+	0x36, 0x64, 0x2c, 0x96, 0xdc, 0xc6, 0xdc, 0xa1, 0x36, 0x8b, 0x97, 0x25, 0x12, 0xfc, 0xda, 0x80,
+	0x77, 0xff, 0x65, 0xeb, 0x02, 0x3e, 0x01, 0x50, 0x9a, 0x49, 0x1d, 0xa9, 0x4c, 0x54, 0xb6, 0x5d,
+	0x83, 0x9c, 0x67, 0x42, 0x93, 0xf7, 0xa0, 0x83, 0x3c, 0xb1, 0xca, 0x86, 0x51, 0xb6, 0x91, 0x27,
+	0x46, 0x75, 0x08, 0x03, 0x13, 0x43, 0x45, 0xd2, 0xf8, 0xc6, 0xc4, 0x6f, 0x1a, 0x46, 0xdf, 0xc2,
+	0xd4, 0xa1, 0xe4, 0x63, 0x20, 0xaa, 0xac, 0x39, 0xca, 0x04, 0x4b, 0x22, 0x9d, 0xe6, 0x18, 0xe5,
+	0xca, 0xdf, 0x31, 0xdc, 0x81, 0xd1, 0x7c, 0x2b, 0x58, 0x72, 0x91, 0xe6, 0xf8, 0x46, 0x91, 0xa7,
+	0xd0, 0xb7, 0xee, 0xd6, 0xc4, 0x96, 0x21, 0xf6, 0x2c, 0xea, 0x58, 0x1f, 0xc1, 0x5b, 0x2e, 0x76,
+	0x81, 0x32, 0x52, 0x18, 0x0b, 0x9e, 0xf8, 0x0f, 0xc6, 0xde, 0xc4, 0xa3, 0x2e, 0xa9, 0xef, 0x50,
+	0x9e, 0x1b, 0x98, 0x4c, 0xe1, 0x6d, 0x95, 0x2e, 0x38, 0xd3, 0x4b, 0x89, 0x2a, 0x5a, 0xa1, 0x4c,
+	0xe7, 0x29, 0x26, 0x7e, 0x7b, 0xec, 0x4d, 0x3a, 0x94, 0xdc, 0xaa, 0xbe, 0x77, 0x9a, 0x60, 0x08,
+	0x7b, 0xa7, 0xa5, 0x8f, 0xaf, 0xa4, 0x4c, 0x57, 0x2c, 0x53, 0xae, 0xcf, 0xc1, 0x2f, 0x1e, 0xf4,
+	0x36, 0x15, 0x84, 0xc0, 0xce, 0x46, 0xd7, 0xcc, 0x77, 0x6d, 0xfe, 0x8d, 0xda, 0xfc, 0xcb, 0x7e,
+	0x26, 0x58, 0x56, 0x97, 0x2b, 0xd3, 0xad, 0x26, 0x6d, 0x1b, 0xf9, 0x8d, 0x22, 0x8f, 0xa1, 0x3b,
+	0x4f, 0x39, 0xcb, 0xd2, 0x1f, 0x31, 0x31, 0xdd, 0xe9, 0xd0, 0x5b, 0x80, 0x8c, 0xa0, 0x23, 0x64,
+	0x71, 0xc9, 0x38, 0x26, 0xa6, 0x23, 0x1d, 0xba, 0x96, 0x83, 0x9f, 0x1b, 0xf0, 0x4e, 0x2d, 0x63,
+	0x37, 0xdd, 0x2f, 0xa1, 0xc3, 0x1c, 0xe6, 0x7b, 0xe3, 0xe6, 0x64, 0xf7, 0xe8, 0x69, 0x78, 0xf7,
+	0x5e, 0x87, 0x9b, 0x0e, 0xe8, 0xda, 0x8a, 0x7c, 0x08, 0x83, 0x1c, 0x93, 0x94, 0xf1, 0x68, 0x9d,
+	0x77, 0xc3, 0xe4, 0xfd, 0xd0, 0xc2, 0xaf, 0x5c, 0xf6, 0x63, 0xe8, 0x15, 0x27, 0x2f, 0xa2, 0x5a,
+	0x71, 0x50, 0x9c, 0xbc, 0xa8, 0x18, 0x87, 0x30, 0x58, 0x97, 0xe3, 0x56, 0xd5, 0xee, 0x40, 0x7f,
+	0x0d, 0x9b, 0x75, 0x25, 0x1f, 0x40, 0xbf, 0x2a, 0xcd, 0xf1, 0xec, 0x0a, 0x3c, 0xac, 0x50, 0x4b,
+	0x3b, 0x80, 0x5d, 0x0b, 0x44, 0x92, 0x69, 0x74, 0xd3, 0x07, 0x0b, 0x51, 0xa6, 0x31, 0xf0, 0x61,
+	0xf8, 0x7a, 0x3e, 0xc7, 0x58, 0xa7, 0x2b, 0x7c, 0x29, 0xf8, 0x3c, 0x5d, 0x54, 0x93, 0xfc, 0xcd,
+	0x83, 0x41, 0x4d, 0x55, 0xbe, 0xbc, 0x15, 0x4a, 0x95, 0x0a, 0x6e, 0xe6, 0xd9, 0xa5, 0x95, 0x48,
+	0xbe, 0x81, 0xd6, 0x3c, 0x63, 0x8b, 0xb2, 0xf0, 0xb2, 0x83, 0x47, 0xf7, 0x75, 0xb0, 0xe6, 0x31,
+	0xfc, 0xba, 0x34, 0x7a, 0xcd, 0xb5, 0xbc, 0xa6, 0xd6, 0xc1, 0xe8, 0x18, 0xe0, 0x16, 0x24, 0x8f,
+	0xa0, 0x79, 0x85, 0xd7, 0x2e, 0x5a, 0xf9, 0x49, 0xf6, 0xa0, 0xb5, 0x62, 0xd9, 0x12, 0x4d, 0x8b,
+	0xbb, 0xd4, 0x0a, 0x9f, 0x37, 0x8e, 0xbd, 0xa3, 0x3f, 0x9b, 0xd0, 0x7a, 0x55, 0x5e, 0x27, 0xf2,
+	0x93, 0x07, 0x7b, 0xe6, 0x66, 0xd4, 0xee, 0x08, 0x79, 0x7e, 0x5f, 0x5e, 0xff, 0x71, 0xae, 0x46,
+	0x9f, 0xfd, 0x3f, 0x23, 0xb7, 0x56, 0x12, 0x06, 0x1b, 0x97, 0xa4, 0x7c, 0x97, 0x24, 0xbc, 0xcf,
+	0xd1, 0xdd, 0x47, 0x6b, 0x34, 0xdd, 0x9a, 0xef, 0x62, 0x0a, 0x78, 0x74, 0x86, 0xfa, 0x1f, 0x6b,
+	0x4e, 0x3e, 0xd9, 0x66, 0x99, 0xab, 0xf7, 0x3b, 0xfa, 0x74, 0x4b, 0xb6, 0x0b, 0x98, 0x03, 0x39,
+	0x43, 0x5d, 0x5f, 0x93, 0x70, 0xcb, 0xe9, 0x57, 0x41, 0x0f, 0xb7, 0xe4, 0x9f, 0xf6, 0x7e, 0xbf,
+	0xd9, 0xf7, 0xfe, 0xb8, 0xd9, 0xf7, 0xfe, 0xba, 0xd9, 0xf7, 0x66, 0x0f, 0xcc, 0x4f, 0xe8, 0xf9,
+	0xdf, 0x03, 0x00, 0x7b, 0xfa, 0x6a, 0x1d, 0xa7, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	TraceStateGeneration(ctx context.Context, in *StateGenerationTraceRequest, opts ...grpc.CallOption) (*StateGenerationTraceResponse, error)
+	BenchmarkReplay(ctx context.Context, in *ReplayBenchmarkRequest, opts ...grpc.CallOption) (*ReplayBenchmarkResponse, error)
+	GetBlockArrivals(ctx context.Context, in *BlockArrivalsRequest, opts ...grpc.CallOption) (*BlockArrivalsResponse, error)
+	GetEffectiveConfig(ctx context.Context, in *EffectiveConfigRequest, opts ...grpc.CallOption) (*EffectiveConfig, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) TraceStateGeneration(ctx context.Context, in *StateGenerationTraceRequest, opts ...grpc.CallOption) (*StateGenerationTraceResponse, error) {
+	out := new(StateGenerationTraceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/TraceStateGeneration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) BenchmarkReplay(ctx context.Context, in *ReplayBenchmarkRequest, opts ...grpc.CallOption) (*ReplayBenchmarkResponse, error) {
+	out := new(ReplayBenchmarkResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/BenchmarkReplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBlockArrivals(ctx context.Context, in *BlockArrivalsRequest, opts ...grpc.CallOption) (*BlockArrivalsResponse, error) {
+	out := new(BlockArrivalsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlockArrivals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetEffectiveConfig(ctx context.Context, in *EffectiveConfigRequest, opts ...grpc.CallOption) (*EffectiveConfig, error) {
+	out := new(EffectiveConfig)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetEffectiveConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	TraceStateGeneration(context.Context, *StateGenerationTraceRequest) (*StateGenerationTraceResponse, error)
+	BenchmarkReplay(context.Context, *ReplayBenchmarkRequest) (*ReplayBenchmarkResponse, error)
+	GetBlockArrivals(context.Context, *BlockArrivalsRequest) (*BlockArrivalsResponse, error)
+	GetEffectiveConfig(context.Context, *EffectiveConfigRequest) (*EffectiveConfig, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) TraceStateGeneration(ctx context.Context, req *StateGenerationTraceRequest) (*StateGenerationTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceStateGeneration not implemented")
+}
+func (*UnimplementedDebugServer) BenchmarkReplay(ctx context.Context, req *ReplayBenchmarkRequest) (*ReplayBenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BenchmarkReplay not implemented")
+}
+func (*UnimplementedDebugServer) GetBlockArrivals(ctx context.Context, req *BlockArrivalsRequest) (*BlockArrivalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockArrivals not implemented")
+}
+func (*UnimplementedDebugServer) GetEffectiveConfig(ctx context.Context, req *EffectiveConfigRequest) (*EffectiveConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveConfig not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_TraceStateGeneration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateGenerationTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).TraceStateGeneration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/TraceStateGeneration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).TraceStateGeneration(ctx, req.(*StateGenerationTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_BenchmarkReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).BenchmarkReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/BenchmarkReplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).BenchmarkReplay(ctx, req.(*ReplayBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBlockArrivals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockArrivalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlockArrivals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlockArrivals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlockArrivals(ctx, req.(*BlockArrivalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetEffectiveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetEffectiveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetEffectiveConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetEffectiveConfig(ctx, req.(*EffectiveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TraceStateGeneration",
+			Handler:    _Debug_TraceStateGeneration_Handler,
+		},
+		{
+			MethodName: "BenchmarkReplay",
+			Handler:    _Debug_BenchmarkReplay_Handler,
+		},
+		{
+			MethodName: "GetBlockArrivals",
+			Handler:    _Debug_GetBlockArrivals_Handler,
+		},
+		{
+			MethodName: "GetEffectiveConfig",
+			Handler:    _Debug_GetEffectiveConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *StateGenerationTraceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateGenerationTraceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateGenerationTraceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateGenerationTraceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateGenerationTraceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateGenerationTraceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayBenchmarkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayBenchmarkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayBenchmarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayBenchmarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayBenchmarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayBenchmarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SignaturesVerified {
+		i--
+		if m.SignaturesVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlocksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BlocksPerSecond))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.ReplayTimeMs != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ReplayTimeMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StateLoadTimeMs != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.StateLoadTimeMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlocksReplayed != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BlocksReplayed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.EndSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockArrivalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockArrivalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockArrivalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockArrival) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockArrival) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockArrival) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Orphaned {
+		i--
+		if m.Orphaned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DelayMs != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DelayMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockArrivalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockArrivalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockArrivalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OrphanRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OrphanRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.OrphanedCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OrphanedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FinalizedCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.P95DelayMs != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.P95DelayMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MedianDelayMs != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MedianDelayMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Arrivals) > 0 {
+		for iNdEx := len(m.Arrivals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Arrivals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Flags) > 0 {
+		for k := range m.Flags {
+			v := m.Flags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDebug(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StateGenerationTraceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateGenerationTraceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayBenchmarkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovDebug(uint64(m.BlockCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayBenchmarkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovDebug(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovDebug(uint64(m.EndSlot))
+	}
+	if m.BlocksReplayed != 0 {
+		n += 1 + sovDebug(uint64(m.BlocksReplayed))
+	}
+	if m.StateLoadTimeMs != 0 {
+		n += 1 + sovDebug(uint64(m.StateLoadTimeMs))
+	}
+	if m.ReplayTimeMs != 0 {
+		n += 1 + sovDebug(uint64(m.ReplayTimeMs))
+	}
+	if m.BlocksPerSecond != 0 {
+		n += 9
+	}
+	if m.SignaturesVerified {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockArrivalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockArrival) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.DelayMs != 0 {
+		n += 1 + sovDebug(uint64(m.DelayMs))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.Orphaned {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockArrivalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Arrivals) > 0 {
+		for _, e := range m.Arrivals {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.MedianDelayMs != 0 {
+		n += 1 + sovDebug(uint64(m.MedianDelayMs))
+	}
+	if m.P95DelayMs != 0 {
+		n += 1 + sovDebug(uint64(m.P95DelayMs))
+	}
+	if m.FinalizedCount != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedCount))
+	}
+	if m.OrphanedCount != 0 {
+		n += 1 + sovDebug(uint64(m.OrphanedCount))
+	}
+	if m.OrphanRate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EffectiveConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EffectiveConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Flags) > 0 {
+		for k, v := range m.Flags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + len(v) + sovDebug(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StateGenerationTraceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateGenerationTraceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateGenerationTraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateGenerationTraceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateGenerationTraceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateGenerationTraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayBenchmarkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayBenchmarkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayBenchmarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayBenchmarkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayBenchmarkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayBenchmarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksReplayed", wireType)
+			}
+			m.BlocksReplayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksReplayed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateLoadTimeMs", wireType)
+			}
+			m.StateLoadTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateLoadTimeMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayTimeMs", wireType)
+			}
+			m.ReplayTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplayTimeMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BlocksPerSecond = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaturesVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignaturesVerified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockArrivalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockArrivalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockArrivalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockArrival) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockArrival: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockArrival: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMs", wireType)
+			}
+			m.DelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphaned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Orphaned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockArrivalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockArrivalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockArrivalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arrivals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arrivals = append(m.Arrivals, &BlockArrival{})
+			if err := m.Arrivals[len(m.Arrivals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDelayMs", wireType)
+			}
+			m.MedianDelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianDelayMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95DelayMs", wireType)
+			}
+			m.P95DelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P95DelayMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedCount", wireType)
+			}
+			m.FinalizedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedCount", wireType)
+			}
+			m.OrphanedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrphanedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OrphanRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Flags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDebug(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDebug
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDebug
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDebug
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDebug        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDebug          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDebug = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1612 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/peers.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PeerConnectionDirection int32
+
+const (
+	PeerConnectionDirection_UNKNOWN_DIRECTION PeerConnectionDirection = 0
+	PeerConnectionDirection_INBOUND           PeerConnectionDirection = 1
+	PeerConnectionDirection_OUTBOUND          PeerConnectionDirection = 2
+)
+
+var PeerConnectionDirection_name = map[int32]string{
+	0: "UNKNOWN_DIRECTION",
+	1: "INBOUND",
+	2: "OUTBOUND",
+}
+
+var PeerConnectionDirection_value = map[string]int32{
+	"UNKNOWN_DIRECTION": 0,
+	"INBOUND":           1,
+	"OUTBOUND":          2,
+}
+
+func (x PeerConnectionDirection) String() string {
+	return proto.EnumName(PeerConnectionDirection_name, int32(x))
+}
+
+func (PeerConnectionDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0c11b8758388fda, []int{0}
+}
+
+type ListPeerDetailsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPeerDetailsRequest) Reset()         { *m = ListPeerDetailsRequest{} }
+func (m *ListPeerDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPeerDetailsRequest) ProtoMessage()    {}
+func (*ListPeerDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0c11b8758388fda, []int{0}
+}
+func (m *ListPeerDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPeerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPeerDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPeerDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeerDetailsRequest.Merge(m, src)
+}
+func (m *ListPeerDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPeerDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeerDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPeerDetailsRequest proto.InternalMessageInfo
+
+type ListPeerDetailsResponse struct {
+	Peers                []*PeerDetails `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Clients              []*PeerCount   `protobuf:"bytes,2,rep,name=clients,proto3" json:"clients,omitempty"`
+	Directions           []*PeerCount   `protobuf:"bytes,3,rep,name=directions,proto3" json:"directions,omitempty"`
+	TotalBytesIn         uint64         `protobuf:"varint,4,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
+	TotalBytesOut        uint64         `protobuf:"varint,5,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListPeerDetailsResponse) Reset()         { *m = ListPeerDetailsResponse{} }
+func (m *ListPeerDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPeerDetailsResponse) ProtoMessage()    {}
+func (*ListPeerDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0c11b8758388fda, []int{1}
+}
+func (m *ListPeerDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPeerDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPeerDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPeerDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeerDetailsResponse.Merge(m, src)
+}
+func (m *ListPeerDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPeerDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeerDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPeerDetailsResponse proto.InternalMessageInfo
+
+func (m *ListPeerDetailsResponse) GetPeers() []*PeerDetails {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *ListPeerDetailsResponse) GetClients() []*PeerCount {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+func (m *ListPeerDetailsResponse) GetDirections() []*PeerCount {
+	if m != nil {
+		return m.Directions
+	}
+	return nil
+}
+
+func (m *ListPeerDetailsResponse) GetTotalBytesIn() uint64 {
+	if m != nil {
+		return m.TotalBytesIn
+	}
+	return 0
+}
+
+func (m *ListPeerDetailsResponse) GetTotalBytesOut() uint64 {
+	if m != nil {
+		return m.TotalBytesOut
+	}
+	return 0
+}
+
+type PeerDetails struct {
+	PeerId               string                  `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Address              string                  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Agent                string                  `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
+	Direction            PeerConnectionDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=ethereum.beacon.rpc.v1.PeerConnectionDirection" json:"direction,omitempty"`
+	BadResponses         uint64                  `protobuf:"varint,5,opt,name=bad_responses,json=badResponses,proto3" json:"bad_responses,omitempty"`
+	MaxBadResponses      uint64                  `protobuf:"varint,6,opt,name=max_bad_responses,json=maxBadResponses,proto3" json:"max_bad_responses,omitempty"`
+	HeadSlot             uint64                  `protobuf:"varint,7,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	FinalizedEpoch       uint64                  `protobuf:"varint,8,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	BytesIn              uint64                  `protobuf:"varint,9,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut             uint64                  `protobuf:"varint,10,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	RateIn               float64                 `protobuf:"fixed64,11,opt,name=rate_in,json=rateIn,proto3" json:"rate_in,omitempty"`
+	RateOut              float64                 `protobuf:"fixed64,12,opt,name=rate_out,json=rateOut,proto3" json:"rate_out,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PeerDetails) Reset()         { *m = PeerDetails{} }
+func (m *PeerDetails) String() string { return proto.CompactTextString(m) }
+func (*PeerDetails) ProtoMessage()    {}
+func (*PeerDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0c11b8758388fda, []int{2}
+}
+func (m *PeerDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerDetails.Merge(m, src)
+}
+func (m *PeerDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerDetails proto.InternalMessageInfo
+
+func (m *PeerDetails) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *PeerDetails) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerDetails) GetAgent() string {
+	if m != nil {
+		return m.Agent
+	}
+	return ""
+}
+
+func (m *PeerDetails) GetDirection() PeerConnectionDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PeerConnectionDirection_UNKNOWN_DIRECTION
+}
+
+func (m *PeerDetails) GetBadResponses() uint64 {
+	if m != nil {
+		return m.BadResponses
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetMaxBadResponses() uint64 {
+	if m != nil {
+		return m.MaxBadResponses
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetBytesIn() uint64 {
+	if m != nil {
+		return m.BytesIn
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetBytesOut() uint64 {
+	if m != nil {
+		return m.BytesOut
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetRateIn() float64 {
+	if m != nil {
+		return m.RateIn
+	}
+	return 0
+}
+
+func (m *PeerDetails) GetRateOut() float64 {
+	if m != nil {
+		return m.RateOut
+	}
+	return 0
+}
+
+type PeerCount struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerCount) Reset()         { *m = PeerCount{} }
+func (m *PeerCount) String() string { return proto.CompactTextString(m) }
+func (*PeerCount) ProtoMessage()    {}
+func (*PeerCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0c11b8758388fda, []int{3}
+}
+func (m *PeerCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerCount.Merge(m, src)
+}
+func (m *PeerCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerCount proto.InternalMessageInfo
+
+func (m *PeerCount) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PeerCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.PeerConnectionDirection", PeerConnectionDirection_name, PeerConnectionDirection_value)
+	proto.RegisterType((*ListPeerDetailsRequest)(nil), "ethereum.beacon.rpc.v1.ListPeerDetailsRequest")
+	proto.RegisterType((*ListPeerDetailsResponse)(nil), "ethereum.beacon.rpc.v1.ListPeerDetailsResponse")
+	proto.RegisterType((*PeerDetails)(nil), "ethereum.beacon.rpc.v1.PeerDetails")
+	proto.RegisterType((*PeerCount)(nil), "ethereum.beacon.rpc.v1.PeerCount")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/peers.proto", fileDescriptor_e0c11b8758388fda) }
+
+var fileDescriptor_e0c11b8758388fda = []byte{
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x7f, 0x6e, 0xda, 0x38, 0x9e, 0xb8, 0x4d, 0xbb, 0xfa, 0xd1, 0x1a, 0x2a, 0x95, 0x90,
+	0x22, 0x88, 0x7a, 0xb0, 0xd5, 0xf4, 0x84, 0x38, 0x91, 0x26, 0x07, 0xab, 0xe0, 0x20, 0xd3, 0x88,
+	0xa3, 0xb5, 0xb6, 0x17, 0x62, 0xe1, 0xec, 0x9a, 0xdd, 0x4d, 0x95, 0x72, 0xe4, 0x51, 0x38, 0xf1,
+	0x28, 0x1c, 0x79, 0x04, 0x94, 0x27, 0x41, 0xbb, 0xce, 0x3f, 0xa0, 0xad, 0x7a, 0xf3, 0xcc, 0x7c,
+	0xbe, 0xe3, 0x9d, 0xf9, 0x7a, 0x0d, 0x8f, 0x0b, 0xce, 0x24, 0xf3, 0x62, 0x82, 0x13, 0x46, 0x3d,
+	0x5e, 0x24, 0xde, 0xd5, 0xa9, 0x57, 0x10, 0xc2, 0x85, 0xab, 0x2b, 0x68, 0x9f, 0xc8, 0x11, 0xe1,
+	0x64, 0x32, 0x76, 0x4b, 0xc6, 0xe5, 0x45, 0xe2, 0x5e, 0x9d, 0xb6, 0x1c, 0xd8, 0x7f, 0x9d, 0x09,
+	0xf9, 0x96, 0x10, 0xde, 0x23, 0x12, 0x67, 0xb9, 0x08, 0xc9, 0xe7, 0x09, 0x11, 0xb2, 0xf5, 0x6d,
+	0x03, 0x0e, 0xfe, 0x29, 0x89, 0x82, 0x51, 0x41, 0xd0, 0x0b, 0xd8, 0xd2, 0xcd, 0x1d, 0xa3, 0x59,
+	0x69, 0xd7, 0x3b, 0xc7, 0xee, 0xcd, 0xdd, 0xdd, 0x75, 0x6d, 0xa9, 0x40, 0x2f, 0xc1, 0x4c, 0xf2,
+	0x8c, 0x50, 0x29, 0x9c, 0x0d, 0x2d, 0x7e, 0x72, 0x97, 0xf8, 0x9c, 0x4d, 0xa8, 0x0c, 0x17, 0x0a,
+	0xf4, 0x0a, 0x20, 0xcd, 0x38, 0x49, 0x64, 0xc6, 0xa8, 0x70, 0x2a, 0xf7, 0xd5, 0xaf, 0x89, 0xd0,
+	0x53, 0xd8, 0x91, 0x4c, 0xe2, 0x3c, 0x8a, 0xaf, 0x25, 0x11, 0x51, 0x46, 0x9d, 0xcd, 0xa6, 0xd1,
+	0xde, 0x0c, 0x6d, 0x9d, 0xed, 0xaa, 0xa4, 0x4f, 0xd1, 0x33, 0x68, 0xac, 0x53, 0x6c, 0x22, 0x9d,
+	0x2d, 0x8d, 0x6d, 0xaf, 0xb0, 0xc1, 0x44, 0xb6, 0xbe, 0x57, 0xa0, 0xbe, 0x36, 0x24, 0x3a, 0x00,
+	0x53, 0x8d, 0x19, 0x65, 0xa9, 0x63, 0x34, 0x8d, 0xb6, 0x15, 0x56, 0x55, 0xe8, 0xa7, 0xc8, 0x01,
+	0x13, 0xa7, 0x29, 0x27, 0x42, 0x8d, 0xad, 0x0a, 0x8b, 0x10, 0xfd, 0x0f, 0x5b, 0xf8, 0x23, 0xa1,
+	0xd2, 0xa9, 0xe8, 0x7c, 0x19, 0xa0, 0x37, 0x60, 0x2d, 0x0f, 0xad, 0x4f, 0xb8, 0xd3, 0xf1, 0xee,
+	0x1e, 0x94, 0xd2, 0x92, 0xee, 0x2d, 0x64, 0xe1, 0xaa, 0x03, 0x3a, 0x86, 0xed, 0x18, 0xa7, 0x11,
+	0x9f, 0x1b, 0x28, 0xe6, 0xd3, 0xd8, 0x31, 0x4e, 0x17, 0xa6, 0x0a, 0x74, 0x02, 0x7b, 0x63, 0x3c,
+	0x8d, 0xfe, 0x04, 0xab, 0x1a, 0x6c, 0x8c, 0xf1, 0xb4, 0xbb, 0xce, 0x1e, 0x82, 0x35, 0x22, 0x38,
+	0x8d, 0x44, 0xce, 0xa4, 0x63, 0x6a, 0xa6, 0xa6, 0x12, 0xef, 0x72, 0x26, 0xd1, 0x73, 0x68, 0x7c,
+	0xc8, 0x28, 0xce, 0xb3, 0x2f, 0x24, 0x8d, 0x48, 0xc1, 0x92, 0x91, 0x53, 0xd3, 0xc8, 0xce, 0x32,
+	0xdd, 0x57, 0x59, 0xf4, 0x10, 0x6a, 0x4b, 0x1b, 0x2c, 0x4d, 0x98, 0xf1, 0xdc, 0x81, 0x43, 0xb0,
+	0x56, 0xbb, 0x87, 0xf2, 0x05, 0xf1, 0x7c, 0xed, 0x6a, 0xcd, 0x1c, 0x4b, 0xa2, 0x64, 0xf5, 0xa6,
+	0xd1, 0x36, 0xc2, 0xaa, 0x0a, 0x7d, 0xaa, 0x1a, 0xea, 0x82, 0x12, 0xd9, 0xba, 0xa2, 0x41, 0x65,
+	0xd5, 0x19, 0x58, 0xcb, 0x2f, 0x02, 0xed, 0x42, 0xe5, 0x13, 0xb9, 0x9e, 0x7b, 0xa4, 0x1e, 0x95,
+	0x0d, 0x89, 0x2a, 0x69, 0x7b, 0x36, 0xc3, 0x32, 0x38, 0xb9, 0x80, 0x83, 0x5b, 0xb6, 0x8b, 0x1e,
+	0xc0, 0xde, 0x30, 0xb8, 0x08, 0x06, 0xef, 0x83, 0xa8, 0xe7, 0x87, 0xfd, 0xf3, 0x4b, 0x7f, 0x10,
+	0xec, 0xfe, 0x87, 0xea, 0x60, 0xfa, 0x41, 0x77, 0x30, 0x0c, 0x7a, 0xbb, 0x06, 0xb2, 0xa1, 0x36,
+	0x18, 0x5e, 0x96, 0xd1, 0x46, 0xe7, 0xab, 0x01, 0xb6, 0xea, 0xd6, 0x9f, 0x16, 0x8c, 0x4b, 0xc2,
+	0x11, 0x87, 0xc6, 0x5f, 0x37, 0x0c, 0xb9, 0xb7, 0x99, 0x7c, 0xf3, 0x2d, 0x7d, 0xe4, 0xdd, 0x9b,
+	0x2f, 0x9d, 0xeb, 0xda, 0x3f, 0x66, 0x47, 0xc6, 0xcf, 0xd9, 0x91, 0xf1, 0x6b, 0x76, 0x64, 0xc4,
+	0x55, 0xfd, 0x77, 0x38, 0xfb, 0x3d, 0x00, 0x5a, 0x25, 0xcc, 0x86, 0x40, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PeerExporterClient is the client API for PeerExporter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PeerExporterClient interface {
+	ListPeerDetails(ctx context.Context, in *ListPeerDetailsRequest, opts ...grpc.CallOption) (*ListPeerDetailsResponse, error)
+}
+
+type peerExporterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPeerExporterClient(cc *grpc.ClientConn) PeerExporterClient {
+	return &peerExporterClient{cc}
+}
+
+func (c *peerExporterClient) ListPeerDetails(ctx context.Context, in *ListPeerDetailsRequest, opts ...grpc.CallOption) (*ListPeerDetailsResponse, error) {
+	out := new(ListPeerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.PeerExporter/ListPeerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeerExporterServer is the server API for PeerExporter service.
+type PeerExporterServer interface {
+	ListPeerDetails(context.Context, *ListPeerDetailsRequest) (*ListPeerDetailsResponse, error)
+}
+
+// UnimplementedPeerExporterServer can be embedded to have forward compatible implementations.
+type UnimplementedPeerExporterServer struct {
+}
+
+func (*UnimplementedPeerExporterServer) ListPeerDetails(ctx context.Context, req *ListPeerDetailsRequest) (*ListPeerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerDetails not implemented")
+}
+
+func RegisterPeerExporterServer(s *grpc.Server, srv PeerExporterServer) {
+	s.RegisterService(&_PeerExporter_serviceDesc, srv)
+}
+
+func _PeerExporter_ListPeerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeerDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerExporterServer).ListPeerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.PeerExporter/ListPeerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerExporterServer).ListPeerDetails(ctx, req.(*ListPeerDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PeerExporter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.PeerExporter",
+	HandlerType: (*PeerExporterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPeerDetails",
+			Handler:    _PeerExporter_ListPeerDetails_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/peers.proto",
+}
+
+func (m *ListPeerDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPeerDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPeerDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPeerDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPeerDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPeerDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalBytesOut != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.TotalBytesOut))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalBytesIn != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.TotalBytesIn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Directions) > 0 {
+		for iNdEx := len(m.Directions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Directions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPeers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPeers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPeers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RateOut != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RateOut))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.RateIn != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RateIn))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.BytesOut != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.BytesOut))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BytesIn != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.BytesIn))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxBadResponses != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.MaxBadResponses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BadResponses != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.BadResponses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Direction != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListPeerDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPeerDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovPeers(uint64(l))
+		}
+	}
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovPeers(uint64(l))
+		}
+	}
+	if len(m.Directions) > 0 {
+		for _, e := range m.Directions {
+			l = e.Size()
+			n += 1 + l + sovPeers(uint64(l))
+		}
+	}
+	if m.TotalBytesIn != 0 {
+		n += 1 + sovPeers(uint64(m.TotalBytesIn))
+	}
+	if m.TotalBytesOut != 0 {
+		n += 1 + sovPeers(uint64(m.TotalBytesOut))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovPeers(uint64(m.Direction))
+	}
+	if m.BadResponses != 0 {
+		n += 1 + sovPeers(uint64(m.BadResponses))
+	}
+	if m.MaxBadResponses != 0 {
+		n += 1 + sovPeers(uint64(m.MaxBadResponses))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovPeers(uint64(m.HeadSlot))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovPeers(uint64(m.FinalizedEpoch))
+	}
+	if m.BytesIn != 0 {
+		n += 1 + sovPeers(uint64(m.BytesIn))
+	}
+	if m.BytesOut != 0 {
+		n += 1 + sovPeers(uint64(m.BytesOut))
+	}
+	if m.RateIn != 0 {
+		n += 9
+	}
+	if m.RateOut != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPeers(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPeers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeers(x uint64) (n int) {
+	return sovPeers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListPeerDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPeerDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPeerDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPeerDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPeerDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPeerDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerDetails{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, &PeerCount{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Directions = append(m.Directions, &PeerCount{})
+			if err := m.Directions[len(m.Directions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytesIn", wireType)
+			}
+			m.TotalBytesIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytesIn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytesOut", wireType)
+			}
+			m.TotalBytesOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytesOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PeerConnectionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadResponses", wireType)
+			}
+			m.BadResponses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadResponses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBadResponses", wireType)
+			}
+			m.MaxBadResponses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBadResponses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesIn", wireType)
+			}
+			m.BytesIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesIn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesOut", wireType)
+			}
+			m.BytesOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateIn", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RateIn = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateOut", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RateOut = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeers(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeers
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeers
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeers
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeers        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeers          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeers = fmt.Errorf("proto: unexpected end of group")
+)
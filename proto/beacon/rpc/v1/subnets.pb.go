@@ -0,0 +1,1282 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/subnets.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SubnetOverride int32
+
+const (
+	SubnetOverride_NONE              SubnetOverride = 0
+	SubnetOverride_FORCE_SUBSCRIBE   SubnetOverride = 1
+	SubnetOverride_FORCE_UNSUBSCRIBE SubnetOverride = 2
+)
+
+var SubnetOverride_name = map[int32]string{
+	0: "NONE",
+	1: "FORCE_SUBSCRIBE",
+	2: "FORCE_UNSUBSCRIBE",
+}
+
+var SubnetOverride_value = map[string]int32{
+	"NONE":              0,
+	"FORCE_SUBSCRIBE":   1,
+	"FORCE_UNSUBSCRIBE": 2,
+}
+
+func (x SubnetOverride) String() string {
+	return proto.EnumName(SubnetOverride_name, int32(x))
+}
+
+func (SubnetOverride) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aff27ea068ab9d28, []int{0}
+}
+
+type ListAttestationSubnetsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAttestationSubnetsRequest) Reset()         { *m = ListAttestationSubnetsRequest{} }
+func (m *ListAttestationSubnetsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAttestationSubnetsRequest) ProtoMessage()    {}
+func (*ListAttestationSubnetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff27ea068ab9d28, []int{0}
+}
+func (m *ListAttestationSubnetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAttestationSubnetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAttestationSubnetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAttestationSubnetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttestationSubnetsRequest.Merge(m, src)
+}
+func (m *ListAttestationSubnetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAttestationSubnetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttestationSubnetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttestationSubnetsRequest proto.InternalMessageInfo
+
+type ListAttestationSubnetsResponse struct {
+	Subnets              []*AttestationSubnet `protobuf:"bytes,1,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListAttestationSubnetsResponse) Reset()         { *m = ListAttestationSubnetsResponse{} }
+func (m *ListAttestationSubnetsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAttestationSubnetsResponse) ProtoMessage()    {}
+func (*ListAttestationSubnetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff27ea068ab9d28, []int{1}
+}
+func (m *ListAttestationSubnetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAttestationSubnetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAttestationSubnetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAttestationSubnetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttestationSubnetsResponse.Merge(m, src)
+}
+func (m *ListAttestationSubnetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAttestationSubnetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttestationSubnetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttestationSubnetsResponse proto.InternalMessageInfo
+
+func (m *ListAttestationSubnetsResponse) GetSubnets() []*AttestationSubnet {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+type AttestationSubnet struct {
+	Subnet               uint64         `protobuf:"varint,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Subscribed           bool           `protobuf:"varint,2,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	Override             SubnetOverride `protobuf:"varint,3,opt,name=override,proto3,enum=ethereum.beacon.rpc.v1.SubnetOverride" json:"override,omitempty"`
+	MessagesTotal        uint64         `protobuf:"varint,4,opt,name=messages_total,json=messagesTotal,proto3" json:"messages_total,omitempty"`
+	MessagesPerSecond    float64        `protobuf:"fixed64,5,opt,name=messages_per_second,json=messagesPerSecond,proto3" json:"messages_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AttestationSubnet) Reset()         { *m = AttestationSubnet{} }
+func (m *AttestationSubnet) String() string { return proto.CompactTextString(m) }
+func (*AttestationSubnet) ProtoMessage()    {}
+func (*AttestationSubnet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff27ea068ab9d28, []int{2}
+}
+func (m *AttestationSubnet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationSubnet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationSubnet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationSubnet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationSubnet.Merge(m, src)
+}
+func (m *AttestationSubnet) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationSubnet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationSubnet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationSubnet proto.InternalMessageInfo
+
+func (m *AttestationSubnet) GetSubnet() uint64 {
+	if m != nil {
+		return m.Subnet
+	}
+	return 0
+}
+
+func (m *AttestationSubnet) GetSubscribed() bool {
+	if m != nil {
+		return m.Subscribed
+	}
+	return false
+}
+
+func (m *AttestationSubnet) GetOverride() SubnetOverride {
+	if m != nil {
+		return m.Override
+	}
+	return SubnetOverride_NONE
+}
+
+func (m *AttestationSubnet) GetMessagesTotal() uint64 {
+	if m != nil {
+		return m.MessagesTotal
+	}
+	return 0
+}
+
+func (m *AttestationSubnet) GetMessagesPerSecond() float64 {
+	if m != nil {
+		return m.MessagesPerSecond
+	}
+	return 0
+}
+
+type SetAttestationSubnetOverrideRequest struct {
+	Subnet               uint64         `protobuf:"varint,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Override             SubnetOverride `protobuf:"varint,2,opt,name=override,proto3,enum=ethereum.beacon.rpc.v1.SubnetOverride" json:"override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetAttestationSubnetOverrideRequest) Reset()         { *m = SetAttestationSubnetOverrideRequest{} }
+func (m *SetAttestationSubnetOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAttestationSubnetOverrideRequest) ProtoMessage()    {}
+func (*SetAttestationSubnetOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff27ea068ab9d28, []int{3}
+}
+func (m *SetAttestationSubnetOverrideRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAttestationSubnetOverrideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAttestationSubnetOverrideRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAttestationSubnetOverrideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAttestationSubnetOverrideRequest.Merge(m, src)
+}
+func (m *SetAttestationSubnetOverrideRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAttestationSubnetOverrideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAttestationSubnetOverrideRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAttestationSubnetOverrideRequest proto.InternalMessageInfo
+
+func (m *SetAttestationSubnetOverrideRequest) GetSubnet() uint64 {
+	if m != nil {
+		return m.Subnet
+	}
+	return 0
+}
+
+func (m *SetAttestationSubnetOverrideRequest) GetOverride() SubnetOverride {
+	if m != nil {
+		return m.Override
+	}
+	return SubnetOverride_NONE
+}
+
+type SetAttestationSubnetOverrideResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAttestationSubnetOverrideResponse) Reset()         { *m = SetAttestationSubnetOverrideResponse{} }
+func (m *SetAttestationSubnetOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAttestationSubnetOverrideResponse) ProtoMessage()    {}
+func (*SetAttestationSubnetOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aff27ea068ab9d28, []int{4}
+}
+func (m *SetAttestationSubnetOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAttestationSubnetOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAttestationSubnetOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAttestationSubnetOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAttestationSubnetOverrideResponse.Merge(m, src)
+}
+func (m *SetAttestationSubnetOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAttestationSubnetOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAttestationSubnetOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAttestationSubnetOverrideResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.SubnetOverride", SubnetOverride_name, SubnetOverride_value)
+	proto.RegisterType((*ListAttestationSubnetsRequest)(nil), "ethereum.beacon.rpc.v1.ListAttestationSubnetsRequest")
+	proto.RegisterType((*ListAttestationSubnetsResponse)(nil), "ethereum.beacon.rpc.v1.ListAttestationSubnetsResponse")
+	proto.RegisterType((*AttestationSubnet)(nil), "ethereum.beacon.rpc.v1.AttestationSubnet")
+	proto.RegisterType((*SetAttestationSubnetOverrideRequest)(nil), "ethereum.beacon.rpc.v1.SetAttestationSubnetOverrideRequest")
+	proto.RegisterType((*SetAttestationSubnetOverrideResponse)(nil), "ethereum.beacon.rpc.v1.SetAttestationSubnetOverrideResponse")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/subnets.proto", fileDescriptor_aff27ea068ab9d28) }
+
+var fileDescriptor_aff27ea068ab9d28 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcf, 0x8e, 0xd2, 0x40,
+	0x18, 0x77, 0xba, 0xb8, 0x92, 0x4f, 0xc5, 0x65, 0x36, 0x92, 0x66, 0xa3, 0xb5, 0x56, 0xdd, 0x54,
+	0x0f, 0x6d, 0x16, 0xa3, 0x17, 0xbd, 0x58, 0x02, 0x89, 0x89, 0x01, 0x33, 0x95, 0x33, 0x69, 0xcb,
+	0x17, 0x6d, 0x22, 0x9d, 0x3a, 0x33, 0xe5, 0xee, 0xc9, 0x67, 0xf0, 0x55, 0x7c, 0x02, 0x8f, 0x3e,
+	0x82, 0xe1, 0xe0, 0x73, 0x18, 0x3b, 0x05, 0x31, 0xa5, 0x98, 0xe5, 0xd8, 0xdf, 0x9f, 0x7e, 0xbf,
+	0xef, 0xcf, 0xc0, 0xfd, 0x5c, 0x70, 0xc5, 0xfd, 0x18, 0xa3, 0x84, 0x67, 0xbe, 0xc8, 0x13, 0x7f,
+	0x79, 0xe1, 0xcb, 0x22, 0xce, 0x50, 0x49, 0xaf, 0xe4, 0x68, 0x0f, 0xd5, 0x07, 0x14, 0x58, 0x2c,
+	0x3c, 0xad, 0xf2, 0x44, 0x9e, 0x78, 0xcb, 0x0b, 0xe7, 0x1e, 0xdc, 0x7d, 0x93, 0x4a, 0xf5, 0x4a,
+	0x29, 0x94, 0x2a, 0x52, 0x29, 0xcf, 0x42, 0xed, 0x63, 0xf8, 0xa9, 0x40, 0xa9, 0x1c, 0x04, 0xab,
+	0x49, 0x20, 0x73, 0x9e, 0x49, 0xa4, 0x03, 0xb8, 0x56, 0xd5, 0x32, 0x89, 0x7d, 0xe4, 0x5e, 0xef,
+	0x3f, 0xf6, 0x76, 0x17, 0xf3, 0x6a, 0x3f, 0x61, 0x6b, 0xa7, 0xf3, 0x8b, 0x40, 0xb7, 0x46, 0xd3,
+	0x1e, 0x1c, 0x6b, 0x81, 0x49, 0x6c, 0xe2, 0xb6, 0x58, 0xf5, 0x45, 0x2d, 0x00, 0x59, 0xc4, 0x32,
+	0x11, 0x69, 0x8c, 0x73, 0xd3, 0xb0, 0x89, 0xdb, 0x66, 0x5b, 0x08, 0x0d, 0xa0, 0xcd, 0x97, 0x28,
+	0x44, 0x3a, 0x47, 0xf3, 0xc8, 0x26, 0x6e, 0xa7, 0x7f, 0xde, 0x94, 0x49, 0x57, 0x9a, 0x54, 0x6a,
+	0xb6, 0xf1, 0xd1, 0x47, 0xd0, 0x59, 0xa0, 0x94, 0xd1, 0x7b, 0x94, 0x33, 0xc5, 0x55, 0xf4, 0xd1,
+	0x6c, 0x95, 0x19, 0x6e, 0xae, 0xd1, 0x77, 0x7f, 0x40, 0xea, 0xc1, 0xe9, 0x46, 0x96, 0xa3, 0x98,
+	0x49, 0x4c, 0x78, 0x36, 0x37, 0xaf, 0xda, 0xc4, 0x25, 0xac, 0xbb, 0xa6, 0xde, 0xa2, 0x08, 0x4b,
+	0xc2, 0xf9, 0x4c, 0xe0, 0x41, 0x88, 0xf5, 0x79, 0x6e, 0x12, 0xe8, 0xb9, 0x37, 0xb6, 0xbe, 0xdd,
+	0x9a, 0x71, 0x58, 0x6b, 0xce, 0x39, 0x3c, 0xdc, 0x1f, 0x41, 0x6f, 0xf6, 0xc9, 0x08, 0x3a, 0xff,
+	0x32, 0xb4, 0x0d, 0xad, 0xf1, 0x64, 0x3c, 0x3c, 0xb9, 0x42, 0x4f, 0xe1, 0xd6, 0x68, 0xc2, 0x06,
+	0xc3, 0x59, 0x38, 0x0d, 0xc2, 0x01, 0x7b, 0x1d, 0x0c, 0x4f, 0x08, 0xbd, 0x0d, 0x5d, 0x0d, 0x4e,
+	0xc7, 0x7f, 0x61, 0xa3, 0xff, 0xcd, 0x00, 0x5a, 0x3f, 0x20, 0xfa, 0x85, 0x40, 0x6f, 0xf7, 0x6d,
+	0xd1, 0x67, 0x4d, 0x3d, 0xed, 0x3d, 0xd6, 0xb3, 0xe7, 0x97, 0xb5, 0x55, 0x27, 0xfc, 0x95, 0xc0,
+	0x9d, 0x7d, 0x13, 0xa1, 0x2f, 0x1a, 0x67, 0xfc, 0xff, 0x55, 0x9e, 0xbd, 0x3c, 0xcc, 0xac, 0xb3,
+	0x05, 0x37, 0xbe, 0xaf, 0x2c, 0xf2, 0x63, 0x65, 0x91, 0x9f, 0x2b, 0x8b, 0xc4, 0xc7, 0xe5, 0x73,
+	0x7e, 0xfa, 0x7b, 0x00, 0x1a, 0xc1, 0x94, 0xe0, 0xf3, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AttestationSubnetsClient is the client API for AttestationSubnets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttestationSubnetsClient interface {
+	ListAttestationSubnets(ctx context.Context, in *ListAttestationSubnetsRequest, opts ...grpc.CallOption) (*ListAttestationSubnetsResponse, error)
+	SetAttestationSubnetOverride(ctx context.Context, in *SetAttestationSubnetOverrideRequest, opts ...grpc.CallOption) (*SetAttestationSubnetOverrideResponse, error)
+}
+
+type attestationSubnetsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAttestationSubnetsClient(cc *grpc.ClientConn) AttestationSubnetsClient {
+	return &attestationSubnetsClient{cc}
+}
+
+func (c *attestationSubnetsClient) ListAttestationSubnets(ctx context.Context, in *ListAttestationSubnetsRequest, opts ...grpc.CallOption) (*ListAttestationSubnetsResponse, error) {
+	out := new(ListAttestationSubnetsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttestationSubnets/ListAttestationSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attestationSubnetsClient) SetAttestationSubnetOverride(ctx context.Context, in *SetAttestationSubnetOverrideRequest, opts ...grpc.CallOption) (*SetAttestationSubnetOverrideResponse, error) {
+	out := new(SetAttestationSubnetOverrideResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttestationSubnets/SetAttestationSubnetOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttestationSubnetsServer is the server API for AttestationSubnets service.
+type AttestationSubnetsServer interface {
+	ListAttestationSubnets(context.Context, *ListAttestationSubnetsRequest) (*ListAttestationSubnetsResponse, error)
+	SetAttestationSubnetOverride(context.Context, *SetAttestationSubnetOverrideRequest) (*SetAttestationSubnetOverrideResponse, error)
+}
+
+// UnimplementedAttestationSubnetsServer can be embedded to have forward compatible implementations.
+type UnimplementedAttestationSubnetsServer struct {
+}
+
+func (*UnimplementedAttestationSubnetsServer) ListAttestationSubnets(ctx context.Context, req *ListAttestationSubnetsRequest) (*ListAttestationSubnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestationSubnets not implemented")
+}
+func (*UnimplementedAttestationSubnetsServer) SetAttestationSubnetOverride(ctx context.Context, req *SetAttestationSubnetOverrideRequest) (*SetAttestationSubnetOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttestationSubnetOverride not implemented")
+}
+
+func RegisterAttestationSubnetsServer(s *grpc.Server, srv AttestationSubnetsServer) {
+	s.RegisterService(&_AttestationSubnets_serviceDesc, srv)
+}
+
+func _AttestationSubnets_ListAttestationSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationSubnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationSubnetsServer).ListAttestationSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttestationSubnets/ListAttestationSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationSubnetsServer).ListAttestationSubnets(ctx, req.(*ListAttestationSubnetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttestationSubnets_SetAttestationSubnetOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttestationSubnetOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationSubnetsServer).SetAttestationSubnetOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttestationSubnets/SetAttestationSubnetOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationSubnetsServer).SetAttestationSubnetOverride(ctx, req.(*SetAttestationSubnetOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AttestationSubnets_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttestationSubnets",
+	HandlerType: (*AttestationSubnetsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttestationSubnets",
+			Handler:    _AttestationSubnets_ListAttestationSubnets_Handler,
+		},
+		{
+			MethodName: "SetAttestationSubnetOverride",
+			Handler:    _AttestationSubnets_SetAttestationSubnetOverride_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/subnets.proto",
+}
+
+func (m *ListAttestationSubnetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttestationSubnetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAttestationSubnetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAttestationSubnetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttestationSubnetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAttestationSubnetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subnets) > 0 {
+		for iNdEx := len(m.Subnets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subnets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubnets(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationSubnet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationSubnet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationSubnet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MessagesPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MessagesPerSecond))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.MessagesTotal != 0 {
+		i = encodeVarintSubnets(dAtA, i, uint64(m.MessagesTotal))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Override != 0 {
+		i = encodeVarintSubnets(dAtA, i, uint64(m.Override))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Subscribed {
+		i--
+		if m.Subscribed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Subnet != 0 {
+		i = encodeVarintSubnets(dAtA, i, uint64(m.Subnet))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAttestationSubnetOverrideRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAttestationSubnetOverrideRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAttestationSubnetOverrideRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Override != 0 {
+		i = encodeVarintSubnets(dAtA, i, uint64(m.Override))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Subnet != 0 {
+		i = encodeVarintSubnets(dAtA, i, uint64(m.Subnet))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAttestationSubnetOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAttestationSubnetOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAttestationSubnetOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubnets(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubnets(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListAttestationSubnetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttestationSubnetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subnets) > 0 {
+		for _, e := range m.Subnets {
+			l = e.Size()
+			n += 1 + l + sovSubnets(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationSubnet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subnet != 0 {
+		n += 1 + sovSubnets(uint64(m.Subnet))
+	}
+	if m.Subscribed {
+		n += 2
+	}
+	if m.Override != 0 {
+		n += 1 + sovSubnets(uint64(m.Override))
+	}
+	if m.MessagesTotal != 0 {
+		n += 1 + sovSubnets(uint64(m.MessagesTotal))
+	}
+	if m.MessagesPerSecond != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetAttestationSubnetOverrideRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subnet != 0 {
+		n += 1 + sovSubnets(uint64(m.Subnet))
+	}
+	if m.Override != 0 {
+		n += 1 + sovSubnets(uint64(m.Override))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetAttestationSubnetOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSubnets(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubnets(x uint64) (n int) {
+	return sovSubnets(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListAttestationSubnetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationSubnetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationSubnetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttestationSubnetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationSubnetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationSubnetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subnets = append(m.Subnets, &AttestationSubnet{})
+			if err := m.Subnets[len(m.Subnets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationSubnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationSubnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationSubnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnet", wireType)
+			}
+			m.Subnet = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subnet |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Subscribed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			m.Override = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Override |= SubnetOverride(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesTotal", wireType)
+			}
+			m.MessagesTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MessagesPerSecond = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAttestationSubnetOverrideRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAttestationSubnetOverrideRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAttestationSubnetOverrideRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnet", wireType)
+			}
+			m.Subnet = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subnet |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			m.Override = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Override |= SubnetOverride(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAttestationSubnetOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAttestationSubnetOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAttestationSubnetOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubnets(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubnets
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubnets
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubnets
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubnets        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubnets          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubnets = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package ethereum_slashing
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -30,6 +31,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SlashingProof_Condition int32
+
+const (
+	SlashingProof_UnknownCondition SlashingProof_Condition = 0
+	SlashingProof_DoubleVote       SlashingProof_Condition = 1
+	SlashingProof_SurroundingVote  SlashingProof_Condition = 2
+	SlashingProof_SurroundedVote   SlashingProof_Condition = 3
+	SlashingProof_DoubleProposal   SlashingProof_Condition = 4
+)
+
+var SlashingProof_Condition_name = map[int32]string{
+	0: "UnknownCondition",
+	1: "DoubleVote",
+	2: "SurroundingVote",
+	3: "SurroundedVote",
+	4: "DoubleProposal",
+}
+
+var SlashingProof_Condition_value = map[string]int32{
+	"UnknownCondition": 0,
+	"DoubleVote":       1,
+	"SurroundingVote":  2,
+	"SurroundedVote":   3,
+	"DoubleProposal":   4,
+}
+
+func (x SlashingProof_Condition) String() string {
+	return proto.EnumName(SlashingProof_Condition_name, int32(x))
+}
+
+func (SlashingProof_Condition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{6, 0}
+}
+
 type SlashingStatusRequest_SlashingStatus int32
 
 const (
@@ -58,28 +93,28 @@ func (x SlashingStatusRequest_SlashingStatus) String() string {
 }
 
 func (SlashingStatusRequest_SlashingStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{6, 0}
+	return fileDescriptor_da7e95107d0081b4, []int{13, 0}
 }
 
-type ProposerSlashingResponse struct {
-	ProposerSlashing     []*v1alpha1.ProposerSlashing `protobuf:"bytes,1,rep,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type HighestAttestationRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProposerSlashingResponse) Reset()         { *m = ProposerSlashingResponse{} }
-func (m *ProposerSlashingResponse) String() string { return proto.CompactTextString(m) }
-func (*ProposerSlashingResponse) ProtoMessage()    {}
-func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
+func (m *HighestAttestationRequest) Reset()         { *m = HighestAttestationRequest{} }
+func (m *HighestAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*HighestAttestationRequest) ProtoMessage()    {}
+func (*HighestAttestationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{0}
 }
-func (m *ProposerSlashingResponse) XXX_Unmarshal(b []byte) error {
+func (m *HighestAttestationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProposerSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HighestAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProposerSlashingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HighestAttestationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -89,44 +124,46 @@ func (m *ProposerSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ProposerSlashingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposerSlashingResponse.Merge(m, src)
+func (m *HighestAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighestAttestationRequest.Merge(m, src)
 }
-func (m *ProposerSlashingResponse) XXX_Size() int {
+func (m *HighestAttestationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProposerSlashingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProposerSlashingResponse.DiscardUnknown(m)
+func (m *HighestAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighestAttestationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProposerSlashingResponse proto.InternalMessageInfo
+var xxx_messageInfo_HighestAttestationRequest proto.InternalMessageInfo
 
-func (m *ProposerSlashingResponse) GetProposerSlashing() []*v1alpha1.ProposerSlashing {
+func (m *HighestAttestationRequest) GetValidatorIndex() uint64 {
 	if m != nil {
-		return m.ProposerSlashing
+		return m.ValidatorIndex
 	}
-	return nil
+	return 0
 }
 
-type AttesterSlashingResponse struct {
-	AttesterSlashing     []*v1alpha1.AttesterSlashing `protobuf:"bytes,1,rep,name=attester_slashing,json=attesterSlashing,proto3" json:"attester_slashing,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type HighestAttestation struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	HighestSourceEpoch   uint64   `protobuf:"varint,2,opt,name=highest_source_epoch,json=highestSourceEpoch,proto3" json:"highest_source_epoch,omitempty"`
+	HighestTargetEpoch   uint64   `protobuf:"varint,3,opt,name=highest_target_epoch,json=highestTargetEpoch,proto3" json:"highest_target_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttesterSlashingResponse) Reset()         { *m = AttesterSlashingResponse{} }
-func (m *AttesterSlashingResponse) String() string { return proto.CompactTextString(m) }
-func (*AttesterSlashingResponse) ProtoMessage()    {}
-func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
+func (m *HighestAttestation) Reset()         { *m = HighestAttestation{} }
+func (m *HighestAttestation) String() string { return proto.CompactTextString(m) }
+func (*HighestAttestation) ProtoMessage()    {}
+func (*HighestAttestation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{1}
 }
-func (m *AttesterSlashingResponse) XXX_Unmarshal(b []byte) error {
+func (m *HighestAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttesterSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HighestAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttesterSlashingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HighestAttestation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -136,45 +173,59 @@ func (m *AttesterSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *AttesterSlashingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttesterSlashingResponse.Merge(m, src)
+func (m *HighestAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighestAttestation.Merge(m, src)
 }
-func (m *AttesterSlashingResponse) XXX_Size() int {
+func (m *HighestAttestation) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttesterSlashingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttesterSlashingResponse.DiscardUnknown(m)
+func (m *HighestAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighestAttestation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttesterSlashingResponse proto.InternalMessageInfo
+var xxx_messageInfo_HighestAttestation proto.InternalMessageInfo
 
-func (m *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSlashing {
+func (m *HighestAttestation) GetValidatorIndex() uint64 {
 	if m != nil {
-		return m.AttesterSlashing
+		return m.ValidatorIndex
 	}
-	return nil
+	return 0
 }
 
-type MinMaxEpochSpan struct {
-	MinEpochSpan         uint32   `protobuf:"varint,1,opt,name=min_epoch_span,json=minEpochSpan,proto3" json:"min_epoch_span,omitempty"`
-	MaxEpochSpan         uint32   `protobuf:"varint,2,opt,name=max_epoch_span,json=maxEpochSpan,proto3" json:"max_epoch_span,omitempty"`
+func (m *HighestAttestation) GetHighestSourceEpoch() uint64 {
+	if m != nil {
+		return m.HighestSourceEpoch
+	}
+	return 0
+}
+
+func (m *HighestAttestation) GetHighestTargetEpoch() uint64 {
+	if m != nil {
+		return m.HighestTargetEpoch
+	}
+	return 0
+}
+
+type ValidatorEpochSpanRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MinMaxEpochSpan) Reset()         { *m = MinMaxEpochSpan{} }
-func (m *MinMaxEpochSpan) String() string { return proto.CompactTextString(m) }
-func (*MinMaxEpochSpan) ProtoMessage()    {}
-func (*MinMaxEpochSpan) Descriptor() ([]byte, []int) {
+func (m *ValidatorEpochSpanRequest) Reset()         { *m = ValidatorEpochSpanRequest{} }
+func (m *ValidatorEpochSpanRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochSpanRequest) ProtoMessage()    {}
+func (*ValidatorEpochSpanRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{2}
 }
-func (m *MinMaxEpochSpan) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorEpochSpanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinMaxEpochSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEpochSpanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MinMaxEpochSpan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEpochSpanRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -184,51 +235,56 @@ func (m *MinMaxEpochSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MinMaxEpochSpan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinMaxEpochSpan.Merge(m, src)
+func (m *ValidatorEpochSpanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochSpanRequest.Merge(m, src)
 }
-func (m *MinMaxEpochSpan) XXX_Size() int {
+func (m *ValidatorEpochSpanRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinMaxEpochSpan) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinMaxEpochSpan.DiscardUnknown(m)
+func (m *ValidatorEpochSpanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochSpanRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinMaxEpochSpan proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEpochSpanRequest proto.InternalMessageInfo
 
-func (m *MinMaxEpochSpan) GetMinEpochSpan() uint32 {
+func (m *ValidatorEpochSpanRequest) GetValidatorIndex() uint64 {
 	if m != nil {
-		return m.MinEpochSpan
+		return m.ValidatorIndex
 	}
 	return 0
 }
 
-func (m *MinMaxEpochSpan) GetMaxEpochSpan() uint32 {
+func (m *ValidatorEpochSpanRequest) GetEpoch() uint64 {
 	if m != nil {
-		return m.MaxEpochSpan
+		return m.Epoch
 	}
 	return 0
 }
 
-type EpochSpanMap struct {
-	EpochSpanMap         map[uint64]*MinMaxEpochSpan `protobuf:"bytes,1,rep,name=epoch_span_map,json=epochSpanMap,proto3" json:"epoch_span_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type ValidatorEpochSpan struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	MinSpan              uint32   `protobuf:"varint,3,opt,name=min_span,json=minSpan,proto3" json:"min_span,omitempty"`
+	MaxSpan              uint32   `protobuf:"varint,4,opt,name=max_span,json=maxSpan,proto3" json:"max_span,omitempty"`
+	SigBytes             []byte   `protobuf:"bytes,5,opt,name=sig_bytes,json=sigBytes,proto3" json:"sig_bytes,omitempty"`
+	HasAttested          bool     `protobuf:"varint,6,opt,name=has_attested,json=hasAttested,proto3" json:"has_attested,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EpochSpanMap) Reset()         { *m = EpochSpanMap{} }
-func (m *EpochSpanMap) String() string { return proto.CompactTextString(m) }
-func (*EpochSpanMap) ProtoMessage()    {}
-func (*EpochSpanMap) Descriptor() ([]byte, []int) {
+func (m *ValidatorEpochSpan) Reset()         { *m = ValidatorEpochSpan{} }
+func (m *ValidatorEpochSpan) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochSpan) ProtoMessage()    {}
+func (*ValidatorEpochSpan) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{3}
 }
-func (m *EpochSpanMap) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorEpochSpan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EpochSpanMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEpochSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EpochSpanMap.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEpochSpan.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -238,45 +294,81 @@ func (m *EpochSpanMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EpochSpanMap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EpochSpanMap.Merge(m, src)
+func (m *ValidatorEpochSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochSpan.Merge(m, src)
 }
-func (m *EpochSpanMap) XXX_Size() int {
+func (m *ValidatorEpochSpan) XXX_Size() int {
 	return m.Size()
 }
-func (m *EpochSpanMap) XXX_DiscardUnknown() {
-	xxx_messageInfo_EpochSpanMap.DiscardUnknown(m)
+func (m *ValidatorEpochSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochSpan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EpochSpanMap proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEpochSpan proto.InternalMessageInfo
 
-func (m *EpochSpanMap) GetEpochSpanMap() map[uint64]*MinMaxEpochSpan {
+func (m *ValidatorEpochSpan) GetValidatorIndex() uint64 {
 	if m != nil {
-		return m.EpochSpanMap
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSpan) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSpan) GetMinSpan() uint32 {
+	if m != nil {
+		return m.MinSpan
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSpan) GetMaxSpan() uint32 {
+	if m != nil {
+		return m.MaxSpan
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSpan) GetSigBytes() []byte {
+	if m != nil {
+		return m.SigBytes
 	}
 	return nil
 }
 
-type ProposalHistory struct {
-	EpochBits            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"epoch_bits,omitempty"`
-	LatestEpochWritten   uint64                                       `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+func (m *ValidatorEpochSpan) GetHasAttested() bool {
+	if m != nil {
+		return m.HasAttested
+	}
+	return false
 }
 
-func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
-func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
-func (*ProposalHistory) ProtoMessage()    {}
-func (*ProposalHistory) Descriptor() ([]byte, []int) {
+type ProposerSlashingResponse struct {
+	ProposerSlashing     []*v1alpha1.ProposerSlashing `protobuf:"bytes,1,rep,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
+	DetectionContext     *DetectionContext            `protobuf:"bytes,2,opt,name=detection_context,json=detectionContext,proto3" json:"detection_context,omitempty"`
+	Proofs               []*SlashingProof             `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ProposerSlashingResponse) Reset()         { *m = ProposerSlashingResponse{} }
+func (m *ProposerSlashingResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashingResponse) ProtoMessage()    {}
+func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{4}
 }
-func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
+func (m *ProposerSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProposalHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposerSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProposalHistory.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposerSlashingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -286,52 +378,60 @@ func (m *ProposalHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ProposalHistory) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposalHistory.Merge(m, src)
+func (m *ProposerSlashingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashingResponse.Merge(m, src)
 }
-func (m *ProposalHistory) XXX_Size() int {
+func (m *ProposerSlashingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProposalHistory) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProposalHistory.DiscardUnknown(m)
+func (m *ProposerSlashingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProposalHistory proto.InternalMessageInfo
+var xxx_messageInfo_ProposerSlashingResponse proto.InternalMessageInfo
 
-func (m *ProposalHistory) GetEpochBits() github_com_prysmaticlabs_go_bitfield.Bitlist {
+func (m *ProposerSlashingResponse) GetProposerSlashing() []*v1alpha1.ProposerSlashing {
 	if m != nil {
-		return m.EpochBits
+		return m.ProposerSlashing
 	}
 	return nil
 }
 
-func (m *ProposalHistory) GetLatestEpochWritten() uint64 {
+func (m *ProposerSlashingResponse) GetDetectionContext() *DetectionContext {
 	if m != nil {
-		return m.LatestEpochWritten
+		return m.DetectionContext
 	}
-	return 0
+	return nil
 }
 
-type AttestationHistory struct {
-	TargetToSource       map[uint64]uint64 `protobuf:"bytes,1,rep,name=target_to_source,json=targetToSource,proto3" json:"target_to_source,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	LatestEpochWritten   uint64            `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ProposerSlashingResponse) GetProofs() []*SlashingProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-func (m *AttestationHistory) Reset()         { *m = AttestationHistory{} }
-func (m *AttestationHistory) String() string { return proto.CompactTextString(m) }
-func (*AttestationHistory) ProtoMessage()    {}
-func (*AttestationHistory) Descriptor() ([]byte, []int) {
+type AttesterSlashingResponse struct {
+	AttesterSlashing     []*v1alpha1.AttesterSlashing `protobuf:"bytes,1,rep,name=attester_slashing,json=attesterSlashing,proto3" json:"attester_slashing,omitempty"`
+	DetectionContext     *DetectionContext            `protobuf:"bytes,2,opt,name=detection_context,json=detectionContext,proto3" json:"detection_context,omitempty"`
+	Proofs               []*SlashingProof             `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *AttesterSlashingResponse) Reset()         { *m = AttesterSlashingResponse{} }
+func (m *AttesterSlashingResponse) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashingResponse) ProtoMessage()    {}
+func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{5}
 }
-func (m *AttestationHistory) XXX_Unmarshal(b []byte) error {
+func (m *AttesterSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttestationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttesterSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttestationHistory.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttesterSlashingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -341,51 +441,66 @@ func (m *AttestationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AttestationHistory) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttestationHistory.Merge(m, src)
+func (m *AttesterSlashingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashingResponse.Merge(m, src)
 }
-func (m *AttestationHistory) XXX_Size() int {
+func (m *AttesterSlashingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttestationHistory) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttestationHistory.DiscardUnknown(m)
+func (m *AttesterSlashingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttestationHistory proto.InternalMessageInfo
+var xxx_messageInfo_AttesterSlashingResponse proto.InternalMessageInfo
 
-func (m *AttestationHistory) GetTargetToSource() map[uint64]uint64 {
+func (m *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSlashing {
 	if m != nil {
-		return m.TargetToSource
+		return m.AttesterSlashing
 	}
 	return nil
 }
 
-func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
+func (m *AttesterSlashingResponse) GetDetectionContext() *DetectionContext {
 	if m != nil {
-		return m.LatestEpochWritten
+		return m.DetectionContext
 	}
-	return 0
+	return nil
 }
 
-type SlashingStatusRequest struct {
-	Status               SlashingStatusRequest_SlashingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatusRequest_SlashingStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_unrecognized     []byte                               `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+func (m *AttesterSlashingResponse) GetProofs() []*SlashingProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-func (m *SlashingStatusRequest) Reset()         { *m = SlashingStatusRequest{} }
-func (m *SlashingStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*SlashingStatusRequest) ProtoMessage()    {}
-func (*SlashingStatusRequest) Descriptor() ([]byte, []int) {
+type SlashingProof struct {
+	Condition            SlashingProof_Condition `protobuf:"varint,1,opt,name=condition,proto3,enum=ethereum.slashing.SlashingProof_Condition" json:"condition,omitempty"`
+	Root_1               []byte                  `protobuf:"bytes,2,opt,name=root_1,json=root1,proto3" json:"root_1,omitempty"`
+	Root_2               []byte                  `protobuf:"bytes,3,opt,name=root_2,json=root2,proto3" json:"root_2,omitempty"`
+	SourceEpoch_1        uint64                  `protobuf:"varint,4,opt,name=source_epoch_1,json=sourceEpoch1,proto3" json:"source_epoch_1,omitempty"`
+	TargetEpoch_1        uint64                  `protobuf:"varint,5,opt,name=target_epoch_1,json=targetEpoch1,proto3" json:"target_epoch_1,omitempty"`
+	SourceEpoch_2        uint64                  `protobuf:"varint,6,opt,name=source_epoch_2,json=sourceEpoch2,proto3" json:"source_epoch_2,omitempty"`
+	TargetEpoch_2        uint64                  `protobuf:"varint,7,opt,name=target_epoch_2,json=targetEpoch2,proto3" json:"target_epoch_2,omitempty"`
+	ValidatorIndices     []uint64                `protobuf:"varint,8,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,9,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SlashingProof) Reset()         { *m = SlashingProof{} }
+func (m *SlashingProof) String() string { return proto.CompactTextString(m) }
+func (*SlashingProof) ProtoMessage()    {}
+func (*SlashingProof) Descriptor() ([]byte, []int) {
 	return fileDescriptor_da7e95107d0081b4, []int{6}
 }
-func (m *SlashingStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *SlashingProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SlashingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SlashingProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SlashingStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SlashingProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -395,644 +510,3697 @@ func (m *SlashingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *SlashingStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SlashingStatusRequest.Merge(m, src)
+func (m *SlashingProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingProof.Merge(m, src)
 }
-func (m *SlashingStatusRequest) XXX_Size() int {
+func (m *SlashingProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *SlashingStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SlashingStatusRequest.DiscardUnknown(m)
+func (m *SlashingProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SlashingStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_SlashingProof proto.InternalMessageInfo
 
-func (m *SlashingStatusRequest) GetStatus() SlashingStatusRequest_SlashingStatus {
+func (m *SlashingProof) GetCondition() SlashingProof_Condition {
 	if m != nil {
-		return m.Status
+		return m.Condition
 	}
-	return SlashingStatusRequest_Unknown
+	return SlashingProof_UnknownCondition
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.slashing.SlashingStatusRequest_SlashingStatus", SlashingStatusRequest_SlashingStatus_name, SlashingStatusRequest_SlashingStatus_value)
-	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
-	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
-	proto.RegisterType((*MinMaxEpochSpan)(nil), "ethereum.slashing.MinMaxEpochSpan")
-	proto.RegisterType((*EpochSpanMap)(nil), "ethereum.slashing.EpochSpanMap")
-	proto.RegisterMapType((map[uint64]*MinMaxEpochSpan)(nil), "ethereum.slashing.EpochSpanMap.EpochSpanMapEntry")
-	proto.RegisterType((*ProposalHistory)(nil), "ethereum.slashing.ProposalHistory")
-	proto.RegisterType((*AttestationHistory)(nil), "ethereum.slashing.AttestationHistory")
-	proto.RegisterMapType((map[uint64]uint64)(nil), "ethereum.slashing.AttestationHistory.TargetToSourceEntry")
-	proto.RegisterType((*SlashingStatusRequest)(nil), "ethereum.slashing.SlashingStatusRequest")
+func (m *SlashingProof) GetRoot_1() []byte {
+	if m != nil {
+		return m.Root_1
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
-
-var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x6d, 0x69, 0x61, 0x1b, 0x52, 0x77, 0x29, 0xa8, 0x8a, 0x44, 0x5b, 0x45, 0x48, 0x14,
-	0x41, 0x9d, 0x36, 0x1c, 0x28, 0xdc, 0x1a, 0xa9, 0x52, 0x7b, 0xa8, 0x8a, 0x9c, 0xa2, 0x9e, 0x50,
-	0xb4, 0xb6, 0xa7, 0xf6, 0xaa, 0xce, 0xee, 0xe2, 0x1d, 0xa7, 0xcd, 0x7b, 0xf0, 0x1e, 0xbc, 0x06,
-	0x07, 0x0e, 0x3c, 0x01, 0x42, 0x3d, 0x23, 0x1e, 0x80, 0x13, 0xf2, 0xda, 0x29, 0x4e, 0xe2, 0x48,
-	0x70, 0xdb, 0xf9, 0x66, 0xe6, 0xfb, 0xe6, 0xc7, 0x1e, 0xf2, 0x44, 0x25, 0x12, 0x65, 0x4b, 0xc7,
-	0x4c, 0x47, 0x5c, 0x84, 0xb7, 0x0f, 0xc7, 0xe0, 0x74, 0x15, 0x30, 0x82, 0x04, 0xd2, 0xbe, 0x33,
-	0x72, 0x34, 0x36, 0x01, 0xa3, 0xd6, 0x60, 0x8f, 0xc5, 0x2a, 0x62, 0x7b, 0x2d, 0x0f, 0x98, 0x2f,
-	0x45, 0xcf, 0x8b, 0xa5, 0x7f, 0x99, 0xe7, 0x34, 0x76, 0x42, 0x8e, 0x51, 0xea, 0x39, 0xbe, 0xec,
-	0xb7, 0x42, 0x19, 0xca, 0x96, 0x81, 0xbd, 0xf4, 0xc2, 0x58, 0xb9, 0x5e, 0xf6, 0xca, 0xc3, 0x9b,
-	0x8a, 0xac, 0xbf, 0x4b, 0xa4, 0x92, 0x1a, 0x92, 0x6e, 0xa1, 0xe1, 0x82, 0x56, 0x52, 0x68, 0xa0,
-	0x67, 0x64, 0x55, 0x15, 0xbe, 0xde, 0xa8, 0x80, 0x75, 0x6b, 0x6b, 0x7e, 0x7b, 0xb9, 0xfd, 0xcc,
-	0xb9, 0x2d, 0x0d, 0x30, 0x72, 0x46, 0x05, 0x39, 0x53, 0x5c, 0xb6, 0x9a, 0x40, 0x32, 0xc5, 0x03,
-	0x44, 0xd0, 0x58, 0xad, 0xc8, 0x0a, 0xdf, 0xbf, 0x2a, 0x4e, 0x71, 0xd9, 0x6c, 0x02, 0x69, 0x7e,
-	0x20, 0x2b, 0x27, 0x5c, 0x9c, 0xb0, 0xeb, 0x43, 0x25, 0xfd, 0xa8, 0xab, 0x98, 0xa0, 0x4f, 0x49,
-	0xbd, 0xcf, 0x45, 0x0f, 0x32, 0xa0, 0xa7, 0x15, 0x13, 0xeb, 0xd6, 0x96, 0xb5, 0xfd, 0xc0, 0xad,
-	0xf5, 0xb9, 0x18, 0x8f, 0x62, 0xd7, 0xe5, 0xa8, 0xb9, 0x22, 0xaa, 0xc4, 0xd5, 0xfc, 0x6a, 0x91,
-	0xda, 0xad, 0x75, 0xc2, 0x14, 0x3d, 0x27, 0xf5, 0xbf, 0x29, 0xbd, 0x3e, 0x53, 0x45, 0x0b, 0x7b,
-	0xce, 0xd4, 0x3e, 0x9d, 0x72, 0xe2, 0x98, 0x71, 0x28, 0x30, 0x19, 0xba, 0x35, 0x28, 0x41, 0x0d,
-	0x9f, 0xac, 0x4e, 0x85, 0x50, 0x9b, 0xcc, 0x5f, 0xc2, 0xd0, 0xd4, 0xbf, 0xe0, 0x66, 0x4f, 0xba,
-	0x4f, 0xee, 0x0e, 0x58, 0x9c, 0x82, 0xa9, 0x76, 0xb9, 0xdd, 0xac, 0x90, 0x9d, 0x98, 0x87, 0x9b,
-	0x27, 0xbc, 0x9d, 0xdb, 0xb7, 0x9a, 0x9f, 0x2c, 0xb2, 0x92, 0xaf, 0x91, 0xc5, 0x47, 0x5c, 0xa3,
-	0x4c, 0x86, 0xf4, 0x94, 0x90, 0xbc, 0x23, 0x8f, 0xa3, 0x36, 0x52, 0xb5, 0xce, 0xee, 0xef, 0xef,
-	0x9b, 0x2f, 0x4b, 0x1f, 0x9b, 0x4a, 0x86, 0xba, 0xcf, 0x90, 0xfb, 0x31, 0xf3, 0x74, 0x2b, 0x94,
-	0x3b, 0x1e, 0xc7, 0x0b, 0x0e, 0x71, 0xe0, 0x74, 0x38, 0xc6, 0x5c, 0xa3, 0x7b, 0xdf, 0x70, 0x74,
-	0x38, 0x6a, 0xba, 0x4b, 0xd6, 0x62, 0x96, 0xad, 0xa9, 0x18, 0xee, 0x55, 0xc2, 0x11, 0x21, 0x9f,
-	0xef, 0x82, 0x4b, 0x73, 0x9f, 0x29, 0xef, 0x3c, 0xf7, 0x34, 0x7f, 0x59, 0x84, 0xe6, 0xbb, 0x66,
-	0xc8, 0xa5, 0x18, 0x55, 0xe6, 0x13, 0x1b, 0x59, 0x12, 0x02, 0xf6, 0x50, 0xf6, 0xb4, 0x4c, 0x13,
-	0x1f, 0x8a, 0x69, 0xbf, 0xa9, 0x68, 0x7b, 0x9a, 0xc0, 0x39, 0x33, 0xd9, 0x67, 0xb2, 0x6b, 0x72,
-	0xf3, 0xa9, 0xd7, 0x71, 0x0c, 0xfc, 0xff, 0x6a, 0x1b, 0x07, 0xe4, 0x61, 0x05, 0x71, 0xc5, 0xae,
-	0xd6, 0xca, 0xbb, 0x5a, 0x28, 0xef, 0xe1, 0xb3, 0x45, 0x1e, 0x8d, 0x3e, 0xe1, 0x2e, 0x32, 0x4c,
-	0xb5, 0x0b, 0x1f, 0x53, 0xd0, 0x48, 0x4f, 0xc9, 0xa2, 0x36, 0x80, 0x21, 0xaa, 0xb7, 0x5f, 0x57,
-	0x74, 0x5a, 0x99, 0x39, 0x89, 0x16, 0x34, 0xcd, 0x43, 0x52, 0x1f, 0xf7, 0xd0, 0x65, 0xb2, 0xf4,
-	0x5e, 0x5c, 0x0a, 0x79, 0x25, 0xec, 0x3b, 0x94, 0x90, 0xc5, 0x03, 0x1f, 0xf9, 0x00, 0x6c, 0x8b,
-	0xd6, 0xc8, 0xbd, 0x63, 0xe1, 0xc7, 0x69, 0x00, 0x81, 0x3d, 0x97, 0x59, 0x2e, 0x0c, 0x20, 0x41,
-	0x08, 0xec, 0xf9, 0xf6, 0x4f, 0x8b, 0x2c, 0x19, 0x1e, 0x48, 0xa8, 0x22, 0x8f, 0x8f, 0xb5, 0x31,
-	0x98, 0x17, 0x43, 0x69, 0xee, 0xf4, 0xf9, 0x8c, 0x1f, 0xf9, 0x58, 0x04, 0x70, 0x0d, 0x41, 0x29,
-	0xb4, 0xf1, 0x62, 0xe6, 0x0a, 0x2b, 0x6e, 0x87, 0x24, 0x76, 0x49, 0xb1, 0x93, 0x9d, 0x44, 0xea,
-	0xcc, 0xd0, 0xea, 0xf2, 0x50, 0x40, 0xd0, 0x31, 0xd7, 0xd3, 0x44, 0x1e, 0x01, 0x0b, 0x20, 0xa9,
-	0x14, 0x9c, 0x75, 0x1e, 0x3b, 0xb5, 0x2f, 0x37, 0x1b, 0xd6, 0xb7, 0x9b, 0x0d, 0xeb, 0xc7, 0xcd,
-	0x86, 0xe5, 0x2d, 0x9a, 0x7b, 0xfa, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x35, 0xb6,
-	0xef, 0xd3, 0x05, 0x00, 0x00,
+func (m *SlashingProof) GetRoot_2() []byte {
+	if m != nil {
+		return m.Root_2
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// SlasherClient is the client API for Slasher service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SlasherClient interface {
-	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
-	IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
+func (m *SlashingProof) GetSourceEpoch_1() uint64 {
+	if m != nil {
+		return m.SourceEpoch_1
+	}
+	return 0
 }
 
-type slasherClient struct {
-	cc *grpc.ClientConn
+func (m *SlashingProof) GetTargetEpoch_1() uint64 {
+	if m != nil {
+		return m.TargetEpoch_1
+	}
+	return 0
 }
 
-func NewSlasherClient(cc *grpc.ClientConn) SlasherClient {
-	return &slasherClient{cc}
+func (m *SlashingProof) GetSourceEpoch_2() uint64 {
+	if m != nil {
+		return m.SourceEpoch_2
+	}
+	return 0
 }
 
-func (c *slasherClient) IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error) {
-	out := new(AttesterSlashingResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SlashingProof) GetTargetEpoch_2() uint64 {
+	if m != nil {
+		return m.TargetEpoch_2
 	}
-	return out, nil
+	return 0
 }
 
-func (c *slasherClient) IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error) {
-	out := new(ProposerSlashingResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SlashingProof) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
 	}
-	return out, nil
+	return nil
 }
 
-// SlasherServer is the server API for Slasher service.
-type SlasherServer interface {
-	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
-	IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error)
+func (m *SlashingProof) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
 }
 
-// UnimplementedSlasherServer can be embedded to have forward compatible implementations.
-type UnimplementedSlasherServer struct {
+type DetectionContext struct {
+	HeadBlockRoot        []byte   `protobuf:"bytes,1,opt,name=head_block_root,json=headBlockRoot,proto3" json:"head_block_root,omitempty"`
+	HeadSlot             uint64   `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	FinalizedBlockRoot   []byte   `protobuf:"bytes,4,opt,name=finalized_block_root,json=finalizedBlockRoot,proto3" json:"finalized_block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedSlasherServer) IsSlashableAttestation(ctx context.Context, req *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableAttestation not implemented")
+func (m *DetectionContext) Reset()         { *m = DetectionContext{} }
+func (m *DetectionContext) String() string { return proto.CompactTextString(m) }
+func (*DetectionContext) ProtoMessage()    {}
+func (*DetectionContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{7}
 }
-func (*UnimplementedSlasherServer) IsSlashableBlock(ctx context.Context, req *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableBlock not implemented")
+func (m *DetectionContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
-	s.RegisterService(&_Slasher_serviceDesc, srv)
+func (m *DetectionContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DetectionContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DetectionContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectionContext.Merge(m, src)
+}
+func (m *DetectionContext) XXX_Size() int {
+	return m.Size()
+}
+func (m *DetectionContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectionContext.DiscardUnknown(m)
 }
 
-func _Slasher_IsSlashableAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.IndexedAttestation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SlasherServer).IsSlashableAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.slashing.Slasher/IsSlashableAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SlasherServer).IsSlashableAttestation(ctx, req.(*v1alpha1.IndexedAttestation))
+var xxx_messageInfo_DetectionContext proto.InternalMessageInfo
+
+func (m *DetectionContext) GetHeadBlockRoot() []byte {
+	if m != nil {
+		return m.HeadBlockRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Slasher_IsSlashableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.SignedBeaconBlockHeader)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SlasherServer).IsSlashableBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.slashing.Slasher/IsSlashableBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SlasherServer).IsSlashableBlock(ctx, req.(*v1alpha1.SignedBeaconBlockHeader))
+func (m *DetectionContext) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Slasher_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.slashing.Slasher",
-	HandlerType: (*SlasherServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "IsSlashableAttestation",
-			Handler:    _Slasher_IsSlashableAttestation_Handler,
-		},
-		{
-			MethodName: "IsSlashableBlock",
-			Handler:    _Slasher_IsSlashableBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/slashing/slashing.proto",
+func (m *DetectionContext) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
 }
 
-func (m *ProposerSlashingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DetectionContext) GetFinalizedBlockRoot() []byte {
+	if m != nil {
+		return m.FinalizedBlockRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProposerSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeferredDetection struct {
+	Attestation          *v1alpha1.IndexedAttestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	ValidatorIndex       uint64                       `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	SlashableEpoch       uint64                       `protobuf:"varint,3,opt,name=slashable_epoch,json=slashableEpoch,proto3" json:"slashable_epoch,omitempty"`
+	Kind                 uint32                       `protobuf:"varint,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	SigBytes             []byte                       `protobuf:"bytes,5,opt,name=sig_bytes,json=sigBytes,proto3" json:"sig_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (m *ProposerSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ProposerSlashing) > 0 {
-		for iNdEx := len(m.ProposerSlashing) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProposerSlashing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSlashing(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DeferredDetection) Reset()         { *m = DeferredDetection{} }
+func (m *DeferredDetection) String() string { return proto.CompactTextString(m) }
+func (*DeferredDetection) ProtoMessage()    {}
+func (*DeferredDetection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{8}
+}
+func (m *DeferredDetection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeferredDetection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferredDetection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeferredDetection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferredDetection.Merge(m, src)
+}
+func (m *DeferredDetection) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferredDetection) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferredDetection.DiscardUnknown(m)
 }
 
-func (m *AttesterSlashingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeferredDetection proto.InternalMessageInfo
+
+func (m *DeferredDetection) GetAttestation() *v1alpha1.IndexedAttestation {
+	if m != nil {
+		return m.Attestation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttesterSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeferredDetection) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
 }
 
-func (m *AttesterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.AttesterSlashing) > 0 {
-		for iNdEx := len(m.AttesterSlashing) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AttesterSlashing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSlashing(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *DeferredDetection) GetSlashableEpoch() uint64 {
+	if m != nil {
+		return m.SlashableEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MinMaxEpochSpan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeferredDetection) GetKind() uint32 {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MinMaxEpochSpan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MinMaxEpochSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MaxEpochSpan != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxEpochSpan))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.MinEpochSpan != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MinEpochSpan))
-		i--
-		dAtA[i] = 0x8
+func (m *DeferredDetection) GetSigBytes() []byte {
+	if m != nil {
+		return m.SigBytes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EpochSpanMap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MinMaxEpochSpan struct {
+	MinEpochSpan         uint32   `protobuf:"varint,1,opt,name=min_epoch_span,json=minEpochSpan,proto3" json:"min_epoch_span,omitempty"`
+	MaxEpochSpan         uint32   `protobuf:"varint,2,opt,name=max_epoch_span,json=maxEpochSpan,proto3" json:"max_epoch_span,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EpochSpanMap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinMaxEpochSpan) Reset()         { *m = MinMaxEpochSpan{} }
+func (m *MinMaxEpochSpan) String() string { return proto.CompactTextString(m) }
+func (*MinMaxEpochSpan) ProtoMessage()    {}
+func (*MinMaxEpochSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{9}
 }
-
-func (m *EpochSpanMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.EpochSpanMap) > 0 {
-		for k := range m.EpochSpanMap {
-			v := m.EpochSpanMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintSlashing(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintSlashing(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintSlashing(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *MinMaxEpochSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinMaxEpochSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinMaxEpochSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ProposalHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MinMaxEpochSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinMaxEpochSpan.Merge(m, src)
 }
-
-func (m *ProposalHistory) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinMaxEpochSpan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinMaxEpochSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinMaxEpochSpan.DiscardUnknown(m)
 }
 
-func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LatestEpochWritten != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.EpochBits) > 0 {
-		i -= len(m.EpochBits)
-		copy(dAtA[i:], m.EpochBits)
-		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EpochBits)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MinMaxEpochSpan proto.InternalMessageInfo
+
+func (m *MinMaxEpochSpan) GetMinEpochSpan() uint32 {
+	if m != nil {
+		return m.MinEpochSpan
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AttestationHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MinMaxEpochSpan) GetMaxEpochSpan() uint32 {
+	if m != nil {
+		return m.MaxEpochSpan
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AttestationHistory) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EpochSpanMap struct {
+	EpochSpanMap         map[uint64]*MinMaxEpochSpan `protobuf:"bytes,1,rep,name=epoch_span_map,json=epochSpanMap,proto3" json:"epoch_span_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *AttestationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LatestEpochWritten != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TargetToSource) > 0 {
-		for k := range m.TargetToSource {
-			v := m.TargetToSource[k]
-			baseI := i
-			i = encodeVarintSlashing(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintSlashing(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintSlashing(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *EpochSpanMap) Reset()         { *m = EpochSpanMap{} }
+func (m *EpochSpanMap) String() string { return proto.CompactTextString(m) }
+func (*EpochSpanMap) ProtoMessage()    {}
+func (*EpochSpanMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{10}
+}
+func (m *EpochSpanMap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSpanMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSpanMap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EpochSpanMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSpanMap.Merge(m, src)
+}
+func (m *EpochSpanMap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSpanMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSpanMap.DiscardUnknown(m)
 }
 
-func (m *SlashingStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EpochSpanMap proto.InternalMessageInfo
+
+func (m *EpochSpanMap) GetEpochSpanMap() map[uint64]*MinMaxEpochSpan {
+	if m != nil {
+		return m.EpochSpanMap
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SlashingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ProposalHistory struct {
+	EpochBits            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"epoch_bits,omitempty"`
+	LatestEpochWritten   uint64                                       `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
 }
 
-func (m *SlashingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
+func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
+func (*ProposalHistory) ProtoMessage()    {}
+func (*ProposalHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{11}
 }
-
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *ProposerSlashingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalHistory.Merge(m, src)
+}
+func (m *ProposalHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalHistory proto.InternalMessageInfo
+
+func (m *ProposalHistory) GetEpochBits() github_com_prysmaticlabs_go_bitfield.Bitlist {
+	if m != nil {
+		return m.EpochBits
 	}
-	var l int
-	_ = l
-	if len(m.ProposerSlashing) > 0 {
-		for _, e := range m.ProposerSlashing {
-			l = e.Size()
-			n += 1 + l + sovSlashing(uint64(l))
+	return nil
+}
+
+func (m *ProposalHistory) GetLatestEpochWritten() uint64 {
+	if m != nil {
+		return m.LatestEpochWritten
+	}
+	return 0
+}
+
+type AttestationHistory struct {
+	TargetToSource       map[uint64]uint64 `protobuf:"bytes,1,rep,name=target_to_source,json=targetToSource,proto3" json:"target_to_source,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LatestEpochWritten   uint64            `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AttestationHistory) Reset()         { *m = AttestationHistory{} }
+func (m *AttestationHistory) String() string { return proto.CompactTextString(m) }
+func (*AttestationHistory) ProtoMessage()    {}
+func (*AttestationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{12}
+}
+func (m *AttestationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *AttestationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationHistory.Merge(m, src)
+}
+func (m *AttestationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationHistory proto.InternalMessageInfo
+
+func (m *AttestationHistory) GetTargetToSource() map[uint64]uint64 {
+	if m != nil {
+		return m.TargetToSource
 	}
-	return n
+	return nil
 }
 
-func (m *AttesterSlashingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
+	if m != nil {
+		return m.LatestEpochWritten
 	}
-	var l int
-	_ = l
-	if len(m.AttesterSlashing) > 0 {
-		for _, e := range m.AttesterSlashing {
-			l = e.Size()
-			n += 1 + l + sovSlashing(uint64(l))
+	return 0
+}
+
+type SlashingStatusRequest struct {
+	Status               SlashingStatusRequest_SlashingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatusRequest_SlashingStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *SlashingStatusRequest) Reset()         { *m = SlashingStatusRequest{} }
+func (m *SlashingStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingStatusRequest) ProtoMessage()    {}
+func (*SlashingStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{13}
+}
+func (m *SlashingStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SlashingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingStatusRequest.Merge(m, src)
+}
+func (m *SlashingStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingStatusRequest proto.InternalMessageInfo
+
+func (m *SlashingStatusRequest) GetStatus() SlashingStatusRequest_SlashingStatus {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return SlashingStatusRequest_Unknown
 }
 
-func (m *MinMaxEpochSpan) Size() (n int) {
-	if m == nil {
-		return 0
+type SlasherStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlasherStatusRequest) Reset()         { *m = SlasherStatusRequest{} }
+func (m *SlasherStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SlasherStatusRequest) ProtoMessage()    {}
+func (*SlasherStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{14}
+}
+func (m *SlasherStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlasherStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlasherStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.MinEpochSpan != 0 {
-		n += 1 + sovSlashing(uint64(m.MinEpochSpan))
+}
+func (m *SlasherStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlasherStatusRequest.Merge(m, src)
+}
+func (m *SlasherStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlasherStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlasherStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlasherStatusRequest proto.InternalMessageInfo
+
+type SlasherStatusResponse struct {
+	IntakeFilter         *IntakeFilterStatus `protobuf:"bytes,1,opt,name=intake_filter,json=intakeFilter,proto3" json:"intake_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SlasherStatusResponse) Reset()         { *m = SlasherStatusResponse{} }
+func (m *SlasherStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SlasherStatusResponse) ProtoMessage()    {}
+func (*SlasherStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{15}
+}
+func (m *SlasherStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlasherStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlasherStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxEpochSpan != 0 {
-		n += 1 + sovSlashing(uint64(m.MaxEpochSpan))
+}
+func (m *SlasherStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlasherStatusResponse.Merge(m, src)
+}
+func (m *SlasherStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlasherStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlasherStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlasherStatusResponse proto.InternalMessageInfo
+
+func (m *SlasherStatusResponse) GetIntakeFilter() *IntakeFilterStatus {
+	if m != nil {
+		return m.IntakeFilter
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+type IntakeFilterStatus struct {
+	Enabled                   bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ValidatorIndices          []uint64 `protobuf:"varint,2,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	MinCommitteeParticipation float64  `protobuf:"fixed64,3,opt,name=min_committee_participation,json=minCommitteeParticipation,proto3" json:"min_committee_participation,omitempty"`
+	FilteredByValidatorSet    uint64   `protobuf:"varint,4,opt,name=filtered_by_validator_set,json=filteredByValidatorSet,proto3" json:"filtered_by_validator_set,omitempty"`
+	FilteredByParticipation   uint64   `protobuf:"varint,5,opt,name=filtered_by_participation,json=filteredByParticipation,proto3" json:"filtered_by_participation,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *IntakeFilterStatus) Reset()         { *m = IntakeFilterStatus{} }
+func (m *IntakeFilterStatus) String() string { return proto.CompactTextString(m) }
+func (*IntakeFilterStatus) ProtoMessage()    {}
+func (*IntakeFilterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{16}
+}
+func (m *IntakeFilterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntakeFilterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntakeFilterStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *IntakeFilterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntakeFilterStatus.Merge(m, src)
+}
+func (m *IntakeFilterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntakeFilterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntakeFilterStatus.DiscardUnknown(m)
 }
 
-func (m *EpochSpanMap) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_IntakeFilterStatus proto.InternalMessageInfo
+
+func (m *IntakeFilterStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	var l int
-	_ = l
-	if len(m.EpochSpanMap) > 0 {
-		for k, v := range m.EpochSpanMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSlashing(uint64(l))
+	return false
+}
+
+func (m *IntakeFilterStatus) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *IntakeFilterStatus) GetMinCommitteeParticipation() float64 {
+	if m != nil {
+		return m.MinCommitteeParticipation
+	}
+	return 0
+}
+
+func (m *IntakeFilterStatus) GetFilteredByValidatorSet() uint64 {
+	if m != nil {
+		return m.FilteredByValidatorSet
+	}
+	return 0
+}
+
+func (m *IntakeFilterStatus) GetFilteredByParticipation() uint64 {
+	if m != nil {
+		return m.FilteredByParticipation
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.slashing.SlashingProof_Condition", SlashingProof_Condition_name, SlashingProof_Condition_value)
+	proto.RegisterEnum("ethereum.slashing.SlashingStatusRequest_SlashingStatus", SlashingStatusRequest_SlashingStatus_name, SlashingStatusRequest_SlashingStatus_value)
+	proto.RegisterType((*HighestAttestationRequest)(nil), "ethereum.slashing.HighestAttestationRequest")
+	proto.RegisterType((*HighestAttestation)(nil), "ethereum.slashing.HighestAttestation")
+	proto.RegisterType((*ValidatorEpochSpanRequest)(nil), "ethereum.slashing.ValidatorEpochSpanRequest")
+	proto.RegisterType((*ValidatorEpochSpan)(nil), "ethereum.slashing.ValidatorEpochSpan")
+	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
+	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
+	proto.RegisterType((*SlashingProof)(nil), "ethereum.slashing.SlashingProof")
+	proto.RegisterType((*DetectionContext)(nil), "ethereum.slashing.DetectionContext")
+	proto.RegisterType((*DeferredDetection)(nil), "ethereum.slashing.DeferredDetection")
+	proto.RegisterType((*MinMaxEpochSpan)(nil), "ethereum.slashing.MinMaxEpochSpan")
+	proto.RegisterType((*EpochSpanMap)(nil), "ethereum.slashing.EpochSpanMap")
+	proto.RegisterMapType((map[uint64]*MinMaxEpochSpan)(nil), "ethereum.slashing.EpochSpanMap.EpochSpanMapEntry")
+	proto.RegisterType((*ProposalHistory)(nil), "ethereum.slashing.ProposalHistory")
+	proto.RegisterType((*AttestationHistory)(nil), "ethereum.slashing.AttestationHistory")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "ethereum.slashing.AttestationHistory.TargetToSourceEntry")
+	proto.RegisterType((*SlashingStatusRequest)(nil), "ethereum.slashing.SlashingStatusRequest")
+	proto.RegisterType((*SlasherStatusRequest)(nil), "ethereum.slashing.SlasherStatusRequest")
+	proto.RegisterType((*SlasherStatusResponse)(nil), "ethereum.slashing.SlasherStatusResponse")
+	proto.RegisterType((*IntakeFilterStatus)(nil), "ethereum.slashing.IntakeFilterStatus")
+}
+
+func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
+
+var fileDescriptor_da7e95107d0081b4 = []byte{
+	// 1400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdf, 0x6e, 0xdb, 0x36,
+	0x17, 0xaf, 0x6c, 0xe7, 0xdf, 0x89, 0xe3, 0x28, 0x6c, 0xda, 0xcf, 0x71, 0xf1, 0xb5, 0xf9, 0xf4,
+	0xb5, 0x4b, 0xb6, 0xb6, 0x4e, 0xed, 0x5d, 0xac, 0xed, 0xc5, 0x80, 0xb8, 0xe9, 0x90, 0x6c, 0x28,
+	0x1a, 0xc8, 0x5d, 0x0b, 0x0c, 0x18, 0x04, 0x5a, 0x62, 0x6c, 0x22, 0xb2, 0xa8, 0x89, 0x74, 0x1a,
+	0xef, 0x31, 0x86, 0xbd, 0xc0, 0x6e, 0x76, 0x3b, 0x60, 0x4f, 0xb1, 0x01, 0xbb, 0xe8, 0x13, 0x0c,
+	0x43, 0x77, 0xbf, 0x07, 0x18, 0x76, 0x31, 0x90, 0xa2, 0x64, 0xc9, 0x96, 0xdb, 0x14, 0xbb, 0xd9,
+	0x9d, 0xf8, 0x3b, 0xe7, 0xfc, 0x0e, 0x0f, 0x75, 0xf8, 0x23, 0x09, 0xff, 0x0d, 0x23, 0x26, 0xd8,
+	0x1e, 0xf7, 0x31, 0x1f, 0xd0, 0xa0, 0x9f, 0x7e, 0x34, 0x15, 0x8e, 0x36, 0x88, 0x18, 0x90, 0x88,
+	0x8c, 0x86, 0xcd, 0xc4, 0xd0, 0xb8, 0x41, 0xc4, 0x60, 0xef, 0xac, 0x85, 0xfd, 0x70, 0x80, 0x5b,
+	0x7b, 0x3d, 0x82, 0x5d, 0x16, 0x38, 0x3d, 0x9f, 0xb9, 0xa7, 0x71, 0x4c, 0xe3, 0x6e, 0x9f, 0x8a,
+	0xc1, 0xa8, 0xd7, 0x74, 0xd9, 0x70, 0xaf, 0xcf, 0xfa, 0x6c, 0x4f, 0xc1, 0xbd, 0xd1, 0x89, 0x1a,
+	0xc5, 0xf9, 0xe4, 0x57, 0xec, 0x6e, 0x1d, 0xc0, 0xd6, 0x21, 0xed, 0x0f, 0x08, 0x17, 0xfb, 0x42,
+	0x10, 0x2e, 0xb0, 0xa0, 0x2c, 0xb0, 0xc9, 0x57, 0x23, 0xc2, 0x05, 0xda, 0x81, 0xf5, 0x33, 0xec,
+	0x53, 0x0f, 0x0b, 0x16, 0x39, 0x34, 0xf0, 0xc8, 0x79, 0xdd, 0xd8, 0x36, 0x76, 0x2b, 0x76, 0x2d,
+	0x85, 0x8f, 0x24, 0x6a, 0x7d, 0x67, 0x00, 0x9a, 0xa5, 0xb9, 0x70, 0x3c, 0xba, 0x07, 0x9b, 0x83,
+	0x38, 0xdc, 0xe1, 0x6c, 0x14, 0xb9, 0xc4, 0x21, 0x21, 0x73, 0x07, 0xf5, 0x92, 0xf2, 0x46, 0xda,
+	0xd6, 0x55, 0xa6, 0xc7, 0xd2, 0x92, 0x8d, 0x10, 0x38, 0xea, 0x13, 0xa1, 0x23, 0xca, 0xb9, 0x88,
+	0x67, 0xca, 0xa4, 0x22, 0xac, 0x2f, 0x60, 0xeb, 0x79, 0x92, 0x55, 0x21, 0xdd, 0x10, 0xbf, 0x73,
+	0xa5, 0x68, 0x13, 0x16, 0xb2, 0x53, 0x8b, 0x07, 0xd6, 0xcf, 0x06, 0xa0, 0x59, 0xf2, 0x7f, 0xc8,
+	0x8a, 0xb6, 0x60, 0x79, 0x48, 0x03, 0x87, 0x87, 0x38, 0x50, 0x75, 0xad, 0xd9, 0x4b, 0x43, 0x1a,
+	0x28, 0x66, 0x69, 0xc2, 0xe7, 0xb1, 0xa9, 0xa2, 0x4d, 0xf8, 0x5c, 0x99, 0xae, 0xc1, 0x0a, 0xa7,
+	0x7d, 0xa7, 0x37, 0x16, 0x84, 0xd7, 0x17, 0xb6, 0x8d, 0xdd, 0xaa, 0xbd, 0xcc, 0x69, 0xbf, 0x23,
+	0xc7, 0xe8, 0x7f, 0x50, 0x1d, 0x60, 0xee, 0x60, 0xf5, 0x93, 0x88, 0x57, 0x5f, 0xdc, 0x36, 0x76,
+	0x97, 0xed, 0xd5, 0x01, 0xe6, 0xfb, 0x1a, 0xb2, 0xfe, 0x32, 0xa0, 0x7e, 0x1c, 0xb1, 0x90, 0x71,
+	0x12, 0x75, 0x75, 0xdb, 0xd9, 0x84, 0x87, 0x2c, 0xe0, 0x04, 0x3d, 0x83, 0x8d, 0x50, 0xdb, 0x9c,
+	0xa4, 0x27, 0xeb, 0xc6, 0x76, 0x79, 0x77, 0xb5, 0xbd, 0xd3, 0x4c, 0xbb, 0x95, 0x88, 0x41, 0x33,
+	0xe9, 0xd1, 0xe6, 0x0c, 0x97, 0x19, 0x4e, 0x21, 0xe8, 0x18, 0x36, 0x3c, 0x22, 0x88, 0x2b, 0x9b,
+	0xc6, 0x71, 0x59, 0x20, 0xc8, 0xb9, 0x50, 0x4b, 0xb1, 0xda, 0xfe, 0x7f, 0x73, 0x66, 0x0f, 0x34,
+	0x0f, 0x12, 0xdf, 0x47, 0xb1, 0xab, 0x6d, 0x7a, 0x53, 0x08, 0xba, 0x0f, 0x8b, 0x61, 0xc4, 0xd8,
+	0x09, 0xaf, 0x97, 0xd5, 0xe4, 0xb6, 0x0b, 0x68, 0x92, 0xf4, 0xc7, 0xd2, 0xd1, 0xd6, 0xfe, 0xaa,
+	0x7c, 0xbd, 0x16, 0x85, 0xe5, 0xeb, 0xa5, 0xbb, 0x70, 0xf9, 0x33, 0x5c, 0x26, 0x9e, 0x42, 0xfe,
+	0x55, 0xe5, 0xbf, 0x2a, 0xc3, 0x5a, 0xce, 0x82, 0x0e, 0x61, 0xc5, 0x65, 0x81, 0x47, 0x25, 0xbf,
+	0x6a, 0xdf, 0x5a, 0xfb, 0x83, 0xb7, 0xd1, 0x35, 0x1f, 0x25, 0x11, 0xf6, 0x24, 0x18, 0x5d, 0x81,
+	0xc5, 0x88, 0x31, 0xe1, 0xb4, 0x54, 0x71, 0x55, 0x7b, 0x41, 0x8e, 0x5a, 0x29, 0xdc, 0xae, 0x97,
+	0x27, 0x70, 0x1b, 0xdd, 0x84, 0x5a, 0x56, 0x0b, 0x9c, 0x96, 0x6a, 0xf4, 0x8a, 0x5d, 0xe5, 0x13,
+	0x19, 0x68, 0x49, 0xaf, 0xec, 0xfe, 0x77, 0x5a, 0xaa, 0xe5, 0x2b, 0x76, 0x55, 0x4c, 0xb6, 0x7e,
+	0x6b, 0x86, 0xab, 0x5d, 0x5f, 0x9c, 0xe1, 0x6a, 0xcf, 0x70, 0xb5, 0xeb, 0x4b, 0x33, 0x5c, 0x6d,
+	0x74, 0x1b, 0x36, 0x72, 0x9b, 0x9a, 0xba, 0x84, 0xd7, 0x97, 0xb7, 0xcb, 0xbb, 0x15, 0xdb, 0xcc,
+	0x6e, 0x6b, 0x89, 0x23, 0x04, 0x15, 0xee, 0x33, 0x51, 0x5f, 0x51, 0x44, 0xea, 0xdb, 0x0a, 0x60,
+	0x25, 0x5d, 0x1e, 0xb4, 0x09, 0xe6, 0xe7, 0xc1, 0x69, 0xc0, 0x5e, 0x06, 0x29, 0x66, 0x5e, 0x42,
+	0x35, 0x80, 0x03, 0x36, 0xea, 0xf9, 0xe4, 0x39, 0x13, 0xc4, 0x34, 0xd0, 0x65, 0x58, 0xef, 0x8e,
+	0xa2, 0x88, 0x8d, 0x02, 0x8f, 0x06, 0x7d, 0x05, 0x96, 0x10, 0x82, 0x5a, 0x02, 0x12, 0x4f, 0x61,
+	0x65, 0x89, 0xc5, 0x81, 0xf1, 0xae, 0xc3, 0xbe, 0x59, 0xb1, 0x7e, 0x34, 0xc0, 0x9c, 0xee, 0x19,
+	0xf4, 0x1e, 0xac, 0x0f, 0x08, 0xf6, 0xe2, 0xa3, 0xc3, 0x91, 0x2b, 0xae, 0xfe, 0x6d, 0xd5, 0x5e,
+	0x93, 0x70, 0x47, 0xa2, 0x36, 0x63, 0x42, 0xaa, 0x89, 0xf2, 0x53, 0x55, 0xc4, 0xea, 0xb4, 0x2c,
+	0x81, 0xae, 0xcf, 0x94, 0x6a, 0x9e, 0xd0, 0x00, 0xfb, 0xf4, 0x6b, 0xe2, 0xe5, 0xf4, 0xb7, 0x96,
+	0xc2, 0xa9, 0x5a, 0x4f, 0x1c, 0x33, 0x29, 0x2b, 0x2a, 0x25, 0x4a, 0x6d, 0x69, 0x5e, 0xeb, 0x77,
+	0x03, 0x36, 0x0e, 0xc8, 0x09, 0x89, 0x22, 0xe2, 0xa5, 0x93, 0x47, 0x9f, 0xc1, 0x2a, 0x9e, 0x9c,
+	0x2f, 0x6a, 0xc6, 0xab, 0xed, 0xf7, 0xe7, 0xec, 0x3c, 0x25, 0xad, 0xc4, 0xcb, 0x9e, 0x6b, 0xd9,
+	0xe8, 0x22, 0x75, 0x2e, 0x15, 0xaa, 0xf3, 0x0e, 0xac, 0xab, 0x36, 0xc7, 0x3d, 0x9f, 0xe4, 0xcb,
+	0x4c, 0xe1, 0xb8, 0x4c, 0x04, 0x95, 0x53, 0x1a, 0x78, 0x5a, 0x91, 0xd5, 0xf7, 0x1b, 0xe5, 0xd8,
+	0xfa, 0x12, 0xd6, 0x9f, 0xd0, 0xe0, 0x09, 0x3e, 0x9f, 0x9c, 0x19, 0x37, 0xa1, 0x26, 0x45, 0x3f,
+	0xee, 0x40, 0xa5, 0xef, 0x86, 0x62, 0xab, 0x0e, 0x69, 0x90, 0xf7, 0xc2, 0xe7, 0x59, 0xaf, 0x92,
+	0xf6, 0xca, 0x70, 0x59, 0xbf, 0x18, 0x50, 0x4d, 0x47, 0x4f, 0x70, 0x88, 0x5e, 0x40, 0x6d, 0x12,
+	0xe2, 0x0c, 0x71, 0xa8, 0xc5, 0xab, 0x55, 0xb0, 0xa1, 0xb3, 0x81, 0xb9, 0xc1, 0xe3, 0x40, 0x44,
+	0x63, 0xbb, 0x4a, 0x32, 0x50, 0xc3, 0x85, 0x8d, 0x19, 0x17, 0x64, 0x42, 0xf9, 0x94, 0x8c, 0xf5,
+	0x91, 0x27, 0x3f, 0xd1, 0x7d, 0x58, 0x38, 0xc3, 0xfe, 0x88, 0x68, 0x75, 0xb3, 0x0a, 0xd2, 0x4e,
+	0xad, 0x87, 0x1d, 0x07, 0x3c, 0x2c, 0xdd, 0x37, 0xac, 0x6f, 0x0d, 0x58, 0x4f, 0xfa, 0xfa, 0x90,
+	0x72, 0xc1, 0xa2, 0x31, 0x7a, 0x0a, 0x10, 0x57, 0xd4, 0xa3, 0x82, 0xc7, 0x2d, 0xdc, 0xb9, 0xf7,
+	0xe7, 0xaf, 0x37, 0xee, 0x64, 0xae, 0x41, 0x61, 0x34, 0xe6, 0x43, 0x2c, 0xa8, 0xeb, 0xe3, 0x1e,
+	0xdf, 0xeb, 0xb3, 0xbb, 0x3d, 0x2a, 0x4e, 0x28, 0xf1, 0xbd, 0x66, 0x87, 0x0a, 0x9f, 0x72, 0x61,
+	0xaf, 0x28, 0x8e, 0x0e, 0x15, 0x5c, 0xb6, 0xaa, 0x8f, 0x65, 0x93, 0xe8, 0xc5, 0x7d, 0x19, 0x51,
+	0x21, 0x48, 0x90, 0x5c, 0x45, 0x62, 0x9b, 0x9a, 0xde, 0x8b, 0xd8, 0x62, 0xfd, 0x61, 0x00, 0xca,
+	0x34, 0x59, 0x32, 0x33, 0x17, 0x4c, 0xad, 0x26, 0x82, 0xe9, 0x5b, 0x8d, 0x5e, 0xed, 0x07, 0x05,
+	0x65, 0xcf, 0x12, 0x34, 0xe3, 0xcb, 0xcb, 0x33, 0xa6, 0xaf, 0x3d, 0x6a, 0xd5, 0x6b, 0x22, 0x07,
+	0xbe, 0xfb, 0x6c, 0x1b, 0xfb, 0x70, 0xb9, 0x80, 0xb8, 0xe0, 0x5f, 0x6d, 0x66, 0xff, 0x55, 0x25,
+	0xfb, 0x1f, 0x7e, 0x30, 0xe0, 0x4a, 0x22, 0xf7, 0x5d, 0x81, 0xc5, 0x88, 0x27, 0xd7, 0xa8, 0xa7,
+	0xb0, 0xc8, 0x15, 0xa0, 0x0f, 0x8a, 0x8f, 0xde, 0x70, 0x50, 0xe4, 0x22, 0xa7, 0x51, 0x4d, 0x63,
+	0x3d, 0x86, 0x5a, 0xde, 0x82, 0x56, 0x61, 0x49, 0x0b, 0xa6, 0x79, 0x09, 0x01, 0x2c, 0xee, 0xbb,
+	0x82, 0x9e, 0x49, 0x8d, 0xac, 0xc2, 0xf2, 0x51, 0xe0, 0xfa, 0x23, 0x8f, 0x78, 0x66, 0x49, 0x8e,
+	0x6c, 0x72, 0x46, 0x22, 0x41, 0x3c, 0xb3, 0x6c, 0x5d, 0x85, 0x4d, 0x45, 0x43, 0xa2, 0x5c, 0x56,
+	0xcb, 0x85, 0x2b, 0x53, 0xb8, 0x3e, 0xe8, 0x3f, 0x85, 0x35, 0x1a, 0x08, 0x7c, 0x4a, 0x9c, 0x13,
+	0xea, 0x0b, 0x12, 0x69, 0xa9, 0xb9, 0x55, 0x50, 0xcf, 0x91, 0xf2, 0xfb, 0x44, 0xb9, 0x69, 0x96,
+	0x2a, 0xcd, 0x60, 0xd6, 0x37, 0x25, 0x40, 0xb3, 0x4e, 0xa8, 0x0e, 0x4b, 0x24, 0x90, 0xda, 0xe1,
+	0x29, 0xf2, 0x65, 0x3b, 0x19, 0x16, 0x9f, 0x30, 0xa5, 0x39, 0x27, 0xcc, 0xc7, 0x70, 0x4d, 0xea,
+	0x85, 0xcb, 0x86, 0x43, 0xf9, 0x83, 0x89, 0x13, 0xe2, 0x48, 0x50, 0x97, 0x86, 0xb1, 0x44, 0x4a,
+	0xa1, 0x32, 0xec, 0xad, 0x21, 0x0d, 0x1e, 0x25, 0x1e, 0xc7, 0x59, 0x07, 0xf4, 0x00, 0xb6, 0xe2,
+	0x12, 0xa5, 0x32, 0x8f, 0x9d, 0x49, 0x62, 0x4e, 0x84, 0x3e, 0x71, 0xaf, 0x26, 0x0e, 0x9d, 0x71,
+	0x7a, 0xc9, 0xed, 0x12, 0x81, 0x1e, 0xe6, 0x43, 0xf3, 0x89, 0xe3, 0x63, 0xf8, 0x3f, 0x93, 0xd0,
+	0x5c, 0xda, 0xf6, 0xf7, 0x15, 0x58, 0xd2, 0x4b, 0x8f, 0x42, 0xb8, 0x7a, 0xc4, 0xbb, 0x89, 0x94,
+	0x66, 0x1f, 0x10, 0x17, 0x97, 0xf6, 0xc6, 0xed, 0xb9, 0x9b, 0xaa, 0xe0, 0x1e, 0xc7, 0xc0, 0xcc,
+	0x64, 0x54, 0xa7, 0x0e, 0x6a, 0xce, 0xc9, 0xd5, 0xa5, 0xfd, 0x80, 0x78, 0x1d, 0xf5, 0xd2, 0x52,
+	0x9e, 0x87, 0x04, 0x7b, 0x24, 0x2a, 0x4c, 0x38, 0xf7, 0xde, 0x4c, 0x0b, 0xdf, 0x07, 0x77, 0x0a,
+	0x28, 0xe6, 0xbe, 0x51, 0x1a, 0xb7, 0x2e, 0xe4, 0x8d, 0x68, 0xe1, 0x53, 0xac, 0x28, 0xd5, 0xdc,
+	0x87, 0x5f, 0xe3, 0xd6, 0x85, 0xbc, 0x51, 0x4f, 0xdf, 0x15, 0xd3, 0x9e, 0xde, 0x99, 0xb7, 0xdf,
+	0xa7, 0x36, 0x5e, 0x63, 0xf7, 0xed, 0x8e, 0xf1, 0xca, 0x75, 0xaa, 0x3f, 0xbd, 0xbe, 0x6e, 0xbc,
+	0x7a, 0x7d, 0xdd, 0xf8, 0xed, 0xf5, 0x75, 0xa3, 0xb7, 0xa8, 0x5e, 0xad, 0x1f, 0xfe, 0x3d, 0x00,
+	0x6b, 0x88, 0xa4, 0x78, 0x39, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SlasherClient is the client API for Slasher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SlasherClient interface {
+	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
+	IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
+	ValidatorEpochSpan(ctx context.Context, in *ValidatorEpochSpanRequest, opts ...grpc.CallOption) (*ValidatorEpochSpan, error)
+	HighestAttestation(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestation, error)
+	SlasherStatus(ctx context.Context, in *SlasherStatusRequest, opts ...grpc.CallOption) (*SlasherStatusResponse, error)
+}
+
+type slasherClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSlasherClient(cc *grpc.ClientConn) SlasherClient {
+	return &slasherClient{cc}
+}
+
+func (c *slasherClient) IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error) {
+	out := new(AttesterSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error) {
+	out := new(ProposerSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) ValidatorEpochSpan(ctx context.Context, in *ValidatorEpochSpanRequest, opts ...grpc.CallOption) (*ValidatorEpochSpan, error) {
+	out := new(ValidatorEpochSpan)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ValidatorEpochSpan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) HighestAttestation(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestation, error) {
+	out := new(HighestAttestation)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/HighestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) SlasherStatus(ctx context.Context, in *SlasherStatusRequest, opts ...grpc.CallOption) (*SlasherStatusResponse, error) {
+	out := new(SlasherStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/SlasherStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlasherServer is the server API for Slasher service.
+type SlasherServer interface {
+	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
+	IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error)
+	ValidatorEpochSpan(context.Context, *ValidatorEpochSpanRequest) (*ValidatorEpochSpan, error)
+	HighestAttestation(context.Context, *HighestAttestationRequest) (*HighestAttestation, error)
+	SlasherStatus(context.Context, *SlasherStatusRequest) (*SlasherStatusResponse, error)
+}
+
+// UnimplementedSlasherServer can be embedded to have forward compatible implementations.
+type UnimplementedSlasherServer struct {
+}
+
+func (*UnimplementedSlasherServer) IsSlashableAttestation(ctx context.Context, req *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableAttestation not implemented")
+}
+func (*UnimplementedSlasherServer) IsSlashableBlock(ctx context.Context, req *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableBlock not implemented")
+}
+func (*UnimplementedSlasherServer) ValidatorEpochSpan(ctx context.Context, req *ValidatorEpochSpanRequest) (*ValidatorEpochSpan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEpochSpan not implemented")
+}
+func (*UnimplementedSlasherServer) HighestAttestation(ctx context.Context, req *HighestAttestationRequest) (*HighestAttestation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestation not implemented")
+}
+func (*UnimplementedSlasherServer) SlasherStatus(ctx context.Context, req *SlasherStatusRequest) (*SlasherStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlasherStatus not implemented")
+}
+
+func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
+	s.RegisterService(&_Slasher_serviceDesc, srv)
+}
+
+func _Slasher_IsSlashableAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.IndexedAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).IsSlashableAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/IsSlashableAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).IsSlashableAttestation(ctx, req.(*v1alpha1.IndexedAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_IsSlashableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.SignedBeaconBlockHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).IsSlashableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/IsSlashableBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).IsSlashableBlock(ctx, req.(*v1alpha1.SignedBeaconBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_ValidatorEpochSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorEpochSpanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ValidatorEpochSpan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ValidatorEpochSpan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ValidatorEpochSpan(ctx, req.(*ValidatorEpochSpanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_HighestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighestAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).HighestAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/HighestAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).HighestAttestation(ctx, req.(*HighestAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_SlasherStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlasherStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).SlasherStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/SlasherStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).SlasherStatus(ctx, req.(*SlasherStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Slasher_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.slashing.Slasher",
+	HandlerType: (*SlasherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsSlashableAttestation",
+			Handler:    _Slasher_IsSlashableAttestation_Handler,
+		},
+		{
+			MethodName: "IsSlashableBlock",
+			Handler:    _Slasher_IsSlashableBlock_Handler,
+		},
+		{
+			MethodName: "ValidatorEpochSpan",
+			Handler:    _Slasher_ValidatorEpochSpan_Handler,
+		},
+		{
+			MethodName: "HighestAttestation",
+			Handler:    _Slasher_HighestAttestation_Handler,
+		},
+		{
+			MethodName: "SlasherStatus",
+			Handler:    _Slasher_SlasherStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/slashing/slashing.proto",
+}
+
+func (m *HighestAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighestAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HighestTargetEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.HighestTargetEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HighestSourceEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.HighestSourceEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochSpanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochSpanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochSpanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HasAttested {
+		i--
+		if m.HasAttested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SigBytes) > 0 {
+		i -= len(m.SigBytes)
+		copy(dAtA[i:], m.SigBytes)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SigBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxSpan != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxSpan))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinSpan != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MinSpan))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerSlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DetectionContext != nil {
+		{
+			size, err := m.DetectionContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProposerSlashing) > 0 {
+		for iNdEx := len(m.ProposerSlashing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerSlashing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttesterSlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DetectionContext != nil {
+		{
+			size, err := m.DetectionContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttesterSlashing) > 0 {
+		for iNdEx := len(m.AttesterSlashing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttesterSlashing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA4 := make([]byte, len(m.ValidatorIndices)*10)
+		var j3 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSlashing(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TargetEpoch_2 != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.TargetEpoch_2))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SourceEpoch_2 != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SourceEpoch_2))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetEpoch_1 != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.TargetEpoch_1))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SourceEpoch_1 != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SourceEpoch_1))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root_2) > 0 {
+		i -= len(m.Root_2)
+		copy(dAtA[i:], m.Root_2)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Root_2)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Root_1) > 0 {
+		i -= len(m.Root_1)
+		copy(dAtA[i:], m.Root_1)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Root_1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Condition != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetectionContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetectionContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetectionContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FinalizedBlockRoot) > 0 {
+		i -= len(m.FinalizedBlockRoot)
+		copy(dAtA[i:], m.FinalizedBlockRoot)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.FinalizedBlockRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HeadBlockRoot) > 0 {
+		i -= len(m.HeadBlockRoot)
+		copy(dAtA[i:], m.HeadBlockRoot)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.HeadBlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeferredDetection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferredDetection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferredDetection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SigBytes) > 0 {
+		i -= len(m.SigBytes)
+		copy(dAtA[i:], m.SigBytes)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SigBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashableEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashableEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinMaxEpochSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinMaxEpochSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinMaxEpochSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxEpochSpan != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxEpochSpan))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinEpochSpan != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MinEpochSpan))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSpanMap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSpanMap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSpanMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EpochSpanMap) > 0 {
+		for k := range m.EpochSpanMap {
+			v := m.EpochSpanMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSlashing(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintSlashing(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintSlashing(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LatestEpochWritten != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochBits) > 0 {
+		i -= len(m.EpochBits)
+		copy(dAtA[i:], m.EpochBits)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EpochBits)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LatestEpochWritten != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TargetToSource) > 0 {
+		for k := range m.TargetToSource {
+			v := m.TargetToSource[k]
+			baseI := i
+			i = encodeVarintSlashing(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintSlashing(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintSlashing(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlasherStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlasherStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlasherStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlasherStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlasherStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlasherStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IntakeFilter != nil {
+		{
+			size, err := m.IntakeFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntakeFilterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntakeFilterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntakeFilterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FilteredByParticipation != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.FilteredByParticipation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FilteredByValidatorSet != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.FilteredByValidatorSet))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinCommitteeParticipation != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinCommitteeParticipation))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA9 := make([]byte, len(m.ValidatorIndices)*10)
+		var j8 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSlashing(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HighestAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HighestAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorIndex))
+	}
+	if m.HighestSourceEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.HighestSourceEpoch))
+	}
+	if m.HighestTargetEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.HighestTargetEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochSpanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorIndex))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSlashing(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorIndex))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSlashing(uint64(m.Epoch))
+	}
+	if m.MinSpan != 0 {
+		n += 1 + sovSlashing(uint64(m.MinSpan))
+	}
+	if m.MaxSpan != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxSpan))
+	}
+	l = len(m.SigBytes)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.HasAttested {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposerSlashing) > 0 {
+		for _, e := range m.ProposerSlashing {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.DetectionContext != nil {
+		l = m.DetectionContext.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttesterSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AttesterSlashing) > 0 {
+		for _, e := range m.AttesterSlashing {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.DetectionContext != nil {
+		l = m.DetectionContext.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlashingProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Condition != 0 {
+		n += 1 + sovSlashing(uint64(m.Condition))
+	}
+	l = len(m.Root_1)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Root_2)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.SourceEpoch_1 != 0 {
+		n += 1 + sovSlashing(uint64(m.SourceEpoch_1))
+	}
+	if m.TargetEpoch_1 != 0 {
+		n += 1 + sovSlashing(uint64(m.TargetEpoch_1))
+	}
+	if m.SourceEpoch_2 != 0 {
+		n += 1 + sovSlashing(uint64(m.SourceEpoch_2))
+	}
+	if m.TargetEpoch_2 != 0 {
+		n += 1 + sovSlashing(uint64(m.TargetEpoch_2))
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
+	}
+	if m.Slot != 0 {
+		n += 1 + sovSlashing(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetectionContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeadBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovSlashing(uint64(m.HeadSlot))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.FinalizedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeferredDetection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorIndex))
+	}
+	if m.SlashableEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashableEpoch))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovSlashing(uint64(m.Kind))
+	}
+	l = len(m.SigBytes)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MinMaxEpochSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinEpochSpan != 0 {
+		n += 1 + sovSlashing(uint64(m.MinEpochSpan))
+	}
+	if m.MaxEpochSpan != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxEpochSpan))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochSpanMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochSpanMap) > 0 {
+		for k, v := range m.EpochSpanMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSlashing(uint64(l))
+			}
+			mapEntrySize := 1 + sovSlashing(uint64(k)) + l
+			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposalHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochBits)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.LatestEpochWritten != 0 {
+		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetToSource) > 0 {
+		for k, v := range m.TargetToSource {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovSlashing(uint64(k)) + 1 + sovSlashing(uint64(v))
+			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+		}
+	}
+	if m.LatestEpochWritten != 0 {
+		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlashingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlasherStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlasherStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntakeFilter != nil {
+		l = m.IntakeFilter.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IntakeFilterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
+	}
+	if m.MinCommitteeParticipation != 0 {
+		n += 9
+	}
+	if m.FilteredByValidatorSet != 0 {
+		n += 1 + sovSlashing(uint64(m.FilteredByValidatorSet))
+	}
+	if m.FilteredByParticipation != 0 {
+		n += 1 + sovSlashing(uint64(m.FilteredByParticipation))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestSourceEpoch", wireType)
+			}
+			m.HighestSourceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestSourceEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestTargetEpoch", wireType)
+			}
+			m.HighestTargetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestTargetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochSpanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochSpanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpan", wireType)
+			}
+			m.MinSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSpan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpan", wireType)
+			}
+			m.MaxSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSpan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigBytes = append(m.SigBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigBytes == nil {
+				m.SigBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAttested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasAttested = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSlashingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSlashing = append(m.ProposerSlashing, &v1alpha1.ProposerSlashing{})
+			if err := m.ProposerSlashing[len(m.ProposerSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectionContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DetectionContext == nil {
+				m.DetectionContext = &DetectionContext{}
+			}
+			if err := m.DetectionContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &SlashingProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterSlashingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterSlashing = append(m.AttesterSlashing, &v1alpha1.AttesterSlashing{})
+			if err := m.AttesterSlashing[len(m.AttesterSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectionContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DetectionContext == nil {
+				m.DetectionContext = &DetectionContext{}
+			}
+			if err := m.DetectionContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &SlashingProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= SlashingProof_Condition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root_1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root_1 = append(m.Root_1[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root_1 == nil {
+				m.Root_1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root_2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root_2 = append(m.Root_2[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root_2 == nil {
+				m.Root_2 = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEpoch_1", wireType)
+			}
+			m.SourceEpoch_1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceEpoch_1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEpoch_1", wireType)
+			}
+			m.TargetEpoch_1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEpoch_1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEpoch_2", wireType)
+			}
+			m.SourceEpoch_2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceEpoch_2 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEpoch_2", wireType)
+			}
+			m.TargetEpoch_2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEpoch_2 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetectionContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetectionContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetectionContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadBlockRoot = append(m.HeadBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadBlockRoot == nil {
+				m.HeadBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedBlockRoot == nil {
+				m.FinalizedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			mapEntrySize := 1 + sovSlashing(uint64(k)) + l
-			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *ProposalHistory) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EpochBits)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.LatestEpochWritten != 0 {
-		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *AttestationHistory) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TargetToSource) > 0 {
-		for k, v := range m.TargetToSource {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovSlashing(uint64(k)) + 1 + sovSlashing(uint64(v))
-			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+func (m *DeferredDetection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredDetection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredDetection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &v1alpha1.IndexedAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashableEpoch", wireType)
+			}
+			m.SlashableEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashableEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigBytes = append(m.SigBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigBytes == nil {
+				m.SigBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.LatestEpochWritten != 0 {
-		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *SlashingStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovSlashing(uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
+func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1055,17 +4223,36 @@ func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposerSlashingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinMaxEpochSpan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposerSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinMaxEpochSpan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashing", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochSpan", wireType)
+			}
+			m.MinEpochSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinEpochSpan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochSpan", wireType)
 			}
-			var msglen int
+			m.MaxEpochSpan = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1075,26 +4262,11 @@ func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxEpochSpan |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposerSlashing = append(m.ProposerSlashing, &v1alpha1.ProposerSlashing{})
-			if err := m.ProposerSlashing[len(m.ProposerSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -1120,7 +4292,7 @@ func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1143,15 +4315,15 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttesterSlashingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochSpanMap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttesterSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochSpanMap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSpanMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1178,10 +4350,91 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttesterSlashing = append(m.AttesterSlashing, &v1alpha1.AttesterSlashing{})
-			if err := m.AttesterSlashing[len(m.AttesterSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.EpochSpanMap == nil {
+				m.EpochSpanMap = make(map[uint64]*MinMaxEpochSpan)
+			}
+			var mapkey uint64
+			var mapvalue *MinMaxEpochSpan
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MinMaxEpochSpan{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSlashing(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.EpochSpanMap[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1208,7 +4461,7 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
+func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1231,17 +4484,17 @@ func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinMaxEpochSpan: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinMaxEpochSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochSpan", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBits", wireType)
 			}
-			m.MinEpochSpan = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1251,16 +4504,31 @@ func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinEpochSpan |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBits = append(m.EpochBits[:0], dAtA[iNdEx:postIndex]...)
+			if m.EpochBits == nil {
+				m.EpochBits = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochSpan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpochWritten", wireType)
 			}
-			m.MaxEpochSpan = 0
+			m.LatestEpochWritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1270,7 +4538,7 @@ func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEpochSpan |= uint32(b&0x7F) << shift
+				m.LatestEpochWritten |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1300,7 +4568,7 @@ func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
+func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,15 +4591,15 @@ func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochSpanMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochSpanMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochSpanMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetToSource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1358,11 +4626,11 @@ func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EpochSpanMap == nil {
-				m.EpochSpanMap = make(map[uint64]*MinMaxEpochSpan)
+			if m.TargetToSource == nil {
+				m.TargetToSource = make(map[uint64]uint64)
 			}
 			var mapkey uint64
-			var mapvalue *MinMaxEpochSpan
+			var mapvalue uint64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -1397,7 +4665,6 @@ func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 						}
 					}
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSlashing
@@ -1407,26 +4674,11 @@ func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSlashing
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthSlashing
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &MinMaxEpochSpan{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -1442,8 +4694,100 @@ func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.EpochSpanMap[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.TargetToSource[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpochWritten", wireType)
+			}
+			m.LatestEpochWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEpochWritten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashingStatusRequest_SlashingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -1469,7 +4813,7 @@ func (m *EpochSpanMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
+func (m *SlasherStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1492,17 +4836,71 @@ func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlasherStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlasherStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlasherStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlasherStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlasherStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntakeFilter", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1512,45 +4910,28 @@ func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochBits = append(m.EpochBits[:0], dAtA[iNdEx:postIndex]...)
-			if m.EpochBits == nil {
-				m.EpochBits = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpochWritten", wireType)
+			if m.IntakeFilter == nil {
+				m.IntakeFilter = &IntakeFilterStatus{}
 			}
-			m.LatestEpochWritten = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LatestEpochWritten |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.IntakeFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -1576,7 +4957,7 @@ func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
+func (m *IntakeFilterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,17 +4980,17 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: IntakeFilterStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IntakeFilterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetToSource", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1619,29 +5000,15 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TargetToSource == nil {
-				m.TargetToSource = make(map[uint64]uint64)
-			}
-			var mapkey uint64
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType == 0 {
+				var v uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowSlashing
@@ -1651,28 +5018,51 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSlashing
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
 					}
-				} else if fieldNum == 2 {
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSlashing
@@ -1682,33 +5072,32 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
+						v |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSlashing(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSlashing
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
 			}
-			m.TargetToSource[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommitteeParticipation", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinCommitteeParticipation = float64(math.Float64frombits(v))
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpochWritten", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredByValidatorSet", wireType)
 			}
-			m.LatestEpochWritten = 0
+			m.FilteredByValidatorSet = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1718,70 +5107,16 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestEpochWritten |= uint64(b&0x7F) << shift
+				m.FilteredByValidatorSet |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SlashingStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SlashingStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredByParticipation", wireType)
 			}
-			m.Status = 0
+			m.FilteredByParticipation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1791,7 +5126,7 @@ func (m *SlashingStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SlashingStatusRequest_SlashingStatus(b&0x7F) << shift
+				m.FilteredByParticipation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
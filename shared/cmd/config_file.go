@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/urfave/cli.v2"
+	"gopkg.in/urfave/cli.v2/altsrc"
+)
+
+// LoadFlagsFromConfigFile sets the flags from the file of --config-file, if specified. The file
+// is a TOML document when it has the .toml extension and a YAML document otherwise, its keys are
+// the flag names. Flags set on the command line override the values of the file. The flags must
+// have been wrapped with WrapFlags.
+func LoadFlagsFromConfigFile(ctx *cli.Context, flags []cli.Flag) error {
+	if !ctx.IsSet(ConfigFileFlag.Name) {
+		return nil
+	}
+	return altsrc.InitInputSourceWithContext(flags, func(ctx *cli.Context) (altsrc.InputSourceContext, error) {
+		return ConfigFileSource(ctx.String(ConfigFileFlag.Name))
+	})(ctx)
+}
+
+// ConfigFileSource reads the flag values of a config file, a TOML document when the file has the
+// .toml extension and a YAML document otherwise.
+func ConfigFileSource(path string) (altsrc.InputSourceContext, error) {
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		return altsrc.NewTomlSourceFromFile(path)
+	}
+	return altsrc.NewYamlSourceFromFile(path)
+}
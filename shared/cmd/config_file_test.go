@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestLoadFlagsFromConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config-file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "config.yaml",
+			content: "host: 10.0.0.1\npeers: 50\nmonitoring: true\n",
+		},
+		{
+			name:    "config.toml",
+			content: "host = \"10.0.0.1\"\npeers = 50\nmonitoring = true\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, tt.name)
+			if err := ioutil.WriteFile(file, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			flags := WrapFlags([]cli.Flag{
+				ConfigFileFlag,
+				&cli.StringFlag{Name: "host", Value: "127.0.0.1"},
+				&cli.Uint64Flag{Name: "peers", Value: 30},
+				&cli.BoolFlag{Name: "monitoring"},
+			})
+			var host string
+			var peers uint64
+			var monitoring bool
+			app := &cli.App{
+				Flags: flags,
+				Before: func(ctx *cli.Context) error {
+					return LoadFlagsFromConfigFile(ctx, flags)
+				},
+				Action: func(ctx *cli.Context) error {
+					host = ctx.String("host")
+					peers = ctx.Uint64("peers")
+					monitoring = ctx.Bool("monitoring")
+					return nil
+				},
+			}
+			if err := app.Run([]string{"app", "--config-file", file, "--peers", "70"}); err != nil {
+				t.Fatal(err)
+			}
+			if host != "10.0.0.1" {
+				t.Errorf("Wanted host %s from the config file, got %s", "10.0.0.1", host)
+			}
+			if !monitoring {
+				t.Error("Wanted monitoring to be enabled by the config file")
+			}
+			if peers != 70 {
+				t.Errorf("Wanted peers %d from the command line, got %d", 70, peers)
+			}
+		})
+	}
+}
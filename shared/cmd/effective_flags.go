@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+// redactedValue replaces the value of the sensitive flags in the effective flags.
+const redactedValue = "<redacted>"
+
+// sensitiveFlags are the flags whose values are never exposed by EffectiveFlags, as they hold
+// endpoints with embedded credentials or API keys, webhook secrets or paths to private keys.
+var sensitiveFlags = map[string]bool{
+	"web3provider":             true,
+	"http-web3provider":        true,
+	"kafka-url":                true,
+	"validator-status-webhook": true,
+	"tracing-endpoint":         true,
+	"slasher-provider":         true,
+	"p2p-priv-key":             true,
+	"tls-key":                  true,
+}
+
+// EffectiveFlags returns the value of every flag of the application, whether it was set on the
+// command line, loaded from the config file or left to its default, keyed by the flag name. The
+// values of sensitive flags are redacted when set.
+func EffectiveFlags(ctx *cli.Context) map[string]string {
+	values := make(map[string]string)
+	if ctx.App == nil {
+		return values
+	}
+	for _, f := range ctx.App.Flags {
+		name := f.Names()[0]
+		v := ctx.Generic(name)
+		if v == nil {
+			continue
+		}
+		value := fmt.Sprint(v)
+		if sensitiveFlags[name] && value != "" {
+			value = redactedValue
+		}
+		values[name] = value
+	}
+	return values
+}
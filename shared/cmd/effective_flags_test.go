@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"flag"
+	"testing"
+
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestEffectiveFlags(t *testing.T) {
+	app := &cli.App{
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "host", Value: "127.0.0.1"},
+			&cli.Uint64Flag{Name: "peers", Value: 30},
+		},
+	}
+	set := flag.NewFlagSet("test", 0)
+	set.String("host", "127.0.0.1", "")
+	set.Uint64("peers", 30, "")
+	if err := set.Set("peers", "50"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := cli.NewContext(app, set, nil)
+
+	values := EffectiveFlags(ctx)
+	if values["host"] != "127.0.0.1" {
+		t.Errorf("Wanted defaulted host %s, got %s", "127.0.0.1", values["host"])
+	}
+	if values["peers"] != "50" {
+		t.Errorf("Wanted set peers %s, got %s", "50", values["peers"])
+	}
+}
+
+func TestEffectiveFlags_RedactsSensitiveFlags(t *testing.T) {
+	app := &cli.App{
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "http-web3provider"},
+			&cli.StringFlag{Name: "kafka-url"},
+		},
+	}
+	set := flag.NewFlagSet("test", 0)
+	set.String("http-web3provider", "", "")
+	set.String("kafka-url", "", "")
+	if err := set.Set("http-web3provider", "https://mainnet.infura.io/v3/secret-api-key"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := cli.NewContext(app, set, nil)
+
+	values := EffectiveFlags(ctx)
+	if values["http-web3provider"] != redactedValue {
+		t.Errorf("Wanted web3 provider redacted, got %s", values["http-web3provider"])
+	}
+	if values["kafka-url"] != "" {
+		t.Errorf("Wanted unset kafka url left empty, got %s", values["kafka-url"])
+	}
+}
@@ -106,6 +106,26 @@ var (
 		Usage: "The DNS address advertised by libp2p. This may be used to advertise an external DNS.",
 		Value: "",
 	}
+	// P2PIPMode defines the IP versions used by libp2p and discv5.
+	P2PIPMode = &cli.StringFlag{
+		Name: "p2p-ip-mode",
+		Usage: "The IP versions to listen and advertise on: ipv4, ipv6 for hosts without an IPv4 address, " +
+			"or dual-stack for both.",
+		Value: "ipv4",
+	}
+	// P2PIP6 defines the local IPv6 to be used by libp2p and discv5.
+	P2PIP6 = &cli.StringFlag{
+		Name:  "p2p-local-ip6",
+		Usage: "The local IPv6 address to listen for incoming data in the ipv6 and dual-stack IP modes.",
+		Value: "",
+	}
+	// P2PHost6 defines the host IPv6 to be used by libp2p and discv5.
+	P2PHost6 = &cli.StringFlag{
+		Name: "p2p-host-ip6",
+		Usage: "The IPv6 address advertised by libp2p and discv5 in the ipv6 and dual-stack IP modes. " +
+			"This may be used to advertise an external IPv6.",
+		Value: "",
+	}
 	// P2PPrivKey defines a flag to specify the location of the private key file for libp2p.
 	P2PPrivKey = &cli.StringFlag{
 		Name:  "p2p-priv-key",
@@ -144,7 +164,7 @@ var (
 	// LogFormat specifies the log output format.
 	LogFormat = &cli.StringFlag{
 		Name:  "log-format",
-		Usage: "Specify log formatting. Supports: text, json, fluentd.",
+		Usage: "Specify log formatting. Supports: text, json, fluentd. The json format follows a stable schema with the time, level, msg, module, slot, root, peer, error and fields keys.",
 		Value: "text",
 	}
 	// MaxGoroutines specifies the maximum amount of goroutines tolerated, before a status check fails.
@@ -166,6 +186,6 @@ var (
 	// ConfigFileFlag specifies the filepath to load flag values.
 	ConfigFileFlag = &cli.StringFlag{
 		Name:  "config-file",
-		Usage: "The filepath to a yaml file with flag values",
+		Usage: "The filepath to a YAML or TOML (.toml extension) file with flag values, flags set on the command line take precedence",
 	}
 )
@@ -53,6 +53,10 @@ type Flags struct {
 	EnableInitSyncQueue                        bool   // EnableInitSyncQueue enables the new initial sync implementation.
 	EnableFieldTrie                            bool   // EnableFieldTrie enables the state from using field specific tries when computing the root.
 	EnableBlockHTR                             bool   // EnableBlockHTR enables custom hashing of our beacon blocks.
+	VerifyColdStates                           bool   // VerifyColdStates recomputes and checks the state root of cold states loaded from the DB.
+	PregenerateColdStates                      bool   // PregenerateColdStates generates missing archived point states while the node is idle.
+	PregenerateBoundaryStates                  bool   // PregenerateBoundaryStates generates the upcoming epoch boundary state of the head ahead of time.
+	AdaptiveStateSaves                         bool   // AdaptiveStateSaves adjusts the frequency of full hot state saves to replay latencies and disk pressure.
 	// DisableForkChoice disables using LMD-GHOST fork choice to update
 	// the head of the chain based on attestations and instead accepts any valid received block
 	// as the chain head. UNSAFE, use with caution.
@@ -190,6 +194,22 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enabling custom block hashing")
 		cfg.EnableBlockHTR = true
 	}
+	if ctx.Bool(verifyColdStates.Name) {
+		log.Warn("Enabling cold state root verification")
+		cfg.VerifyColdStates = true
+	}
+	if ctx.Bool(pregenerateColdStates.Name) {
+		log.Warn("Enabling cold state pregeneration")
+		cfg.PregenerateColdStates = true
+	}
+	if ctx.Bool(pregenerateBoundaryStates.Name) {
+		log.Warn("Enabling epoch boundary state pregeneration")
+		cfg.PregenerateBoundaryStates = true
+	}
+	if ctx.Bool(adaptiveStateSaves.Name) {
+		log.Warn("Enabling adaptive hot state saves")
+		cfg.AdaptiveStateSaves = true
+	}
 	Init(cfg)
 }
 
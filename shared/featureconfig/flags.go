@@ -141,6 +141,26 @@ var (
 		Name:  "enable-custom-block-htr",
 		Usage: "Enables the usage of a custom hashing method for our block",
 	}
+	verifyColdStates = &cli.BoolFlag{
+		Name: "verify-cold-states",
+		Usage: "Recompute the hash tree root of cold states loaded from the DB and compare it with the " +
+			"state root of the corresponding block. This catches corrupted archival data at the cost of extra hashing.",
+	}
+	pregenerateColdStates = &cli.BoolFlag{
+		Name: "pregenerate-cold-states",
+		Usage: "Generate the archived point states missing from the DB for newly finalized epochs while the node " +
+			"is idle, instead of on the first request reaching them. Requires --new-state-mgmt.",
+	}
+	pregenerateBoundaryStates = &cli.BoolFlag{
+		Name: "pregenerate-boundary-states",
+		Usage: "Generate the upcoming epoch boundary state of the head at the end of every epoch, so the states " +
+			"requested at the start of the epoch don't all replay the previous epoch. Requires --new-state-mgmt.",
+	}
+	adaptiveStateSaves = &cli.BoolFlag{
+		Name: "adaptive-state-saves",
+		Usage: "Also save hot states in full within epochs, adjusting the number of slots between them to the replay " +
+			"latencies and the free disk space. Full states are still saved on every epoch boundary. Requires --new-state-mgmt.",
+	}
 )
 
 // Deprecated flags list.
@@ -323,6 +343,10 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableInitSyncQueue,
 	enableFieldTrie,
 	enableCustomBlockHTR,
+	verifyColdStates,
+	pregenerateColdStates,
+	pregenerateBoundaryStates,
+	adaptiveStateSaves,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -0,0 +1,168 @@
+// +build ignore
+
+// gen_htr generates the hash tree root helpers of htr_generated.go from the field layouts of the
+// containers below. Run it with go generate after changing the layouts.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"text/template"
+)
+
+type fieldKind int
+
+const (
+	uint64Field fieldKind = iota
+	bytes32Field
+	containerField
+)
+
+type field struct {
+	Name string
+	Kind fieldKind
+	// Container is the name of the container of a container field.
+	Container string
+}
+
+// IsUint64 reports whether the field is a uint64, which is little endian encoded in its chunk.
+func (f field) IsUint64() bool {
+	return f.Kind == uint64Field
+}
+
+// IsBytes32 reports whether the field is a 32 bytes root, which is its own chunk.
+func (f field) IsBytes32() bool {
+	return f.Kind == bytes32Field
+}
+
+type container struct {
+	Name   string
+	Var    string
+	Fields []field
+}
+
+// Chunks returns the number of leaves of the merkle tree of the container, the number of fields
+// padded to the next power of two.
+func (c container) Chunks() int {
+	n := 1
+	for n < len(c.Fields) {
+		n *= 2
+	}
+	return n
+}
+
+// HasContainerField reports whether a field of the container is itself a container, whose root
+// is computed with an error.
+func (c container) HasContainerField() bool {
+	for _, f := range c.Fields {
+		if f.Kind == containerField {
+			return true
+		}
+	}
+	return false
+}
+
+// containers must be listed in the order of the eth2 spec, with the fields in the order of their
+// SSZ serialization.
+var containers = []container{
+	{
+		Name: "Checkpoint",
+		Var:  "c",
+		Fields: []field{
+			{Name: "Epoch", Kind: uint64Field},
+			{Name: "Root", Kind: bytes32Field},
+		},
+	},
+	{
+		Name: "AttestationData",
+		Var:  "d",
+		Fields: []field{
+			{Name: "Slot", Kind: uint64Field},
+			{Name: "CommitteeIndex", Kind: uint64Field},
+			{Name: "BeaconBlockRoot", Kind: bytes32Field},
+			{Name: "Source", Kind: containerField, Container: "Checkpoint"},
+			{Name: "Target", Kind: containerField, Container: "Checkpoint"},
+		},
+	},
+}
+
+var tmpl = template.Must(template.New("htr").Parse(`// Code generated by gen_htr.go. DO NOT EDIT.
+
+package hashutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+{{range $c := .}}
+// {{$c.Name}}HashTreeRoot returns the hash tree root of the {{$c.Name}} without reflection.
+func {{$c.Name}}HashTreeRoot({{$c.Var}} *ethpb.{{$c.Name}}) ([32]byte, error) {
+	if {{$c.Var}} == nil {
+		return [32]byte{}, ErrNilProto
+	}
+	h := sha256Pool.Get().(hash.Hash)
+	defer sha256Pool.Put(h)
+	return hash{{$c.Name}}(h, {{$c.Var}})
+}
+
+// {{$c.Name}}Hashes returns both the HashProto sha256 and the hash tree root of the {{$c.Name}}.
+func {{$c.Name}}Hashes({{$c.Var}} *ethpb.{{$c.Name}}) (sha [32]byte, root [32]byte, err error) {
+	sha, err = HashProto({{$c.Var}})
+	if err != nil {
+		return [32]byte{}, [32]byte{}, err
+	}
+	h := sha256Pool.Get().(hash.Hash)
+	defer sha256Pool.Put(h)
+	root, err = hash{{$c.Name}}(h, {{$c.Var}})
+	if err != nil {
+		return [32]byte{}, [32]byte{}, err
+	}
+	return sha, root, nil
+}
+
+// hash{{$c.Name}} computes the hash tree root of the {{$c.Name}} with the hasher, a nil
+// {{$c.Name}} has the root of its zero value. Roots which aren't 32 bytes long have no SSZ
+// encoding and are rejected.
+func hash{{$c.Name}}(h hash.Hash, {{$c.Var}} *ethpb.{{$c.Name}}) ([32]byte, error) {
+	var chunks [{{$c.Chunks}}][32]byte
+	if {{$c.Var}} != nil {
+{{- if $c.HasContainerField}}
+		var err error
+{{- end}}
+{{- range $i, $f := $c.Fields}}
+{{- if $f.IsUint64}}
+		binary.LittleEndian.PutUint64(chunks[{{$i}}][:8], {{$c.Var}}.{{$f.Name}})
+{{- else if $f.IsBytes32}}
+		if len({{$c.Var}}.{{$f.Name}}) != 32 {
+			return [32]byte{}, fmt.Errorf("{{$c.Name}}.{{$f.Name}} is %d bytes long, wanted 32", len({{$c.Var}}.{{$f.Name}}))
+		}
+		copy(chunks[{{$i}}][:], {{$c.Var}}.{{$f.Name}})
+{{- else}}
+		if chunks[{{$i}}], err = hash{{$f.Container}}(h, {{$c.Var}}.{{$f.Name}}); err != nil {
+			return [32]byte{}, err
+		}
+{{- end}}
+{{- end}}
+	}
+	return merkleizeChunks(h, chunks[:]), nil
+}
+{{end}}`))
+
+func main() {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, containers); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Could not format generated code: %v\n%s", err, buf.String())
+	}
+	if err := ioutil.WriteFile("htr_generated.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,6 +1,7 @@
 package hashutil
 
 import (
+	"bytes"
 	"errors"
 	"hash"
 	"reflect"
@@ -128,8 +129,103 @@ func FastSum64(data []byte) uint64 {
 	return highwayhash.Sum64(data, fastSumHashKey[:])
 }
 
+// FastSum64Batch returns the FastSum64 of every item, in order. A single highwayhash state is reset
+// and reused for all the items instead of setting one up per item, which adds up on large batches.
+func FastSum64Batch(items [][]byte) []uint64 {
+	sums := make([]uint64, len(items))
+	h, err := highwayhash.New64(fastSumHashKey[:])
+	if err != nil {
+		// Only happens with a key which isn't 32 bytes long.
+		for i, item := range items {
+			sums[i] = FastSum64(item)
+		}
+		return sums
+	}
+	for i, item := range items {
+		h.Reset()
+		// #nosec G104 -- Writing to a hash never returns an error.
+		h.Write(item)
+		sums[i] = h.Sum64()
+	}
+	return sums
+}
+
+// UniqueProtoIndices returns the indices of the first occurrence of every distinct message, in
+// order. Messages are bucketed by the FastSum64 of their encoding and the encodings are compared
+// within a bucket, so a sum collision never drops a message.
+func UniqueProtoIndices(msgs []proto.Message) ([]int, error) {
+	encoded := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		if msg == nil || reflect.ValueOf(msg).IsNil() {
+			return nil, ErrNilProto
+		}
+		enc, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = enc
+	}
+	sums := FastSum64Batch(encoded)
+	seen := make(map[uint64][]int, len(msgs))
+	unique := make([]int, 0, len(msgs))
+	for i, sum := range sums {
+		duplicate := false
+		for _, j := range seen[sum] {
+			if bytes.Equal(encoded[i], encoded[j]) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		seen[sum] = append(seen[sum], i)
+		unique = append(unique, i)
+	}
+	return unique, nil
+}
+
 // FastSum256 returns a hash sum of the input data using highwayhash. This method is not secure, but
 // may be used as a quick identifier for objects where collisions are acceptable.
 func FastSum256(data []byte) [32]byte {
 	return highwayhash.Sum(data, fastSumHashKey[:])
 }
+
+// CacheKey returns a sha256 key for in-memory caches. The namespace and every part are length
+// prefixed before hashing, so keys from different caches, or from different splits of the same
+// bytes into parts, never share an input. Cache keys are derived from data received from peers,
+// a collision resistant hash is used so that crafted inputs can't make two entries share a key.
+func CacheKey(namespace string, parts ...[]byte) [32]byte {
+	size := 8 + len(namespace)
+	for _, p := range parts {
+		size += 8 + len(p)
+	}
+	buf := make([]byte, 0, size)
+	buf = append(buf, bytesutil.Bytes8(uint64(len(namespace)))...)
+	buf = append(buf, namespace...)
+	for _, p := range parts {
+		buf = append(buf, bytesutil.Bytes8(uint64(len(p)))...)
+		buf = append(buf, p...)
+	}
+	return Hash(buf)
+}
+
+// CacheKeyProto returns the CacheKey of a marshaled protocol buffer message.
+func CacheKeyProto(namespace string, msg proto.Message) (result [32]byte, err error) {
+	// Marshaling a proto with nil pointers will cause a panic in the unsafe
+	// proto.Marshal library.
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrNilProto
+		}
+	}()
+
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		return [32]byte{}, ErrNilProto
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return CacheKey(namespace, data), nil
+}
@@ -2,9 +2,12 @@ package hashutil_test
 
 import (
 	"encoding/hex"
+	"reflect"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	fuzz "github.com/google/gofuzz"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -97,3 +100,98 @@ func TestHashProtoFuzz(t *testing.T) {
 		_, _ = hashutil.HashProto(msg)
 	}
 }
+
+func TestCacheKey_NamespaceSeparation(t *testing.T) {
+	data := []byte("data")
+	if hashutil.CacheKey("a", data) != hashutil.CacheKey("a", data) {
+		t.Error("Expected cache keys of equal inputs to be equal")
+	}
+	if hashutil.CacheKey("a", data) == hashutil.CacheKey("b", data) {
+		t.Error("Expected cache keys in different namespaces to differ")
+	}
+	if hashutil.CacheKey("ab", []byte("c")) == hashutil.CacheKey("a", []byte("bc")) {
+		t.Error("Expected namespace and parts boundaries to be part of the key")
+	}
+	if hashutil.CacheKey("a", []byte("bc")) == hashutil.CacheKey("a", []byte("b"), []byte("c")) {
+		t.Error("Expected boundaries between parts to be part of the key")
+	}
+}
+
+func TestCacheKeyProto(t *testing.T) {
+	msg := &pb.Puzzle{
+		Challenge: "hello",
+	}
+	key, err := hashutil.CacheKeyProto("puzzle", msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != hashutil.CacheKey("puzzle", enc) {
+		t.Errorf("Expected key of the marshaled message, received %#x", key)
+	}
+	if _, err := hashutil.CacheKeyProto("puzzle", nil); err != hashutil.ErrNilProto {
+		t.Errorf("Expected %v, received %v", hashutil.ErrNilProto, err)
+	}
+}
+
+func TestFastSum64Batch(t *testing.T) {
+	items := [][]byte{{}, {0}, []byte("attestation"), []byte("attestation"), make([]byte, 300)}
+	sums := hashutil.FastSum64Batch(items)
+	if len(sums) != len(items) {
+		t.Fatalf("Wanted %d sums, got %d", len(items), len(sums))
+	}
+	for i, item := range items {
+		if want := hashutil.FastSum64(item); sums[i] != want {
+			t.Errorf("Item %d: wanted sum %d, got %d", i, want, sums[i])
+		}
+	}
+	if len(hashutil.FastSum64Batch(nil)) != 0 {
+		t.Error("Wanted no sums for no items")
+	}
+}
+
+func TestUniqueProtoIndices(t *testing.T) {
+	msgs := []proto.Message{
+		&ethpb.Checkpoint{Epoch: 1},
+		&ethpb.Checkpoint{Epoch: 2},
+		&ethpb.Checkpoint{Epoch: 1},
+		&ethpb.Checkpoint{Epoch: 3},
+		&ethpb.Checkpoint{Epoch: 2},
+	}
+	unique, err := hashutil.UniqueProtoIndices(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 1, 3}; !reflect.DeepEqual(unique, want) {
+		t.Errorf("Wanted indices %v, got %v", want, unique)
+	}
+	var nilCheckpoint *ethpb.Checkpoint
+	if _, err := hashutil.UniqueProtoIndices([]proto.Message{nilCheckpoint}); err != hashutil.ErrNilProto {
+		t.Errorf("Wanted %v, got %v", hashutil.ErrNilProto, err)
+	}
+}
+
+func BenchmarkFastSum64Batch(b *testing.B) {
+	items := make([][]byte, 1024)
+	for i := range items {
+		items[i] = bytesutil.Bytes32(uint64(i))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hashutil.FastSum64Batch(items)
+	}
+}
+
+func TestCacheKey_IsSHA256OfLengthPrefixedInput(t *testing.T) {
+	var enc []byte
+	enc = append(enc, bytesutil.Bytes8(1)...)
+	enc = append(enc, 'a')
+	enc = append(enc, bytesutil.Bytes8(2)...)
+	enc = append(enc, 'b', 'c')
+	if key := hashutil.CacheKey("a", []byte("bc")); key != hashutil.Hash(enc) {
+		t.Errorf("Wanted sha256 key %#x, received %#x", hashutil.Hash(enc), key)
+	}
+}
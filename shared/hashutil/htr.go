@@ -0,0 +1,25 @@
+package hashutil
+
+import (
+	"hash"
+)
+
+//go:generate go run gen_htr.go
+
+// merkleizeChunks returns the merkle root of the chunks, hashing the pairs of nodes of every
+// level in place. The number of chunks must be a power of two, as it is for the containers of
+// htr_generated.go whose chunks are padded with zero chunks.
+func merkleizeChunks(h hash.Hash, chunks [][32]byte) [32]byte {
+	var pair [64]byte
+	for n := len(chunks); n > 1; n /= 2 {
+		for i := 0; i < n/2; i++ {
+			copy(pair[:32], chunks[2*i][:])
+			copy(pair[32:], chunks[2*i+1][:])
+			h.Reset()
+			// #nosec G104 -- Writing to a hash never returns an error.
+			h.Write(pair[:])
+			h.Sum(chunks[i][:0])
+		}
+	}
+	return chunks[0]
+}
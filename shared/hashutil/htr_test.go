@@ -0,0 +1,155 @@
+package hashutil_test
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func fuzzedAttestationData(f *fuzz.Fuzzer) *ethpb.AttestationData {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	f.Fuzz(&data.Slot)
+	f.Fuzz(&data.CommitteeIndex)
+	f.Fuzz(&data.Source.Epoch)
+	f.Fuzz(&data.Target.Epoch)
+	for _, root := range [][]byte{data.BeaconBlockRoot, data.Source.Root, data.Target.Root} {
+		var b [32]byte
+		f.Fuzz(&b)
+		copy(root, b[:])
+	}
+	return data
+}
+
+func TestCheckpointHashTreeRoot_MatchesSSZ(t *testing.T) {
+	f := fuzz.New()
+	for i := 0; i < 100; i++ {
+		checkpoint := fuzzedAttestationData(f).Source
+		want, err := ssz.HashTreeRoot(checkpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := hashutil.CheckpointHashTreeRoot(checkpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != want {
+			t.Fatalf("Wanted root %#x for checkpoint %v, received %#x", want, checkpoint, root)
+		}
+	}
+}
+
+func TestAttestationDataHashTreeRoot_MatchesSSZ(t *testing.T) {
+	f := fuzz.New()
+	for i := 0; i < 100; i++ {
+		data := fuzzedAttestationData(f)
+		want, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := hashutil.AttestationDataHashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != want {
+			t.Fatalf("Wanted root %#x for attestation data %v, received %#x", want, data, root)
+		}
+	}
+}
+
+func TestAttestationDataHashes(t *testing.T) {
+	data := fuzzedAttestationData(fuzz.New())
+	sha, root, err := hashutil.AttestationDataHashes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSha, err := hashutil.HashProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sha != wantSha {
+		t.Errorf("Wanted sha256 %#x, received %#x", wantSha, sha)
+	}
+	wantRoot, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != wantRoot {
+		t.Errorf("Wanted root %#x, received %#x", wantRoot, root)
+	}
+}
+
+func TestHashTreeRoot_NilMessage(t *testing.T) {
+	if _, err := hashutil.CheckpointHashTreeRoot(nil); err != hashutil.ErrNilProto {
+		t.Errorf("Wanted error %v, received %v", hashutil.ErrNilProto, err)
+	}
+	if _, _, err := hashutil.AttestationDataHashes(nil); err != hashutil.ErrNilProto {
+		t.Errorf("Wanted error %v, received %v", hashutil.ErrNilProto, err)
+	}
+}
+
+func TestHashTreeRoot_InvalidRootLength(t *testing.T) {
+	if _, err := hashutil.CheckpointHashTreeRoot(&ethpb.Checkpoint{Root: []byte{1}}); err == nil {
+		t.Error("Wanted an error for a checkpoint root which isn't 32 bytes")
+	}
+	data := fuzzedAttestationData(fuzz.New())
+	data.Target.Root = make([]byte, 33)
+	if _, _, err := hashutil.AttestationDataHashes(data); err == nil {
+		t.Error("Wanted an error for a target root which isn't 32 bytes")
+	}
+	data = fuzzedAttestationData(fuzz.New())
+	data.BeaconBlockRoot = nil
+	if _, err := hashutil.AttestationDataHashTreeRoot(data); err == nil {
+		t.Error("Wanted an error for a missing beacon block root")
+	}
+}
+
+func BenchmarkAttestationDataHashTreeRoot_Generated(b *testing.B) {
+	data := fuzzedAttestationData(fuzz.New())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := hashutil.AttestationDataHashTreeRoot(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAttestationDataHashTreeRoot_SSZ(b *testing.B) {
+	data := fuzzedAttestationData(fuzz.New())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ssz.HashTreeRoot(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCheckpointHashTreeRoot_Generated(b *testing.B) {
+	checkpoint := fuzzedAttestationData(fuzz.New()).Target
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := hashutil.CheckpointHashTreeRoot(checkpoint); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCheckpointHashTreeRoot_SSZ(b *testing.B) {
+	checkpoint := fuzzedAttestationData(fuzz.New()).Target
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ssz.HashTreeRoot(checkpoint); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
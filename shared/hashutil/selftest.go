@@ -0,0 +1,94 @@
+package hashutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+)
+
+type hashVector struct {
+	name  string
+	input []byte
+	want  string
+}
+
+// selfTestInput returns a 1000 byte input, long enough to go through the multi block paths of
+// the hash implementations.
+func selfTestInput() []byte {
+	b := make([]byte, 1000)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+var sha256Vectors = []hashVector{
+	{name: "empty", input: []byte{}, want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	{name: "abc", input: []byte("abc"), want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	{
+		name:  "two blocks",
+		input: []byte("abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq"),
+		want:  "248d6a61d20638b8e5c026930c3e6039a33ce45964ff2167f6ecedd419db06c1",
+	},
+	{name: "long", input: selfTestInput(), want: "a8af099bf2e878609558dbf69d8f88f4a31040a8cf84b549a0cfa912f12ffc3f"},
+}
+
+var keccak256Vectors = []hashVector{
+	{name: "empty", input: []byte{}, want: "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+	{name: "abc", input: []byte("abc"), want: "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+}
+
+// Vectors of FastSum256 with the fast sum key, the FastSum64 vectors are the same inputs.
+var fastSum256Vectors = []hashVector{
+	{name: "empty", input: []byte{}, want: "3843fb3d782e80cf58bd18728397b7a23e68f2bb8162d127554c73685a4a2dac"},
+	{name: "abc", input: []byte("abc"), want: "72fe2f3139d89fc24732c929cbaad987cd4e4ab23829e9e98dc012799adf41bd"},
+	{name: "long", input: selfTestInput(), want: "efcf9d3b274bcd5ff5bfcb8a5154527f46665e68a9b3e64f17cafa0e29f26a8d"},
+}
+
+var fastSum64Vectors = map[string]uint64{
+	"empty": 10020712669254708513,
+	"abc":   1317662450804459254,
+	"long":  7031500366538522267,
+}
+
+// SelfTest hashes known vectors with the SHA256, Keccak256 and highwayhash functions of this
+// package and returns an error describing the first mismatch. It is cheap enough to run on
+// startup, and catches miscompiled or broken assembly paths on unusual hardware before they
+// corrupt consensus critical hashing.
+func SelfTest() error {
+	hasher := CustomSHA256Hasher()
+	for _, v := range sha256Vectors {
+		if err := checkVector("sha256", v, Hash(v.input)); err != nil {
+			return err
+		}
+		if err := checkVector("custom sha256", v, hasher(v.input)); err != nil {
+			return err
+		}
+	}
+	for _, v := range keccak256Vectors {
+		if err := checkVector("keccak256", v, HashKeccak256(v.input)); err != nil {
+			return err
+		}
+	}
+	for _, v := range fastSum256Vectors {
+		if err := checkVector("highwayhash256", v, FastSum256(v.input)); err != nil {
+			return err
+		}
+		want := fastSum64Vectors[v.name]
+		if got := FastSum64(v.input); got != want {
+			return fmt.Errorf("highwayhash64 self-test %q failed: wanted %d, got %d", v.name, want, got)
+		}
+	}
+	return nil
+}
+
+func checkVector(hashName string, v hashVector, got [32]byte) error {
+	want, err := hex.DecodeString(v.want)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(want, got[:]) {
+		return fmt.Errorf("%s self-test %q failed: wanted %#x, got %#x", hashName, v.name, want, got)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package hashutil_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestSelfTest(t *testing.T) {
+	if err := hashutil.SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,6 +1,7 @@
 package iputils
 
 import (
+	"errors"
 	"net"
 )
 
@@ -41,3 +42,43 @@ func ExternalIPv4() (string, error) {
 	}
 	return "127.0.0.1", nil
 }
+
+// ExternalIPv6 returns the first global unicast IPv6 available.
+func ExternalIPv6() (string, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 {
+			continue // interface down
+		}
+		if iface.Flags&net.FlagLoopback != 0 {
+			continue // loopback interface
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			return "", err
+		}
+		for _, addr := range addrs {
+			var ip net.IP
+			switch v := addr.(type) {
+			case *net.IPNet:
+				ip = v.IP
+			case *net.IPAddr:
+				ip = v.IP
+			}
+			if ip == nil || ip.IsLoopback() {
+				continue
+			}
+			if ip.To4() != nil {
+				continue // not an ipv6 address
+			}
+			if !ip.IsGlobalUnicast() {
+				continue // link local address, which can't be reached by peers
+			}
+			return ip.String(), nil
+		}
+	}
+	return "", errors.New("no global unicast ipv6 address found")
+}
@@ -0,0 +1,118 @@
+package logutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// The top level keys of the JSON log schema. Log pipelines can rely on these keys across
+// releases and across the beacon node and slasher:
+//
+//   time   - RFC3339 timestamp with nanoseconds.
+//   level  - the log level, e.g. "info".
+//   msg    - the log message.
+//   module - the logger prefix, e.g. "sync" or "blockchain".
+//   slot   - the slot the event relates to.
+//   root   - the 0x prefixed hex block root the event relates to.
+//   peer   - the ID of the peer the event relates to.
+//   error  - the error message of the event.
+//   fields - every other field of the event, keyed by its name.
+//
+// Keys without a value for an event are omitted.
+const (
+	JSONKeyTime   = "time"
+	JSONKeyLevel  = "level"
+	JSONKeyMsg    = "msg"
+	JSONKeyModule = "module"
+	JSONKeySlot   = "slot"
+	JSONKeyRoot   = "root"
+	JSONKeyPeer   = "peer"
+	JSONKeyError  = "error"
+	JSONKeyFields = "fields"
+)
+
+// Loggers name the same values differently, these are mapped to the schema keys. The first
+// field found in the list wins, the others are kept in the fields object.
+var jsonKeyAliases = map[string][]string{
+	JSONKeyModule: {"prefix"},
+	JSONKeySlot:   {"slot", "blockSlot"},
+	JSONKeyRoot:   {"root", "blockRoot"},
+	JSONKeyPeer:   {"peer", "peerID", "pid"},
+	JSONKeyError:  {logrus.ErrorKey},
+}
+
+// JSONFormatter formats log entries as JSON objects following the stable schema documented
+// above, so that log pipelines don't depend on the field names picked by each logger.
+type JSONFormatter struct{}
+
+// NewJSONFormatter returns a formatter for the --log-format=json mode.
+func NewJSONFormatter() *JSONFormatter {
+	return &JSONFormatter{}
+}
+
+// Format renders a single log entry as a JSON line.
+func (f *JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	out := map[string]interface{}{
+		JSONKeyTime:  entry.Time.Format(time.RFC3339Nano),
+		JSONKeyLevel: entry.Level.String(),
+		JSONKeyMsg:   entry.Message,
+	}
+	fields := make(map[string]interface{}, len(entry.Data))
+	for k, v := range entry.Data {
+		fields[k] = v
+	}
+	for key, aliases := range jsonKeyAliases {
+		for _, alias := range aliases {
+			v, ok := fields[alias]
+			if !ok {
+				continue
+			}
+			out[key] = v
+			delete(fields, alias)
+			break
+		}
+	}
+	if err, ok := out[JSONKeyError].(error); ok {
+		out[JSONKeyError] = err.Error()
+	}
+	if root, ok := out[JSONKeyRoot]; ok {
+		out[JSONKeyRoot] = hexValue(root)
+	}
+	for k, v := range fields {
+		// Errors have no exported fields and would be encoded as empty objects.
+		if err, ok := v.(error); ok {
+			fields[k] = err.Error()
+		}
+	}
+	if len(fields) > 0 {
+		out[JSONKeyFields] = fields
+	}
+
+	enc, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal log entry to JSON: %v", err)
+	}
+	return append(enc, '\n'), nil
+}
+
+// Roots are logged as byte slices, arrays or hex encoded strings with or without prefix, this
+// normalizes them to 0x prefixed hex strings.
+func hexValue(v interface{}) interface{} {
+	switch r := v.(type) {
+	case []byte:
+		return fmt.Sprintf("%#x", r)
+	case [32]byte:
+		return fmt.Sprintf("%#x", r)
+	case string:
+		if strings.HasPrefix(r, "0x") {
+			return r
+		}
+		return "0x" + r
+	default:
+		return v
+	}
+}
@@ -0,0 +1,72 @@
+package logutil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestJSONFormatter_StableSchema(t *testing.T) {
+	entry := &logrus.Entry{
+		Time:    time.Unix(100, 0).UTC(),
+		Level:   logrus.InfoLevel,
+		Message: "Synced new block",
+		Data: logrus.Fields{
+			"prefix":        "sync",
+			"blockSlot":     uint64(5),
+			"blockRoot":     []byte{0xab, 0xcd},
+			"pid":           "16Uiu2",
+			logrus.ErrorKey: errors.New("bad"),
+			"epoch":         uint64(0),
+		},
+	}
+	enc, err := NewJSONFormatter().Format(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(map[string]interface{})
+	if err := json.Unmarshal(enc, &out); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		JSONKeyTime:   "1970-01-01T00:01:40Z",
+		JSONKeyLevel:  "info",
+		JSONKeyMsg:    "Synced new block",
+		JSONKeyModule: "sync",
+		JSONKeySlot:   float64(5),
+		JSONKeyRoot:   "0xabcd",
+		JSONKeyPeer:   "16Uiu2",
+		JSONKeyError:  "bad",
+	}
+	for k, v := range want {
+		if out[k] != v {
+			t.Errorf("Wanted %s to be %v, received %v", k, v, out[k])
+		}
+	}
+	fields, ok := out[JSONKeyFields].(map[string]interface{})
+	if !ok || len(fields) != 1 || fields["epoch"] != float64(0) {
+		t.Errorf("Wanted only the epoch in the fields object, received %v", out[JSONKeyFields])
+	}
+}
+
+func TestJSONFormatter_OmitsMissingKeys(t *testing.T) {
+	enc, err := NewJSONFormatter().Format(&logrus.Entry{Message: "hello", Data: logrus.Fields{"root": "abcd"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(map[string]interface{})
+	if err := json.Unmarshal(enc, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out[JSONKeyRoot] != "0xabcd" {
+		t.Errorf("Wanted prefixed root, received %v", out[JSONKeyRoot])
+	}
+	for _, k := range []string{JSONKeyModule, JSONKeySlot, JSONKeyPeer, JSONKeyError, JSONKeyFields} {
+		if _, ok := out[k]; ok {
+			t.Errorf("Did not want key %s in %s", k, enc)
+		}
+	}
+}
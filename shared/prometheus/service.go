@@ -22,6 +22,8 @@ type Service struct {
 	server      *http.Server
 	svcRegistry *shared.ServiceRegistry
 	failStatus  error
+	certFile    string
+	keyFile     string
 }
 
 // Handler represents a path and handler func to serve on the same port as /metrics, /healthz, /goroutinez, etc.
@@ -94,11 +96,23 @@ func (s *Service) goroutinezHandler(w http.ResponseWriter, _ *http.Request) {
 	pprof.Lookup("goroutine").WriteTo(w, 2)
 }
 
+// EnableTLS serves the endpoints over TLS with the given certificate and key files. It must be
+// called before the service is started.
+func (s *Service) EnableTLS(certFile string, keyFile string) {
+	s.certFile = certFile
+	s.keyFile = keyFile
+}
+
 // Start the prometheus service.
 func (s *Service) Start() {
 	log.WithField("endpoint", s.server.Addr).Info("Collecting metrics at endpoint")
 	go func() {
-		err := s.server.ListenAndServe()
+		var err error
+		if s.certFile != "" && s.keyFile != "" {
+			err = s.server.ListenAndServeTLS(s.certFile, s.keyFile)
+		} else {
+			err = s.server.ListenAndServe()
+		}
 		if err != nil && err != http.ErrServerClosed {
 			log.Errorf("Could not listen to host:port :%s: %v", s.server.Addr, err)
 			s.failStatus = err
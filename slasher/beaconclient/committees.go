@@ -0,0 +1,94 @@
+package beaconclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Attestations received from the beacon node are for the current and previous epochs, the
+// committees of a few epochs are kept around so late attestations don't trigger requests.
+const committeesCacheSize = 4
+
+// CommitteeSize returns the number of validators of the beacon committee with the input index
+// at the input slot. The committees of an epoch are requested from the beacon node once and
+// cached.
+func (bs *Service) CommitteeSize(ctx context.Context, slot uint64, committeeIndex uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.CommitteeSize")
+	defer span.End()
+	committee, err := bs.committee(ctx, slot, committeeIndex)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(committee)), nil
+}
+
+// IndexAttestation resolves the attesting indices of an unindexed attestation, such as an
+// aggregate from a feed which doesn't index attestations, from its aggregation bits and its
+// beacon committee. The committees of an epoch are requested from the beacon node once and
+// cached.
+func (bs *Service) IndexAttestation(ctx context.Context, att *ethpb.Attestation) (*ethpb.IndexedAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.IndexAttestation")
+	defer span.End()
+	if att.Data == nil {
+		return nil, errors.New("attestation has no data")
+	}
+	committee, err := bs.committee(ctx, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	if att.AggregationBits.Len() != uint64(len(committee)) {
+		return nil, errors.Errorf(
+			"aggregation bits length %d does not match committee size %d",
+			att.AggregationBits.Len(),
+			len(committee),
+		)
+	}
+	return attestationutil.ConvertToIndexed(ctx, att, committee), nil
+}
+
+func (bs *Service) committee(ctx context.Context, slot uint64, committeeIndex uint64) ([]uint64, error) {
+	committees, err := bs.committeesForEpoch(ctx, slot/params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := committees.Committees[slot]
+	if !ok || committeeIndex >= uint64(len(list.Committees)) {
+		return nil, errors.Errorf("no committee %d at slot %d", committeeIndex, slot)
+	}
+	return list.Committees[committeeIndex].ValidatorIndices, nil
+}
+
+func (bs *Service) committeesForEpoch(ctx context.Context, epoch uint64) (*ethpb.BeaconCommittees, error) {
+	bs.committeesLock.RLock()
+	committees, ok := bs.committeesCache[epoch]
+	bs.committeesLock.RUnlock()
+	if ok {
+		return committees, nil
+	}
+
+	committees, err := bs.beaconClient.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: epoch},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not request committees for epoch %d", epoch)
+	}
+	bs.committeesLock.Lock()
+	defer bs.committeesLock.Unlock()
+	bs.committeesCache[epoch] = committees
+	// The oldest epoch is evicted once the cache is full.
+	if len(bs.committeesCache) > committeesCacheSize {
+		oldest := epoch
+		for e := range bs.committeesCache {
+			if e < oldest {
+				oldest = e
+			}
+		}
+		delete(bs.committeesCache, oldest)
+	}
+	return committees, nil
+}
@@ -0,0 +1,132 @@
+package beaconclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+)
+
+func committeesAtSlot(slot uint64, committees ...[]uint64) *ethpb.BeaconCommittees {
+	list := &ethpb.BeaconCommittees_CommitteesList{}
+	for _, c := range committees {
+		list.Committees = append(list.Committees, &ethpb.BeaconCommittees_CommitteeItem{ValidatorIndices: c})
+	}
+	return &ethpb.BeaconCommittees{
+		Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{slot: list},
+	}
+}
+
+func TestService_IndexAttestation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	bs := &Service{
+		beaconClient:    client,
+		committeesCache: make(map[uint64]*ethpb.BeaconCommittees),
+	}
+	// The committees of the epoch are requested once for all of its attestations.
+	client.EXPECT().ListBeaconCommittees(
+		gomock.Any(),
+		&ethpb.ListCommitteesRequest{QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 0}},
+	).Return(committeesAtSlot(5, []uint64{10, 4, 7}, []uint64{3, 1, 8, 2}), nil).Times(1)
+
+	bits := bitfield.NewBitlist(4)
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(3, true)
+	att := &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            &ethpb.AttestationData{Slot: 5, CommitteeIndex: 1},
+		Signature:       []byte{1},
+	}
+	indexed, err := bs.IndexAttestation(context.Background(), att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(indexed.AttestingIndices, []uint64{2, 3}) {
+		t.Errorf("Wanted attesting indices %v, received %v", []uint64{2, 3}, indexed.AttestingIndices)
+	}
+
+	bits = bitfield.NewBitlist(3)
+	bits.SetBitAt(1, true)
+	att = &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            &ethpb.AttestationData{Slot: 5, CommitteeIndex: 0},
+	}
+	indexed, err = bs.IndexAttestation(context.Background(), att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(indexed.AttestingIndices, []uint64{4}) {
+		t.Errorf("Wanted attesting indices %v, received %v", []uint64{4}, indexed.AttestingIndices)
+	}
+}
+
+func TestService_IndexAttestation_BitsMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	bs := &Service{
+		beaconClient:    client,
+		committeesCache: make(map[uint64]*ethpb.BeaconCommittees),
+	}
+	client.EXPECT().ListBeaconCommittees(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(committeesAtSlot(5, []uint64{10, 4, 7}), nil)
+
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(8),
+		Data:            &ethpb.AttestationData{Slot: 5, CommitteeIndex: 0},
+	}
+	if _, err := bs.IndexAttestation(context.Background(), att); err == nil {
+		t.Error("Expected an error for aggregation bits not matching the committee")
+	}
+	att.Data.CommitteeIndex = 2
+	if _, err := bs.IndexAttestation(context.Background(), att); err == nil {
+		t.Error("Expected an error for an unknown committee")
+	}
+}
+
+func TestService_ReceiveUnindexedAttestations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	bs := &Service{
+		beaconClient:               client,
+		committeesCache:            make(map[uint64]*ethpb.BeaconCommittees),
+		receivedAttestationsBuffer: make(chan *ethpb.IndexedAttestation, 1),
+	}
+	client.EXPECT().ListBeaconCommittees(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(committeesAtSlot(5, []uint64{10, 4, 7}), nil)
+
+	stream := mock.NewMockBeaconChain_StreamAttestationsClient(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	client.EXPECT().StreamAttestations(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(stream, nil)
+	bits := bitfield.NewBitlist(3)
+	bits.SetBitAt(2, true)
+	stream.EXPECT().Recv().Return(&ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            &ethpb.AttestationData{Slot: 5},
+	}, nil)
+	stream.EXPECT().Recv().Return(nil, nil).Do(func() {
+		cancel()
+	})
+
+	go bs.receiveUnindexedAttestations(ctx)
+	received := <-bs.receivedAttestationsBuffer
+	if !reflect.DeepEqual(received.AttestingIndices, []uint64{7}) {
+		t.Errorf("Wanted attesting indices %v, received %v", []uint64{7}, received.AttestingIndices)
+	}
+	<-ctx.Done()
+}
@@ -0,0 +1,65 @@
+package beaconclient
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// beaconNodesScheme is the resolver scheme of the beacon node endpoints list.
+const beaconNodesScheme = "slasher-beacon-nodes"
+
+// resubscribeDelay is the time waited before subscribing again to a broken stream, giving the
+// connection time to fail over to another beacon node.
+var resubscribeDelay = 2 * time.Second
+
+// dialTarget returns the gRPC dial target of the beacon node endpoints. A single endpoint is
+// dialed directly. Multiple endpoints are resolved in order by a manual resolver, the default
+// pick first balancer then connects to the first reachable endpoint and moves on to the next
+// one when it becomes unreachable, without the clients built on the connection noticing.
+func dialTarget(providers []string) string {
+	if len(providers) == 1 {
+		return providers[0]
+	}
+	r := manual.NewBuilderWithScheme(beaconNodesScheme)
+	addrs := make([]resolver.Address, len(providers))
+	for i, p := range providers {
+		addrs[i] = resolver.Address{Addr: p}
+	}
+	r.InitialState(resolver.State{Addresses: addrs})
+	resolver.Register(r)
+	return r.Scheme() + ":///beacon-nodes"
+}
+
+// watchConnectionState logs the state changes of the connection to the beacon nodes, so
+// operators can tell when the slasher lost its upstream or failed over.
+func (bs *Service) watchConnectionState(ctx context.Context, conn *grpc.ClientConn) {
+	state := conn.GetState()
+	for conn.WaitForStateChange(ctx, state) {
+		state = conn.GetState()
+		switch state {
+		case connectivity.TransientFailure:
+			beaconNodeConnectionFailures.Inc()
+			log.WithField("providers", bs.providers).Warn("Lost connection to beacon node, trying the next endpoint")
+		case connectivity.Ready:
+			log.Info("Connected to beacon node")
+		default:
+			log.WithField("state", state).Debug("Beacon node connection state changed")
+		}
+	}
+}
+
+// waitToResubscribe waits before a broken stream is subscribed to again. It returns false if
+// the context was canceled in the meantime.
+func waitToResubscribe(ctx context.Context) bool {
+	select {
+	case <-time.After(resubscribeDelay):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
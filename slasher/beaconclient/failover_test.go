@@ -0,0 +1,60 @@
+package beaconclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"google.golang.org/grpc/resolver"
+)
+
+func TestDialTarget_SingleProvider(t *testing.T) {
+	if got := dialTarget([]string{"localhost:4000"}); got != "localhost:4000" {
+		t.Errorf("Wanted single provider dialed directly, received target %s", got)
+	}
+}
+
+func TestDialTarget_MultipleProviders(t *testing.T) {
+	target := dialTarget([]string{"localhost:4000", "localhost:4001"})
+	if !strings.HasPrefix(target, beaconNodesScheme) {
+		t.Fatalf("Wanted target with scheme %s, received %s", beaconNodesScheme, target)
+	}
+	if resolver.Get(strings.Split(target, ":")[0]) == nil {
+		t.Error("Expected resolver of the beacon nodes to be registered")
+	}
+}
+
+func TestService_ReceiveBlocks_Resubscribes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	defer func(d time.Duration) { resubscribeDelay = d }(resubscribeDelay)
+	resubscribeDelay = time.Millisecond
+
+	bs := Service{
+		beaconClient: client,
+		blockFeed:    new(event.Feed),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	brokenStream := mock.NewMockBeaconChain_StreamBlocksClient(ctrl)
+	brokenStream.EXPECT().Recv().Return(nil, errors.New("connection reset"))
+	stream := mock.NewMockBeaconChain_StreamBlocksClient(ctrl)
+	stream.EXPECT().Recv().Return(
+		&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}},
+		nil,
+	).Do(func() {
+		cancel()
+	})
+	gomock.InOrder(
+		client.EXPECT().StreamBlocks(gomock.Any(), &ptypes.Empty{}).Return(brokenStream, nil),
+		client.EXPECT().StreamBlocks(gomock.Any(), &ptypes.Empty{}).Return(stream, nil),
+	)
+	bs.receiveBlocks(ctx)
+}
@@ -0,0 +1,73 @@
+package beaconclient
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// genesisRoot identifies the network of a beacon node by the root of its genesis block. The
+// beacon node API doesn't expose a genesis validators root, the genesis block commits to the
+// genesis state root and so to the genesis validator registry, which differs between networks
+// even when they share a genesis time and deposit contract.
+func (bs *Service) genesisRoot(ctx context.Context) ([]byte, error) {
+	res, err := bs.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Genesis{Genesis: true},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve genesis block")
+	}
+	if len(res.BlockContainers) != 1 || len(res.BlockContainers[0].BlockRoot) != 32 {
+		return nil, errors.New("beacon node returned no genesis block root")
+	}
+	return res.BlockContainers[0].BlockRoot, nil
+}
+
+// updateGenesisNamespace switches the slasher DB to the namespace of the network the beacon
+// node is currently following, so data of a network the beacon node was reset from is never
+// used for detection.
+func (bs *Service) updateGenesisNamespace(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.updateGenesisNamespace")
+	defer span.End()
+	root, err := bs.genesisRoot(ctx)
+	if err != nil {
+		return err
+	}
+	previous := bs.slasherDB.GenesisRoot()
+	if bytes.Equal(previous, root) {
+		return nil
+	}
+	if previous != nil {
+		genesisChanges.Inc()
+		log.WithFields(logrus.Fields{
+			"previousGenesisRoot": bytesutil.Trunc(previous),
+			"genesisRoot":         bytesutil.Trunc(root),
+		}).Warn("Beacon node genesis changed, switching to the database of the new network")
+	}
+	return bs.slasherDB.UseGenesisNamespace(ctx, root)
+}
+
+// watchGenesis checks the genesis of the beacon node every epoch, to catch the beacon node
+// being reset to a new network while the slasher is running.
+func (bs *Service) watchGenesis(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := bs.updateGenesisNamespace(ctx); err != nil {
+				log.WithError(err).Error("Could not update genesis namespace")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package beaconclient
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+)
+
+func TestService_UpdateGenesisNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    db,
+	}
+	root1 := [32]byte{'A'}
+	root2 := [32]byte{'B'}
+	network1 := &ethpb.ListBlocksResponse{BlockContainers: []*ethpb.BeaconBlockContainer{{BlockRoot: root1[:]}}}
+	network2 := &ethpb.ListBlocksResponse{BlockContainers: []*ethpb.BeaconBlockContainer{{BlockRoot: root2[:]}}}
+
+	client.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(network1, nil)
+	if err := bs.updateGenesisNamespace(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(db.GenesisRoot(), root1[:]) {
+		t.Fatalf("Wanted genesis root %#x, received %#x", root1, db.GenesisRoot())
+	}
+	if err := db.SaveChainHead(ctx, &ethpb.ChainHead{HeadEpoch: 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The beacon node was reset to a new network.
+	client.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(network2, nil)
+	if err := bs.updateGenesisNamespace(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(db.GenesisRoot(), root2[:]) {
+		t.Fatalf("Wanted genesis root %#x, received %#x", root2, db.GenesisRoot())
+	}
+	head, err := db.ChainHead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != nil {
+		t.Errorf("Expected no chain head in the new network namespace, received %v", head)
+	}
+
+	// Switching back finds the data of the first network.
+	client.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(network1, nil)
+	if err := bs.updateGenesisNamespace(ctx); err != nil {
+		t.Fatal(err)
+	}
+	head, err = db.ChainHead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head == nil || head.HeadEpoch != 10 {
+		t.Errorf("Expected chain head of the first network, received %v", head)
+	}
+}
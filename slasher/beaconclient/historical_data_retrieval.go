@@ -47,3 +47,35 @@ func (bs *Service) RequestHistoricalAttestations(
 	}
 	return indexedAtts, nil
 }
+
+// RequestHistoricalBlocks requests all blocks for a given epoch
+// from a beacon node via gRPC.
+func (bs *Service) RequestHistoricalBlocks(
+	ctx context.Context,
+	epoch uint64,
+) ([]*ethpb.SignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.RequestHistoricalBlocks")
+	defer span.End()
+	blocks := make([]*ethpb.SignedBeaconBlock, 0)
+	res := &ethpb.ListBlocksResponse{}
+	var err error
+	for {
+		res, err = bs.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+			QueryFilter: &ethpb.ListBlocksRequest_Epoch{
+				Epoch: epoch,
+			},
+			PageSize:  int32(params.BeaconConfig().DefaultPageSize),
+			PageToken: res.NextPageToken,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not request blocks for epoch: %d", epoch)
+		}
+		for _, container := range res.BlockContainers {
+			blocks = append(blocks, container.Block)
+		}
+		if res.NextPageToken == "" || res.TotalSize == 0 || len(blocks) >= int(res.TotalSize) {
+			break
+		}
+	}
+	return blocks, nil
+}
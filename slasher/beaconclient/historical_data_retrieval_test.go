@@ -87,3 +87,42 @@ func TestService_RequestHistoricalAttestations(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Retrieved 500/1000 indexed attestations for epoch 0")
 	testutil.AssertLogsContain(t, hook, "Retrieved 1000/1000 indexed attestations for epoch 0")
 }
+
+func TestService_RequestHistoricalBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+
+	bs := Service{
+		beaconClient: client,
+	}
+
+	wanted := []*ethpb.SignedBeaconBlock{
+		{Block: &ethpb.BeaconBlock{Slot: 8}},
+		{Block: &ethpb.BeaconBlock{Slot: 9}},
+	}
+	client.EXPECT().ListBlocks(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{{Block: wanted[0]}},
+		NextPageToken:   "1",
+		TotalSize:       2,
+	}, nil)
+	client.EXPECT().ListBlocks(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{{Block: wanted[1]}},
+		NextPageToken:   "",
+		TotalSize:       2,
+	}, nil)
+
+	res, err := bs.RequestHistoricalBlocks(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
@@ -10,4 +10,36 @@ var (
 		Name: "slasher_attestations_received_total",
 		Help: "The # of attestations received by slasher",
 	})
+	slashingsSubmitted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_slashings_submitted_total",
+		Help: "The # of active slashings submitted again to the beacon node",
+	})
+	slashingsIncluded = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_slashings_included_total",
+		Help: "The # of detected slashings seen included in a block",
+	})
+	slashingsReconciled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_slashings_reconciled_total",
+		Help: "The # of active slashings marked included as their validators were already slashed on chain",
+	})
+	genesisChanges = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_genesis_changes_total",
+		Help: "The # of genesis changes of the beacon node observed by slasher",
+	})
+	beaconNodeConnectionFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_beacon_node_connection_failures_total",
+		Help: "The # of times slasher lost its connection to the beacon node it was connected to",
+	})
+	attestationsIndexed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_indexed_total",
+		Help: "The # of unindexed attestations whose attesting indices were resolved from their beacon committee",
+	})
+	attestationsIndexingFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_indexing_failed_total",
+		Help: "The # of unindexed attestations dropped because their attesting indices could not be resolved",
+	})
+	attestationsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_attestations_pending",
+		Help: "The # of received attestations waiting to be saved and sent to detection",
+	})
 )
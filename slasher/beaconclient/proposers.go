@@ -0,0 +1,113 @@
+package beaconclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Blocks received from the beacon node are mostly of the current epoch, the proposers of a
+// few epochs are kept around so late blocks don't trigger requests.
+const proposersCacheSize = 4
+
+// assignmentsPageSize is the number of validator assignments requested at once from the beacon
+// node, it is below the default maximum page size of the beacon node RPC.
+const assignmentsPageSize = 250
+
+// ProposerIndex returns the index of the validator assigned to propose the block at the input
+// slot. The proposers of an epoch are requested from the beacon node once and cached.
+func (bs *Service) ProposerIndex(ctx context.Context, slot uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.ProposerIndex")
+	defer span.End()
+	proposers, err := bs.proposersForEpoch(ctx, slot/params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		return 0, err
+	}
+	idx, ok := proposers[slot]
+	if !ok {
+		return 0, errors.Errorf("no proposer assigned to slot %d", slot)
+	}
+	return idx, nil
+}
+
+func (bs *Service) proposersForEpoch(ctx context.Context, epoch uint64) (map[uint64]uint64, error) {
+	bs.proposersLock.RLock()
+	proposers, ok := bs.proposersCache[epoch]
+	bs.proposersLock.RUnlock()
+	if ok {
+		return proposers, nil
+	}
+
+	// Assignments identify validators by public key, which are resolved to indices afterwards.
+	slotKeys := make(map[uint64][]byte, params.BeaconConfig().SlotsPerEpoch)
+	pageToken := ""
+	received := 0
+	for {
+		res, err := bs.beaconClient.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{
+			QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+			PageSize:    assignmentsPageSize,
+			PageToken:   pageToken,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not request validator assignments for epoch %d", epoch)
+		}
+		for _, assignment := range res.Assignments {
+			// The genesis slot has no proposer, a zero proposer slot means no proposal assigned.
+			if assignment.ProposerSlot != 0 {
+				slotKeys[assignment.ProposerSlot] = assignment.PublicKey
+			}
+		}
+		received += len(res.Assignments)
+		// The beacon node hands a next page token on a last page which is full.
+		if res.NextPageToken == "" || len(res.Assignments) == 0 || received >= int(res.TotalSize) {
+			break
+		}
+		pageToken = res.NextPageToken
+	}
+
+	pubKeys := make([][]byte, 0, len(slotKeys))
+	for _, key := range slotKeys {
+		pubKeys = append(pubKeys, key)
+	}
+	indices := make(map[string]uint64, len(pubKeys))
+	if len(pubKeys) > 0 {
+		res, err := bs.beaconClient.ListValidators(ctx, &ethpb.ListValidatorsRequest{
+			PublicKeys: pubKeys,
+			PageSize:   assignmentsPageSize,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not request proposer validators")
+		}
+		for _, v := range res.ValidatorList {
+			if v.Validator != nil {
+				indices[string(v.Validator.PublicKey)] = v.Index
+			}
+		}
+	}
+	proposers = make(map[uint64]uint64, len(slotKeys))
+	for slot, key := range slotKeys {
+		idx, ok := indices[string(key)]
+		if !ok {
+			return nil, errors.Errorf("could not find the index of the proposer of slot %d", slot)
+		}
+		proposers[slot] = idx
+	}
+
+	bs.proposersLock.Lock()
+	defer bs.proposersLock.Unlock()
+	bs.proposersCache[epoch] = proposers
+	// The oldest epoch is evicted once the cache is full.
+	if len(bs.proposersCache) > proposersCacheSize {
+		oldest := epoch
+		for e := range bs.proposersCache {
+			if e < oldest {
+				oldest = e
+			}
+		}
+		delete(bs.proposersCache, oldest)
+	}
+	return proposers, nil
+}
@@ -0,0 +1,48 @@
+package beaconclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+)
+
+func TestService_ProposerIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	bs := &Service{
+		beaconClient:   client,
+		proposersCache: make(map[uint64]map[uint64]uint64),
+	}
+	// The proposers of the epoch are requested once for all of its blocks.
+	client.EXPECT().ListValidatorAssignments(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorAssignments{
+		Assignments: []*ethpb.ValidatorAssignments_CommitteeAssignment{
+			{PublicKey: []byte("a"), ProposerSlot: 5},
+			{PublicKey: []byte("b")},
+			{PublicKey: []byte("c"), ProposerSlot: 6},
+		},
+		TotalSize: 3,
+	}, nil).Times(1)
+	client.EXPECT().ListValidators(gomock.Any(), gomock.Any()).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 12, Validator: &ethpb.Validator{PublicKey: []byte("a")}},
+			{Index: 40, Validator: &ethpb.Validator{PublicKey: []byte("c")}},
+		},
+	}, nil).Times(1)
+
+	for slot, want := range map[uint64]uint64{5: 12, 6: 40} {
+		idx, err := bs.ProposerIndex(context.Background(), slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if idx != want {
+			t.Errorf("Wanted proposer %d at slot %d, received %d", want, slot, idx)
+		}
+	}
+	if _, err := bs.ProposerIndex(context.Background(), 7); err == nil {
+		t.Error("Wanted an error for a slot without proposer")
+	}
+}
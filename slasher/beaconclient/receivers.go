@@ -2,11 +2,12 @@ package beaconclient
 
 import (
 	"context"
-	"io"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -14,27 +15,38 @@ import (
 // receiveBlocks starts a gRPC client stream listener to obtain
 // blocks from the beacon node. Upon receiving a block, the service
 // broadcasts it to a feed for other services in slasher to subscribe to.
+// The stream is subscribed to again when it breaks, such as when the
+// connection fails over to another beacon node.
 func (bs *Service) receiveBlocks(ctx context.Context) {
 	ctx, span := trace.StartSpan(ctx, "beaconclient.receiveBlocks")
 	defer span.End()
-	stream, err := bs.beaconClient.StreamBlocks(ctx, &ptypes.Empty{})
-	if err != nil {
-		log.WithError(err).Error("Failed to retrieve blocks stream")
-		return
-	}
 	for {
-		res, err := stream.Recv()
-		// If the stream is closed, we stop the loop.
-		if err == io.EOF {
-			break
+		stream, err := bs.beaconClient.StreamBlocks(ctx, &ptypes.Empty{})
+		if err != nil {
+			log.WithError(err).Error("Failed to retrieve blocks stream")
+		} else if err := bs.receiveBlocksFromStream(ctx, stream); err != nil {
+			log.WithError(err).Warn("Blocks stream from beacon node broke, subscribing again")
 		}
 		// If context is canceled we stop the loop.
 		if ctx.Err() == context.Canceled {
 			log.WithError(ctx.Err()).Error("Context canceled - shutting down blocks receiver")
 			return
 		}
+		if !waitToResubscribe(ctx) {
+			return
+		}
+	}
+}
+
+// receiveBlocksFromStream receives blocks until the stream breaks or the context is canceled.
+func (bs *Service) receiveBlocksFromStream(ctx context.Context, stream ethpb.BeaconChain_StreamBlocksClient) error {
+	for {
+		res, err := stream.Recv()
+		if ctx.Err() == context.Canceled {
+			return nil
+		}
 		if err != nil {
-			log.WithError(err).Error("Could not receive block from beacon node")
+			return err
 		}
 		if res == nil {
 			continue
@@ -42,36 +54,52 @@ func (bs *Service) receiveBlocks(ctx context.Context) {
 		log.WithField("slot", res.Block.Slot).Info("Received block from beacon node")
 		// We send the received block over the block feed.
 		bs.blockFeed.Send(res)
+		if err := bs.markIncludedSlashings(ctx, res); err != nil {
+			log.WithError(err).Error("Could not mark slashings included in block")
+		}
 	}
 }
 
 // receiveAttestations starts a gRPC client stream listener to obtain
 // attestations from the beacon node. Upon receiving an attestation, the service
 // broadcasts it to a feed for other services in slasher to subscribe to.
+// The stream is subscribed to again when it breaks, such as when the
+// connection fails over to another beacon node.
 func (bs *Service) receiveAttestations(ctx context.Context) {
 	ctx, span := trace.StartSpan(ctx, "beaconclient.receiveAttestations")
 	defer span.End()
-	stream, err := bs.beaconClient.StreamIndexedAttestations(ctx, &ptypes.Empty{})
-	if err != nil {
-		log.WithError(err).Error("Failed to retrieve attestations stream")
-		return
-	}
-
 	go bs.collectReceivedAttestations(ctx)
 	for {
-		res, err := stream.Recv()
-		// If the stream is closed, we stop the loop.
-		if err == io.EOF {
-			break
+		stream, err := bs.beaconClient.StreamIndexedAttestations(ctx, &ptypes.Empty{})
+		if err != nil {
+			log.WithError(err).Error("Failed to retrieve attestations stream")
+		} else if err := bs.receiveAttestationsFromStream(ctx, stream); err != nil {
+			log.WithError(err).Warn("Attestations stream from beacon node broke, subscribing again")
 		}
 		// If context is canceled we stop the loop.
 		if ctx.Err() == context.Canceled {
 			log.WithError(ctx.Err()).Error("Context canceled - shutting down attestations receiver")
 			return
 		}
+		if !waitToResubscribe(ctx) {
+			return
+		}
+	}
+}
+
+// receiveAttestationsFromStream receives attestations until the stream breaks or the context
+// is canceled.
+func (bs *Service) receiveAttestationsFromStream(
+	ctx context.Context,
+	stream ethpb.BeaconChain_StreamIndexedAttestationsClient,
+) error {
+	for {
+		res, err := stream.Recv()
+		if ctx.Err() == context.Canceled {
+			return nil
+		}
 		if err != nil {
-			log.WithError(err).Error("Could not receive attestations from beacon node")
-			continue
+			return err
 		}
 		if res == nil {
 			continue
@@ -80,6 +108,59 @@ func (bs *Service) receiveAttestations(ctx context.Context) {
 	}
 }
 
+// receiveUnindexedAttestations starts a gRPC client stream listener to obtain the unindexed
+// attestations of the beacon node, for beacon nodes which can't stream indexed attestations.
+// The attesting indices of each attestation are resolved from its beacon committee before it is
+// collected with the indexed attestations, attestations which can't be indexed are dropped.
+func (bs *Service) receiveUnindexedAttestations(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.receiveUnindexedAttestations")
+	defer span.End()
+	for {
+		stream, err := bs.beaconClient.StreamAttestations(ctx, &ptypes.Empty{})
+		if err != nil {
+			log.WithError(err).Error("Failed to retrieve unindexed attestations stream")
+		} else if err := bs.receiveUnindexedAttestationsFromStream(ctx, stream); err != nil {
+			log.WithError(err).Warn("Unindexed attestations stream from beacon node broke, subscribing again")
+		}
+		// If context is canceled we stop the loop.
+		if ctx.Err() == context.Canceled {
+			log.WithError(ctx.Err()).Error("Context canceled - shutting down unindexed attestations receiver")
+			return
+		}
+		if !waitToResubscribe(ctx) {
+			return
+		}
+	}
+}
+
+// receiveUnindexedAttestationsFromStream receives and indexes attestations until the stream
+// breaks or the context is canceled.
+func (bs *Service) receiveUnindexedAttestationsFromStream(
+	ctx context.Context,
+	stream ethpb.BeaconChain_StreamAttestationsClient,
+) error {
+	for {
+		res, err := stream.Recv()
+		if ctx.Err() == context.Canceled {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			continue
+		}
+		indexedAtt, err := bs.IndexAttestation(ctx, res)
+		if err != nil {
+			log.WithError(err).Debug("Could not index attestation, dropping it")
+			attestationsIndexingFailed.Inc()
+			continue
+		}
+		attestationsIndexed.Inc()
+		bs.receivedAttestationsBuffer <- indexedAtt
+	}
+}
+
 func (bs *Service) collectReceivedAttestations(ctx context.Context) {
 	ctx, span := trace.StartSpan(ctx, "beaconclient.collectReceivedAttestations")
 	defer span.End()
@@ -92,10 +173,13 @@ func (bs *Service) collectReceivedAttestations(ctx context.Context) {
 			if len(atts) > 0 {
 				bs.collectedAttestationsBuffer <- atts
 				atts = []*ethpb.IndexedAttestation{}
+				attestationsPending.Set(0)
 			}
 		case att := <-bs.receivedAttestationsBuffer:
 			atts = append(atts, att)
+			attestationsPending.Set(float64(len(atts)))
 		case collectedAtts := <-bs.collectedAttestationsBuffer:
+			collectedAtts = dedupAttestations(collectedAtts)
 			if err := bs.slasherDB.SaveIndexedAttestations(ctx, collectedAtts); err != nil {
 				log.WithError(err).Error("Could not save indexed attestation")
 				continue
@@ -105,6 +189,11 @@ func (bs *Service) collectReceivedAttestations(ctx context.Context) {
 				"slot":        collectedAtts[0].Data.Slot,
 			}).Info("Attestations saved to slasher DB")
 			slasherNumAttestationsReceived.Add(float64(len(collectedAtts)))
+			// Attestations are queued until detection has processed them, so the ones left
+			// unprocessed by a crash are replayed on restart.
+			if err := bs.slasherDB.SavePendingAttestations(ctx, collectedAtts); err != nil {
+				log.WithError(err).Error("Could not queue attestations for detection")
+			}
 
 			// After saving, we send the received attestation over the attestation feed.
 			for _, att := range collectedAtts {
@@ -119,3 +208,23 @@ func (bs *Service) collectReceivedAttestations(ctx context.Context) {
 		}
 	}
 }
+
+// This removes the duplicates from a batch of collected attestations, keeping the first
+// occurrence. The same attestation is received again when the attestations stream is
+// subscribed to again, such as after failing over to another beacon node. The batch is
+// returned as is if an attestation can't be encoded.
+func dedupAttestations(atts []*ethpb.IndexedAttestation) []*ethpb.IndexedAttestation {
+	msgs := make([]proto.Message, len(atts))
+	for i, att := range atts {
+		msgs[i] = att
+	}
+	unique, err := hashutil.UniqueProtoIndices(msgs)
+	if err != nil {
+		return atts
+	}
+	deduped := make([]*ethpb.IndexedAttestation, len(unique))
+	for i, j := range unique {
+		deduped[i] = atts[j]
+	}
+	return deduped
+}
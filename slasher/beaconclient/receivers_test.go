@@ -118,3 +118,29 @@ func TestService_ReceiveAttestations_Batched(t *testing.T) {
 		t.Fatalf("Expected %d received attestations to be batched", len(atts))
 	}
 }
+
+func TestDedupAttestations(t *testing.T) {
+	att := func(slot uint64, indices ...uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				Slot:   slot,
+				Target: &ethpb.Checkpoint{Epoch: 1},
+			},
+			Signature: []byte{1, 2},
+		}
+	}
+	atts := []*ethpb.IndexedAttestation{att(5, 1, 2), att(6, 1, 2), att(5, 1, 2), att(5, 3), att(6, 1, 2)}
+	deduped := dedupAttestations(atts)
+	if len(deduped) != 3 {
+		t.Fatalf("Expected 3 attestations, received %d", len(deduped))
+	}
+	for i, want := range []*ethpb.IndexedAttestation{atts[0], atts[1], atts[3]} {
+		if deduped[i] != want {
+			t.Errorf("Attestation %d: expected first occurrence %v, received %v", i, want, deduped[i])
+		}
+	}
+	if len(dedupAttestations(nil)) != 0 {
+		t.Error("Expected no attestations")
+	}
+}
@@ -0,0 +1,89 @@
+package beaconclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+)
+
+// reconcileActiveSlashings marks the stored active slashings whose validators are all already
+// slashed on chain as included. Such slashings were included in a block slasher didn't see, or
+// are made redundant by another slashing of the same validators, so they must neither be
+// submitted again nor be counted as active.
+func (bs *Service) reconcileActiveSlashings(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.reconcileActiveSlashings")
+	defer span.End()
+	proposerSlashings, err := bs.slasherDB.ProposalSlashingsByStatus(ctx, status.Active)
+	if err != nil {
+		return errors.Wrap(err, "could not get active proposer slashings")
+	}
+	attesterSlashings, err := bs.slasherDB.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		return errors.Wrap(err, "could not get active attester slashings")
+	}
+	if len(proposerSlashings) == 0 && len(attesterSlashings) == 0 {
+		return nil
+	}
+
+	var indices []uint64
+	for _, slashing := range proposerSlashings {
+		indices = append(indices, slashing.ProposerIndex)
+	}
+	for _, slashing := range attesterSlashings {
+		indices = append(indices, attesterSlashingIndices(slashing)...)
+	}
+	slashed, err := bs.SlashedValidators(ctx, sliceutil.SetUint64(indices))
+	if err != nil {
+		return errors.Wrap(err, "could not check whether validators are slashed on chain")
+	}
+
+	reconciled := 0
+	for _, slashing := range proposerSlashings {
+		if !slashed[slashing.ProposerIndex] {
+			continue
+		}
+		if err := bs.slasherDB.SaveProposerSlashing(ctx, status.Included, slashing); err != nil {
+			return err
+		}
+		reconciled++
+	}
+	for _, slashing := range attesterSlashings {
+		if !allValidatorsSlashed(attesterSlashingIndices(slashing), slashed) {
+			continue
+		}
+		if err := bs.slasherDB.SaveAttesterSlashing(ctx, status.Included, slashing); err != nil {
+			return err
+		}
+		reconciled++
+	}
+	if reconciled > 0 {
+		slashingsReconciled.Add(float64(reconciled))
+		log.WithField("slashings", reconciled).Info("Marked active slashings of validators already slashed on chain as included")
+	}
+	return nil
+}
+
+// attesterSlashingIndices returns the validators slashed by the attester slashing, the
+// validators attesting in both of its attestations.
+func attesterSlashingIndices(slashing *ethpb.AttesterSlashing) []uint64 {
+	if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		return nil
+	}
+	return sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+}
+
+func allValidatorsSlashed(indices []uint64, slashed map[uint64]bool) bool {
+	if len(indices) == 0 {
+		return false
+	}
+	for _, idx := range indices {
+		if !slashed[idx] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package beaconclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+func TestService_ReconcileActiveSlashings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    db,
+	}
+	header := func(sig byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{Slot: 5},
+			Signature: []byte{sig},
+		}
+	}
+	attesterSlashing := func(indices []uint64) *ethpb.AttesterSlashing {
+		return &ethpb.AttesterSlashing{
+			Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: indices, Signature: []byte{1}},
+			Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: indices, Signature: []byte{2}},
+		}
+	}
+	slashedProposer := &ethpb.ProposerSlashing{ProposerIndex: 5, Header_1: header(1), Header_2: header(2)}
+	activeProposer := &ethpb.ProposerSlashing{ProposerIndex: 6, Header_1: header(1), Header_2: header(2)}
+	slashedAttesters := attesterSlashing([]uint64{1})
+	partlySlashedAttesters := attesterSlashing([]uint64{2, 3})
+	if err := db.SaveProposerSlashings(ctx, status.Active, []*ethpb.ProposerSlashing{slashedProposer, activeProposer}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashings(ctx, status.Active, []*ethpb.AttesterSlashing{slashedAttesters, partlySlashedAttesters}); err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().ListValidators(gomock.Any(), gomock.Any()).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 1, Validator: &ethpb.Validator{Slashed: true}},
+			{Index: 2, Validator: &ethpb.Validator{Slashed: true}},
+			{Index: 3, Validator: &ethpb.Validator{}},
+			{Index: 5, Validator: &ethpb.Validator{Slashed: true}},
+			{Index: 6, Validator: &ethpb.Validator{}},
+		},
+	}, nil)
+	if err := bs.reconcileActiveSlashings(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		slashing *ethpb.ProposerSlashing
+		want     status.SlashingStatus
+	}{
+		{slashing: slashedProposer, want: status.Included},
+		{slashing: activeProposer, want: status.Active},
+	} {
+		_, st, err := db.HasProposerSlashing(ctx, tt.slashing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st != tt.want {
+			t.Errorf("Wanted proposer slashing of validator %d to be %s, received %s", tt.slashing.ProposerIndex, tt.want, st)
+		}
+	}
+	for _, tt := range []struct {
+		slashing *ethpb.AttesterSlashing
+		want     status.SlashingStatus
+	}{
+		{slashing: slashedAttesters, want: status.Included},
+		{slashing: partlySlashedAttesters, want: status.Active},
+	} {
+		_, st, err := db.HasAttesterSlashing(ctx, tt.slashing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st != tt.want {
+			t.Errorf("Wanted attester slashing of validators %v to be %s, received %s", tt.slashing.Attestation_1.AttestingIndices, tt.want, st)
+		}
+	}
+}
+
+func TestService_ReconcileActiveSlashings_NoActiveSlashings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    db,
+	}
+	// The beacon node is not queried without active slashings.
+	if err := bs.reconcileActiveSlashings(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,6 +8,7 @@ package beaconclient
 import (
 	"context"
 	"errors"
+	"sync"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -15,6 +16,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -37,13 +39,38 @@ type ChainFetcher interface {
 	ChainHead(ctx context.Context) (*ethpb.ChainHead, error)
 }
 
+// HistoricalFetcher defines a struct which can retrieve the blocks
+// and indexed attestations of past epochs from a beacon node.
+type HistoricalFetcher interface {
+	RequestHistoricalBlocks(ctx context.Context, epoch uint64) ([]*ethpb.SignedBeaconBlock, error)
+	RequestHistoricalAttestations(ctx context.Context, epoch uint64) ([]*ethpb.IndexedAttestation, error)
+}
+
+// CommitteeFetcher defines a struct which can retrieve the size
+// of beacon committees from a beacon node.
+type CommitteeFetcher interface {
+	CommitteeSize(ctx context.Context, slot uint64, committeeIndex uint64) (uint64, error)
+}
+
+// ProposerFetcher defines a struct which can retrieve the
+// proposer assigned to a slot from a beacon node.
+type ProposerFetcher interface {
+	ProposerIndex(ctx context.Context, slot uint64) (uint64, error)
+}
+
+// SlashedValidatorsFetcher defines a struct which can retrieve whether
+// validators are already slashed on chain from a beacon node.
+type SlashedValidatorsFetcher interface {
+	SlashedValidators(ctx context.Context, indices []uint64) (map[uint64]bool, error)
+}
+
 // Service struct for the beaconclient service of the slasher.
 type Service struct {
 	ctx                         context.Context
 	cancel                      context.CancelFunc
 	cert                        string
 	conn                        *grpc.ClientConn
-	provider                    string
+	providers                   []string
 	beaconClient                ethpb.BeaconChainClient
 	slasherDB                   db.Database
 	nodeClient                  ethpb.NodeClient
@@ -56,15 +83,29 @@ type Service struct {
 	proposerSlashingsFeed       *event.Feed
 	receivedAttestationsBuffer  chan *ethpb.IndexedAttestation
 	collectedAttestationsBuffer chan []*ethpb.IndexedAttestation
+	committeesCache             map[uint64]*ethpb.BeaconCommittees
+	committeesLock              sync.RWMutex
+	proposersCache              map[uint64]map[uint64]uint64
+	proposersLock               sync.RWMutex
+	notifications               *notifications.Service
+	streamIndexedAttestations   bool
 }
 
 // Config options for the beaconclient service.
 type Config struct {
-	BeaconProvider        string
+	// BeaconProviders lists the beacon node endpoints in order of preference, the slasher
+	// fails over to the next one when the endpoint it is connected to becomes unreachable.
+	BeaconProviders       []string
 	BeaconCert            string
 	SlasherDB             db.Database
 	ProposerSlashingsFeed *event.Feed
 	AttesterSlashingsFeed *event.Feed
+	// Notifications is notified of the submitted and included slashings, nil disables
+	// notifications.
+	Notifications *notifications.Service
+	// StreamIndexedAttestations receives the indexed attestations stream of the beacon node instead
+	// of the unindexed one, whose attesting indices are resolved from the beacon committees.
+	StreamIndexedAttestations bool
 }
 
 // NewBeaconClientService instantiation.
@@ -74,7 +115,7 @@ func NewBeaconClientService(ctx context.Context, cfg *Config) *Service {
 		cert:                        cfg.BeaconCert,
 		ctx:                         ctx,
 		cancel:                      cancel,
-		provider:                    cfg.BeaconProvider,
+		providers:                   cfg.BeaconProviders,
 		blockFeed:                   new(event.Feed),
 		clientFeed:                  new(event.Feed),
 		attestationFeed:             new(event.Feed),
@@ -85,6 +126,10 @@ func NewBeaconClientService(ctx context.Context, cfg *Config) *Service {
 		proposerSlashingsFeed:       cfg.ProposerSlashingsFeed,
 		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
 		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
+		committeesCache:             make(map[uint64]*ethpb.BeaconCommittees),
+		proposersCache:              make(map[uint64]map[uint64]uint64),
+		notifications:               cfg.Notifications,
+		streamIndexedAttestations:   cfg.StreamIndexedAttestations,
 	}
 }
 
@@ -155,18 +200,26 @@ func (bs *Service) Start() {
 			grpc_prometheus.UnaryClientInterceptor,
 		)),
 	}
-	conn, err := grpc.DialContext(bs.ctx, bs.provider, beaconOpts...)
+	conn, err := grpc.DialContext(bs.ctx, dialTarget(bs.providers), beaconOpts...)
 	if err != nil {
-		log.Fatalf("Could not dial endpoint: %s, %v", bs.provider, err)
+		log.Fatalf("Could not dial endpoints: %v, %v", bs.providers, err)
 	}
-	log.Info("Successfully started gRPC connection")
+	log.WithField("providers", bs.providers).Info("Successfully started gRPC connection")
 	bs.conn = conn
+	go bs.watchConnectionState(bs.ctx, conn)
 	bs.beaconClient = ethpb.NewBeaconChainClient(bs.conn)
 	bs.nodeClient = ethpb.NewNodeClient(bs.conn)
 
 	// We poll for the sync status of the beacon node until it is fully synced.
 	bs.querySyncStatus(bs.ctx)
 
+	// Data is stored per network, so a beacon node reset to a new genesis doesn't mix the
+	// data of both networks.
+	if err := bs.updateGenesisNamespace(bs.ctx); err != nil {
+		log.Fatalf("Could not set genesis namespace of the slasher DB: %v", err)
+	}
+	go bs.watchGenesis(bs.ctx)
+
 	// We notify other services in slasher that the beacon client is ready
 	// and the connection is active.
 	bs.clientFeed.Send(true)
@@ -176,8 +229,15 @@ func (bs *Service) Start() {
 	// as they are found.
 	go bs.subscribeDetectedProposerSlashings(bs.ctx, bs.proposerSlashingsChan)
 	go bs.subscribeDetectedAttesterSlashings(bs.ctx, bs.attesterSlashingsChan)
+	// Slashings not yet included in a block are submitted again until they are.
+	go bs.submitActiveSlashings(bs.ctx)
 
 	// We listen to a stream of blocks and attestations from the beacon node.
 	go bs.receiveBlocks(bs.ctx)
-	go bs.receiveAttestations(bs.ctx)
+	if bs.streamIndexedAttestations {
+		go bs.receiveAttestations(bs.ctx)
+	} else {
+		go bs.collectReceivedAttestations(bs.ctx)
+		go bs.receiveUnindexedAttestations(bs.ctx)
+	}
 }
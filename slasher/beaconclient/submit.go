@@ -1,12 +1,23 @@
 package beaconclient
 
 import (
+	"bytes"
 	"context"
+	"time"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
 	"go.opencensus.io/trace"
 )
 
+// activeSlashingsSubmissionPeriod is how often the slashings not yet included in a block are
+// submitted to the beacon node again.
+var activeSlashingsSubmissionPeriod = time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+
 // subscribeDetectedProposerSlashings subscribes to an event feed for
 // slashing objects from the slasher runtime. Upon receiving
 // a proposer slashing from the feed, we submit the object to the
@@ -21,7 +32,9 @@ func (bs *Service) subscribeDetectedProposerSlashings(ctx context.Context, ch ch
 		case slashing := <-ch:
 			if _, err := bs.beaconClient.SubmitProposerSlashing(ctx, slashing); err != nil {
 				log.Error(err)
+				continue
 			}
+			bs.notifications.Notify(notifications.ProposerSlashingAlert(notifications.Submission, slashing))
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -46,7 +59,9 @@ func (bs *Service) subscribeDetectedAttesterSlashings(ctx context.Context, ch ch
 		case slashing := <-ch:
 			if _, err := bs.beaconClient.SubmitAttesterSlashing(ctx, slashing); err != nil {
 				log.Error(err)
+				continue
 			}
+			bs.notifications.Notify(notifications.AttesterSlashingAlert(notifications.Submission, slashing))
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -56,3 +71,132 @@ func (bs *Service) subscribeDetectedAttesterSlashings(ctx context.Context, ch ch
 		}
 	}
 }
+
+// submitActiveSlashings periodically submits the slashings stored as active to the beacon
+// node, until they are seen included in a block. This covers slashings whose submission on
+// detection failed, and slashings detected while the beacon node was unreachable. Before every
+// submission, the active slashings are reconciled against the validators slashed on chain.
+func (bs *Service) submitActiveSlashings(ctx context.Context) {
+	ticker := time.NewTicker(activeSlashingsSubmissionPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// Slashings left active on a failed reconciliation are still submitted.
+			if err := bs.reconcileActiveSlashings(ctx); err != nil {
+				log.WithError(err).Error("Could not reconcile active slashings with the chain")
+			}
+			if err := bs.resubmitActiveSlashings(ctx); err != nil {
+				log.WithError(err).Error("Could not submit active slashings")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// resubmitActiveSlashings submits all the slashings stored as active to the beacon node.
+func (bs *Service) resubmitActiveSlashings(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.resubmitActiveSlashings")
+	defer span.End()
+	proposerSlashings, err := bs.slasherDB.ProposalSlashingsByStatus(ctx, status.Active)
+	if err != nil {
+		return errors.Wrap(err, "could not get active proposer slashings")
+	}
+	for _, slashing := range proposerSlashings {
+		if _, err := bs.beaconClient.SubmitProposerSlashing(ctx, slashing); err != nil {
+			log.WithError(err).Error("Could not submit proposer slashing")
+			continue
+		}
+		slashingsSubmitted.Inc()
+	}
+	attesterSlashings, err := bs.slasherDB.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		return errors.Wrap(err, "could not get active attester slashings")
+	}
+	for _, slashing := range attesterSlashings {
+		if _, err := bs.beaconClient.SubmitAttesterSlashing(ctx, slashing); err != nil {
+			log.WithError(err).Error("Could not submit attester slashing")
+			continue
+		}
+		slashingsSubmitted.Inc()
+	}
+	return nil
+}
+
+// markIncludedSlashings marks the stored slashings included in the block as included, so they
+// are no longer submitted. The beacon node may include a slashing with its headers or
+// attestations in either order, so both orders are looked up. Slashings of a block which isn't
+// the head of the canonical chain are left active, as the block may be orphaned; they are marked
+// included by reconcileActiveSlashings once their validators are slashed on chain.
+func (bs *Service) markIncludedSlashings(ctx context.Context, blk *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.markIncludedSlashings")
+	defer span.End()
+	if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+		return nil
+	}
+	if len(blk.Block.Body.ProposerSlashings) == 0 && len(blk.Block.Body.AttesterSlashings) == 0 {
+		return nil
+	}
+	canonical, err := bs.isCanonicalHead(ctx, blk.Block)
+	if err != nil {
+		return err
+	}
+	if !canonical {
+		log.WithField("slot", blk.Block.Slot).Debug("Block is not the canonical head, not marking its slashings as included")
+		return nil
+	}
+	for _, slashing := range blk.Block.Body.ProposerSlashings {
+		for _, s := range []*ethpb.ProposerSlashing{
+			slashing,
+			{ProposerIndex: slashing.ProposerIndex, Header_1: slashing.Header_2, Header_2: slashing.Header_1},
+		} {
+			found, st, err := bs.slasherDB.HasProposerSlashing(ctx, s)
+			if err != nil {
+				return err
+			}
+			if !found || st == status.Included {
+				continue
+			}
+			if err := bs.slasherDB.SaveProposerSlashing(ctx, status.Included, s); err != nil {
+				return err
+			}
+			slashingsIncluded.Inc()
+			bs.notifications.Notify(notifications.ProposerSlashingAlert(notifications.Inclusion, s))
+		}
+	}
+	for _, slashing := range blk.Block.Body.AttesterSlashings {
+		for _, s := range []*ethpb.AttesterSlashing{
+			slashing,
+			{Attestation_1: slashing.Attestation_2, Attestation_2: slashing.Attestation_1},
+		} {
+			found, st, err := bs.slasherDB.HasAttesterSlashing(ctx, s)
+			if err != nil {
+				return err
+			}
+			if !found || st == status.Included {
+				continue
+			}
+			if err := bs.slasherDB.SaveAttesterSlashing(ctx, status.Included, s); err != nil {
+				return err
+			}
+			slashingsIncluded.Inc()
+			bs.notifications.Notify(notifications.AttesterSlashingAlert(notifications.Inclusion, s))
+		}
+	}
+	return nil
+}
+
+// isCanonicalHead returns true if the block is the head of the canonical chain of the beacon node.
+func (bs *Service) isCanonicalHead(ctx context.Context, blk *ethpb.BeaconBlock) (bool, error) {
+	root, err := ssz.HashTreeRoot(blk)
+	if err != nil {
+		return false, errors.Wrap(err, "could not compute block root")
+	}
+	head, err := bs.ChainHead(ctx)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(root[:], head.HeadBlockRoot), nil
+}
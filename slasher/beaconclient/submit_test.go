@@ -6,9 +6,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -88,3 +91,95 @@ func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
 	exitRoutine <- true
 	testutil.AssertLogsContain(t, hook, "Context canceled")
 }
+
+func TestService_SubmitAndMarkIncludedSlashings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    db,
+	}
+	header := func(sig byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{Slot: 5},
+			Signature: []byte{sig},
+		}
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{ProposerIndex: 5, Header_1: header(1), Header_2: header(2)}
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}, Signature: []byte{1}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}, Signature: []byte{2}},
+	}
+	if err := db.SaveProposerSlashing(ctx, status.Active, proposerSlashing); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashing(ctx, status.Active, attesterSlashing); err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().SubmitProposerSlashing(gomock.Any(), gomock.Any()).Return(nil, nil)
+	client.EXPECT().SubmitAttesterSlashing(gomock.Any(), gomock.Any()).Return(nil, nil)
+	if err := bs.resubmitActiveSlashings(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The beacon node included the slashings with their headers and attestations swapped.
+	blk := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Body: &ethpb.BeaconBlockBody{
+				ProposerSlashings: []*ethpb.ProposerSlashing{
+					{ProposerIndex: 5, Header_1: header(2), Header_2: header(1)},
+				},
+				AttesterSlashings: []*ethpb.AttesterSlashing{
+					{Attestation_1: attesterSlashing.Attestation_2, Attestation_2: attesterSlashing.Attestation_1},
+				},
+			},
+		},
+	}
+	blockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A block which isn't the canonical head may be orphaned, its slashings stay active.
+	client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadBlockRoot: []byte("fork")}, nil)
+	if err := bs.markIncludedSlashings(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	_, st, err := db.HasProposerSlashing(ctx, proposerSlashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != status.Active {
+		t.Errorf("Wanted proposer slashing status %s, received %s", status.SlashingStatus(status.Active), st)
+	}
+
+	client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadBlockRoot: blockRoot[:]}, nil)
+	if err := bs.markIncludedSlashings(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	_, st, err = db.HasProposerSlashing(ctx, proposerSlashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != status.Included {
+		t.Errorf("Wanted proposer slashing status %s, received %s", status.SlashingStatus(status.Included), st)
+	}
+	_, st, err = db.HasAttesterSlashing(ctx, attesterSlashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != status.Included {
+		t.Errorf("Wanted attester slashing status %s, received %s", status.SlashingStatus(status.Included), st)
+	}
+
+	// Included slashings are no longer submitted.
+	if err := bs.resubmitActiveSlashings(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
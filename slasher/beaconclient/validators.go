@@ -0,0 +1,40 @@
+package beaconclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// slashedValidatorsPageSize is the number of validators requested at once from the beacon node,
+// it is below the default maximum page size of the beacon node RPC.
+const slashedValidatorsPageSize = 250
+
+// SlashedValidators returns which of the input validators are already slashed in the head
+// state of the beacon node.
+func (bs *Service) SlashedValidators(ctx context.Context, indices []uint64) (map[uint64]bool, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.SlashedValidators")
+	defer span.End()
+	slashed := make(map[uint64]bool, len(indices))
+	for start := 0; start < len(indices); start += slashedValidatorsPageSize {
+		end := start + slashedValidatorsPageSize
+		if end > len(indices) {
+			end = len(indices)
+		}
+		res, err := bs.beaconClient.ListValidators(ctx, &ethpb.ListValidatorsRequest{
+			Indices:  indices[start:end],
+			PageSize: slashedValidatorsPageSize,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not request validators")
+		}
+		for _, v := range res.ValidatorList {
+			if v.Validator != nil && v.Validator.Slashed {
+				slashed[v.Index] = true
+			}
+		}
+	}
+	return slashed, nil
+}
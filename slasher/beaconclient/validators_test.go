@@ -0,0 +1,37 @@
+package beaconclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+)
+
+func TestService_SlashedValidators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+
+	bs := Service{
+		beaconClient: client,
+	}
+	client.EXPECT().ListValidators(gomock.Any(), &ethpb.ListValidatorsRequest{
+		Indices:  []uint64{1, 2, 3},
+		PageSize: slashedValidatorsPageSize,
+	}).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 1, Validator: &ethpb.Validator{Slashed: true}},
+			{Index: 2, Validator: &ethpb.Validator{}},
+			{Index: 3, Validator: &ethpb.Validator{Slashed: true}},
+		},
+	}, nil)
+	slashed, err := bs.SlashedValidators(context.Background(), []uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashed) != 2 || !slashed[1] || !slashed[3] {
+		t.Errorf("Wanted validators 1 and 3 slashed, received %v", slashed)
+	}
+}
@@ -7,6 +7,10 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
 
+// spanMapEntrySize is the estimated number of bytes taken in memory by a validator entry of
+// a span map: 8 bytes of key, 8 bytes of span and the bookkeeping of the map.
+const spanMapEntrySize = 24
+
 var (
 	// epochSpansCacheSize defines the max number of epoch spans the cache can hold.
 	epochSpansCacheSize = 256
@@ -61,6 +65,60 @@ func (c *EpochSpansCache) Delete(epoch uint64) bool {
 	return c.cache.Remove(epoch)
 }
 
+// Peek returns the cached value for the requested epoch key, if any, without updating the
+// recentness of the key or the cache metrics.
+func (c *EpochSpansCache) Peek(epoch uint64) (map[uint64]types.Span, bool) {
+	item, exists := c.cache.Peek(epoch)
+	if exists && item != nil {
+		return item.(map[uint64]types.Span), true
+	}
+	return nil, false
+}
+
+// Keys returns the cached epochs, from the least to the most recently used.
+func (c *EpochSpansCache) Keys() []uint64 {
+	keys := c.cache.Keys()
+	epochs := make([]uint64, 0, len(keys))
+	for _, k := range keys {
+		if epoch, ok := k.(uint64); ok {
+			epochs = append(epochs, epoch)
+		}
+	}
+	return epochs
+}
+
+// Len returns the number of epochs in the cache.
+func (c *EpochSpansCache) Len() int {
+	return c.cache.Len()
+}
+
+// RemoveOldest removes the least recently used epoch from the cache, performing the
+// onEviction function before removal. It returns the removed epoch and span map.
+func (c *EpochSpansCache) RemoveOldest() (uint64, map[uint64]types.Span, bool) {
+	key, item, ok := c.cache.RemoveOldest()
+	if !ok || item == nil {
+		return 0, nil, false
+	}
+	return key.(uint64), item.(map[uint64]types.Span), true
+}
+
+// EstimatedSize returns an estimation of the number of bytes taken in memory by the cached
+// span maps.
+func (c *EpochSpansCache) EstimatedSize() uint64 {
+	var size uint64
+	for _, epoch := range c.Keys() {
+		if spanMap, ok := c.Peek(epoch); ok {
+			size += EstimateSpanMapSize(spanMap)
+		}
+	}
+	return size
+}
+
+// EstimateSpanMapSize returns an estimation of the number of bytes taken in memory by a span map.
+func EstimateSpanMapSize(spanMap map[uint64]types.Span) uint64 {
+	return uint64(len(spanMap)) * spanMapEntrySize
+}
+
 // Has returns true if the key exists in the cache.
 func (c *EpochSpansCache) Has(epoch uint64) bool {
 	return c.cache.Contains(epoch)
@@ -0,0 +1,301 @@
+// Package dump serializes the slasher detection state, min-max span maps, indexed attestations
+// and detected slashings, to a portable file which can be imported in the slasher DB of another
+// machine or slasher version. Unlike the BoltDB file, the format doesn't depend on the bucket
+// layout or key encodings of the DB.
+//
+// The file is a gzip compressed stream of JSON lines. The first line is a header holding the
+// format version and the genesis root of the network the data belongs to, each following line
+// is one record.
+package dump
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	detectionTypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "dump")
+
+// formatVersion is bumped on changes of the file format which older slashers can't import.
+const formatVersion = 1
+
+// Importing a line larger than the default scanner buffer is expected for the span maps of
+// large validator sets.
+const maxLineSize = 1 << 30
+
+var slashingStatuses = []types.SlashingStatus{types.Active, types.Included, types.Reverted}
+
+type header struct {
+	Version     int    `json:"version"`
+	GenesisRoot string `json:"genesis_root,omitempty"`
+}
+
+type record struct {
+	SpanMap            *spanMapRecord            `json:"span_map,omitempty"`
+	IndexedAttestation *ethpb.IndexedAttestation `json:"indexed_attestation,omitempty"`
+	AttesterSlashing   *attesterSlashingRecord   `json:"attester_slashing,omitempty"`
+	ProposerSlashing   *proposerSlashingRecord   `json:"proposer_slashing,omitempty"`
+}
+
+type spanMapRecord struct {
+	Epoch uint64        `json:"epoch"`
+	Spans []*spanRecord `json:"spans"`
+}
+
+type spanRecord struct {
+	ValidatorIndex uint64  `json:"validator_index"`
+	MinSpan        uint16  `json:"min_span"`
+	MaxSpan        uint16  `json:"max_span"`
+	SigBytes       [2]byte `json:"sig_bytes"`
+	HasAttested    bool    `json:"has_attested"`
+}
+
+type attesterSlashingRecord struct {
+	Status   string                  `json:"status"`
+	Slashing *ethpb.AttesterSlashing `json:"slashing"`
+}
+
+type proposerSlashingRecord struct {
+	Status   string                  `json:"status"`
+	Slashing *ethpb.ProposerSlashing `json:"slashing"`
+}
+
+// Stats counts the records written by an export or read by an import.
+type Stats struct {
+	SpanMaps            int
+	IndexedAttestations int
+	AttesterSlashings   int
+	ProposerSlashings   int
+}
+
+// Export writes the detection state of the slasher DB to w. Span maps and indexed attestations
+// are exported up to the latest target epoch of the saved indexed attestations.
+func Export(ctx context.Context, slasherDB db.Database, w io.Writer) (*Stats, error) {
+	gw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(gw)
+	enc := json.NewEncoder(bw)
+	if err := enc.Encode(&header{
+		Version:     formatVersion,
+		GenesisRoot: hex.EncodeToString(slasherDB.GenesisRoot()),
+	}); err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	latestEpoch, err := slasherDB.LatestIndexedAttestationsTargetEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest indexed attestations target epoch")
+	}
+	for epoch := uint64(0); epoch <= latestEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		spanMap, err := slasherDB.EpochSpansMap(ctx, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get span map for epoch %d", epoch)
+		}
+		if len(spanMap) > 0 {
+			if err := enc.Encode(&record{SpanMap: newSpanMapRecord(epoch, spanMap)}); err != nil {
+				return nil, err
+			}
+			stats.SpanMaps++
+		}
+		atts, err := slasherDB.IndexedAttestationsForTarget(ctx, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get indexed attestations for epoch %d", epoch)
+		}
+		for _, att := range atts {
+			if err := enc.Encode(&record{IndexedAttestation: att}); err != nil {
+				return nil, err
+			}
+		}
+		stats.IndexedAttestations += len(atts)
+	}
+
+	for _, status := range slashingStatuses {
+		attSlashings, err := slasherDB.AttesterSlashings(ctx, status)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get %s attester slashings", status)
+		}
+		for _, slashing := range attSlashings {
+			rec := &record{AttesterSlashing: &attesterSlashingRecord{Status: status.String(), Slashing: slashing}}
+			if err := enc.Encode(rec); err != nil {
+				return nil, err
+			}
+		}
+		stats.AttesterSlashings += len(attSlashings)
+		propSlashings, err := slasherDB.ProposalSlashingsByStatus(ctx, status)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get %s proposer slashings", status)
+		}
+		for _, slashing := range propSlashings {
+			rec := &record{ProposerSlashing: &proposerSlashingRecord{Status: status.String(), Slashing: slashing}}
+			if err := enc.Encode(rec); err != nil {
+				return nil, err
+			}
+		}
+		stats.ProposerSlashings += len(propSlashings)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"spanMaps":            stats.SpanMaps,
+		"indexedAttestations": stats.IndexedAttestations,
+		"attesterSlashings":   stats.AttesterSlashings,
+		"proposerSlashings":   stats.ProposerSlashings,
+	}).Info("Exported slasher DB")
+	return stats, nil
+}
+
+// Import saves the detection state read from r into the slasher DB. When the file belongs to
+// a genesis namespace, the DB is switched to that namespace first so the data of different
+// networks doesn't mix.
+func Import(ctx context.Context, slasherDB db.Database, r io.Reader) (*Stats, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open gzip stream")
+	}
+	defer func() {
+		if err := gr.Close(); err != nil {
+			log.WithError(err).Error("Could not close gzip stream")
+		}
+	}()
+	scanner := bufio.NewScanner(gr)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("missing header")
+	}
+	h := &header{}
+	if err := json.Unmarshal(scanner.Bytes(), h); err != nil {
+		return nil, errors.Wrap(err, "could not decode header")
+	}
+	if h.Version > formatVersion {
+		return nil, fmt.Errorf("unsupported format version %d, this slasher supports up to %d", h.Version, formatVersion)
+	}
+	if h.GenesisRoot != "" {
+		genesisRoot, err := hex.DecodeString(h.GenesisRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode genesis root")
+		}
+		if err := slasherDB.UseGenesisNamespace(ctx, genesisRoot); err != nil {
+			return nil, errors.Wrap(err, "could not switch to genesis namespace")
+		}
+	}
+
+	stats := &Stats{}
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		rec := &record{}
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return nil, errors.Wrap(err, "could not decode record")
+		}
+		if err := importRecord(ctx, slasherDB, rec, stats); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"spanMaps":            stats.SpanMaps,
+		"indexedAttestations": stats.IndexedAttestations,
+		"attesterSlashings":   stats.AttesterSlashings,
+		"proposerSlashings":   stats.ProposerSlashings,
+	}).Info("Imported slasher DB")
+	return stats, nil
+}
+
+func importRecord(ctx context.Context, slasherDB db.Database, rec *record, stats *Stats) error {
+	switch {
+	case rec.SpanMap != nil:
+		spanMap := make(map[uint64]detectionTypes.Span, len(rec.SpanMap.Spans))
+		for _, s := range rec.SpanMap.Spans {
+			spanMap[s.ValidatorIndex] = detectionTypes.Span{
+				MinSpan:     s.MinSpan,
+				MaxSpan:     s.MaxSpan,
+				SigBytes:    s.SigBytes,
+				HasAttested: s.HasAttested,
+			}
+		}
+		if err := slasherDB.SaveEpochSpansMap(ctx, rec.SpanMap.Epoch, spanMap); err != nil {
+			return errors.Wrapf(err, "could not save span map for epoch %d", rec.SpanMap.Epoch)
+		}
+		stats.SpanMaps++
+	case rec.IndexedAttestation != nil:
+		if err := slasherDB.SaveIndexedAttestation(ctx, rec.IndexedAttestation); err != nil {
+			return errors.Wrap(err, "could not save indexed attestation")
+		}
+		stats.IndexedAttestations++
+	case rec.AttesterSlashing != nil:
+		status, err := parseStatus(rec.AttesterSlashing.Status)
+		if err != nil {
+			return err
+		}
+		if err := slasherDB.SaveAttesterSlashing(ctx, status, rec.AttesterSlashing.Slashing); err != nil {
+			return errors.Wrap(err, "could not save attester slashing")
+		}
+		stats.AttesterSlashings++
+	case rec.ProposerSlashing != nil:
+		status, err := parseStatus(rec.ProposerSlashing.Status)
+		if err != nil {
+			return err
+		}
+		if err := slasherDB.SaveProposerSlashing(ctx, status, rec.ProposerSlashing.Slashing); err != nil {
+			return errors.Wrap(err, "could not save proposer slashing")
+		}
+		stats.ProposerSlashings++
+	default:
+		// Records added by newer format revisions are skipped.
+		log.Debug("Skipping unknown record")
+	}
+	return nil
+}
+
+func newSpanMapRecord(epoch uint64, spanMap map[uint64]detectionTypes.Span) *spanMapRecord {
+	rec := &spanMapRecord{Epoch: epoch, Spans: make([]*spanRecord, 0, len(spanMap))}
+	for idx, s := range spanMap {
+		rec.Spans = append(rec.Spans, &spanRecord{
+			ValidatorIndex: idx,
+			MinSpan:        s.MinSpan,
+			MaxSpan:        s.MaxSpan,
+			SigBytes:       s.SigBytes,
+			HasAttested:    s.HasAttested,
+		})
+	}
+	sort.Slice(rec.Spans, func(i, j int) bool {
+		return rec.Spans[i].ValidatorIndex < rec.Spans[j].ValidatorIndex
+	})
+	return rec
+}
+
+func parseStatus(name string) (types.SlashingStatus, error) {
+	for _, status := range slashingStatuses {
+		if status.String() == name {
+			return status, nil
+		}
+	}
+	return types.Unknown, fmt.Errorf("unknown slashing status %q", name)
+}
@@ -0,0 +1,107 @@
+package dump
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	detectionTypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, source)
+
+	spanMap := map[uint64]detectionTypes.Span{
+		1: {MinSpan: 2, MaxSpan: 3, SigBytes: [2]byte{4, 5}, HasAttested: true},
+		7: {MinSpan: 0, MaxSpan: 1},
+	}
+	if err := source.SaveEpochSpansMap(ctx, 2, spanMap); err != nil {
+		t.Fatal(err)
+	}
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 7},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: []byte("root"),
+			Source:          &ethpb.Checkpoint{Epoch: 1, Root: []byte("source")},
+			Target:          &ethpb.Checkpoint{Epoch: 2, Root: []byte("target")},
+		},
+		Signature: []byte{4, 5, 6},
+	}
+	if err := source.SaveIndexedAttestation(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+	attSlashing := &ethpb.AttesterSlashing{Attestation_1: att, Attestation_2: att}
+	if err := source.SaveAttesterSlashing(ctx, types.Included, attSlashing); err != nil {
+		t.Fatal(err)
+	}
+	propSlashing := &ethpb.ProposerSlashing{
+		ProposerIndex: 3,
+		Header_1:      &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 5}, Signature: []byte{1}},
+		Header_2:      &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 5}, Signature: []byte{2}},
+	}
+	if err := source.SaveProposerSlashing(ctx, types.Active, propSlashing); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	exported, err := Export(ctx, source, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Stats{SpanMaps: 1, IndexedAttestations: 1, AttesterSlashings: 1, ProposerSlashings: 1}
+	if !reflect.DeepEqual(exported, want) {
+		t.Errorf("Wanted export stats %+v, received %+v", want, exported)
+	}
+
+	target := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, target)
+	imported, err := Import(ctx, target, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(imported, want) {
+		t.Errorf("Wanted import stats %+v, received %+v", want, imported)
+	}
+
+	gotSpans, err := target.EpochSpansMap(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotSpans, spanMap) {
+		t.Errorf("Wanted span map %v, received %v", spanMap, gotSpans)
+	}
+	if ok, err := target.HasIndexedAttestation(ctx, att); err != nil || !ok {
+		t.Errorf("Expected imported indexed attestation, err: %v", err)
+	}
+	if ok, status, err := target.HasAttesterSlashing(ctx, attSlashing); err != nil || !ok || status != types.Included {
+		t.Errorf("Expected imported included attester slashing, received status %s, err: %v", status, err)
+	}
+	if ok, status, err := target.HasProposerSlashing(ctx, propSlashing); err != nil || !ok || status != types.Active {
+		t.Errorf("Expected imported active proposer slashing, received status %s, err: %v", status, err)
+	}
+}
+
+func TestImport_UnsupportedVersion(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, slasherDB)
+
+	buf := new(bytes.Buffer)
+	gw := gzip.NewWriter(buf)
+	if _, err := gw.Write([]byte("{\"version\":2}\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Import(ctx, slasherDB, buf); err == nil {
+		t.Error("Expected error importing a newer format version")
+	}
+}
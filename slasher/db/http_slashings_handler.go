@@ -0,0 +1,166 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/sirupsen/logrus"
+)
+
+// slashingStatuses are the statuses of the slashings which can be listed.
+var slashingStatuses = []types.SlashingStatus{types.Active, types.Included, types.Reverted}
+
+// slashingsFilter holds the query parameters of a slashings request, nil fields match all
+// the slashings.
+type slashingsFilter struct {
+	validatorIndex *uint64
+	epoch          *uint64
+	statuses       []types.SlashingStatus
+}
+
+// attesterSlashingJSON is an attester slashing listed by the slashings endpoint. The epoch is
+// the target epoch of the first attestation.
+type attesterSlashingJSON struct {
+	Status           string                  `json:"status"`
+	ValidatorIndices []uint64                `json:"validator_indices"`
+	Epoch            uint64                  `json:"epoch"`
+	Slashing         *ethpb.AttesterSlashing `json:"slashing"`
+}
+
+// proposerSlashingJSON is a proposer slashing listed by the slashings endpoint.
+type proposerSlashingJSON struct {
+	Status         string                  `json:"status"`
+	ValidatorIndex uint64                  `json:"validator_index"`
+	Epoch          uint64                  `json:"epoch"`
+	Slashing       *ethpb.ProposerSlashing `json:"slashing"`
+}
+
+type slashingsResponse struct {
+	AttesterSlashings []*attesterSlashingJSON `json:"attester_slashings"`
+	ProposerSlashings []*proposerSlashingJSON `json:"proposer_slashings"`
+}
+
+// SlashingsHandler lists the detected slashings as JSON. The slashings can be filtered with
+// the validator_index, epoch and status query parameters, status being one of Active,
+// Included or Reverted and defaulting to all of them.
+func SlashingsHandler(db ReadOnlyDatabase) func(http.ResponseWriter, *http.Request) {
+	log := logrus.WithField("prefix", "db")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Only GET requests are supported", http.StatusMethodNotAllowed)
+			return
+		}
+		filter, err := parseSlashingsFilter(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res, err := listSlashings(r.Context(), db, filter)
+		if err != nil {
+			log.WithError(err).Error("Could not list slashings")
+			http.Error(w, "Could not list slashings", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.WithError(err).Error("Could not write slashings response")
+		}
+	}
+}
+
+func parseSlashingsFilter(r *http.Request) (*slashingsFilter, error) {
+	query := r.URL.Query()
+	filter := &slashingsFilter{statuses: slashingStatuses}
+	if v := query.Get("validator_index"); v != "" {
+		idx, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator_index %q", v)
+		}
+		filter.validatorIndex = &idx
+	}
+	if v := query.Get("epoch"); v != "" {
+		epoch, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid epoch %q", v)
+		}
+		filter.epoch = &epoch
+	}
+	if v := query.Get("status"); v != "" {
+		filter.statuses = nil
+		for _, status := range slashingStatuses {
+			if strings.EqualFold(v, status.String()) {
+				filter.statuses = []types.SlashingStatus{status}
+			}
+		}
+		if filter.statuses == nil {
+			return nil, fmt.Errorf("invalid status %q, wanted one of Active, Included or Reverted", v)
+		}
+	}
+	return filter, nil
+}
+
+func listSlashings(ctx context.Context, db ReadOnlyDatabase, filter *slashingsFilter) (*slashingsResponse, error) {
+	res := &slashingsResponse{
+		AttesterSlashings: make([]*attesterSlashingJSON, 0),
+		ProposerSlashings: make([]*proposerSlashingJSON, 0),
+	}
+	for _, status := range filter.statuses {
+		attesterSlashings, err := db.AttesterSlashings(ctx, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, slashing := range attesterSlashings {
+			if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil ||
+				slashing.Attestation_1.Data == nil || slashing.Attestation_1.Data.Target == nil {
+				continue
+			}
+			indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+			epoch := slashing.Attestation_1.Data.Target.Epoch
+			if filter.validatorIndex != nil && !sliceutil.IsInUint64(*filter.validatorIndex, indices) {
+				continue
+			}
+			if filter.epoch != nil && *filter.epoch != epoch {
+				continue
+			}
+			res.AttesterSlashings = append(res.AttesterSlashings, &attesterSlashingJSON{
+				Status:           status.String(),
+				ValidatorIndices: indices,
+				Epoch:            epoch,
+				Slashing:         slashing,
+			})
+		}
+
+		proposerSlashings, err := db.ProposalSlashingsByStatus(ctx, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, slashing := range proposerSlashings {
+			if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+				continue
+			}
+			epoch := helpers.SlotToEpoch(slashing.Header_1.Header.Slot)
+			if filter.validatorIndex != nil && *filter.validatorIndex != slashing.ProposerIndex {
+				continue
+			}
+			if filter.epoch != nil && *filter.epoch != epoch {
+				continue
+			}
+			res.ProposerSlashings = append(res.ProposerSlashings, &proposerSlashingJSON{
+				Status:         status.String(),
+				ValidatorIndex: slashing.ProposerIndex,
+				Epoch:          epoch,
+				Slashing:       slashing,
+			})
+		}
+	}
+	return res, nil
+}
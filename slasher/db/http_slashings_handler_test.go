@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+func TestSlashingsHandler(t *testing.T) {
+	ctx := context.Background()
+	store, err := kv.NewKVStore(path.Join(testutil.TempDir(), "slashings-handler"), &kv.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.ClearDB(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	attesterSlashing := func(target uint64, indices []uint64) *ethpb.AttesterSlashing {
+		att := func(root byte) *ethpb.IndexedAttestation {
+			return &ethpb.IndexedAttestation{
+				AttestingIndices: indices,
+				Data: &ethpb.AttestationData{
+					BeaconBlockRoot: []byte{root},
+					Source:          &ethpb.Checkpoint{Epoch: target - 1},
+					Target:          &ethpb.Checkpoint{Epoch: target},
+				},
+			}
+		}
+		return &ethpb.AttesterSlashing{Attestation_1: att(1), Attestation_2: att(2)}
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{
+		ProposerIndex: 5,
+		Header_1: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{Slot: 3 * params.BeaconConfig().SlotsPerEpoch},
+		},
+		Header_2: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{Slot: 3 * params.BeaconConfig().SlotsPerEpoch, StateRoot: []byte{1}},
+		},
+	}
+	if err := store.SaveAttesterSlashing(ctx, types.Active, attesterSlashing(2, []uint64{1, 2})); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SaveAttesterSlashing(ctx, types.Included, attesterSlashing(4, []uint64{3})); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SaveProposerSlashing(ctx, types.Active, proposerSlashing); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query        string
+		wantCode     int
+		wantAttester int
+		wantProposer int
+	}{
+		{query: "", wantCode: http.StatusOK, wantAttester: 2, wantProposer: 1},
+		{query: "status=active", wantCode: http.StatusOK, wantAttester: 1, wantProposer: 1},
+		{query: "status=Included", wantCode: http.StatusOK, wantAttester: 1},
+		{query: "validator_index=2", wantCode: http.StatusOK, wantAttester: 1},
+		{query: "validator_index=5", wantCode: http.StatusOK, wantProposer: 1},
+		{query: "epoch=4", wantCode: http.StatusOK, wantAttester: 1},
+		{query: "epoch=3", wantCode: http.StatusOK, wantProposer: 1},
+		{query: "status=pending", wantCode: http.StatusBadRequest},
+		{query: "epoch=-1", wantCode: http.StatusBadRequest},
+	}
+	handler := SlashingsHandler(store)
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/slashings?%s", tt.query), nil))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("Wanted status %d, received %d: %s", tt.wantCode, rec.Code, rec.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			res := &slashingsResponse{}
+			if err := json.NewDecoder(rec.Body).Decode(res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.AttesterSlashings) != tt.wantAttester || len(res.ProposerSlashings) != tt.wantProposer {
+				t.Errorf(
+					"Wanted %d attester and %d proposer slashings, received %d and %d",
+					tt.wantAttester,
+					tt.wantProposer,
+					len(res.AttesterSlashings),
+					len(res.ProposerSlashings),
+				)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	detectionTypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
@@ -21,11 +22,17 @@ type ReadOnlyDatabase interface {
 	BlockHeaders(ctx context.Context, epoch uint64, validatorID uint64) ([]*ethpb.SignedBeaconBlockHeader, error)
 	HasBlockHeader(ctx context.Context, epoch uint64, validatorID uint64) bool
 
+	// Proposal history related methods.
+	ProposalHistoryForSlot(ctx context.Context, proposerIdx uint64, slot uint64) ([]*ethpb.SignedBeaconBlockHeader, error)
+
 	// IndexedAttestations related methods.
 	HasIndexedAttestation(ctx context.Context, att *ethpb.IndexedAttestation) (bool, error)
 	IndexedAttestationsForTarget(ctx context.Context, targetEpoch uint64) ([]*ethpb.IndexedAttestation, error)
 	IndexedAttestationsWithPrefix(ctx context.Context, targetEpoch uint64, sigBytes []byte) ([]*ethpb.IndexedAttestation, error)
+	IndexedAttestationsWithPrefixForValidator(ctx context.Context, targetEpoch uint64, sigBytes []byte, validatorIdx uint64) ([]*ethpb.IndexedAttestation, error)
+	IndexedAttestationsWithPrefixCount(ctx context.Context, targetEpoch uint64, sigBytes []byte) (int, error)
 	LatestIndexedAttestationsTargetEpoch(ctx context.Context) (uint64, error)
+	HighestAttestation(ctx context.Context, validatorIdx uint64) (*slashpb.HighestAttestation, error)
 
 	// MinMaxSpan related methods.
 	EpochSpansMap(ctx context.Context, epoch uint64) (map[uint64]detectionTypes.Span, error)
@@ -40,6 +47,19 @@ type ReadOnlyDatabase interface {
 
 	// Chain data related methods.
 	ChainHead(ctx context.Context) (*ethpb.ChainHead, error)
+	SpansLength(ctx context.Context) (uint64, error)
+
+	// Detection context related methods.
+	AttesterSlashingContext(ctx context.Context, slashing *ethpb.AttesterSlashing) (*slashpb.DetectionContext, error)
+	ProposerSlashingContext(ctx context.Context, slashing *ethpb.ProposerSlashing) (*slashpb.DetectionContext, error)
+
+	// Deferred detection related methods.
+	DeferredDetections(ctx context.Context, limit int) ([]*slashpb.DeferredDetection, error)
+	DeferredDetectionsCount(ctx context.Context) (int, error)
+
+	// Pending attestation related methods.
+	PendingAttestations(ctx context.Context, offset int, limit int) ([]*ethpb.IndexedAttestation, error)
+	PendingAttestationsCount(ctx context.Context) (int, error)
 }
 
 // WriteAccessDatabase represents a write access database with only functions that can modify the DB.
@@ -54,6 +74,10 @@ type WriteAccessDatabase interface {
 	DeleteBlockHeader(ctx context.Context, validatorID uint64, blockHeader *ethpb.SignedBeaconBlockHeader) error
 	PruneBlockHistory(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error
 
+	// Proposal history related methods.
+	SaveProposalHistory(ctx context.Context, proposerIdx uint64, header *ethpb.SignedBeaconBlockHeader) error
+	PruneProposalHistory(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error
+
 	// IndexedAttestations related methods.
 	SaveIndexedAttestation(ctx context.Context, idxAttestation *ethpb.IndexedAttestation) error
 	SaveIndexedAttestations(ctx context.Context, idxAttestations []*ethpb.IndexedAttestation) error
@@ -64,8 +88,10 @@ type WriteAccessDatabase interface {
 	SaveEpochSpansMap(ctx context.Context, epoch uint64, spanMap map[uint64]detectionTypes.Span) error
 	SaveValidatorEpochSpan(ctx context.Context, validatorIdx uint64, epoch uint64, spans detectionTypes.Span) error
 	SaveCachedSpansMaps(ctx context.Context) error
+	SnapshotSpanMaps(ctx context.Context) error
 	DeleteEpochSpans(ctx context.Context, validatorIdx uint64) error
 	DeleteValidatorSpanByEpoch(ctx context.Context, validatorIdx uint64, epoch uint64) error
+	PruneSpanMaps(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error
 
 	// ProposerSlashing related methods.
 	DeleteProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
@@ -78,6 +104,24 @@ type WriteAccessDatabase interface {
 
 	// Chain data related methods.
 	SaveChainHead(ctx context.Context, head *ethpb.ChainHead) error
+	SaveSpansLength(ctx context.Context, spansLength uint64) error
+
+	// Detection context related methods.
+	SaveAttesterSlashingContext(ctx context.Context, slashing *ethpb.AttesterSlashing, detectionCtx *slashpb.DetectionContext) error
+	SaveProposerSlashingContext(ctx context.Context, slashing *ethpb.ProposerSlashing, detectionCtx *slashpb.DetectionContext) error
+
+	// Deferred detection related methods.
+	SaveDeferredDetection(ctx context.Context, detection *slashpb.DeferredDetection) error
+	DeleteDeferredDetection(ctx context.Context, detection *slashpb.DeferredDetection) error
+
+	// Pending attestation related methods.
+	SavePendingAttestations(ctx context.Context, atts []*ethpb.IndexedAttestation) error
+	RecordPendingAttestationFailure(ctx context.Context, att *ethpb.IndexedAttestation) (uint64, error)
+	DeletePendingAttestation(ctx context.Context, att *ethpb.IndexedAttestation) error
+
+	// Pruning related methods.
+	PruneHistory(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error
+	CompactHistory(ctx context.Context, currentEpoch uint64, compactionEpochAge uint64) error
 }
 
 // FullAccessDatabase represents a full access database with only DB interaction functions.
@@ -93,4 +137,6 @@ type Database interface {
 
 	DatabasePath() string
 	ClearDB() error
+	GenesisRoot() []byte
+	UseGenesisNamespace(ctx context.Context, genesisRoot []byte) error
 }
@@ -7,13 +7,23 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
+// attesterSlashingKey returns the slashing bucket key of an attester slashing, derived from
+// its hash tree root so equal slashings map to the same record regardless of encoding.
+func attesterSlashingKey(slashing *ethpb.AttesterSlashing) ([]byte, error) {
+	root, err := ssz.HashTreeRoot(slashing)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hash root of attesterSlashing")
+	}
+	return encodeTypeRoot(types.SlashingType(types.Attestation), root), nil
+}
+
 func unmarshalAttSlashing(enc []byte) (*ethpb.AttesterSlashing, error) {
 	protoSlashing := &ethpb.AttesterSlashing{}
 	err := proto.Unmarshal(enc, protoSlashing)
@@ -61,19 +71,18 @@ func (db *Store) AttesterSlashings(ctx context.Context, status types.SlashingSta
 func (db *Store) DeleteAttesterSlashing(ctx context.Context, attesterSlashing *ethpb.AttesterSlashing) error {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.DeleteAttesterSlashing")
 	defer span.End()
-	root, err := hashutil.HashProto(attesterSlashing)
+	k, err := attesterSlashingKey(attesterSlashing)
 	if err != nil {
-		return errors.Wrap(err, "failed to get hash root of attesterSlashing")
+		return err
 	}
 	return db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(slashingBucket)
-		k := encodeTypeRoot(types.SlashingType(types.Attestation), root)
-		if err != nil {
-			return errors.Wrap(err, "failed to get key for for attester slashing.")
-		}
 		if err := bucket.Delete(k); err != nil {
 			return errors.Wrap(err, "failed to delete the slashing proof from slashing bucket")
 		}
+		if err := tx.Bucket(detectionContextBucket).Delete(k); err != nil {
+			return errors.Wrap(err, "failed to delete the detection context of the slashing proof")
+		}
 		return nil
 	})
 }
@@ -84,11 +93,10 @@ func (db *Store) HasAttesterSlashing(ctx context.Context, slashing *ethpb.Attest
 	defer span.End()
 	var status types.SlashingStatus
 	var found bool
-	root, err := hashutil.HashProto(slashing)
+	key, err := attesterSlashingKey(slashing)
 	if err != nil {
-		return found, status, errors.Wrap(err, "failed to get hash root of attesterSlashing")
+		return found, status, err
 	}
-	key := encodeTypeRoot(types.SlashingType(types.Attestation), root)
 	err = db.view(func(tx *bolt.Tx) error {
 		b := tx.Bucket(slashingBucket)
 		enc := b.Get(key)
@@ -109,8 +117,10 @@ func (db *Store) SaveAttesterSlashing(ctx context.Context, status types.Slashing
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal")
 	}
-	root := hashutil.Hash(enc)
-	key := encodeTypeRoot(types.SlashingType(types.Attestation), root)
+	key, err := attesterSlashingKey(slashing)
+	if err != nil {
+		return err
+	}
 	return db.update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(slashingBucket)
 		e := b.Put(key, append([]byte{byte(status)}, enc...))
@@ -119,24 +129,41 @@ func (db *Store) SaveAttesterSlashing(ctx context.Context, status types.Slashing
 }
 
 // SaveAttesterSlashings accepts a slice of slashing proof and its status and writes it to disk.
+// Saving is idempotent: slashings are keyed by their root, duplicates within the slice are
+// written once and slashings already stored only get their status updated. A slashing already
+// included in a block isn't marked active again when it is detected once more.
 func (db *Store) SaveAttesterSlashings(ctx context.Context, status types.SlashingStatus, slashings []*ethpb.AttesterSlashing) error {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveAttesterSlashings")
 	defer span.End()
-	enc := make([][]byte, len(slashings))
-	key := make([][]byte, len(slashings))
-	var err error
-	for i, slashing := range slashings {
-		enc[i], err = proto.Marshal(slashing)
+	enc := make([][]byte, 0, len(slashings))
+	key := make([][]byte, 0, len(slashings))
+	seen := make(map[string]bool, len(slashings))
+	for _, slashing := range slashings {
+		k, err := attesterSlashingKey(slashing)
+		if err != nil {
+			return err
+		}
+		if seen[string(k)] {
+			continue
+		}
+		seen[string(k)] = true
+		encoded, err := proto.Marshal(slashing)
 		if err != nil {
 			return errors.Wrap(err, "failed to marshal")
 		}
-		encHash := hashutil.Hash(enc[i])
-		key[i] = encodeTypeRoot(types.SlashingType(types.Attestation), encHash)
+		enc = append(enc, encoded)
+		key = append(key, k)
 	}
 
 	return db.update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(slashingBucket)
 		for i := 0; i < len(enc); i++ {
+			if existing := b.Get(key[i]); existing != nil {
+				existingStatus := types.SlashingStatus(existing[0])
+				if existingStatus == status || (existingStatus == types.Included && status == types.Active) {
+					continue
+				}
+			}
 			e := b.Put(key[i], append([]byte{byte(status)}, enc[i]...))
 			if e != nil {
 				return e
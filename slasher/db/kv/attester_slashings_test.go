@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	bolt "go.etcd.io/bbolt"
 	"gopkg.in/urfave/cli.v2"
 )
 
@@ -108,6 +110,75 @@ func TestStore_SaveAttesterSlashings(t *testing.T) {
 	}
 }
 
+func TestStore_SaveAttesterSlashings_Idempotent(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	as := []*ethpb.AttesterSlashing{
+		{Attestation_1: &ethpb.IndexedAttestation{Signature: []byte("1")}},
+		{Attestation_1: &ethpb.IndexedAttestation{Signature: []byte("1")}},
+		{Attestation_1: &ethpb.IndexedAttestation{Signature: []byte("2")}},
+	}
+	if err := db.SaveAttesterSlashings(ctx, types.Active, as); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashings(ctx, types.Active, as); err != nil {
+		t.Fatal(err)
+	}
+	active, err := db.AttesterSlashings(ctx, types.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 2 {
+		t.Fatalf("Wanted 2 active slashings, received %d", len(active))
+	}
+
+	// An included slashing detected again stays included, other status changes are applied.
+	if err := db.SaveAttesterSlashing(ctx, types.Included, as[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashings(ctx, types.Active, as); err != nil {
+		t.Fatal(err)
+	}
+	if _, st, err := db.HasAttesterSlashing(ctx, as[0]); err != nil || st != types.Included {
+		t.Errorf("Wanted status %s, received %s (err %v)", types.SlashingStatus(types.Included), st, err)
+	}
+	if err := db.SaveAttesterSlashings(ctx, types.Reverted, as[2:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, st, err := db.HasAttesterSlashing(ctx, as[2]); err != nil || st != types.Reverted {
+		t.Errorf("Wanted status %s, received %s (err %v)", types.SlashingStatus(types.Reverted), st, err)
+	}
+}
+
+func TestStore_SaveAttesterSlashings_KeyedByHashTreeRoot(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	as := &ethpb.AttesterSlashing{Attestation_1: &ethpb.IndexedAttestation{Signature: []byte("1")}}
+	if err := db.SaveAttesterSlashings(ctx, types.Active, []*ethpb.AttesterSlashing{as}); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(as)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.view(func(tx *bolt.Tx) error {
+		if tx.Bucket(slashingBucket).Get(encodeTypeRoot(types.SlashingType(types.Attestation), root)) == nil {
+			t.Error("Wanted the slashing stored under its hash tree root")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestStore_UpdateAttesterSlashingStatus(t *testing.T) {
 	app := cli.App{}
 	set := flag.NewFlagSet("test", 0)
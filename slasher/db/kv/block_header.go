@@ -17,6 +17,13 @@ import (
 func unmarshalBlockHeader(ctx context.Context, enc []byte) (*ethpb.SignedBeaconBlockHeader, error) {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.unmarshalBlockHeader")
 	defer span.End()
+	if isSSZEncoded(enc) {
+		blockHeader, err := unmarshalBlockHeaderSSZ(enc)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoded block header")
+		}
+		return blockHeader, nil
+	}
 	protoBlockHeader := &ethpb.SignedBeaconBlockHeader{}
 	err := proto.Unmarshal(enc, protoBlockHeader)
 	if err != nil {
@@ -72,12 +79,9 @@ func (db *Store) SaveBlockHeader(ctx context.Context, validatorID uint64, blockH
 	defer span.End()
 	epoch := helpers.SlotToEpoch(blockHeader.Header.Slot)
 	key := encodeEpochValidatorIDSig(epoch, validatorID, blockHeader.Signature)
-	enc, err := proto.Marshal(blockHeader)
-	if err != nil {
-		return errors.Wrap(err, "failed to encode block")
-	}
+	enc := marshalBlockHeaderSSZ(blockHeader)
 
-	err = db.update(func(tx *bolt.Tx) error {
+	err := db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicBlockHeadersBucket)
 		if err := bucket.Put(key, enc); err != nil {
 			return errors.Wrap(err, "failed to include block header in the historical bucket")
@@ -122,7 +126,16 @@ func (db *Store) PruneBlockHistory(ctx context.Context, currentEpoch uint64, pru
 	return db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicBlockHeadersBucket)
 		c := tx.Bucket(historicBlockHeadersBucket).Cursor()
-		for k, _ := c.First(); k != nil && bytesutil.FromBytes8(k[:8]) <= uint64(pruneTill); k, _ = c.Next() {
+		// Keys are deleted after iterating, as deleting while iterating with the cursor would
+		// skip keys. Epochs are little endian encoded so keys aren't sorted by epoch, every key
+		// is decoded and compared.
+		pruned := make([][]byte, 0)
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if bytesutil.FromBytes8(k[:8]) <= uint64(pruneTill) {
+				pruned = append(pruned, k)
+			}
+		}
+		for _, k := range pruned {
 			if err := bucket.Delete(k); err != nil {
 				return errors.Wrap(err, "failed to delete the block header from historical bucket")
 			}
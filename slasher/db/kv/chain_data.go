@@ -6,6 +6,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -45,3 +46,34 @@ func (db *Store) SaveChainHead(ctx context.Context, head *ethpb.ChainHead) error
 		return err
 	})
 }
+
+// SpansLength retrieves the number of epochs covered by the span maps of the DB, 0 if it was
+// never saved.
+func (db *Store) SpansLength(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SpansLength")
+	defer span.End()
+	var spansLength uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainDataBucket)
+		enc := bucket.Get([]byte(spansLengthKey))
+		if enc == nil {
+			return nil
+		}
+		spansLength = bytesutil.FromBytes8(enc)
+		return nil
+	})
+	return spansLength, err
+}
+
+// SaveSpansLength persists the number of epochs covered by the span maps of the DB.
+func (db *Store) SaveSpansLength(ctx context.Context, spansLength uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveSpansLength")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainDataBucket)
+		if err := bucket.Put([]byte(spansLengthKey), bytesutil.Bytes8(spansLength)); err != nil {
+			return errors.Wrap(err, "failed to save spans length to db")
+		}
+		return nil
+	})
+}
@@ -54,3 +54,28 @@ func TestChainHead(t *testing.T) {
 		}
 	}
 }
+
+func TestSpansLength(t *testing.T) {
+	app := &cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(app, set, nil))
+	ctx := context.Background()
+
+	spansLength, err := db.SpansLength(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spansLength != 0 {
+		t.Errorf("Wanted no spans length saved, received %d", spansLength)
+	}
+	if err := db.SaveSpansLength(ctx, 54); err != nil {
+		t.Fatal(err)
+	}
+	spansLength, err = db.SpansLength(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spansLength != 54 {
+		t.Errorf("Wanted spans length 54, received %d", spansLength)
+	}
+}
@@ -0,0 +1,105 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DeferredDetections returns up to limit deferred detections, ordered by slashable epoch.
+func (db *Store) DeferredDetections(ctx context.Context, limit int) ([]*slashpb.DeferredDetection, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.DeferredDetections")
+	defer span.End()
+	var detections []*slashpb.DeferredDetection
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(deferredDetectionsBucket).Cursor()
+		for k, v := c.First(); k != nil && len(detections) < limit; k, v = c.Next() {
+			d := &slashpb.DeferredDetection{}
+			if err := proto.Unmarshal(v, d); err != nil {
+				return err
+			}
+			detections = append(detections, d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal deferred detection")
+	}
+	return detections, nil
+}
+
+// DeferredDetectionsCount returns the number of detections waiting in the deferred queue.
+func (db *Store) DeferredDetectionsCount(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.DeferredDetectionsCount")
+	defer span.End()
+	var count uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		count = queueLength(tx, deferredDetectionsBucket, deferredCountKey)
+		return nil
+	})
+	return int(count), err
+}
+
+// SaveDeferredDetection persists a detection to be processed by the deep check worker.
+func (db *Store) SaveDeferredDetection(ctx context.Context, detection *slashpb.DeferredDetection) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveDeferredDetection")
+	defer span.End()
+	key, err := deferredDetectionKey(detection)
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(detection)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode deferred detection")
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(deferredDetectionsBucket)
+		if bucket.Get(key) != nil {
+			return bucket.Put(key, enc)
+		}
+		count := queueLength(tx, deferredDetectionsBucket, deferredCountKey)
+		if err := bucket.Put(key, enc); err != nil {
+			return err
+		}
+		return setQueueLength(tx, deferredCountKey, count+1)
+	})
+}
+
+// DeleteDeferredDetection removes a processed detection from the deferred queue.
+func (db *Store) DeleteDeferredDetection(ctx context.Context, detection *slashpb.DeferredDetection) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.DeleteDeferredDetection")
+	defer span.End()
+	key, err := deferredDetectionKey(detection)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(deferredDetectionsBucket)
+		if bucket.Get(key) == nil {
+			return nil
+		}
+		count := queueLength(tx, deferredDetectionsBucket, deferredCountKey)
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+		if count > 0 {
+			count--
+		}
+		return setQueueLength(tx, deferredCountKey, count)
+	})
+}
+
+// deferredDetectionKey prefixes the detection hash with its slashable epoch so the queue
+// is drained from the oldest offenses first.
+func deferredDetectionKey(detection *slashpb.DeferredDetection) ([]byte, error) {
+	root, err := hashutil.HashProto(detection)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hash root of deferred detection")
+	}
+	return encodeEpochSig(detection.SlashableEpoch, root[:]), nil
+}
@@ -0,0 +1,96 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_DeferredDetections(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	detections := []*slashpb.DeferredDetection{
+		{
+			Attestation:    &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}, Signature: []byte("sig1")},
+			ValidatorIndex: 1,
+			SlashableEpoch: 9,
+			SigBytes:       []byte{1, 2},
+		},
+		{
+			Attestation:    &ethpb.IndexedAttestation{AttestingIndices: []uint64{2}, Signature: []byte("sig2")},
+			ValidatorIndex: 2,
+			SlashableEpoch: 3,
+			Kind:           1,
+			SigBytes:       []byte{3, 4},
+		},
+	}
+	for _, d := range detections {
+		if err := db.SaveDeferredDetection(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Saving a queued detection again doesn't count it twice.
+	if err := db.SaveDeferredDetection(ctx, detections[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := db.DeferredDetectionsCount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != len(detections) {
+		t.Errorf("Wanted %d deferred detections counted, received %d", len(detections), count)
+	}
+
+	received, err := db.DeferredDetections(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != len(detections) {
+		t.Fatalf("Wanted %d deferred detections, received %d", len(detections), len(received))
+	}
+	// The queue is drained from the lowest slashable epoch.
+	if !proto.Equal(received[0], detections[1]) {
+		t.Errorf("Wanted oldest detection %v first, received %v", detections[1], received[0])
+	}
+
+	limited, err := db.DeferredDetections(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limited) != 1 {
+		t.Errorf("Wanted the limit to be respected, received %d detections", len(limited))
+	}
+
+	if err := db.DeleteDeferredDetection(ctx, detections[1]); err != nil {
+		t.Fatal(err)
+	}
+	received, err = db.DeferredDetections(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || !proto.Equal(received[0], detections[0]) {
+		t.Errorf("Wanted only %v to remain, received %v", detections[0], received)
+	}
+
+	// Deleting a detection no longer queued doesn't change the count.
+	if err := db.DeleteDeferredDetection(ctx, detections[1]); err != nil {
+		t.Fatal(err)
+	}
+	count, err = db.DeferredDetectionsCount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("Wanted 1 deferred detection counted, received %d", count)
+	}
+}
@@ -0,0 +1,94 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// AttesterSlashingContext returns the detection context recorded for an attester slashing,
+// or nil if none was recorded.
+func (db *Store) AttesterSlashingContext(ctx context.Context, slashing *ethpb.AttesterSlashing) (*slashpb.DetectionContext, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.AttesterSlashingContext")
+	defer span.End()
+	key, err := attesterSlashingKey(slashing)
+	if err != nil {
+		return nil, err
+	}
+	return db.detectionContext(key)
+}
+
+// SaveAttesterSlashingContext records the chain context observed when an attester slashing was detected.
+func (db *Store) SaveAttesterSlashingContext(
+	ctx context.Context,
+	slashing *ethpb.AttesterSlashing,
+	detectionCtx *slashpb.DetectionContext,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveAttesterSlashingContext")
+	defer span.End()
+	key, err := attesterSlashingKey(slashing)
+	if err != nil {
+		return err
+	}
+	return db.saveDetectionContext(key, detectionCtx)
+}
+
+// ProposerSlashingContext returns the detection context recorded for a proposer slashing,
+// or nil if none was recorded.
+func (db *Store) ProposerSlashingContext(ctx context.Context, slashing *ethpb.ProposerSlashing) (*slashpb.DetectionContext, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.ProposerSlashingContext")
+	defer span.End()
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hash root of proposerSlashing")
+	}
+	return db.detectionContext(encodeTypeRoot(types.SlashingType(types.Proposal), root))
+}
+
+// SaveProposerSlashingContext records the chain context observed when a proposer slashing was detected.
+func (db *Store) SaveProposerSlashingContext(
+	ctx context.Context,
+	slashing *ethpb.ProposerSlashing,
+	detectionCtx *slashpb.DetectionContext,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveProposerSlashingContext")
+	defer span.End()
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hash root of proposerSlashing")
+	}
+	return db.saveDetectionContext(encodeTypeRoot(types.SlashingType(types.Proposal), root), detectionCtx)
+}
+
+func (db *Store) detectionContext(key []byte) (*slashpb.DetectionContext, error) {
+	var res *slashpb.DetectionContext
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(detectionContextBucket).Get(key)
+		if enc == nil {
+			return nil
+		}
+		res = &slashpb.DetectionContext{}
+		return proto.Unmarshal(enc, res)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal detection context")
+	}
+	return res, nil
+}
+
+func (db *Store) saveDetectionContext(key []byte, detectionCtx *slashpb.DetectionContext) error {
+	enc, err := proto.Marshal(detectionCtx)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode detection context")
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(detectionContextBucket).Put(key, enc)
+	})
+}
@@ -0,0 +1,97 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_AttesterSlashingContext(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{Signature: []byte("sig1")},
+		Attestation_2: &ethpb.IndexedAttestation{Signature: []byte("sig2")},
+	}
+	detectionCtx, err := db.AttesterSlashingContext(ctx, slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detectionCtx != nil {
+		t.Fatal("Expected no detection context before saving one")
+	}
+
+	want := &slashpb.DetectionContext{
+		HeadBlockRoot:      []byte("head"),
+		HeadSlot:           64,
+		FinalizedEpoch:     1,
+		FinalizedBlockRoot: []byte("finalized"),
+	}
+	if err := db.SaveAttesterSlashing(ctx, types.Active, slashing); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashingContext(ctx, slashing, want); err != nil {
+		t.Fatal(err)
+	}
+	detectionCtx, err = db.AttesterSlashingContext(ctx, slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(detectionCtx, want) {
+		t.Errorf("Wanted detection context %v, received %v", want, detectionCtx)
+	}
+
+	if err := db.DeleteAttesterSlashing(ctx, slashing); err != nil {
+		t.Fatal(err)
+	}
+	detectionCtx, err = db.AttesterSlashingContext(ctx, slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detectionCtx != nil {
+		t.Error("Expected detection context to be deleted along with the slashing")
+	}
+}
+
+func TestStore_ProposerSlashingContext(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slashing := &ethpb.ProposerSlashing{ProposerIndex: 5}
+	want := &slashpb.DetectionContext{
+		HeadBlockRoot: []byte("head"),
+		HeadSlot:      10,
+	}
+	if err := db.SaveProposerSlashingContext(ctx, slashing, want); err != nil {
+		t.Fatal(err)
+	}
+	detectionCtx, err := db.ProposerSlashingContext(ctx, slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(detectionCtx, want) {
+		t.Errorf("Wanted detection context %v, received %v", want, detectionCtx)
+	}
+
+	// Contexts are keyed by slashing type, an attester slashing lookup must not find it.
+	other, err := db.AttesterSlashingContext(ctx, &ethpb.AttesterSlashing{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other != nil {
+		t.Error("Expected no attester slashing detection context")
+	}
+}
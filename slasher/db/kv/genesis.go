@@ -0,0 +1,134 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// genesisNamespacePrefix prefixes the directories holding the database of each genesis namespace.
+const genesisNamespacePrefix = "genesis-"
+
+// currentGenesisFileName is the file of the data directory recording the genesis root of the
+// namespace in use, which is opened on startup instead of the unnamespaced database.
+const currentGenesisFileName = "current-genesis"
+
+// GenesisNamespacePath returns the directory holding the database of the genesis namespace
+// with the input genesis root, below the input data directory.
+func GenesisNamespacePath(dirPath string, genesisRoot []byte) string {
+	return path.Join(dirPath, genesisNamespacePrefix+hex.EncodeToString(genesisRoot))
+}
+
+// GenesisRoot returns the genesis root of the network the stored data belongs to, or nil if
+// the store isn't namespaced yet.
+func (db *Store) GenesisRoot() []byte {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	return db.genesisRoot
+}
+
+// UseGenesisNamespace switches the store to the database of the network with the input genesis
+// root, so the data of different networks, such as a testnet before and after a reset, never
+// mixes. Each namespace lives in its own database in the data directory. The data saved before
+// the store was first namespaced is adopted by the first network seen.
+func (db *Store) UseGenesisNamespace(ctx context.Context, genesisRoot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.UseGenesisNamespace")
+	defer span.End()
+	if bytes.Equal(db.GenesisRoot(), genesisRoot) {
+		return nil
+	}
+	// The cached span maps belong to the current namespace, purging the cache persists them
+	// to its database.
+	if db.spanCacheEnabled {
+		db.spanCache.Clear()
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	dirPath := GenesisNamespacePath(db.dirPath, genesisRoot)
+	datafile := path.Join(dirPath, databaseFileName)
+	if err := db.db.Close(); err != nil {
+		return errors.Wrap(err, "could not close database")
+	}
+	if _, err := os.Stat(datafile); os.IsNotExist(err) && db.genesisRoot == nil {
+		if err := os.MkdirAll(dirPath, 0700); err != nil {
+			return err
+		}
+		if err := os.Rename(db.databasePath, datafile); err != nil {
+			return errors.Wrap(err, "could not move database into genesis namespace")
+		}
+	}
+	boltDB, err := openBoltDB(dirPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open genesis namespace database")
+	}
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainDataBucket)
+		saved := bucket.Get([]byte(genesisRootKey))
+		if saved != nil && !bytes.Equal(saved, genesisRoot) {
+			return errors.Errorf("database at %s belongs to genesis root %#x", datafile, saved)
+		}
+		return bucket.Put([]byte(genesisRootKey), genesisRoot)
+	}); err != nil {
+		if closeErr := boltDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close genesis namespace database")
+		}
+		return err
+	}
+
+	if err := ioutil.WriteFile(path.Join(db.dirPath, currentGenesisFileName), []byte(hex.EncodeToString(genesisRoot)), 0600); err != nil {
+		if closeErr := boltDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close genesis namespace database")
+		}
+		return errors.Wrap(err, "could not record genesis namespace in use")
+	}
+
+	db.db = boltDB
+	db.databasePath = datafile
+	db.genesisRoot = genesisRoot
+	// The observed epochs track the span maps cached for the previous namespace.
+	lowestObservedEpoch = params.BeaconConfig().FarFutureEpoch
+	highestObservedEpoch = 0
+	return nil
+}
+
+// currentGenesisRoot returns the genesis root of the namespace last used in the directory, or
+// nil if the data directory was never namespaced.
+func currentGenesisRoot(dirPath string) ([]byte, error) {
+	enc, err := ioutil.ReadFile(path.Join(dirPath, currentGenesisFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(string(enc))
+}
+
+// removeGenesisNamespaces deletes the databases of all genesis namespaces in the directory, and
+// the record of the namespace in use.
+func removeGenesisNamespaces(dirPath string) error {
+	dirs, err := filepath.Glob(path.Join(dirPath, genesisNamespacePrefix+"*"))
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(path.Join(dirPath, currentGenesisFileName)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_UseGenesisNamespace_ReopenedOnStartup(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	ctx := context.Background()
+	dirPath := db.dirPath
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	genesisRoot := []byte("genesis")
+	if err := db.UseGenesisNamespace(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveChainHead(ctx, &ethpb.ChainHead{HeadEpoch: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The namespace is opened before the beacon node genesis is known.
+	db, err := NewKVStore(dirPath, &Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if !bytes.Equal(db.GenesisRoot(), genesisRoot) {
+		t.Errorf("Wanted genesis root %#x, received %#x", genesisRoot, db.GenesisRoot())
+	}
+	head, err := db.ChainHead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head == nil || head.HeadEpoch != 10 {
+		t.Errorf("Expected chain head of the namespace, received %v", head)
+	}
+}
@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// HighestAttestation returns the highest source and target epochs of the attestations saved
+// for a validator, or nil if no attestation of the validator was saved.
+func (db *Store) HighestAttestation(ctx context.Context, validatorIdx uint64) (*slashpb.HighestAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.HighestAttestation")
+	defer span.End()
+	var highest *slashpb.HighestAttestation
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(highestAttestationsBucket).Get(bytesutil.Bytes8(validatorIdx))
+		if enc == nil {
+			return nil
+		}
+		highest = &slashpb.HighestAttestation{}
+		return proto.Unmarshal(enc, highest)
+	})
+	return highest, err
+}
+
+// updateHighestAttestations raises the highest source and target epochs recorded for the
+// attesting validators of the input attestations.
+func updateHighestAttestations(tx *bolt.Tx, atts []*ethpb.IndexedAttestation) error {
+	// Aggregate the batch first so each validator record is read and written once.
+	highest := make(map[uint64]*slashpb.HighestAttestation)
+	for _, att := range atts {
+		for _, idx := range att.AttestingIndices {
+			h, ok := highest[idx]
+			if !ok {
+				h = &slashpb.HighestAttestation{ValidatorIndex: idx}
+				highest[idx] = h
+			}
+			if att.Data.Source.Epoch > h.HighestSourceEpoch {
+				h.HighestSourceEpoch = att.Data.Source.Epoch
+			}
+			if att.Data.Target.Epoch > h.HighestTargetEpoch {
+				h.HighestTargetEpoch = att.Data.Target.Epoch
+			}
+		}
+	}
+
+	bucket := tx.Bucket(highestAttestationsBucket)
+	for idx, h := range highest {
+		key := bytesutil.Bytes8(idx)
+		if enc := bucket.Get(key); enc != nil {
+			saved := &slashpb.HighestAttestation{}
+			if err := proto.Unmarshal(enc, saved); err != nil {
+				return err
+			}
+			if saved.HighestSourceEpoch >= h.HighestSourceEpoch && saved.HighestTargetEpoch >= h.HighestTargetEpoch {
+				continue
+			}
+			if saved.HighestSourceEpoch > h.HighestSourceEpoch {
+				h.HighestSourceEpoch = saved.HighestSourceEpoch
+			}
+			if saved.HighestTargetEpoch > h.HighestTargetEpoch {
+				h.HighestTargetEpoch = saved.HighestTargetEpoch
+			}
+		}
+		enc, err := proto.Marshal(h)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(key, enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_HighestAttestation(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	highest, err := db.HighestAttestation(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if highest != nil {
+		t.Fatal("Expected no highest attestation before saving attestations")
+	}
+
+	newAtt := func(indices []uint64, source uint64, target uint64, sig byte) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: source},
+				Target: &ethpb.Checkpoint{Epoch: target},
+			},
+			Signature: []byte{sig},
+		}
+	}
+	if err := db.SaveIndexedAttestations(ctx, []*ethpb.IndexedAttestation{
+		newAtt([]uint64{1, 2}, 3, 4, 1),
+		newAtt([]uint64{1}, 2, 6, 2),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Lower epochs saved later must not lower the recorded epochs.
+	if err := db.SaveIndexedAttestation(ctx, newAtt([]uint64{1, 2}, 1, 2, 3)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		validatorIdx uint64
+		source       uint64
+		target       uint64
+	}{
+		{validatorIdx: 1, source: 3, target: 6},
+		{validatorIdx: 2, source: 3, target: 4},
+	}
+	for _, tt := range tests {
+		highest, err := db.HighestAttestation(ctx, tt.validatorIdx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if highest.HighestSourceEpoch != tt.source || highest.HighestTargetEpoch != tt.target {
+			t.Errorf(
+				"Wanted validator %d highest source %d and target %d, received %d and %d",
+				tt.validatorIdx,
+				tt.source,
+				tt.target,
+				highest.HighestSourceEpoch,
+				highest.HighestTargetEpoch,
+			)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package kv
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/slasher/cache"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// inMemorySpansCacheSize is the number of epochs the span cache holds in memory spans mode,
+// above the weak subjectivity period so span maps are only evicted by the memory bound.
+const inMemorySpansCacheSize = 1 << 16
+
+var (
+	inMemorySpansEstimatedBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_in_memory_spans_estimated_bytes",
+		Help: "The estimated memory taken by the span maps held in memory",
+	})
+	inMemorySpansLimitEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_in_memory_spans_limit_evictions_total",
+		Help: "The number of span maps written to disk as the in memory spans bound was reached",
+	})
+	spanMapsSnapshots = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_span_maps_snapshots_total",
+		Help: "The number of snapshots of the cached span maps written to disk",
+	})
+)
+
+// SnapshotSpanMaps writes all the span maps currently in the span cache to the DB in a single
+// transaction. Unlike SaveCachedSpansMaps, the span maps are kept in the cache and the cache
+// stays enabled, so detection can go on during the snapshot. The span maps must not be written
+// while the snapshot is taken. It returns nil if the span cache is disabled.
+func (db *Store) SnapshotSpanMaps(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SnapshotSpanMaps")
+	defer span.End()
+	if !db.spanCacheEnabled {
+		return nil
+	}
+	start := time.Now()
+	epochs := db.spanCache.Keys()
+	if err := db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorsMinMaxSpanBucket)
+		for _, epoch := range epochs {
+			spanMap, ok := db.spanCache.Peek(epoch)
+			if !ok {
+				continue
+			}
+			epochBucket, err := bucket.CreateBucketIfNotExists(bytesutil.Bytes8(epoch))
+			if err != nil {
+				return err
+			}
+			for k, v := range spanMap {
+				if err := epochBucket.Put(bytesutil.Bytes8(k), marshalSpan(v)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to snapshot span maps")
+	}
+	spanMapsSnapshots.Inc()
+	log.WithFields(log.Fields{
+		"epochs":   len(epochs),
+		"duration": time.Since(start),
+	}).Debug("Saved span maps snapshot")
+	// Span maps grow with the validator set, the memory bound is checked again on every snapshot.
+	db.enforceInMemorySpansLimit()
+	return nil
+}
+
+// enforceInMemorySpansLimit writes the least recently used span maps to disk and removes them
+// from memory until the estimated memory taken by the span maps is within the in memory spans
+// bound. The most recently used epoch is always kept.
+func (db *Store) enforceInMemorySpansLimit() {
+	if !db.inMemorySpans {
+		return
+	}
+	size := db.spanCache.EstimatedSize()
+	if db.inMemorySpansMaxBytes == 0 || size <= db.inMemorySpansMaxBytes {
+		inMemorySpansEstimatedBytes.Set(float64(size))
+		return
+	}
+	evicted := 0
+	for size > db.inMemorySpansMaxBytes && db.spanCache.Len() > 1 {
+		_, spanMap, ok := db.spanCache.RemoveOldest()
+		if !ok {
+			break
+		}
+		size -= cache.EstimateSpanMapSize(spanMap)
+		evicted++
+	}
+	inMemorySpansEstimatedBytes.Set(float64(size))
+	inMemorySpansLimitEvictions.Add(float64(evicted))
+	log.WithFields(log.Fields{
+		"evictedEpochs":  evicted,
+		"estimatedBytes": size,
+		"maxBytes":       db.inMemorySpansMaxBytes,
+	}).Warn("In memory span maps reached the memory bound, least recently used epochs were written to disk")
+}
@@ -0,0 +1,121 @@
+package kv
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupInMemorySpansDB(t testing.TB, maxBytes uint64) *Store {
+	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		t.Fatalf("Could not generate random file path: %v", err)
+	}
+	p := path.Join(testutil.TempDir(), fmt.Sprintf("/%d", randPath))
+	if err := os.RemoveAll(p); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+	db, err := NewKVStore(p, &Config{InMemorySpans: true, InMemorySpansMaxBytes: maxBytes})
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	return db
+}
+
+// spanMapOnDisk reads the span map of an epoch from the DB, bypassing the span cache.
+func spanMapOnDisk(t *testing.T, db *Store, epoch uint64) map[uint64]types.Span {
+	spanMap := make(map[uint64]types.Span)
+	if err := db.view(func(tx *bolt.Tx) error {
+		epochBucket := tx.Bucket(validatorsMinMaxSpanBucket).Bucket(bytesutil.Bytes8(epoch))
+		if epochBucket == nil {
+			return nil
+		}
+		return epochBucket.ForEach(func(k, v []byte) error {
+			span, err := unmarshalSpan(context.Background(), v)
+			if err != nil {
+				return err
+			}
+			spanMap[bytesutil.FromBytes8(k)] = span
+			return nil
+		})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return spanMap
+}
+
+func TestStore_SnapshotSpanMaps(t *testing.T) {
+	db := setupInMemorySpansDB(t, 0)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for _, tt := range spanTests {
+		if err := db.SaveEpochSpansMap(ctx, tt.epoch, tt.spanMap); err != nil {
+			t.Fatalf("Save validator span map failed: %v", err)
+		}
+	}
+	for _, tt := range spanTests {
+		if sm := spanMapOnDisk(t, db, tt.epoch); len(sm) != 0 {
+			t.Fatalf("Expected span map of epoch %d to only be in memory before the snapshot, got: %v", tt.epoch, sm)
+		}
+	}
+	if err := db.SnapshotSpanMaps(ctx); err != nil {
+		t.Fatalf("Failed to snapshot span maps: %v", err)
+	}
+	for _, tt := range spanTests {
+		if sm := spanMapOnDisk(t, db, tt.epoch); !reflect.DeepEqual(sm, tt.spanMap) {
+			t.Errorf("Wanted span map %v on disk for epoch %d, received %v", tt.spanMap, tt.epoch, sm)
+		}
+		if !db.spanCache.Has(tt.epoch) {
+			t.Errorf("Expected span map of epoch %d to stay in memory after the snapshot", tt.epoch)
+		}
+	}
+}
+
+func TestStore_InMemorySpans_EvictsPastMemoryBound(t *testing.T) {
+	// Room for the span maps of two epochs.
+	db := setupInMemorySpansDB(t, 2*3*24)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for _, tt := range spanTests {
+		if err := db.SaveEpochSpansMap(ctx, tt.epoch, tt.spanMap); err != nil {
+			t.Fatalf("Save validator span map failed: %v", err)
+		}
+	}
+	if db.spanCache.Has(spanTests[0].epoch) {
+		t.Error("Expected the least recently used span map to be removed from memory")
+	}
+	if sm := spanMapOnDisk(t, db, spanTests[0].epoch); !reflect.DeepEqual(sm, spanTests[0].spanMap) {
+		t.Errorf("Wanted evicted span map %v on disk, received %v", spanTests[0].spanMap, sm)
+	}
+	for _, tt := range spanTests[1:] {
+		if !db.spanCache.Has(tt.epoch) {
+			t.Errorf("Expected span map of epoch %d to be in memory", tt.epoch)
+		}
+		sm, err := db.EpochSpansMap(ctx, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(sm, tt.spanMap) {
+			t.Errorf("Wanted span map %v for epoch %d, received %v", tt.spanMap, tt.epoch, sm)
+		}
+	}
+	sm, err := db.EpochSpansMap(ctx, spanTests[0].epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sm, spanTests[0].spanMap) {
+		t.Errorf("Wanted evicted span map %v to be read from disk, received %v", spanTests[0].spanMap, sm)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -15,6 +16,13 @@ import (
 func unmarshalIndexedAttestation(ctx context.Context, enc []byte) (*ethpb.IndexedAttestation, error) {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.unmarshalIndexedAttestation")
 	defer span.End()
+	if isSSZEncoded(enc) {
+		idxAtt, err := unmarshalIndexedAttestationSSZ(enc)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoded indexed attestation")
+		}
+		return idxAtt, nil
+	}
 	protoIdxAtt := &ethpb.IndexedAttestation{}
 	err := proto.Unmarshal(enc, protoIdxAtt)
 	if err != nil {
@@ -66,6 +74,63 @@ func (db *Store) IndexedAttestationsWithPrefix(ctx context.Context, targetEpoch
 	return idxAtts, err
 }
 
+// IndexedAttestationsWithPrefixForValidator returns the attestations with the requested target
+// epoch and signature prefix which the validator index is part of. The attesting indices of
+// each attestation are read without decoding the rest of it, only matching attestations are
+// fully decoded.
+func (db *Store) IndexedAttestationsWithPrefixForValidator(
+	ctx context.Context,
+	targetEpoch uint64,
+	sigBytes []byte,
+	validatorIdx uint64,
+) ([]*ethpb.IndexedAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.IndexedAttestationsWithPrefixForValidator")
+	defer span.End()
+	var idxAtts []*ethpb.IndexedAttestation
+	key := encodeEpochSig(targetEpoch, sigBytes[:])
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(historicIndexedAttestationsBucket).Cursor()
+		for k, enc := c.Seek(key); k != nil && bytes.HasPrefix(k, key); k, enc = c.Next() {
+			if isSSZEncoded(enc) {
+				attested, err := indexedAttestationHasIndexSSZ(enc, validatorIdx)
+				if err != nil {
+					return errors.Wrap(err, "failed to read attesting indices")
+				}
+				if !attested {
+					continue
+				}
+			}
+			idxAtt, err := unmarshalIndexedAttestation(ctx, enc)
+			if err != nil {
+				return err
+			}
+			if !sliceutil.IsInUint64(validatorIdx, idxAtt.AttestingIndices) {
+				continue
+			}
+			idxAtts = append(idxAtts, idxAtt)
+		}
+		return nil
+	})
+	return idxAtts, err
+}
+
+// IndexedAttestationsWithPrefixCount returns the number of attestations with the requested target
+// epoch and signature prefix, without reading them.
+func (db *Store) IndexedAttestationsWithPrefixCount(ctx context.Context, targetEpoch uint64, sigBytes []byte) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.IndexedAttestationsWithPrefixCount")
+	defer span.End()
+	var count int
+	key := encodeEpochSig(targetEpoch, sigBytes[:])
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(historicIndexedAttestationsBucket).Cursor()
+		for k, _ := c.Seek(key); k != nil && bytes.HasPrefix(k, key); k, _ = c.Next() {
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
 // HasIndexedAttestation accepts an attestation and returns true if it exists in the DB.
 func (db *Store) HasIndexedAttestation(ctx context.Context, att *ethpb.IndexedAttestation) (bool, error) {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.HasIndexedAttestation")
@@ -91,11 +156,8 @@ func (db *Store) SaveIndexedAttestation(ctx context.Context, idxAttestation *eth
 	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveIndexedAttestation")
 	defer span.End()
 	key := encodeEpochSig(idxAttestation.Data.Target.Epoch, idxAttestation.Signature)
-	enc, err := proto.Marshal(idxAttestation)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal")
-	}
-	err = db.update(func(tx *bolt.Tx) error {
+	enc := marshalIndexedAttestationSSZ(idxAttestation)
+	err := db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicIndexedAttestationsBucket)
 		//if data is in db skip put and index functions
 		val := bucket.Get(key)
@@ -105,8 +167,10 @@ func (db *Store) SaveIndexedAttestation(ctx context.Context, idxAttestation *eth
 		if err := bucket.Put(key, enc); err != nil {
 			return errors.Wrap(err, "failed to save indexed attestation into historical bucket")
 		}
-
-		return err
+		if err := updateHighestAttestations(tx, []*ethpb.IndexedAttestation{idxAttestation}); err != nil {
+			return errors.Wrap(err, "failed to update highest attestations")
+		}
+		return nil
 	})
 	return err
 }
@@ -118,16 +182,13 @@ func (db *Store) SaveIndexedAttestations(ctx context.Context, idxAttestations []
 	keys := make([][]byte, len(idxAttestations))
 	marshaledAtts := make([][]byte, len(idxAttestations))
 	for i, att := range idxAttestations {
-		enc, err := proto.Marshal(att)
-		if err != nil {
-			return errors.Wrap(err, "failed to marshal")
-		}
 		keys[i] = encodeEpochSig(att.Data.Target.Epoch, att.Signature)
-		marshaledAtts[i] = enc
+		marshaledAtts[i] = marshalIndexedAttestationSSZ(att)
 	}
 
 	err := db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicIndexedAttestationsBucket)
+		saved := make([]*ethpb.IndexedAttestation, 0, len(keys))
 		for i, key := range keys {
 			//if data is in db skip put and index functions
 			val := bucket.Get(key)
@@ -137,6 +198,10 @@ func (db *Store) SaveIndexedAttestations(ctx context.Context, idxAttestations []
 			if err := bucket.Put(key, marshaledAtts[i]); err != nil {
 				return errors.Wrap(err, "failed to save indexed attestation into historical bucket")
 			}
+			saved = append(saved, idxAttestations[i])
+		}
+		if err := updateHighestAttestations(tx, saved); err != nil {
+			return errors.Wrap(err, "failed to update highest attestations")
 		}
 		return nil
 	})
@@ -173,8 +238,16 @@ func (db *Store) PruneAttHistory(ctx context.Context, currentEpoch uint64, pruni
 	return db.update(func(tx *bolt.Tx) error {
 		attBucket := tx.Bucket(historicIndexedAttestationsBucket)
 		c := tx.Bucket(historicIndexedAttestationsBucket).Cursor()
-		max := bytesutil.Bytes8(uint64(pruneFromEpoch))
-		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], max) <= 0; k, _ = c.Next() {
+		// Keys are deleted after iterating, as deleting while iterating with the cursor would
+		// skip keys. Target epochs are little endian encoded so keys aren't sorted by epoch,
+		// every key is decoded and compared.
+		pruned := make([][]byte, 0)
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if bytesutil.FromBytes8(k[:8]) <= uint64(pruneFromEpoch) {
+				pruned = append(pruned, k)
+			}
+		}
+		for _, k := range pruned {
 			if err := attBucket.Delete(k); err != nil {
 				return errors.Wrap(err, "failed to delete indexed attestation from historical bucket")
 			}
@@ -351,6 +351,13 @@ func TestIndexedAttestationsWithPrefix(t *testing.T) {
 			if !reflect.DeepEqual(tt.expectedResult, idxAtts) {
 				t.Fatalf("Expected %v, received: %v", tt.expectedResult, idxAtts)
 			}
+			count, err := db.IndexedAttestationsWithPrefixCount(ctx, tt.targetEpoch, tt.searchPrefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != len(tt.expectedResult) {
+				t.Errorf("Expected %d attestations with the prefix, counted %d", len(tt.expectedResult), count)
+			}
 		})
 	}
 }
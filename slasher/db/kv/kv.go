@@ -1,12 +1,15 @@
 package kv
 
 import (
+	"context"
 	"os"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
+	log "github.com/sirupsen/logrus"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -15,10 +18,17 @@ var databaseFileName = "slasher.db"
 // Store defines an implementation of the slasher Database interface
 // using BoltDB as the underlying persistent kv-store for eth2.
 type Store struct {
+	// lock guards swapping the underlying database when switching genesis namespace.
+	lock             sync.RWMutex
 	db               *bolt.DB
+	dirPath          string
 	databasePath     string
+	genesisRoot      []byte
 	spanCache        *cache.EpochSpansCache
 	spanCacheEnabled bool
+	// inMemorySpans keeps all the span maps in the span cache, see Config.
+	inMemorySpans         bool
+	inMemorySpansMaxBytes uint64
 }
 
 // Config options for the slasher db.
@@ -26,10 +36,24 @@ type Config struct {
 	// SpanCacheEnabled uses span cache to detect surround slashing.
 	SpanCacheEnabled bool
 	SpanCacheSize    int
+	// InMemorySpans keeps the span maps of every epoch in memory instead of the most recently
+	// used ones. Span maps are only written to disk on snapshots, when the estimated memory
+	// taken by the span maps goes over InMemorySpansMaxBytes and when the DB is closed.
+	InMemorySpans bool
+	// InMemorySpansMaxBytes bounds the estimated memory taken by the span maps in memory spans
+	// mode, the least recently used epochs are written to disk past it. 0 disables the bound.
+	InMemorySpansMaxBytes uint64
 }
 
-// Close closes the underlying boltdb database.
+// Close closes the underlying boltdb database. In memory span maps are written to disk first.
 func (db *Store) Close() error {
+	if db.inMemorySpans {
+		if err := db.SnapshotSpanMaps(context.Background()); err != nil {
+			log.WithError(err).Error("Could not save in memory span maps")
+		}
+	}
+	db.lock.RLock()
+	defer db.lock.RUnlock()
 	return db.db.Close()
 }
 
@@ -39,25 +63,38 @@ func (db *Store) ClearSpanCache() {
 }
 
 func (db *Store) update(fn func(*bolt.Tx) error) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
 	return db.db.Update(fn)
 }
 func (db *Store) batch(fn func(*bolt.Tx) error) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
 	return db.db.Batch(fn)
 }
 func (db *Store) view(fn func(*bolt.Tx) error) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
 	return db.db.View(fn)
 }
 
-// ClearDB removes any previously stored data at the configured data directory.
+// ClearDB removes any previously stored data at the configured data directory, including the
+// databases of all genesis namespaces.
 func (db *Store) ClearDB() error {
-	if _, err := os.Stat(db.databasePath); os.IsNotExist(err) {
+	if err := removeGenesisNamespaces(db.dirPath); err != nil {
+		return err
+	}
+	datafile := path.Join(db.dirPath, databaseFileName)
+	if _, err := os.Stat(datafile); os.IsNotExist(err) {
 		return nil
 	}
-	return os.Remove(db.databasePath)
+	return os.Remove(datafile)
 }
 
 // DatabasePath at which this database writes files.
 func (db *Store) DatabasePath() string {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
 	return db.databasePath
 }
 
@@ -72,8 +109,54 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 
 // NewKVStore initializes a new boltDB key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
-// an open connection db object as a property of the Store struct.
+// an open connection db object as a property of the Store struct. Once the
+// store was namespaced, the database of the genesis namespace last used is
+// opened, so the unnamespaced database is never read or written again.
 func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
+	genesisRoot, err := currentGenesisRoot(dirPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read genesis namespace in use")
+	}
+	dbDir := dirPath
+	if genesisRoot != nil {
+		dbDir = GenesisNamespacePath(dirPath, genesisRoot)
+	}
+	datafile := path.Join(dbDir, databaseFileName)
+	boltDB, err := openBoltDB(dbDir)
+	if err != nil {
+		return nil, err
+	}
+	kv := &Store{
+		db:               boltDB,
+		dirPath:          dirPath,
+		databasePath:     datafile,
+		genesisRoot:      genesisRoot,
+		spanCacheEnabled: cfg.SpanCacheEnabled,
+	}
+	cacheSize := cfg.SpanCacheSize
+	if cfg.InMemorySpans {
+		kv.spanCacheEnabled = true
+		kv.inMemorySpans = true
+		kv.inMemorySpansMaxBytes = cfg.InMemorySpansMaxBytes
+		cacheSize = inMemorySpansCacheSize
+		log.WithField("maxBytes", cfg.InMemorySpansMaxBytes).Info("Keeping span maps in memory")
+	}
+	spanCache, err := cache.NewEpochSpansCache(cacheSize, persistSpanMapsOnEviction(kv))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create new cache")
+	}
+	kv.spanCache = spanCache
+
+	if err := kv.migrateToSSZEncoding(); err != nil {
+		return nil, errors.Wrap(err, "could not migrate records to ssz encoding")
+	}
+
+	return kv, err
+}
+
+// openBoltDB opens the slasher database in the input directory and creates the kv-buckets
+// based on the schema.
+func openBoltDB(dirPath string) (*bolt.DB, error) {
 	if err := os.MkdirAll(dirPath, 0700); err != nil {
 		return nil, err
 	}
@@ -85,14 +168,7 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 		}
 		return nil, err
 	}
-	kv := &Store{db: boltDB, databasePath: datafile, spanCacheEnabled: cfg.SpanCacheEnabled}
-	spanCache, err := cache.NewEpochSpansCache(cfg.SpanCacheSize, persistSpanMapsOnEviction(kv))
-	if err != nil {
-		return nil, errors.Wrap(err, "could not create new cache")
-	}
-	kv.spanCache = spanCache
-
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
 			indexedAttestationsBucket,
@@ -104,18 +180,22 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 			validatorsMinMaxSpanBucket,
 			slashingBucket,
 			chainDataBucket,
+			detectionContextBucket,
+			deferredDetectionsBucket,
+			pendingAttestationsBucket,
+			highestAttestationsBucket,
+			proposalHistoryBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
-
-	return kv, err
+	return boltDB, nil
 }
 
 // Size returns the db size in bytes.
 func (db *Store) Size() (int64, error) {
 	var size int64
-	err := db.db.View(func(tx *bolt.Tx) error {
+	err := db.view(func(tx *bolt.Tx) error {
 		size = tx.Size()
 		return nil
 	})
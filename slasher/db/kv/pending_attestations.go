@@ -0,0 +1,181 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PendingAttestations returns up to limit attestations waiting for detection, ordered by target
+// epoch, skipping the first offset attestations of the queue.
+func (db *Store) PendingAttestations(ctx context.Context, offset int, limit int) ([]*ethpb.IndexedAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PendingAttestations")
+	defer span.End()
+	var atts []*ethpb.IndexedAttestation
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(pendingAttestationsBucket).Cursor()
+		k, v := c.First()
+		for i := 0; k != nil && i < offset; i++ {
+			k, v = c.Next()
+		}
+		for ; k != nil && len(atts) < limit; k, v = c.Next() {
+			_, att, err := decodePendingAttestation(v)
+			if err != nil {
+				return err
+			}
+			atts = append(atts, att)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal pending attestation")
+	}
+	return atts, nil
+}
+
+// PendingAttestationsCount returns the number of attestations waiting for detection.
+func (db *Store) PendingAttestationsCount(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PendingAttestationsCount")
+	defer span.End()
+	var count uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		count = queueLength(tx, pendingAttestationsBucket, pendingCountKey)
+		return nil
+	})
+	return int(count), err
+}
+
+// SavePendingAttestations queues attestations until detection has processed them, so they are
+// processed again after a restart if the slasher stopped before. Saving an attestation already
+// queued is a no-op, its failed detection attempts are kept.
+func (db *Store) SavePendingAttestations(ctx context.Context, atts []*ethpb.IndexedAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SavePendingAttestations")
+	defer span.End()
+	keys := make([][]byte, len(atts))
+	encoded := make([][]byte, len(atts))
+	for i, att := range atts {
+		key, err := pendingAttestationKey(att)
+		if err != nil {
+			return err
+		}
+		enc, err := encodePendingAttestation(0, att)
+		if err != nil {
+			return err
+		}
+		keys[i], encoded[i] = key, enc
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(pendingAttestationsBucket)
+		count := queueLength(tx, pendingAttestationsBucket, pendingCountKey)
+		queued := count
+		for i := range keys {
+			if bucket.Get(keys[i]) != nil {
+				continue
+			}
+			if err := bucket.Put(keys[i], encoded[i]); err != nil {
+				return err
+			}
+			queued++
+		}
+		if queued == count {
+			return nil
+		}
+		return setQueueLength(tx, pendingCountKey, queued)
+	})
+}
+
+// RecordPendingAttestationFailure counts a failed detection attempt of a queued attestation and
+// returns the number of failed attempts so far, 0 if the attestation isn't queued.
+func (db *Store) RecordPendingAttestationFailure(ctx context.Context, att *ethpb.IndexedAttestation) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.RecordPendingAttestationFailure")
+	defer span.End()
+	key, err := pendingAttestationKey(att)
+	if err != nil {
+		return 0, err
+	}
+	var attempts uint64
+	err = db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(pendingAttestationsBucket)
+		enc := bucket.Get(key)
+		if enc == nil {
+			return nil
+		}
+		failed, queued, err := decodePendingAttestation(enc)
+		if err != nil {
+			return err
+		}
+		attempts = failed + 1
+		enc, err = encodePendingAttestation(attempts, queued)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(key, enc)
+	})
+	return attempts, err
+}
+
+// DeletePendingAttestation removes an attestation processed by detection from the queue.
+func (db *Store) DeletePendingAttestation(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.DeletePendingAttestation")
+	defer span.End()
+	key, err := pendingAttestationKey(att)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(pendingAttestationsBucket)
+		if bucket.Get(key) == nil {
+			return nil
+		}
+		count := queueLength(tx, pendingAttestationsBucket, pendingCountKey)
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+		if count > 0 {
+			count--
+		}
+		return setQueueLength(tx, pendingCountKey, count)
+	})
+}
+
+// pendingAttestationKey prefixes the attestation hash with its big endian target epoch, so the
+// keys sort by epoch and the queue is replayed from the oldest attestations first.
+func pendingAttestationKey(att *ethpb.IndexedAttestation) ([]byte, error) {
+	if att == nil || att.Data == nil || att.Data.Target == nil {
+		return nil, errors.New("pending attestation is missing its target")
+	}
+	root, err := hashutil.HashProto(att)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hash root of pending attestation")
+	}
+	key := make([]byte, 8, 8+len(root))
+	binary.BigEndian.PutUint64(key, att.Data.Target.Epoch)
+	return append(key, root[:]...), nil
+}
+
+// A queued attestation is saved along with its failed detection attempts.
+func encodePendingAttestation(attempts uint64, att *ethpb.IndexedAttestation) ([]byte, error) {
+	enc, err := proto.Marshal(att)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode pending attestation")
+	}
+	return append(bytesutil.Bytes8(attempts), enc...), nil
+}
+
+func decodePendingAttestation(enc []byte) (uint64, *ethpb.IndexedAttestation, error) {
+	if len(enc) < 8 {
+		return 0, nil, errors.New("pending attestation is too short")
+	}
+	att := &ethpb.IndexedAttestation{}
+	if err := proto.Unmarshal(enc[8:], att); err != nil {
+		return 0, nil, err
+	}
+	return bytesutil.FromBytes8(enc[:8]), att, nil
+}
@@ -0,0 +1,118 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_PendingAttestations(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	atts := []*ethpb.IndexedAttestation{
+		{
+			AttestingIndices: []uint64{1},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 255},
+				Target: &ethpb.Checkpoint{Epoch: 256},
+			},
+			Signature: []byte("sig1"),
+		},
+		{
+			AttestingIndices: []uint64{2},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 2},
+				Target: &ethpb.Checkpoint{Epoch: 3},
+			},
+			Signature: []byte("sig2"),
+		},
+	}
+	if err := db.SavePendingAttestations(ctx, atts); err != nil {
+		t.Fatal(err)
+	}
+	// Queueing an attestation again doesn't duplicate it.
+	if err := db.SavePendingAttestations(ctx, atts[:1]); err != nil {
+		t.Fatal(err)
+	}
+	count, err := db.PendingAttestationsCount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != len(atts) {
+		t.Errorf("Wanted %d pending attestations counted, received %d", len(atts), count)
+	}
+
+	received, err := db.PendingAttestations(ctx, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != len(atts) {
+		t.Fatalf("Wanted %d pending attestations, received %d", len(atts), len(received))
+	}
+	// The queue is replayed from the lowest target epoch.
+	if !proto.Equal(received[0], atts[1]) {
+		t.Errorf("Wanted oldest attestation %v first, received %v", atts[1], received[0])
+	}
+	received, err = db.PendingAttestations(ctx, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || !proto.Equal(received[0], atts[0]) {
+		t.Errorf("Wanted only %v past the offset, received %v", atts[0], received)
+	}
+
+	// Failed attempts are counted, and kept when the attestation is queued again.
+	for want := uint64(1); want <= 2; want++ {
+		attempts, err := db.RecordPendingAttestationFailure(ctx, atts[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if attempts != want {
+			t.Errorf("Wanted %d failed attempts, received %d", want, attempts)
+		}
+		if err := db.SavePendingAttestations(ctx, atts[:1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := db.DeletePendingAttestation(ctx, atts[1]); err != nil {
+		t.Fatal(err)
+	}
+	received, err = db.PendingAttestations(ctx, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || !proto.Equal(received[0], atts[0]) {
+		t.Errorf("Wanted only %v to remain, received %v", atts[0], received)
+	}
+	// Deleting an attestation no longer queued doesn't change the count.
+	if err := db.DeletePendingAttestation(ctx, atts[1]); err != nil {
+		t.Fatal(err)
+	}
+	count, err = db.PendingAttestationsCount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("Wanted 1 pending attestation counted, received %d", count)
+	}
+	attempts, err := db.RecordPendingAttestationFailure(ctx, atts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 0 {
+		t.Errorf("Wanted no attempts recorded for an attestation not queued, received %d", attempts)
+	}
+
+	if err := db.SavePendingAttestations(ctx, []*ethpb.IndexedAttestation{{}}); err == nil {
+		t.Error("Wanted an error queueing an attestation without a target")
+	}
+}
@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ProposalHistoryForSlot returns all the block headers of the proposer seen for a slot, across
+// forks. Returns nil if no block header of the proposer was seen for the slot.
+func (db *Store) ProposalHistoryForSlot(ctx context.Context, proposerIdx uint64, slot uint64) ([]*ethpb.SignedBeaconBlockHeader, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.ProposalHistoryForSlot")
+	defer span.End()
+	var headers []*ethpb.SignedBeaconBlockHeader
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(proposalHistoryBucket).Cursor()
+		prefix := encodeSlotValidatorID(slot, proposerIdx)
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			header, err := unmarshalBlockHeader(ctx, v)
+			if err != nil {
+				return err
+			}
+			headers = append(headers, header)
+		}
+		return nil
+	})
+	return headers, err
+}
+
+// SaveProposalHistory adds a block header to the proposal history of the proposer. Headers are
+// keyed by slot and signature, so every distinct header of a slot is kept.
+func (db *Store) SaveProposalHistory(ctx context.Context, proposerIdx uint64, header *ethpb.SignedBeaconBlockHeader) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveProposalHistory")
+	defer span.End()
+	key := encodeSlotValidatorIDSig(header.Header.Slot, proposerIdx, header.Signature)
+	enc := marshalBlockHeaderSSZ(header)
+	return db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(proposalHistoryBucket).Put(key, enc); err != nil {
+			return errors.Wrap(err, "failed to save block header into proposal history bucket")
+		}
+		return nil
+	})
+}
+
+// PruneProposalHistory deletes the proposal history of all epochs older than the pruning epoch age.
+func (db *Store) PruneProposalHistory(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneProposalHistory")
+	defer span.End()
+	pruneTill := int64(currentEpoch) - int64(pruningEpochAge)
+	if pruneTill <= 0 {
+		return nil
+	}
+	// Slots of the last pruned epoch are included.
+	maxSlot := (uint64(pruneTill)+1)*params.BeaconConfig().SlotsPerEpoch - 1
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(proposalHistoryBucket)
+		c := bucket.Cursor()
+		// Slots are little endian encoded so keys aren't sorted by slot, every key is decoded and
+		// compared instead of stopping at the first newer one.
+		pruned := make([][]byte, 0)
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if bytesutil.FromBytes8(k[:8]) <= maxSlot {
+				pruned = append(pruned, k)
+			}
+		}
+		for _, k := range pruned {
+			if err := bucket.Delete(k); err != nil {
+				return errors.Wrap(err, "failed to delete block header from proposal history bucket")
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,95 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_ProposalHistory(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	headers := []*ethpb.SignedBeaconBlockHeader{
+		{Header: &ethpb.BeaconBlockHeader{Slot: 1}, Signature: []byte("fork 1")},
+		{Header: &ethpb.BeaconBlockHeader{Slot: 1}, Signature: []byte("fork 2")},
+		{Header: &ethpb.BeaconBlockHeader{Slot: 4 * slotsPerEpoch}, Signature: []byte("later")},
+	}
+	for _, h := range headers {
+		if err := db.SaveProposalHistory(ctx, 0, h); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	history, err := db.ProposalHistoryForSlot(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Errorf("Wanted 2 headers for slot 1, received %d", len(history))
+	}
+	history, err = db.ProposalHistoryForSlot(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history != nil {
+		t.Errorf("Wanted no headers for another proposer, received %d", len(history))
+	}
+
+	if err := db.PruneProposalHistory(ctx, 3, 2); err != nil {
+		t.Fatal(err)
+	}
+	history, err = db.ProposalHistoryForSlot(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Errorf("Wanted slot 1 history pruned, received %d headers", len(history))
+	}
+	history, err = db.ProposalHistoryForSlot(ctx, 0, 4*slotsPerEpoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Errorf("Wanted later history kept, received %d headers", len(history))
+	}
+}
+
+func TestStore_PruneProposalHistory_SlotsAboveByteRange(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Little endian encoded, slot 256 sorts before slot 5 which sorts before slot 300.
+	slots := []uint64{5, 256, 300}
+	for _, slot := range slots {
+		h := &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: slot}, Signature: []byte("sig")}
+		if err := db.SaveProposalHistory(ctx, 0, h); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Prunes the slots of epochs 0 and 1, which only include slot 5.
+	if err := db.PruneProposalHistory(ctx, 10, 9); err != nil {
+		t.Fatal(err)
+	}
+	for _, slot := range slots {
+		history, err := db.ProposalHistoryForSlot(ctx, 0, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pruned := slot == 5; pruned != (len(history) == 0) {
+			t.Errorf("Slot %d: wanted history pruned %v, received %d headers", slot, pruned, len(history))
+		}
+	}
+}
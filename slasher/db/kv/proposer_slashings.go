@@ -72,6 +72,9 @@ func (db *Store) DeleteProposerSlashing(ctx context.Context, slashing *ethpb.Pro
 		if err := bucket.Delete(k); err != nil {
 			return errors.Wrap(err, "failed to delete the slashing proof from slashing bucket")
 		}
+		if err := tx.Bucket(detectionContextBucket).Delete(k); err != nil {
+			return errors.Wrap(err, "failed to delete the detection context of the slashing proof")
+		}
 		return nil
 	})
 	return err
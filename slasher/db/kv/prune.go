@@ -0,0 +1,61 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneHistory deletes the span maps, indexed attestations, block headers and proposal history of
+// all epochs older than the pruning epoch age. Detection only looks back as far as the weak
+// subjectivity period, so data older than that can be reclaimed.
+func (db *Store) PruneHistory(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneHistory")
+	defer span.End()
+	if err := db.PruneSpanMaps(ctx, currentEpoch, pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune span maps")
+	}
+	if err := db.PruneAttHistory(ctx, currentEpoch, pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune indexed attestations")
+	}
+	if err := db.PruneBlockHistory(ctx, currentEpoch, pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune block headers")
+	}
+	if err := db.PruneProposalHistory(ctx, currentEpoch, pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune proposal history")
+	}
+	return nil
+}
+
+// CompactHistory deletes the raw history of all epochs older than the compaction age, the same
+// data PruneHistory deletes, while the detected slashings are kept along with the detection
+// context recorded for them. Detection contexts left without a slashing are deleted too, so only
+// the evidence of detected offences survives compaction and long term disk use stays flat.
+func (db *Store) CompactHistory(ctx context.Context, currentEpoch uint64, compactionEpochAge uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.CompactHistory")
+	defer span.End()
+	if err := db.PruneHistory(ctx, currentEpoch, compactionEpochAge); err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		slashings := tx.Bucket(slashingBucket)
+		contexts := tx.Bucket(detectionContextBucket)
+		// Keys are deleted after iterating, as deleting while iterating with the cursor would
+		// skip keys.
+		orphaned := make([][]byte, 0)
+		c := contexts.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if slashings.Get(k) == nil {
+				orphaned = append(orphaned, k)
+			}
+		}
+		for _, k := range orphaned {
+			if err := contexts.Delete(k); err != nil {
+				return errors.Wrap(err, "failed to delete orphaned detection context")
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,231 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for _, tt := range spanTests {
+		if err := db.SaveEpochSpansMap(ctx, tt.epoch, tt.spanMap); err != nil {
+			t.Fatal(err)
+		}
+		att := &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: tt.epoch - 1},
+				Target: &ethpb.Checkpoint{Epoch: tt.epoch},
+			},
+			Signature: []byte{byte(tt.epoch)},
+		}
+		if err := db.SaveIndexedAttestation(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+		header := &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{Slot: tt.epoch * params.BeaconConfig().SlotsPerEpoch},
+			Signature: []byte{byte(tt.epoch)},
+		}
+		if err := db.SaveBlockHeader(ctx, 1, header); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Epochs 1 and 2 are older than the pruning epoch age.
+	if err := db.PruneHistory(ctx, 5, 3); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range spanTests {
+		pruned := tt.epoch <= 2
+		spanMap, err := db.EpochSpansMap(ctx, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pruned != (len(spanMap) == 0) {
+			t.Errorf("Epoch %d: wanted span map pruned %v, got %d spans", tt.epoch, pruned, len(spanMap))
+		}
+		atts, err := db.IndexedAttestationsForTarget(ctx, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pruned != (len(atts) == 0) {
+			t.Errorf("Epoch %d: wanted attestations pruned %v, got %d attestations", tt.epoch, pruned, len(atts))
+		}
+		if pruned == db.HasBlockHeader(ctx, tt.epoch, 1) {
+			t.Errorf("Epoch %d: wanted block header pruned %v", tt.epoch, pruned)
+		}
+	}
+}
+
+func TestStore_PruneHistory_EpochsAboveByteRange(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Little endian encoded, epoch 256 sorts before epoch 5 which sorts before epoch 300.
+	epochs := []uint64{5, 256, 300}
+	for _, epoch := range epochs {
+		spanMap := map[uint64]types.Span{1: {MinSpan: 1, MaxSpan: 2}}
+		if err := db.SaveEpochSpansMap(ctx, epoch, spanMap); err != nil {
+			t.Fatal(err)
+		}
+		att := &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: epoch - 1},
+				Target: &ethpb.Checkpoint{Epoch: epoch},
+			},
+			Signature: bytesutil.Bytes8(epoch),
+		}
+		if err := db.SaveIndexedAttestation(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+		header := &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{Slot: epoch * params.BeaconConfig().SlotsPerEpoch},
+			Signature: bytesutil.Bytes8(epoch),
+		}
+		if err := db.SaveBlockHeader(ctx, 1, header); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only epoch 5 is older than the pruning epoch age.
+	if err := db.PruneHistory(ctx, 300, 290); err != nil {
+		t.Fatal(err)
+	}
+	for _, epoch := range epochs {
+		pruned := epoch == 5
+		spanMap, err := db.EpochSpansMap(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pruned != (len(spanMap) == 0) {
+			t.Errorf("Epoch %d: wanted span map pruned %v, got %d spans", epoch, pruned, len(spanMap))
+		}
+		atts, err := db.IndexedAttestationsForTarget(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pruned != (len(atts) == 0) {
+			t.Errorf("Epoch %d: wanted attestations pruned %v, got %d attestations", epoch, pruned, len(atts))
+		}
+		if pruned == db.HasBlockHeader(ctx, epoch, 1) {
+			t.Errorf("Epoch %d: wanted block header pruned %v", epoch, pruned)
+		}
+	}
+}
+
+func TestStore_PruneSpanMaps_NothingToPrune(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for _, tt := range spanTests {
+		if err := db.SaveEpochSpansMap(ctx, tt.epoch, tt.spanMap); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.PruneSpanMaps(ctx, 3, params.BeaconConfig().WeakSubjectivityPeriod); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range spanTests {
+		spanMap, err := db.EpochSpansMap(ctx, tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(spanMap) != len(tt.spanMap) {
+			t.Errorf("Epoch %d: wanted %d spans, got %d", tt.epoch, len(tt.spanMap), len(spanMap))
+		}
+	}
+}
+
+func TestStore_CompactHistory_KeepsSlashings(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	att1 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		},
+		Signature: []byte("sig1"),
+	}
+	att2 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		},
+		Signature: []byte("sig2"),
+	}
+	if err := db.SaveIndexedAttestations(ctx, []*ethpb.IndexedAttestation{att1, att2}); err != nil {
+		t.Fatal(err)
+	}
+	slashing := &ethpb.AttesterSlashing{Attestation_1: att1, Attestation_2: att2}
+	if err := db.SaveAttesterSlashing(ctx, types.Active, slashing); err != nil {
+		t.Fatal(err)
+	}
+	detectionCtx := &slashpb.DetectionContext{HeadBlockRoot: []byte("head"), HeadSlot: 64}
+	if err := db.SaveAttesterSlashingContext(ctx, slashing, detectionCtx); err != nil {
+		t.Fatal(err)
+	}
+	// A context whose slashing was deleted isn't evidence of anything anymore.
+	orphan := &ethpb.AttesterSlashing{Attestation_1: att2, Attestation_2: att1}
+	if err := db.SaveAttesterSlashingContext(ctx, orphan, detectionCtx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.CompactHistory(ctx, 10, 2); err != nil {
+		t.Fatal(err)
+	}
+	atts, err := db.IndexedAttestationsForTarget(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 0 {
+		t.Errorf("Wanted raw attestations compacted, got %d attestations", len(atts))
+	}
+	found, _, err := db.HasAttesterSlashing(ctx, slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Error("Wanted slashing to be kept by compaction")
+	}
+	kept, err := db.AttesterSlashingContext(ctx, slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(kept, detectionCtx) {
+		t.Errorf("Wanted detection context %v to be kept, got %v", detectionCtx, kept)
+	}
+	orphaned, err := db.AttesterSlashingContext(ctx, orphan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orphaned != nil {
+		t.Error("Wanted orphaned detection context to be deleted")
+	}
+}
@@ -3,11 +3,17 @@ package kv
 import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	bolt "go.etcd.io/bbolt"
 )
 
 const (
 	latestEpochKey       = "LATEST_EPOCH_DETECTED"
 	chainHeadKey         = "CHAIN_HEAD"
+	sszMigrationKey      = "SSZ_ENCODING_MIGRATED"
+	genesisRootKey       = "GENESIS_ROOT"
+	spansLengthKey       = "SPANS_LENGTH"
+	deferredCountKey     = "DEFERRED_DETECTIONS_COUNT"
+	pendingCountKey      = "PENDING_ATTESTATIONS_COUNT"
 	cachedSpanerEpochs   = 256
 	spannerEncodedLength = 7
 )
@@ -22,6 +28,14 @@ var (
 	chainDataBucket                   = []byte("chain-data-bucket")
 	compressedIdxAttsBucket           = []byte("compressed-idx-atts-bucket")
 	validatorsPublicKeysBucket        = []byte("validators-public-keys-bucket")
+	// Chain context observed at detection time, keyed like the slashing bucket.
+	detectionContextBucket = []byte("detection-context-bucket")
+	// Highest source and target epochs attested to, by validator index.
+	highestAttestationsBucket = []byte("highest-attestations-bucket")
+	// Detection results queued for the deep check worker.
+	deferredDetectionsBucket = []byte("deferred-detections-bucket")
+	// Incoming attestations queued until detection has processed them.
+	pendingAttestationsBucket = []byte("pending-attestations-bucket")
 	// In order to quickly detect surround and surrounded attestations we need to store
 	// the min and max span for each validator for each epoch.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
@@ -36,6 +50,14 @@ func encodeEpochValidatorIDSig(epoch uint64, validatorID uint64, sig []byte) []b
 	return append(append(bytesutil.Bytes8(epoch), bytesutil.Bytes8(validatorID)...), sig...)
 }
 
+func encodeSlotValidatorID(slot uint64, validatorID uint64) []byte {
+	return append(bytesutil.Bytes8(slot), bytesutil.Bytes8(validatorID)...)
+}
+
+func encodeSlotValidatorIDSig(slot uint64, validatorID uint64, sig []byte) []byte {
+	return append(encodeSlotValidatorID(slot, validatorID), sig...)
+}
+
 func encodeEpochSig(targetEpoch uint64, sig []byte) []byte {
 	return append(bytesutil.Bytes8(targetEpoch), sig...)
 }
@@ -45,3 +67,17 @@ func encodeType(st types.SlashingType) []byte {
 func encodeTypeRoot(st types.SlashingType, root [32]byte) []byte {
 	return append([]byte{byte(st)}, root[:]...)
 }
+
+// queueLength returns the number of entries of a queue bucket, stored under the countKey of the
+// chain data bucket so it is read without walking the queue. Databases written before the count
+// was stored have their queue counted once, until the count is first stored.
+func queueLength(tx *bolt.Tx, queue []byte, countKey string) uint64 {
+	if enc := tx.Bucket(chainDataBucket).Get([]byte(countKey)); enc != nil {
+		return bytesutil.FromBytes8(enc)
+	}
+	return uint64(tx.Bucket(queue).Stats().KeyN)
+}
+
+func setQueueLength(tx *bolt.Tx, countKey string, length uint64) error {
+	return tx.Bucket(chainDataBucket).Put([]byte(countKey), bytesutil.Bytes8(length))
+}
@@ -224,7 +224,11 @@ func (db *Store) SaveEpochSpansMap(ctx context.Context, epoch uint64, spanMap ma
 	defer span.End()
 	if db.spanCacheEnabled {
 		setObservedEpochs(epoch)
+		cached := db.spanCache.Has(epoch)
 		db.spanCache.Set(epoch, spanMap)
+		if !cached {
+			db.enforceInMemorySpansLimit()
+		}
 		return nil
 	}
 
@@ -287,6 +291,43 @@ func (db *Store) DeleteEpochSpans(ctx context.Context, epoch uint64) error {
 	})
 }
 
+// PruneSpanMaps deletes the span maps of all epochs older than the pruning epoch age, from
+// both the DB and the span cache.
+func (db *Store) PruneSpanMaps(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneSpanMaps")
+	defer span.End()
+	pruneTill := int64(currentEpoch) - int64(pruningEpochAge)
+	if pruneTill <= 0 {
+		return nil
+	}
+	// Evicting a span map from the cache persists it to the DB, so cached span maps are evicted
+	// before the DB is pruned, outside of the pruning transaction.
+	if db.spanCacheEnabled {
+		for epoch := lowestObservedEpoch; epoch <= uint64(pruneTill) && epoch <= highestObservedEpoch; epoch++ {
+			db.spanCache.Delete(epoch)
+		}
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorsMinMaxSpanBucket)
+		c := bucket.Cursor()
+		// Epoch buckets are deleted after iterating, as deleting while iterating with the
+		// cursor would skip keys. Epochs are little endian encoded so keys aren't sorted by
+		// epoch, every key is decoded and compared instead of stopping at the first newer one.
+		pruned := make([][]byte, 0)
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if bytesutil.FromBytes8(k) <= uint64(pruneTill) {
+				pruned = append(pruned, k)
+			}
+		}
+		for _, k := range pruned {
+			if err := bucket.DeleteBucket(k); err != nil {
+				return errors.Wrap(err, "failed to delete span map from validators min max span bucket")
+			}
+		}
+		return nil
+	})
+}
+
 // DeleteValidatorSpanByEpoch deletes a validator span for a certain epoch
 // deletes spans from cache if caching is enabled.
 // using a validator index as bucket key.
@@ -328,6 +369,7 @@ func (db *Store) findOrLoadEpochInCache(ctx context.Context, epoch uint64) (map[
 		return make(map[uint64]types.Span), errors.Wrap(err, "failed to get span map for epoch")
 	}
 	db.spanCache.Set(epoch, spanForEpoch)
+	db.enforceInMemorySpansLimit()
 	return spanForEpoch, nil
 }
 
@@ -0,0 +1,253 @@
+package kv
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// sszEncodingPrefix marks values stored with the SSZ layouts of this file. Protobuf encodings
+// never start with a zero byte, as field number 0 is invalid, so values written before the
+// SSZ migration can still be told apart.
+const sszEncodingPrefix = byte(0)
+
+// Every value starts with a fixed size part holding the integer fields at fixed positions and
+// 4 byte little endian offsets to the variable size parts appended after it, the way SSZ lays
+// out containers. Byte fields are variable size parts rather than fixed size SSZ vectors so
+// their exact length is kept. The presence byte records which nested messages are set.
+const (
+	// Indexed attestation layout.
+	attIndicesOffsetPos    = 1
+	attSlotPos             = 5
+	attCommitteeIndexPos   = 13
+	attSourceEpochPos      = 21
+	attTargetEpochPos      = 29
+	attPresencePos         = 37
+	attBlockRootOffsetPos  = 38
+	attSourceRootOffsetPos = 42
+	attTargetRootOffsetPos = 46
+	attSignatureOffsetPos  = 50
+	attFixedSize           = 54
+
+	// Signed block header layout.
+	headerSlotPos             = 1
+	headerPresencePos         = 9
+	headerParentRootOffsetPos = 10
+	headerStateRootOffsetPos  = 14
+	headerBodyRootOffsetPos   = 18
+	headerSignatureOffsetPos  = 22
+	headerFixedSize           = 26
+)
+
+const (
+	presenceData = 1 << iota
+	presenceSource
+	presenceTarget
+)
+
+const presenceHeader = 1
+
+var (
+	attOffsetPositions = []int{
+		attIndicesOffsetPos,
+		attBlockRootOffsetPos,
+		attSourceRootOffsetPos,
+		attTargetRootOffsetPos,
+		attSignatureOffsetPos,
+	}
+	headerOffsetPositions = []int{
+		headerParentRootOffsetPos,
+		headerStateRootOffsetPos,
+		headerBodyRootOffsetPos,
+		headerSignatureOffsetPos,
+	}
+)
+
+func isSSZEncoded(enc []byte) bool {
+	return len(enc) > 0 && enc[0] == sszEncodingPrefix
+}
+
+// marshalIndexedAttestationSSZ encodes an indexed attestation with its epochs and attesting
+// indices at fixed offsets.
+func marshalIndexedAttestationSSZ(att *ethpb.IndexedAttestation) []byte {
+	fixed := make([]byte, attFixedSize)
+	fixed[0] = sszEncodingPrefix
+	var blockRoot, sourceRoot, targetRoot []byte
+	if d := att.Data; d != nil {
+		fixed[attPresencePos] |= presenceData
+		binary.LittleEndian.PutUint64(fixed[attSlotPos:], d.Slot)
+		binary.LittleEndian.PutUint64(fixed[attCommitteeIndexPos:], d.CommitteeIndex)
+		blockRoot = d.BeaconBlockRoot
+		if d.Source != nil {
+			fixed[attPresencePos] |= presenceSource
+			binary.LittleEndian.PutUint64(fixed[attSourceEpochPos:], d.Source.Epoch)
+			sourceRoot = d.Source.Root
+		}
+		if d.Target != nil {
+			fixed[attPresencePos] |= presenceTarget
+			binary.LittleEndian.PutUint64(fixed[attTargetEpochPos:], d.Target.Epoch)
+			targetRoot = d.Target.Root
+		}
+	}
+	indices := make([]byte, 8*len(att.AttestingIndices))
+	for i, idx := range att.AttestingIndices {
+		binary.LittleEndian.PutUint64(indices[8*i:], idx)
+	}
+	return appendVariableParts(fixed, attOffsetPositions, [][]byte{
+		indices,
+		blockRoot,
+		sourceRoot,
+		targetRoot,
+		att.Signature,
+	})
+}
+
+// unmarshalIndexedAttestationSSZ decodes an indexed attestation encoded with
+// marshalIndexedAttestationSSZ.
+func unmarshalIndexedAttestationSSZ(enc []byte) (*ethpb.IndexedAttestation, error) {
+	if len(enc) < attFixedSize {
+		return nil, errors.New("encoded indexed attestation too short")
+	}
+	parts, err := variableParts(enc, attOffsetPositions)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts[0])%8 != 0 {
+		return nil, errors.New("invalid attesting indices length")
+	}
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: decodeIndices(parts[0]),
+		Signature:        parts[4],
+	}
+	presence := enc[attPresencePos]
+	if presence&presenceData == 0 {
+		return att, nil
+	}
+	att.Data = &ethpb.AttestationData{
+		Slot:            binary.LittleEndian.Uint64(enc[attSlotPos:]),
+		CommitteeIndex:  binary.LittleEndian.Uint64(enc[attCommitteeIndexPos:]),
+		BeaconBlockRoot: parts[1],
+	}
+	if presence&presenceSource != 0 {
+		att.Data.Source = &ethpb.Checkpoint{
+			Epoch: binary.LittleEndian.Uint64(enc[attSourceEpochPos:]),
+			Root:  parts[2],
+		}
+	}
+	if presence&presenceTarget != 0 {
+		att.Data.Target = &ethpb.Checkpoint{
+			Epoch: binary.LittleEndian.Uint64(enc[attTargetEpochPos:]),
+			Root:  parts[3],
+		}
+	}
+	return att, nil
+}
+
+// indexedAttestationHasIndexSSZ reports whether the validator index is one of the attesting
+// indices of an SSZ encoded indexed attestation, reading the indices in place.
+func indexedAttestationHasIndexSSZ(enc []byte, validatorIdx uint64) (bool, error) {
+	if len(enc) < attFixedSize {
+		return false, errors.New("encoded indexed attestation too short")
+	}
+	start := int(binary.LittleEndian.Uint32(enc[attIndicesOffsetPos:]))
+	end := int(binary.LittleEndian.Uint32(enc[attBlockRootOffsetPos:]))
+	if start > end || end > len(enc) || (end-start)%8 != 0 {
+		return false, errors.New("invalid attesting indices offsets")
+	}
+	for i := start; i < end; i += 8 {
+		if binary.LittleEndian.Uint64(enc[i:]) == validatorIdx {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// marshalBlockHeaderSSZ encodes a signed block header.
+func marshalBlockHeaderSSZ(header *ethpb.SignedBeaconBlockHeader) []byte {
+	fixed := make([]byte, headerFixedSize)
+	fixed[0] = sszEncodingPrefix
+	var parentRoot, stateRoot, bodyRoot []byte
+	if h := header.Header; h != nil {
+		fixed[headerPresencePos] |= presenceHeader
+		binary.LittleEndian.PutUint64(fixed[headerSlotPos:], h.Slot)
+		parentRoot, stateRoot, bodyRoot = h.ParentRoot, h.StateRoot, h.BodyRoot
+	}
+	return appendVariableParts(fixed, headerOffsetPositions, [][]byte{
+		parentRoot,
+		stateRoot,
+		bodyRoot,
+		header.Signature,
+	})
+}
+
+// unmarshalBlockHeaderSSZ decodes a signed block header encoded with marshalBlockHeaderSSZ.
+func unmarshalBlockHeaderSSZ(enc []byte) (*ethpb.SignedBeaconBlockHeader, error) {
+	if len(enc) < headerFixedSize {
+		return nil, errors.New("encoded block header too short")
+	}
+	parts, err := variableParts(enc, headerOffsetPositions)
+	if err != nil {
+		return nil, err
+	}
+	header := &ethpb.SignedBeaconBlockHeader{Signature: parts[3]}
+	if enc[headerPresencePos]&presenceHeader != 0 {
+		header.Header = &ethpb.BeaconBlockHeader{
+			Slot:       binary.LittleEndian.Uint64(enc[headerSlotPos:]),
+			ParentRoot: parts[0],
+			StateRoot:  parts[1],
+			BodyRoot:   parts[2],
+		}
+	}
+	return header, nil
+}
+
+// appendVariableParts appends the variable size parts to the fixed part, writing the offset
+// of each part at its position in the fixed part.
+func appendVariableParts(fixed []byte, offsetPositions []int, parts [][]byte) []byte {
+	size := len(fixed)
+	for _, p := range parts {
+		size += len(p)
+	}
+	enc := make([]byte, len(fixed), size)
+	copy(enc, fixed)
+	for i, p := range parts {
+		binary.LittleEndian.PutUint32(enc[offsetPositions[i]:], uint32(len(enc)))
+		enc = append(enc, p...)
+	}
+	return enc
+}
+
+// variableParts returns copies of the variable size parts of an encoding, a part ending
+// where the next one starts and the last one at the end of the encoding. Empty parts are nil.
+func variableParts(enc []byte, offsetPositions []int) ([][]byte, error) {
+	parts := make([][]byte, len(offsetPositions))
+	for i, pos := range offsetPositions {
+		start := int(binary.LittleEndian.Uint32(enc[pos:]))
+		end := len(enc)
+		if i+1 < len(offsetPositions) {
+			end = int(binary.LittleEndian.Uint32(enc[offsetPositions[i+1]:]))
+		}
+		if start > end || end > len(enc) {
+			return nil, errors.New("invalid variable part offsets")
+		}
+		if start == end {
+			continue
+		}
+		// Values read from bolt are only valid within the transaction, copy them out.
+		parts[i] = make([]byte, end-start)
+		copy(parts[i], enc[start:end])
+	}
+	return parts, nil
+}
+
+func decodeIndices(enc []byte) []uint64 {
+	if len(enc) == 0 {
+		return nil
+	}
+	indices := make([]uint64, len(enc)/8)
+	for i := range indices {
+		indices[i] = binary.LittleEndian.Uint64(enc[8*i:])
+	}
+	return indices
+}
@@ -0,0 +1,218 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func benchmarkIndexedAttestation() *ethpb.IndexedAttestation {
+	indices := make([]uint64, 128)
+	for i := range indices {
+		indices[i] = uint64(i * 3)
+	}
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &ethpb.AttestationData{
+			Slot:            100,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 10, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 11, Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestIndexedAttestationSSZ_RoundTrip(t *testing.T) {
+	atts := []*ethpb.IndexedAttestation{
+		benchmarkIndexedAttestation(),
+		{},
+		{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 1}}, Signature: []byte{1, 2}},
+	}
+	for _, tt := range tests {
+		atts = append(atts, tt.idxAtt)
+	}
+	for _, att := range atts {
+		enc := marshalIndexedAttestationSSZ(att)
+		if !isSSZEncoded(enc) {
+			t.Fatal("Expected ssz encoded attestation")
+		}
+		decoded, err := unmarshalIndexedAttestationSSZ(enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(att, decoded) {
+			t.Errorf("Wanted %v, received %v", att, decoded)
+		}
+	}
+}
+
+func TestIndexedAttestationSSZ_HasIndex(t *testing.T) {
+	att := benchmarkIndexedAttestation()
+	enc := marshalIndexedAttestationSSZ(att)
+	for _, idx := range []uint64{0, 3, 381} {
+		has, err := indexedAttestationHasIndexSSZ(enc, idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !has {
+			t.Errorf("Expected validator %d to be part of the attestation", idx)
+		}
+	}
+	has, err := indexedAttestationHasIndexSSZ(enc, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has {
+		t.Error("Did not expect validator 4 to be part of the attestation")
+	}
+	if _, err := indexedAttestationHasIndexSSZ(enc[:attFixedSize-1], 0); err == nil {
+		t.Error("Expected error on a truncated encoding")
+	}
+}
+
+func TestBlockHeaderSSZ_RoundTrip(t *testing.T) {
+	headers := []*ethpb.SignedBeaconBlockHeader{
+		{},
+		{Header: &ethpb.BeaconBlockHeader{Slot: 1}, Signature: []byte("let me in")},
+		{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:       64,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				BodyRoot:   make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		},
+	}
+	for _, header := range headers {
+		decoded, err := unmarshalBlockHeaderSSZ(marshalBlockHeaderSSZ(header))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(header, decoded) {
+			t.Errorf("Wanted %v, received %v", header, decoded)
+		}
+	}
+}
+
+func TestStore_MigrateToSSZEncoding(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	att := tests[1].idxAtt
+	header := &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1}, Signature: []byte("let me in")}
+	attKey := encodeEpochSig(att.Data.Target.Epoch, att.Signature)
+	headerKey := encodeEpochValidatorIDSig(0, 1, header.Signature)
+	// Write records the way earlier versions of the slasher did.
+	if err := db.update(func(tx *bolt.Tx) error {
+		enc, err := proto.Marshal(att)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(historicIndexedAttestationsBucket).Put(attKey, enc); err != nil {
+			return err
+		}
+		enc, err = proto.Marshal(header)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(historicBlockHeadersBucket).Put(headerKey, enc); err != nil {
+			return err
+		}
+		return tx.Bucket(chainDataBucket).Delete([]byte(sszMigrationKey))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Legacy records are still readable before the migration.
+	atts, err := db.IndexedAttestationsWithPrefixForValidator(ctx, att.Data.Target.Epoch, att.Signature, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 || !proto.Equal(atts[0], att) {
+		t.Fatalf("Wanted %v, received %v", att, atts)
+	}
+
+	if err := db.migrateToSSZEncoding(); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.view(func(tx *bolt.Tx) error {
+		if !isSSZEncoded(tx.Bucket(historicIndexedAttestationsBucket).Get(attKey)) {
+			t.Error("Expected indexed attestation to be migrated")
+		}
+		if !isSSZEncoded(tx.Bucket(historicBlockHeadersBucket).Get(headerKey)) {
+			t.Error("Expected block header to be migrated")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	atts, err = db.IndexedAttestationsWithPrefixForValidator(ctx, att.Data.Target.Epoch, att.Signature, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 1 || !proto.Equal(atts[0], att) {
+		t.Errorf("Wanted %v, received %v", att, atts)
+	}
+	atts, err = db.IndexedAttestationsWithPrefixForValidator(ctx, att.Data.Target.Epoch, att.Signature, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atts) != 0 {
+		t.Errorf("Did not expect attestations for validator 3, received %v", atts)
+	}
+	headers, err := db.BlockHeaders(ctx, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(headers) != 1 || !proto.Equal(headers[0], header) {
+		t.Errorf("Wanted %v, received %v", header, headers)
+	}
+}
+
+func BenchmarkUnmarshalIndexedAttestation_Proto(b *testing.B) {
+	enc, err := proto.Marshal(benchmarkIndexedAttestation())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		att := &ethpb.IndexedAttestation{}
+		if err := proto.Unmarshal(enc, att); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalIndexedAttestation_SSZ(b *testing.B) {
+	enc := marshalIndexedAttestationSSZ(benchmarkIndexedAttestation())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := unmarshalIndexedAttestationSSZ(enc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkIndexedAttestationHasIndex_SSZ(b *testing.B) {
+	enc := marshalIndexedAttestationSSZ(benchmarkIndexedAttestation())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := indexedAttestationHasIndexSSZ(enc, 200); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
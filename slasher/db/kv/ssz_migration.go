@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+)
+
+// migrateToSSZEncoding re-encodes the protobuf encoded indexed attestations and block headers
+// of databases created by earlier versions into the ssz layout. The migration runs once, its
+// completion is recorded in the chain data bucket.
+func (db *Store) migrateToSSZEncoding() error {
+	return db.update(func(tx *bolt.Tx) error {
+		chainData := tx.Bucket(chainDataBucket)
+		if chainData.Get([]byte(sszMigrationKey)) != nil {
+			return nil
+		}
+		if err := reencodeBucket(tx.Bucket(historicIndexedAttestationsBucket), func(enc []byte) ([]byte, error) {
+			att := &ethpb.IndexedAttestation{}
+			if err := proto.Unmarshal(enc, att); err != nil {
+				return nil, err
+			}
+			return marshalIndexedAttestationSSZ(att), nil
+		}); err != nil {
+			return errors.Wrap(err, "failed to migrate indexed attestations")
+		}
+		if err := reencodeBucket(tx.Bucket(historicBlockHeadersBucket), func(enc []byte) ([]byte, error) {
+			header := &ethpb.SignedBeaconBlockHeader{}
+			if err := proto.Unmarshal(enc, header); err != nil {
+				return nil, err
+			}
+			return marshalBlockHeaderSSZ(header), nil
+		}); err != nil {
+			return errors.Wrap(err, "failed to migrate block headers")
+		}
+		return chainData.Put([]byte(sszMigrationKey), []byte{1})
+	})
+}
+
+// reencodeBucket rewrites every value of the bucket not yet in the ssz layout.
+func reencodeBucket(bucket *bolt.Bucket, reencode func([]byte) ([]byte, error)) error {
+	var keys, values [][]byte
+	if err := bucket.ForEach(func(k, v []byte) error {
+		if isSSZEncoded(v) {
+			return nil
+		}
+		enc, err := reencode(v)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, append([]byte{}, k...))
+		values = append(values, enc)
+		return nil
+	}); err != nil {
+		return err
+	}
+	// Values can't be modified while iterating the bucket.
+	for i := range keys {
+		if err := bucket.Put(keys[i], values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,7 +14,12 @@ type SpanDetector interface {
 		ctx context.Context,
 		att *ethpb.IndexedAttestation,
 	) ([]*types.DetectionResult, error)
+	DetectSlashingsForAttestations(
+		ctx context.Context,
+		atts []*ethpb.IndexedAttestation,
+	) ([][]*types.DetectionResult, error)
 
 	// Write functions.
 	UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error
+	SnapshotSpans(ctx context.Context) error
 }
@@ -61,6 +61,23 @@ func (s *MockSpanDetector) DetectSlashingsForAttestation(
 	return detections, nil
 }
 
+// DetectSlashingsForAttestations mocks the detection of slashings for many attestations,
+// using the same rules as DetectSlashingsForAttestation for each of them.
+func (s *MockSpanDetector) DetectSlashingsForAttestations(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([][]*types.DetectionResult, error) {
+	results := make([][]*types.DetectionResult, len(atts))
+	for i, att := range atts {
+		detections, err := s.DetectSlashingsForAttestation(ctx, att)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = detections
+	}
+	return results, nil
+}
+
 // SpanForEpochByValidator returns the specific min-max span for a
 // validator index in a given epoch.
 func (s *MockSpanDetector) SpanForEpochByValidator(ctx context.Context, valIdx uint64, epoch uint64) (types.Span, error) {
@@ -81,3 +98,8 @@ func (s *MockSpanDetector) DeleteValidatorSpansByEpoch(ctx context.Context, vali
 func (s *MockSpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
 	return nil
 }
+
+// SnapshotSpans is a mock for writing the cached span maps to disk.
+func (s *MockSpanDetector) SnapshotSpans(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,260 @@
+package attestations
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	db "github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"go.opencensus.io/trace"
+)
+
+// minIndicesPerWorker is the lowest number of attesting indices handed to a span update worker,
+// splitting smaller attestations further isn't worth the goroutine overhead.
+const minIndicesPerWorker = 32
+
+// spanUpdate is the new span of a validator in the span map of an epoch.
+type spanUpdate struct {
+	epoch        uint64
+	validatorIdx uint64
+	span         types.Span
+}
+
+// spanMapReader lazily reads the span maps needed by a span update. Each map is read from the DB
+// once and shared read-only by the update workers.
+type spanMapReader struct {
+	lock      sync.Mutex
+	slasherDB db.Database
+	spanMaps  map[uint64]map[uint64]types.Span
+}
+
+func (r *spanMapReader) spanMap(ctx context.Context, epoch uint64) (map[uint64]types.Span, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if spanMap, ok := r.spanMaps[epoch]; ok {
+		return spanMap, nil
+	}
+	spanMap, err := r.slasherDB.EpochSpansMap(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	r.spanMaps[epoch] = spanMap
+	return spanMap, nil
+}
+
+// UpdateSpans given an indexed attestation for all of its attesting indices.
+//
+// The attesting indices are sharded by validator index and the new spans of the validators are
+// computed in parallel by a pool of workers, each holding the lock of its shards, before being
+// written to the span maps at once. Updates of attestations with distinct validators only
+// contend on the span map writes.
+func (s *SpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "spanner.UpdateSpans")
+	defer span.End()
+
+	shardIDs, indicesByShard := s.shardIndices(att.AttestingIndices)
+	// Shards are locked in increasing order so concurrent updates can't deadlock.
+	for _, id := range shardIDs {
+		s.shardLocks[id].Lock()
+		defer s.shardLocks[id].Unlock()
+	}
+
+	workers := len(att.AttestingIndices) / minIndicesPerWorker
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(shardIDs) {
+		workers = len(shardIDs)
+	}
+	workerIndices := make([][]uint64, workers)
+	for i, id := range shardIDs {
+		workerIndices[i%workers] = append(workerIndices[i%workers], indicesByShard[id]...)
+	}
+
+	distance := float64(att.Data.Target.Epoch - att.Data.Source.Epoch)
+	if att.Data.Source.Epoch >= 1 {
+		latestMinSpanDistanceObserved.Set(distance)
+	}
+	latestMaxSpanDistanceObserved.Set(distance)
+
+	// Min spans are only updated for the epochs within the detection window of the source.
+	lookback := uint64(epochLookback)
+	if window := s.detectionWindow(); window < lookback {
+		lookback = window
+	}
+	reader := &spanMapReader{slasherDB: s.slasherDB, spanMaps: make(map[uint64]map[uint64]types.Span)}
+	updates := make([][]*spanUpdate, workers)
+	errs := make([]error, workers)
+	s.spanMapsLock.RLock()
+	var wg sync.WaitGroup
+	for i := range workerIndices {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			updates[i], errs[i] = computeSpanUpdates(ctx, reader, att, workerIndices[i], lookback)
+		}(i)
+	}
+	wg.Wait()
+	s.spanMapsLock.RUnlock()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return s.applySpanUpdates(ctx, updates)
+}
+
+// SnapshotSpans writes the span maps cached by the slasher DB to disk. Span map writes are
+// held off for the duration of the snapshot, span map reads aren't.
+func (s *SpanDetector) SnapshotSpans(ctx context.Context) error {
+	ctx, traceSpan := trace.StartSpan(ctx, "spanner.SnapshotSpans")
+	defer traceSpan.End()
+	s.spanMapsLock.RLock()
+	defer s.spanMapsLock.RUnlock()
+	return s.slasherDB.SnapshotSpanMaps(ctx)
+}
+
+// This splits the attesting indices into the shards of the span detector. It returns the ids
+// of the shards the indices belong to in increasing order along with the indices of each shard.
+func (s *SpanDetector) shardIndices(indices []uint64) ([]int, map[int][]uint64) {
+	// Span detectors created without shards, as in tests, use a single shard.
+	if len(s.shardLocks) == 0 {
+		s.shardLocks = make([]sync.Mutex, 1)
+	}
+	indicesByShard := make(map[int][]uint64)
+	for _, idx := range indices {
+		id := int(idx % uint64(len(s.shardLocks)))
+		indicesByShard[id] = append(indicesByShard[id], idx)
+	}
+	shardIDs := make([]int, 0, len(indicesByShard))
+	for id := range indicesByShard {
+		shardIDs = append(shardIDs, id)
+	}
+	sort.Ints(shardIDs)
+	return shardIDs, indicesByShard
+}
+
+// This computes the span updates of the input validators for an attestation: the signature
+// bytes at the target epoch, the min spans before the source epoch and the max spans between
+// the source and target epochs. The span maps are only read.
+func computeSpanUpdates(
+	ctx context.Context,
+	reader *spanMapReader,
+	att *ethpb.IndexedAttestation,
+	indices []uint64,
+	lookback uint64,
+) ([]*spanUpdate, error) {
+	ctx, traceSpan := trace.StartSpan(ctx, "spanner.computeSpanUpdates")
+	defer traceSpan.End()
+	source := att.Data.Source.Epoch
+	target := att.Data.Target.Epoch
+	var updates []*spanUpdate
+
+	// Save the signature for the received attestation so we can have more detail to find it in the DB.
+	spanMap, err := reader.spanMap(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	sigBytes := [2]byte{0, 0}
+	if len(att.Signature) > 1 {
+		sigBytes = [2]byte{att.Signature[0], att.Signature[1]}
+	}
+	for _, idx := range indices {
+		span := spanMap[idx]
+		// If the validator has already attested for this target epoch,
+		// then we do not need to update the values of the span sig bytes.
+		if span.HasAttested {
+			continue
+		}
+		span.HasAttested = true
+		span.SigBytes = sigBytes
+		updates = append(updates, &spanUpdate{epoch: target, validatorIdx: idx, span: span})
+	}
+
+	// Update min spans, used for catching surrounding votes.
+	if source >= 1 {
+		lowestEpoch := source - lookback
+		if int(lowestEpoch) <= 0 {
+			lowestEpoch = 0
+		}
+		for epoch := source - 1; epoch >= lowestEpoch; epoch-- {
+			spanMap, err := reader.spanMap(ctx, epoch)
+			if err != nil {
+				return nil, err
+			}
+			updated := false
+			for _, idx := range indices {
+				span := spanMap[idx]
+				newMinSpan := uint16(target - epoch)
+				if span.MinSpan == 0 || span.MinSpan > newMinSpan {
+					span.MinSpan = newMinSpan
+					updates = append(updates, &spanUpdate{epoch: epoch, validatorIdx: idx, span: span})
+					updated = true
+				}
+			}
+			if !updated || epoch == 0 {
+				break
+			}
+		}
+	}
+
+	// Update max spans, used for catching surrounded votes.
+	for epoch := source + 1; epoch < target; epoch++ {
+		spanMap, err := reader.spanMap(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		updated := false
+		for _, idx := range indices {
+			span := spanMap[idx]
+			newMaxSpan := uint16(target - epoch)
+			if newMaxSpan > span.MaxSpan {
+				span.MaxSpan = newMaxSpan
+				updates = append(updates, &spanUpdate{epoch: epoch, validatorIdx: idx, span: span})
+				updated = true
+			}
+		}
+		if !updated {
+			break
+		}
+	}
+	return updates, nil
+}
+
+// This writes the computed span updates to the span maps. The span maps are read again under
+// the write lock, so the updates of other shards written since the workers read them are kept.
+func (s *SpanDetector) applySpanUpdates(ctx context.Context, workerUpdates [][]*spanUpdate) error {
+	ctx, traceSpan := trace.StartSpan(ctx, "spanner.applySpanUpdates")
+	defer traceSpan.End()
+	updatesByEpoch := make(map[uint64][]*spanUpdate)
+	for _, updates := range workerUpdates {
+		for _, update := range updates {
+			updatesByEpoch[update.epoch] = append(updatesByEpoch[update.epoch], update)
+		}
+	}
+	epochs := make([]uint64, 0, len(updatesByEpoch))
+	for epoch := range updatesByEpoch {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	s.spanMapsLock.Lock()
+	defer s.spanMapsLock.Unlock()
+	for _, epoch := range epochs {
+		spanMap, err := s.slasherDB.EpochSpansMap(ctx, epoch)
+		if err != nil {
+			return err
+		}
+		for _, update := range updatesByEpoch[epoch] {
+			spanMap[update.validatorIdx] = update.span
+		}
+		if err := s.slasherDB.SaveEpochSpansMap(ctx, epoch, spanMap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package attestations
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+)
+
+func TestSpanDetector_UpdateSpans_ConcurrentShards(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	sd := NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod)
+
+	// Attestations of distinct validators updating the same epochs concurrently.
+	const attestations = 8
+	const validatorsPerAtt = 100
+	var wg sync.WaitGroup
+	errs := make(chan error, attestations)
+	for i := uint64(0); i < attestations; i++ {
+		indices := make([]uint64, validatorsPerAtt)
+		for j := range indices {
+			indices[j] = i*validatorsPerAtt + uint64(j)
+		}
+		att := &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 2},
+				Target: &ethpb.Checkpoint{Epoch: 5},
+			},
+			Signature: []byte{1, 2},
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- sd.UpdateSpans(ctx, att)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	minSpans, err := db.EpochSpansMap(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxSpans, err := db.EpochSpansMap(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	targetSpans, err := db.EpochSpansMap(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx := uint64(0); idx < attestations*validatorsPerAtt; idx++ {
+		if minSpans[idx].MinSpan != 4 {
+			t.Fatalf("Wanted min span 4 for validator %d, received %d", idx, minSpans[idx].MinSpan)
+		}
+		if maxSpans[idx].MaxSpan != 2 {
+			t.Fatalf("Wanted max span 2 for validator %d, received %d", idx, maxSpans[idx].MaxSpan)
+		}
+		if !targetSpans[idx].HasAttested || targetSpans[idx].SigBytes != [2]byte{1, 2} {
+			t.Fatalf("Wanted signature bytes saved for validator %d, received %v", idx, targetSpans[idx])
+		}
+	}
+}
@@ -2,7 +2,8 @@ package attestations
 
 import (
 	"context"
-	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -37,17 +38,37 @@ var _ = iface.SpanDetector(&SpanDetector{})
 // spans from validators and attestation data roots.
 type SpanDetector struct {
 	slasherDB db.Database
+	// Span updates are sharded by validator index, the lock of a shard is held while the
+	// spans of its validators are updated.
+	shardLocks []sync.Mutex
+	// The span maps of an epoch are shared by all validators, they are read by the update
+	// workers under the read lock and written under the write lock.
+	spanMapsLock sync.RWMutex
+	// Number of epochs between the source and target of an attestation covered by the spans.
+	spansLength uint64
 }
 
 // NewSpanDetector creates a new instance of a struct tracking
 // several epochs of min-max spans for each validator in
-// the beacon state.
-func NewSpanDetector(db db.Database) *SpanDetector {
+// the beacon state. Surround votes are detected for attestations
+// with up to spans length epochs between their source and target.
+func NewSpanDetector(db db.Database, spansLength uint64) *SpanDetector {
 	return &SpanDetector{
-		slasherDB: db,
+		slasherDB:   db,
+		shardLocks:  make([]sync.Mutex, runtime.GOMAXPROCS(0)),
+		spansLength: spansLength,
 	}
 }
 
+// detectionWindow returns the spans length of the detector, span detectors created without
+// one, as in tests, cover the weak subjectivity period.
+func (s *SpanDetector) detectionWindow() uint64 {
+	if s.spansLength == 0 {
+		return params.BeaconConfig().WeakSubjectivityPeriod
+	}
+	return s.spansLength
+}
+
 // DetectSlashingsForAttestation uses a validator index and its corresponding
 // min-max spans during an epoch to detect an epoch in which the validator
 // committed a slashable attestation.
@@ -57,21 +78,70 @@ func (s *SpanDetector) DetectSlashingsForAttestation(
 ) ([]*types.DetectionResult, error) {
 	ctx, traceSpan := trace.StartSpan(ctx, "spanner.DetectSlashingsForAttestation")
 	defer traceSpan.End()
-	sourceEpoch := att.Data.Source.Epoch
-	targetEpoch := att.Data.Target.Epoch
-	if (targetEpoch - sourceEpoch) > params.BeaconConfig().WeakSubjectivityPeriod {
-		return nil, fmt.Errorf(
-			"attestation span was greater than weak subjectivity period %d, received: %d",
-			params.BeaconConfig().WeakSubjectivityPeriod,
-			targetEpoch-sourceEpoch,
-		)
+	return s.detectSlashings(ctx, att, s.slasherDB.EpochSpansMap, s.slasherDB.EpochSpanByValidatorIndex)
+}
+
+// DetectSlashingsForAttestations runs the detection of DetectSlashingsForAttestation on many
+// attestations at once. The span map of every epoch is read once for the whole batch, the
+// results are returned in the order of the attestations.
+func (s *SpanDetector) DetectSlashingsForAttestations(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([][]*types.DetectionResult, error) {
+	ctx, traceSpan := trace.StartSpan(ctx, "spanner.DetectSlashingsForAttestations")
+	defer traceSpan.End()
+	spanMaps := make(map[uint64]map[uint64]types.Span)
+	spanMap := func(ctx context.Context, epoch uint64) (map[uint64]types.Span, error) {
+		if m, ok := spanMaps[epoch]; ok {
+			return m, nil
+		}
+		m, err := s.slasherDB.EpochSpansMap(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		spanMaps[epoch] = m
+		return m, nil
+	}
+	spanByValidator := func(ctx context.Context, validatorIdx uint64, epoch uint64) (types.Span, error) {
+		m, err := spanMap(ctx, epoch)
+		if err != nil {
+			return types.Span{}, err
+		}
+		return m[validatorIdx], nil
 	}
 
-	spanMap, err := s.slasherDB.EpochSpansMap(ctx, sourceEpoch)
-	if err != nil {
-		return nil, err
+	results := make([][]*types.DetectionResult, len(atts))
+	for i, att := range atts {
+		detections, err := s.detectSlashings(ctx, att, spanMap, spanByValidator)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = detections
+	}
+	return results, nil
+}
+
+func (s *SpanDetector) detectSlashings(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	spanMapByEpoch func(context.Context, uint64) (map[uint64]types.Span, error),
+	spanByValidator func(context.Context, uint64, uint64) (types.Span, error),
+) ([]*types.DetectionResult, error) {
+	sourceEpoch := att.Data.Source.Epoch
+	targetEpoch := att.Data.Target.Epoch
+	// The spans don't cover attestations with more epochs between their source and target than
+	// the spans length, only double votes are detected for them.
+	detectSurround := targetEpoch-sourceEpoch <= s.detectionWindow()
+
+	spanMap := make(map[uint64]types.Span)
+	if detectSurround {
+		var err error
+		spanMap, err = spanMapByEpoch(ctx, sourceEpoch)
+		if err != nil {
+			return nil, err
+		}
 	}
-	targetSpanMap, err := s.slasherDB.EpochSpansMap(ctx, targetEpoch)
+	targetSpanMap, err := spanMapByEpoch(ctx, targetEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -79,11 +149,13 @@ func (s *SpanDetector) DetectSlashingsForAttestation(
 	var detections []*types.DetectionResult
 	distance := uint16(targetEpoch - sourceEpoch)
 	for _, idx := range att.AttestingIndices {
+		// Without surround vote detection, the span is left empty and only the double vote
+		// check below applies.
 		span := spanMap[idx]
 		minSpan := span.MinSpan
 		if minSpan > 0 && minSpan < distance {
 			slashableEpoch := sourceEpoch + uint64(minSpan)
-			targetSpan, err := s.slasherDB.EpochSpanByValidatorIndex(ctx, idx, slashableEpoch)
+			targetSpan, err := spanByValidator(ctx, idx, slashableEpoch)
 			if err != nil {
 				return nil, err
 			}
@@ -99,7 +171,7 @@ func (s *SpanDetector) DetectSlashingsForAttestation(
 		maxSpan := span.MaxSpan
 		if maxSpan > distance {
 			slashableEpoch := sourceEpoch + uint64(maxSpan)
-			targetSpan, err := s.slasherDB.EpochSpanByValidatorIndex(ctx, idx, slashableEpoch)
+			targetSpan, err := spanByValidator(ctx, idx, slashableEpoch)
 			if err != nil {
 				return nil, err
 			}
@@ -127,147 +199,3 @@ func (s *SpanDetector) DetectSlashingsForAttestation(
 
 	return detections, nil
 }
-
-// UpdateSpans given an indexed attestation for all of its attesting indices.
-func (s *SpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, span := trace.StartSpan(ctx, "spanner.UpdateSpans")
-	defer span.End()
-	// Save the signature for the received attestation so we can have more detail to find it in the DB.
-	if err := s.saveSigBytes(ctx, att); err != nil {
-		return err
-	}
-	// Update min and max spans.
-	if err := s.updateMinSpan(ctx, att); err != nil {
-		return err
-	}
-	if err := s.updateMaxSpan(ctx, att); err != nil {
-		return err
-	}
-	return nil
-}
-
-// saveSigBytes saves the first 2 bytes of the signature for the att we're updating the spans to.
-// Later used to help us find the violating attestation in the DB.
-func (s *SpanDetector) saveSigBytes(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, traceSpan := trace.StartSpan(ctx, "spanner.saveSigBytes")
-	defer traceSpan.End()
-	target := att.Data.Target.Epoch
-	spanMap, err := s.slasherDB.EpochSpansMap(ctx, target)
-	if err != nil {
-		return err
-	}
-
-	// We loop through the indices, instead of constantly locking/unlocking the cache for equivalent accesses.
-	for _, idx := range att.AttestingIndices {
-		span := spanMap[idx]
-		// If the validator has already attested for this target epoch,
-		// then we do not need to update the values of the span sig bytes.
-		if span.HasAttested {
-			return nil
-		}
-
-		sigBytes := [2]byte{0, 0}
-		if len(att.Signature) > 1 {
-			sigBytes = [2]byte{att.Signature[0], att.Signature[1]}
-		}
-		// Save the signature bytes into the span for this epoch.
-		spanMap[idx] = types.Span{
-			MinSpan:     span.MinSpan,
-			MaxSpan:     span.MaxSpan,
-			HasAttested: true,
-			SigBytes:    sigBytes,
-		}
-	}
-	return s.slasherDB.SaveEpochSpansMap(ctx, target, spanMap)
-}
-
-// Updates a min span for a validator index given a source and target epoch
-// for an attestation produced by the validator. Used for catching surrounding votes.
-func (s *SpanDetector) updateMinSpan(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, traceSpan := trace.StartSpan(ctx, "spanner.updateMinSpan")
-	defer traceSpan.End()
-	source := att.Data.Source.Epoch
-	target := att.Data.Target.Epoch
-	if source < 1 {
-		return nil
-	}
-	valIndices := make([]uint64, len(att.AttestingIndices))
-	copy(valIndices, att.AttestingIndices)
-	lowestEpoch := source - epochLookback
-	if int(lowestEpoch) <= 0 {
-		lowestEpoch = 0
-	}
-	latestMinSpanDistanceObserved.Set(float64(att.Data.Target.Epoch - att.Data.Source.Epoch))
-
-	for epoch := source - 1; epoch >= lowestEpoch; epoch-- {
-		spanMap, err := s.slasherDB.EpochSpansMap(ctx, epoch)
-		if err != nil {
-			return err
-		}
-		indices := valIndices[:0]
-		for _, idx := range valIndices {
-			span := spanMap[idx]
-			newMinSpan := uint16(target - epoch)
-			if span.MinSpan == 0 || span.MinSpan > newMinSpan {
-				span = types.Span{
-					MinSpan:     newMinSpan,
-					MaxSpan:     span.MaxSpan,
-					SigBytes:    span.SigBytes,
-					HasAttested: span.HasAttested,
-				}
-				spanMap[idx] = span
-				indices = append(indices, idx)
-			}
-		}
-		if err := s.slasherDB.SaveEpochSpansMap(ctx, epoch, spanMap); err != nil {
-			return err
-		}
-		if len(indices) == 0 {
-			break
-		}
-		if epoch == 0 {
-			break
-		}
-	}
-	return nil
-}
-
-// Updates a max span for a validator index given a source and target epoch
-// for an attestation produced by the validator. Used for catching surrounded votes.
-func (s *SpanDetector) updateMaxSpan(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, traceSpan := trace.StartSpan(ctx, "spanner.updateMaxSpan")
-	defer traceSpan.End()
-	source := att.Data.Source.Epoch
-	target := att.Data.Target.Epoch
-	latestMaxSpanDistanceObserved.Set(float64(target - source))
-	valIndices := make([]uint64, len(att.AttestingIndices))
-	copy(valIndices, att.AttestingIndices)
-	for epoch := source + 1; epoch < target; epoch++ {
-		spanMap, err := s.slasherDB.EpochSpansMap(ctx, epoch)
-		if err != nil {
-			return err
-		}
-		indices := valIndices[:0]
-		for _, idx := range valIndices {
-			span := spanMap[idx]
-			newMaxSpan := uint16(target - epoch)
-			if newMaxSpan > span.MaxSpan {
-				span = types.Span{
-					MinSpan:     span.MinSpan,
-					MaxSpan:     newMaxSpan,
-					SigBytes:    span.SigBytes,
-					HasAttested: span.HasAttested,
-				}
-				spanMap[idx] = span
-				indices = append(indices, idx)
-			}
-		}
-		if err := s.slasherDB.SaveEpochSpansMap(ctx, epoch, spanMap); err != nil {
-			return err
-		}
-		if len(indices) == 0 {
-			break
-		}
-	}
-	return nil
-}
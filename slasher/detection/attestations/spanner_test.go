@@ -820,3 +820,37 @@ func TestNewSpanDetector_UpdateSpans(t *testing.T) {
 		})
 	}
 }
+
+func TestSpanDetector_SpansLength(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	sd := NewSpanDetector(db, 4)
+
+	// Double votes are still detected for attestations spanning more epochs than the spans length.
+	if err := sd.UpdateSpans(ctx, indexedAttestation(9, 10, []uint64{1})); err != nil {
+		t.Fatal(err)
+	}
+	res, err := sd.DetectSlashingsForAttestation(ctx, indexedAttestation(2, 10, []uint64{1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Kind != types.DoubleVote {
+		t.Errorf("Wanted a double vote for an attestation spanning more epochs than the spans length, received %+v", res)
+	}
+
+	// Min spans are only updated within the spans length before the source.
+	if err := sd.UpdateSpans(ctx, indexedAttestation(8, 10, []uint64{0})); err != nil {
+		t.Fatal(err)
+	}
+	for epoch := uint64(0); epoch < 8; epoch++ {
+		sm, err := db.EpochSpansMap(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		updated := sm[0].MinSpan != 0
+		if wantUpdated := epoch >= 4; updated != wantUpdated {
+			t.Errorf("Epoch %d: wanted min span updated %t, received span %+v", epoch, wantUpdated, sm[0])
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package detection
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"go.opencensus.io/trace"
+)
+
+// backfillHistoricalChainData runs slashing detection on the blocks and attestations of past
+// epochs, from the configured backfill epoch up to the current chain head of the beacon node.
+// Progress is persisted as the slasher chain head after every epoch, so epochs backfilled by a
+// previous run are skipped.
+func (ds *Service) backfillHistoricalChainData(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "detection.backfillHistoricalChainData")
+	defer span.End()
+	// We fetch both the latest persisted chain head in our DB as well
+	// as the current chain head from the beacon node via gRPC.
+	latestStoredHead, err := ds.slasherDB.ChainHead(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve chain head from DB")
+		return
+	}
+	currentChainHead, err := ds.chainFetcher.ChainHead(ctx)
+	if err != nil {
+		log.WithError(err).Error("Cannot retrieve chain head from beacon node")
+		return
+	}
+	startEpoch := ds.backfillFromEpoch
+	if latestStoredHead != nil && latestStoredHead.HeadEpoch > startEpoch {
+		startEpoch = latestStoredHead.HeadEpoch
+	}
+
+	log.Infof("Running slashing detection on historical chain data from epoch %d to %d", startEpoch, currentChainHead.HeadEpoch)
+	for epoch := startEpoch; epoch < currentChainHead.HeadEpoch; epoch++ {
+		if ctx.Err() != nil {
+			log.WithError(ctx.Err()).Error("Context canceled - stopping backfill")
+			return
+		}
+		if err := ds.backfillEpoch(ctx, epoch); err != nil {
+			log.WithError(err).Errorf("Could not backfill epoch %d, stopping backfill", epoch)
+			return
+		}
+		if err := ds.slasherDB.SaveChainHead(ctx, &ethpb.ChainHead{HeadEpoch: epoch + 1}); err != nil {
+			log.WithError(err).Error("Could not persist backfill progress to disk")
+		}
+		backfilledEpoch.Set(float64(epoch))
+	}
+
+	if err := ds.slasherDB.SaveChainHead(ctx, currentChainHead); err != nil {
+		log.WithError(err).Error("Could not persist chain head to disk")
+	}
+	log.Infof("Completed slashing detection on historical chain data up to epoch %d", currentChainHead.HeadEpoch)
+}
+
+// backfillEpoch runs double proposal detection on the blocks of the epoch, then attester slashing
+// detection on its attestations. The attestations which can't be slashable against one another
+// are detected in a single batch, the others one by one, the same way incoming attestations are
+// processed, so offences between attestations of the same epoch are caught too.
+func (ds *Service) backfillEpoch(ctx context.Context, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "detection.backfillEpoch")
+	defer span.End()
+	blocks, err := ds.historicalFetcher.RequestHistoricalBlocks(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	for _, blk := range blocks {
+		header, err := signedBlockHeader(blk)
+		if err != nil {
+			return errors.Wrap(err, "could not compute block header")
+		}
+		slashing, err := ds.DetectDoubleProposals(ctx, header)
+		if err != nil {
+			return errors.Wrap(err, "could not detect double proposals")
+		}
+		if slashing != nil {
+			doubleProposalsDetected.Inc()
+			if !ds.isIncludedProposerSlashing(ctx, slashing) {
+				ds.proposerSlashingsFeed.Send(slashing)
+			}
+		}
+	}
+
+	indexedAtts, err := ds.historicalFetcher.RequestHistoricalAttestations(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	log.Debugf(
+		"Running slashing detection on %d blocks and %d attestations in epoch %d...",
+		len(blocks),
+		len(indexedAtts),
+		epoch,
+	)
+	batch, sequential, err := splitEpochAttestations(indexedAtts)
+	if err != nil {
+		return err
+	}
+	if err := ds.detectAndUpdateSpansBatch(ctx, batch); err != nil {
+		return err
+	}
+	for _, att := range sequential {
+		slashings, err := ds.DetectAndUpdateSpans(ctx, att)
+		if err != nil {
+			return err
+		}
+		ds.submitAttesterSlashings(ctx, slashings)
+	}
+	return nil
+}
+
+// This runs batch detection on attestations which aren't slashable against one another, then
+// updates the spans of the ones without slashings, under the locks of all their validators.
+func (ds *Service) detectAndUpdateSpansBatch(ctx context.Context, atts []*ethpb.IndexedAttestation) error {
+	if len(atts) == 0 {
+		return nil
+	}
+	var indices []uint64
+	for _, att := range atts {
+		indices = append(indices, att.AttestingIndices...)
+	}
+	unlock := ds.validatorLocks.lock(indices)
+	slashings, err := ds.DetectAttesterSlashingsBatch(ctx, atts)
+	if err != nil {
+		unlock()
+		return errors.Wrap(err, "could not detect attester slashings")
+	}
+	slashable := make(map[*ethpb.IndexedAttestation]bool, 2*len(slashings))
+	for _, slashing := range slashings {
+		slashable[slashing.Attestation_1] = true
+		slashable[slashing.Attestation_2] = true
+	}
+	for _, att := range atts {
+		if slashable[att] {
+			continue
+		}
+		if err := ds.minMaxSpanDetector.UpdateSpans(ctx, att); err != nil {
+			unlock()
+			return errors.Wrap(err, "could not update spans")
+		}
+	}
+	unlock()
+	ds.submitAttesterSlashings(ctx, slashings)
+	return nil
+}
+
+// splitEpochAttestations separates the attestations of validators voting on different data
+// within the epoch, which can be slashable against one another, from the others. Only the former
+// have to be checked against the spans of the attestations of the epoch processed before them.
+func splitEpochAttestations(atts []*ethpb.IndexedAttestation) (batch []*ethpb.IndexedAttestation, sequential []*ethpb.IndexedAttestation, err error) {
+	votes := make(map[uint64][32]byte)
+	conflicting := make(map[uint64]bool)
+	for _, att := range atts {
+		if att == nil || att.Data == nil {
+			return nil, nil, errors.New("nil attestation data")
+		}
+		dataRoot, err := ssz.HashTreeRoot(att.Data)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not hash attestation data")
+		}
+		for _, idx := range att.AttestingIndices {
+			if root, ok := votes[idx]; ok && root != dataRoot {
+				conflicting[idx] = true
+			}
+			votes[idx] = dataRoot
+		}
+	}
+	for _, att := range atts {
+		isSequential := false
+		for _, idx := range att.AttestingIndices {
+			if conflicting[idx] {
+				isSequential = true
+				break
+			}
+		}
+		if isSequential {
+			sequential = append(sequential, att)
+		} else {
+			batch = append(batch, att)
+		}
+	}
+	return batch, sequential, nil
+}
+
+func signedBlockHeader(blk *ethpb.SignedBeaconBlock) (*ethpb.SignedBeaconBlockHeader, error) {
+	if blk == nil || blk.Block == nil {
+		return nil, errors.New("nil block")
+	}
+	bodyRoot, err := ssz.HashTreeRoot(blk.Block.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:       blk.Block.Slot,
+			ParentRoot: blk.Block.ParentRoot,
+			StateRoot:  blk.Block.StateRoot,
+			BodyRoot:   bodyRoot[:],
+		},
+		Signature: blk.Signature,
+	}, nil
+}
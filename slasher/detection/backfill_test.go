@@ -0,0 +1,153 @@
+package detection
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
+)
+
+type mockChainFetcher struct {
+	head *ethpb.ChainHead
+}
+
+func (m *mockChainFetcher) ChainHead(_ context.Context) (*ethpb.ChainHead, error) {
+	return m.head, nil
+}
+
+type mockHistoricalFetcher struct {
+	atts            map[uint64][]*ethpb.IndexedAttestation
+	requestedEpochs []uint64
+}
+
+func (m *mockHistoricalFetcher) RequestHistoricalBlocks(_ context.Context, epoch uint64) ([]*ethpb.SignedBeaconBlock, error) {
+	m.requestedEpochs = append(m.requestedEpochs, epoch)
+	return []*ethpb.SignedBeaconBlock{
+		{Block: &ethpb.BeaconBlock{Slot: epoch * 8}, Signature: []byte{byte(epoch)}},
+	}, nil
+}
+
+func (m *mockHistoricalFetcher) RequestHistoricalAttestations(_ context.Context, epoch uint64) ([]*ethpb.IndexedAttestation, error) {
+	return m.atts[epoch], nil
+}
+
+func TestService_BackfillHistoricalChainData(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+
+	fetcher := &mockHistoricalFetcher{
+		atts: map[uint64][]*ethpb.IndexedAttestation{
+			2: {
+				{
+					AttestingIndices: []uint64{1, 2},
+					Data: &ethpb.AttestationData{
+						Source:          &ethpb.Checkpoint{Epoch: 1},
+						Target:          &ethpb.Checkpoint{Epoch: 2},
+						BeaconBlockRoot: []byte("good block root"),
+					},
+					Signature: []byte{1, 2},
+				},
+				{
+					AttestingIndices: []uint64{2},
+					Data: &ethpb.AttestationData{
+						Source:          &ethpb.Checkpoint{Epoch: 1},
+						Target:          &ethpb.Checkpoint{Epoch: 2},
+						BeaconBlockRoot: []byte("bad block root"),
+					},
+					Signature: []byte{1, 3},
+				},
+			},
+			// Surrounds the vote of validator 1 in epoch 2, detected in a batch.
+			3: {
+				{
+					AttestingIndices: []uint64{1},
+					Data: &ethpb.AttestationData{
+						Source: &ethpb.Checkpoint{Epoch: 0},
+						Target: &ethpb.Checkpoint{Epoch: 3},
+					},
+					Signature: []byte{1, 4},
+				},
+			},
+		},
+	}
+	head := &ethpb.ChainHead{HeadEpoch: 4}
+	ds := Service{
+		ctx:                   ctx,
+		slasherDB:             db,
+		chainFetcher:          &mockChainFetcher{head: head},
+		historicalFetcher:     fetcher,
+		minMaxSpanDetector:    attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+		proposalsDetector:     proposals.NewProposeDetector(db),
+		attesterSlashingsFeed: new(event.Feed),
+		proposerSlashingsFeed: new(event.Feed),
+		backfillFromEpoch:     1,
+	}
+	// The attestations are saved by the beacon client as they are retrieved.
+	for _, atts := range fetcher.atts {
+		if err := db.SaveIndexedAttestations(ctx, atts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ds.backfillHistoricalChainData(ctx)
+	if !reflect.DeepEqual(fetcher.requestedEpochs, []uint64{1, 2, 3}) {
+		t.Errorf("Wanted epochs %v to be backfilled, received %v", []uint64{1, 2, 3}, fetcher.requestedEpochs)
+	}
+	slashings, err := db.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 2 {
+		t.Fatalf("Wanted 2 attester slashings to be detected, received %d", len(slashings))
+	}
+	storedHead, err := db.ChainHead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if storedHead.HeadEpoch != head.HeadEpoch {
+		t.Errorf("Wanted stored chain head epoch %d, received %d", head.HeadEpoch, storedHead.HeadEpoch)
+	}
+
+	// A second run resumes from the stored chain head, no epoch is left to backfill.
+	fetcher.requestedEpochs = nil
+	ds.backfillHistoricalChainData(ctx)
+	if len(fetcher.requestedEpochs) != 0 {
+		t.Errorf("Did not want already backfilled epochs to be requested, received %v", fetcher.requestedEpochs)
+	}
+}
+
+func TestSplitEpochAttestations(t *testing.T) {
+	data := func(root string) *ethpb.AttestationData {
+		return &ethpb.AttestationData{
+			Source:          &ethpb.Checkpoint{Epoch: 1},
+			Target:          &ethpb.Checkpoint{Epoch: 2},
+			BeaconBlockRoot: []byte(root),
+		}
+	}
+	atts := []*ethpb.IndexedAttestation{
+		{AttestingIndices: []uint64{1, 2}, Data: data("a")},
+		// The same vote included twice isn't slashable.
+		{AttestingIndices: []uint64{1}, Data: data("a")},
+		{AttestingIndices: []uint64{2, 3}, Data: data("b")},
+		{AttestingIndices: []uint64{4}, Data: data("b")},
+	}
+	batch, sequential, err := splitEpochAttestations(atts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Validator 2 votes on different data, both of its attestations are processed one by one.
+	if !reflect.DeepEqual(batch, []*ethpb.IndexedAttestation{atts[1], atts[3]}) {
+		t.Errorf("Unexpected batch attestations %v", batch)
+	}
+	if !reflect.DeepEqual(sequential, []*ethpb.IndexedAttestation{atts[0], atts[2]}) {
+		t.Errorf("Unexpected sequential attestations %v", sequential)
+	}
+}
@@ -0,0 +1,99 @@
+package detection
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// maxInlineCandidates is the largest number of saved attestations scanned inline for a
+	// single detection result before the scan is deferred to the deep check worker.
+	maxInlineCandidates = 1024
+	// deepCheckPeriod is how often the deep check worker drains the deferred detections.
+	deepCheckPeriod = 4 * time.Second
+	// deepCheckBatchSize is the number of deferred detections processed per period.
+	deepCheckBatchSize = 64
+)
+
+var errTooManyCandidates = errors.New("too many candidate attestations to scan inline")
+
+// deferDetection persists a detection result for the deep check worker. Failing to persist
+// it loses the result, which is logged rather than failing ingestion of the attestation.
+func (ds *Service) deferDetection(ctx context.Context, att *ethpb.IndexedAttestation, result *types.DetectionResult) {
+	detection := &slashpb.DeferredDetection{
+		Attestation:    att,
+		ValidatorIndex: result.ValidatorIndex,
+		SlashableEpoch: result.SlashableEpoch,
+		Kind:           uint32(result.Kind),
+		SigBytes:       result.SigBytes[:],
+	}
+	if err := ds.slasherDB.SaveDeferredDetection(ctx, detection); err != nil {
+		log.WithError(err).Error("Could not defer detection result")
+		return
+	}
+	detectionsDeferred.Inc()
+}
+
+// runDeepChecks periodically runs the unbounded candidate scans of deferred detections.
+func (ds *Service) runDeepChecks(ctx context.Context) {
+	ticker := time.NewTicker(deepCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := ds.processDeferredDetections(ctx); err != nil {
+				log.WithError(err).Error("Could not process deferred detections")
+			}
+		case <-ctx.Done():
+			log.Debug("Context canceled, stopping deep check worker")
+			return
+		}
+	}
+}
+
+// processDeferredDetections runs full detection on a batch of deferred detections, saving and
+// submitting the slashings found. Processed detections are removed from the queue whether or
+// not they resulted in a slashing.
+func (ds *Service) processDeferredDetections(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "detection.processDeferredDetections")
+	defer span.End()
+	detections, err := ds.slasherDB.DeferredDetections(ctx, deepCheckBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, d := range detections {
+		result := &types.DetectionResult{
+			ValidatorIndex: d.ValidatorIndex,
+			SlashableEpoch: d.SlashableEpoch,
+			Kind:           types.DetectionKind(d.Kind),
+		}
+		copy(result.SigBytes[:], d.SigBytes)
+		slashing, err := ds.slashingForResult(ctx, d.Attestation, result, false /* inline */, nil)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"validatorIndex": d.ValidatorIndex,
+				"slashableEpoch": d.SlashableEpoch,
+			}).Error("Could not run deep check on deferred detection")
+		}
+		if slashing != nil {
+			slashings := []*ethpb.AttesterSlashing{slashing}
+			if err := ds.saveAttesterSlashings(ctx, slashings); err != nil {
+				return err
+			}
+			ds.recordAttesterSlashingsContext(ctx, slashings)
+			ds.submitAttesterSlashings(ctx, slashings)
+		}
+		if err := ds.slasherDB.DeleteDeferredDetection(ctx, d); err != nil {
+			return err
+		}
+		deepChecksProcessed.Inc()
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package detection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestDetect_processDeferredDetections(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                   ctx,
+		slasherDB:             db,
+		attesterSlashingsFeed: new(event.Feed),
+	}
+
+	savedAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 3},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	if err := db.SaveIndexedAttestation(ctx, savedAtt); err != nil {
+		t.Fatal(err)
+	}
+	incomingAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 3, 7},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 3},
+	}
+	ds.deferDetection(ctx, incomingAtt, &types.DetectionResult{
+		ValidatorIndex: 3,
+		SlashableEpoch: 4,
+		Kind:           types.DoubleVote,
+		SigBytes:       [2]byte{1, 2},
+	})
+	deferred, err := db.DeferredDetections(ctx, deepCheckBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 1 {
+		t.Fatalf("Wanted 1 deferred detection, received %d", len(deferred))
+	}
+
+	if err := ds.processDeferredDetections(ctx); err != nil {
+		t.Fatal(err)
+	}
+	slashings, err := db.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Wanted the deep check to save 1 slashing, received %d", len(slashings))
+	}
+	if doubleVote, err := isDoubleVote(slashings[0].Attestation_1, slashings[0].Attestation_2); err != nil || !doubleVote {
+		t.Error("Expected the deep check slashing to be a double vote")
+	}
+	deferred, err = db.DeferredDetections(ctx, deepCheckBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 0 {
+		t.Errorf("Wanted the deferred detection to be removed, %d remaining", len(deferred))
+	}
+}
+
+func TestDetectAndUpdateSpansUnbounded_ScansEveryCandidate(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := &Service{
+		ctx:                ctx,
+		slasherDB:          db,
+		minMaxSpanDetector: attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+		detectionBudget:    time.Hour,
+	}
+
+	// More attestations than can be scanned inline share the prefix of the saved vote.
+	savedAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 3},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	atts := []*ethpb.IndexedAttestation{savedAtt}
+	for i := 0; i < maxInlineCandidates; i++ {
+		atts = append(atts, &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{uint64(100 + i)},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 3},
+				Target: &ethpb.Checkpoint{Epoch: 4},
+			},
+			Signature: []byte{1, 2, byte(i >> 8), byte(i)},
+		})
+	}
+	if err := db.SaveIndexedAttestations(ctx, atts); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.UpdateSpans(ctx, savedAtt); err != nil {
+		t.Fatal(err)
+	}
+	incomingAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{9, 9},
+	}
+
+	slashings, err := ds.DetectAttesterSlashings(ctx, incomingAtt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 0 {
+		t.Fatalf("Expected the bounded detection to be deferred, received %d slashings", len(slashings))
+	}
+	slashings, err = ds.DetectAndUpdateSpansUnbounded(ctx, incomingAtt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Wanted the unbounded detection to find 1 slashing, received %d", len(slashings))
+	}
+}
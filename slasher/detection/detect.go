@@ -2,25 +2,39 @@ package detection
 
 import (
 	"context"
+	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
-	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 	"go.opencensus.io/trace"
 )
 
 // DetectAttesterSlashings detects double, surround and surrounding attestation offences given an attestation.
+// The candidate scans exceeding the detection budget are deferred to the deep check worker, the
+// slashings they find are not returned.
 func (ds *Service) DetectAttesterSlashings(
 	ctx context.Context,
 	att *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	return ds.detectAttesterSlashings(ctx, att, true /* bounded */)
+}
+
+// This detects the slashings of an attestation, bounded by the detection budget unless bounded
+// is false, in which case every candidate scan runs inline.
+func (ds *Service) detectAttesterSlashings(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	bounded bool,
 ) ([]*ethpb.AttesterSlashing, error) {
 	ctx, span := trace.StartSpan(ctx, "detection.DetectAttesterSlashings")
 	defer span.End()
+	spanCheckStart := time.Now()
 	results, err := ds.minMaxSpanDetector.DetectSlashingsForAttestation(ctx, att)
+	observeDetectionLatency(spanCheckLatency, spanCheckStart)
 	if err != nil {
 		return nil, err
 	}
@@ -29,27 +43,85 @@ func (ds *Service) DetectAttesterSlashings(
 		return nil, nil
 	}
 
+	// Cheap span checks ran above, the candidate scans confirming each result run inline
+	// until the detection budget is spent and are deferred to the deep check worker after.
+	start := time.Now()
 	var slashings []*ethpb.AttesterSlashing
 	for _, result := range results {
-		var slashing *ethpb.AttesterSlashing
-		switch result.Kind {
-		case types.DoubleVote:
-			slashing, err = ds.detectDoubleVote(ctx, att, result)
-			if err != nil {
-				return nil, errors.Wrap(err, "could not detect double votes on attestation")
-			}
-		case types.SurroundVote:
-			slashing, err = ds.detectSurroundVotes(ctx, att, result)
-			if err != nil {
-				return nil, errors.Wrap(err, "could not detect surround votes on attestation")
-			}
+		if bounded && ds.detectionBudget > 0 && time.Since(start) > ds.detectionBudget {
+			ds.deferDetection(ctx, att, result)
+			continue
+		}
+		slashing, err := ds.slashingForResult(ctx, att, result, bounded /* inline */, nil)
+		if err == errTooManyCandidates {
+			ds.deferDetection(ctx, att, result)
+			continue
+		}
+		if err != nil {
+			return nil, err
 		}
 		if slashing != nil {
 			slashings = append(slashings, slashing)
 		}
 	}
 
-	// Clear out any duplicate results.
+	slashingList, err := dedupeAttesterSlashings(slashings)
+	if err != nil {
+		return nil, err
+	}
+	if err = ds.saveAttesterSlashings(ctx, slashingList); err != nil {
+		return nil, err
+	}
+	ds.recordAttesterSlashingsContext(ctx, slashingList)
+	return slashingList, nil
+}
+
+// DetectAttesterSlashingsBatch detects double, surround and surrounding attestation offences for
+// many attestations in one pass. Span maps are read once per epoch for the whole batch, and the
+// saved attestations of a slashable epoch are read once for all the results pointing to them,
+// which makes it the preferred way to run detection over historical attestations.
+func (ds *Service) DetectAttesterSlashingsBatch(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DetectAttesterSlashingsBatch")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("numAttestations", int64(len(atts))))
+	results, err := ds.minMaxSpanDetector.DetectSlashingsForAttestations(ctx, atts)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(candidateCache)
+	var slashings []*ethpb.AttesterSlashing
+	for i, att := range atts {
+		for _, result := range results[i] {
+			slashing, err := ds.slashingForResult(ctx, att, result, false /* inline */, candidates)
+			if err != nil {
+				return nil, err
+			}
+			if slashing != nil {
+				slashings = append(slashings, slashing)
+			}
+		}
+	}
+	if len(slashings) == 0 {
+		return nil, nil
+	}
+
+	slashingList, err := dedupeAttesterSlashings(slashings)
+	if err != nil {
+		return nil, err
+	}
+	if err = ds.saveAttesterSlashings(ctx, slashingList); err != nil {
+		return nil, err
+	}
+	ds.recordAttesterSlashingsContext(ctx, slashingList)
+	return slashingList, nil
+}
+
+// dedupeAttesterSlashings clears out any duplicate slashings, keeping the first occurrence.
+func dedupeAttesterSlashings(slashings []*ethpb.AttesterSlashing) ([]*ethpb.AttesterSlashing, error) {
 	keys := make(map[[32]byte]bool)
 	var slashingList []*ethpb.AttesterSlashing
 	for _, ss := range slashings {
@@ -62,15 +134,65 @@ func (ds *Service) DetectAttesterSlashings(
 			slashingList = append(slashingList, ss)
 		}
 	}
+	return slashingList, nil
+}
 
-	if err = ds.slasherDB.SaveAttesterSlashings(ctx, status.Active, slashings); err != nil {
+// slashingForResult confirms a span detection result by scanning the attestations saved for
+// the slashable epoch. Inline scans give up with errTooManyCandidates when the candidate set
+// is too large to fit the detection budget. A non-nil candidate cache is used to share the
+// saved attestations read between the results of a batch.
+func (ds *Service) slashingForResult(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	result *types.DetectionResult,
+	inline bool,
+	candidates candidateCache,
+) (*ethpb.AttesterSlashing, error) {
+	defer observeDetectionLatency(resultLatencyType(result), time.Now())
+	slashing, err := ds.confirmDetectionResult(ctx, att, result, inline, candidates)
+	if slashing == nil {
 		return nil, err
 	}
-	return slashingList, nil
+	switch {
+	case result.Kind == types.DoubleVote:
+		doubleVotesDetected.Inc()
+	case slashing.Attestation_1 == att:
+		surroundingVotesDetected.Inc()
+	default:
+		surroundedVotesDetected.Inc()
+	}
+	return slashing, err
+}
+
+// confirmDetectionResult is slashingForResult without recording the detected slashing metrics.
+func (ds *Service) confirmDetectionResult(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	result *types.DetectionResult,
+	inline bool,
+	candidates candidateCache,
+) (*ethpb.AttesterSlashing, error) {
+	switch result.Kind {
+	case types.DoubleVote:
+		slashing, err := ds.detectDoubleVote(ctx, att, result, inline, candidates)
+		if err != nil && err != errTooManyCandidates {
+			return nil, errors.Wrap(err, "could not detect double votes on attestation")
+		}
+		return slashing, err
+	case types.SurroundVote:
+		slashing, err := ds.detectSurroundVotes(ctx, att, result, inline, candidates)
+		if err != nil && err != errTooManyCandidates {
+			return nil, errors.Wrap(err, "could not detect surround votes on attestation")
+		}
+		return slashing, err
+	}
+	return nil, nil
 }
 
 // UpdateSpans passthrough function that updates span maps given an indexed attestation.
 func (ds *Service) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	unlock := ds.validatorLocks.lock(att.AttestingIndices)
+	defer unlock()
 	return ds.minMaxSpanDetector.UpdateSpans(ctx, att)
 }
 
@@ -80,6 +202,8 @@ func (ds *Service) detectDoubleVote(
 	ctx context.Context,
 	incomingAtt *ethpb.IndexedAttestation,
 	detectionResult *types.DetectionResult,
+	inline bool,
+	candidates candidateCache,
 ) (*ethpb.AttesterSlashing, error) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectDoubleVote")
 	defer span.End()
@@ -87,7 +211,7 @@ func (ds *Service) detectDoubleVote(
 		return nil, nil
 	}
 
-	otherAtts, err := ds.slasherDB.IndexedAttestationsWithPrefix(ctx, detectionResult.SlashableEpoch, detectionResult.SigBytes[:])
+	otherAtts, err := ds.candidateAttestations(ctx, detectionResult, inline, candidates)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +225,11 @@ func (ds *Service) detectDoubleVote(
 			continue
 		}
 
-		if isDoubleVote(incomingAtt, att) {
-			doubleVotesDetected.Inc()
+		doubleVote, err := isDoubleVote(incomingAtt, att)
+		if err != nil {
+			return nil, err
+		}
+		if doubleVote {
 			return &ethpb.AttesterSlashing{
 				Attestation_1: incomingAtt,
 				Attestation_2: att,
@@ -119,6 +246,8 @@ func (ds *Service) detectSurroundVotes(
 	ctx context.Context,
 	incomingAtt *ethpb.IndexedAttestation,
 	detectionResult *types.DetectionResult,
+	inline bool,
+	candidates candidateCache,
 ) (*ethpb.AttesterSlashing, error) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectSurroundVotes")
 	defer span.End()
@@ -126,7 +255,7 @@ func (ds *Service) detectSurroundVotes(
 		return nil, nil
 	}
 
-	otherAtts, err := ds.slasherDB.IndexedAttestationsWithPrefix(ctx, detectionResult.SlashableEpoch, detectionResult.SigBytes[:])
+	otherAtts, err := ds.candidateAttestations(ctx, detectionResult, inline, candidates)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +271,11 @@ func (ds *Service) detectSurroundVotes(
 		// Slashings must be submitted as the incoming attestation surrounding the saved attestation.
 		// So we swap the order if needed.
 		if isSurrounding(incomingAtt, att) {
-			surroundingVotesDetected.Inc()
 			return &ethpb.AttesterSlashing{
 				Attestation_1: incomingAtt,
 				Attestation_2: att,
 			}, nil
 		} else if isSurrounding(att, incomingAtt) {
-			surroundedVotesDetected.Inc()
 			return &ethpb.AttesterSlashing{
 				Attestation_1: att,
 				Attestation_2: incomingAtt,
@@ -158,13 +285,197 @@ func (ds *Service) detectSurroundVotes(
 	return nil, errors.New("unexpected false positive in surround vote detection")
 }
 
+// candidateKey identifies the saved attestations sharing a signature prefix in a target epoch.
+type candidateKey struct {
+	epoch  uint64
+	prefix [2]byte
+}
+
+// candidateCache holds the saved attestations read for a batch of detections, so results
+// pointing to the same epoch and signature prefix only read them from the DB once.
+type candidateCache map[candidateKey][]*ethpb.IndexedAttestation
+
+// candidateAttestations returns the saved attestations a detection result has to be checked
+// against. Inline lookups are bounded by maxInlineCandidates when a detection budget is set, the
+// attestations with the prefix are counted before any is read. With a candidate cache, all the attestations with the prefix are read and filtered by validator
+// in memory instead.
+func (ds *Service) candidateAttestations(
+	ctx context.Context,
+	detectionResult *types.DetectionResult,
+	inline bool,
+	candidates candidateCache,
+) ([]*ethpb.IndexedAttestation, error) {
+	if candidates != nil {
+		return ds.cachedCandidateAttestations(ctx, detectionResult, candidates)
+	}
+	if inline && ds.detectionBudget > 0 {
+		count, err := ds.slasherDB.IndexedAttestationsWithPrefixCount(
+			ctx,
+			detectionResult.SlashableEpoch,
+			detectionResult.SigBytes[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+		if count > maxInlineCandidates {
+			return nil, errTooManyCandidates
+		}
+	}
+	return ds.slasherDB.IndexedAttestationsWithPrefixForValidator(
+		ctx,
+		detectionResult.SlashableEpoch,
+		detectionResult.SigBytes[:],
+		detectionResult.ValidatorIndex,
+	)
+}
+
+func (ds *Service) cachedCandidateAttestations(
+	ctx context.Context,
+	detectionResult *types.DetectionResult,
+	candidates candidateCache,
+) ([]*ethpb.IndexedAttestation, error) {
+	key := candidateKey{epoch: detectionResult.SlashableEpoch, prefix: detectionResult.SigBytes}
+	atts, ok := candidates[key]
+	if !ok {
+		var err error
+		atts, err = ds.slasherDB.IndexedAttestationsWithPrefix(ctx, key.epoch, key.prefix[:])
+		if err != nil {
+			return nil, err
+		}
+		candidates[key] = atts
+	}
+	otherAtts := make([]*ethpb.IndexedAttestation, 0, len(atts))
+	for _, att := range atts {
+		if sliceutil.IsInUint64(detectionResult.ValidatorIndex, att.AttestingIndices) {
+			otherAtts = append(otherAtts, att)
+		}
+	}
+	return otherAtts, nil
+}
+
 // DetectDoubleProposals checks if the given signed beacon block is a slashable offense and returns the slashing.
 func (ds *Service) DetectDoubleProposals(ctx context.Context, incomingBlock *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error) {
-	return ds.proposalsDetector.DetectDoublePropose(ctx, incomingBlock)
+	defer observeDetectionLatency(doubleProposalLatency, time.Now())
+	var proposerIdx uint64
+	if ds.proposerFetcher != nil {
+		idx, err := ds.proposerFetcher.ProposerIndex(ctx, incomingBlock.Header.Slot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get block proposer")
+		}
+		proposerIdx = idx
+	}
+	slashing, err := ds.proposalsDetector.DetectDoublePropose(ctx, proposerIdx, incomingBlock)
+	if err != nil || slashing == nil {
+		return slashing, err
+	}
+	ds.recordProposerSlashingContext(ctx, slashing)
+	if err := ds.markProposerSlashingOnChain(ctx, slashing); err != nil {
+		return nil, err
+	}
+	return slashing, nil
+}
+
+// detectionContext returns the chain head and finalized checkpoint currently observed by
+// the beacon node, or nil if the service has no way to fetch the chain head.
+func (ds *Service) detectionContext(ctx context.Context) (*slashpb.DetectionContext, error) {
+	if ds.chainFetcher == nil {
+		return nil, nil
+	}
+	head, err := ds.chainFetcher.ChainHead(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch chain head")
+	}
+	return &slashpb.DetectionContext{
+		HeadBlockRoot:      head.HeadBlockRoot,
+		HeadSlot:           head.HeadSlot,
+		FinalizedEpoch:     head.FinalizedEpoch,
+		FinalizedBlockRoot: head.FinalizedBlockRoot,
+	}, nil
+}
+
+// recordAttesterSlashingsContext saves the detection context alongside the given slashings.
+// The slashings are already persisted at this point, so failures are only logged.
+func (ds *Service) recordAttesterSlashingsContext(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	detectionCtx, err := ds.detectionContext(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get detection context for attester slashings")
+		return
+	}
+	if detectionCtx == nil {
+		return
+	}
+	for _, slashing := range slashings {
+		if err := ds.slasherDB.SaveAttesterSlashingContext(ctx, slashing, detectionCtx); err != nil {
+			log.WithError(err).Error("Could not save detection context for attester slashing")
+		}
+	}
+}
+
+// recordProposerSlashingContext saves the detection context alongside the given slashing.
+func (ds *Service) recordProposerSlashingContext(ctx context.Context, slashing *ethpb.ProposerSlashing) {
+	detectionCtx, err := ds.detectionContext(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get detection context for proposer slashing")
+		return
+	}
+	if detectionCtx == nil {
+		return
+	}
+	if err := ds.slasherDB.SaveProposerSlashingContext(ctx, slashing, detectionCtx); err != nil {
+		log.WithError(err).Error("Could not save detection context for proposer slashing")
+	}
 }
 
-func isDoubleVote(incomingAtt *ethpb.IndexedAttestation, prevAtt *ethpb.IndexedAttestation) bool {
-	return !proto.Equal(incomingAtt.Data, prevAtt.Data) && incomingAtt.Data.Target.Epoch == prevAtt.Data.Target.Epoch
+// isDoubleVote returns true when both attestations have the same target epoch but different
+// attestation data. Only the data roots are compared, attestations of the same data with different
+// attesting indices or signatures, as aggregates of the same vote, aren't double votes.
+func isDoubleVote(incomingAtt *ethpb.IndexedAttestation, prevAtt *ethpb.IndexedAttestation) (bool, error) {
+	incomingData := canonicalAttestationData(incomingAtt.Data)
+	prevData := canonicalAttestationData(prevAtt.Data)
+	if incomingData.Target.Epoch != prevData.Target.Epoch {
+		return false, nil
+	}
+	incomingRoot, err := hashutil.HashProto(incomingData)
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash attestation data")
+	}
+	prevRoot, err := hashutil.HashProto(prevData)
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash attestation data")
+	}
+	return incomingRoot != prevRoot, nil
+}
+
+// canonicalAttestationData returns a copy of the attestation data encoding the same vote the same
+// way: missing checkpoints are empty checkpoints and roots shorter than 32 bytes are zero padded,
+// so equal votes hash to the same root whatever way they were decoded.
+func canonicalAttestationData(data *ethpb.AttestationData) *ethpb.AttestationData {
+	if data == nil {
+		data = &ethpb.AttestationData{}
+	}
+	return &ethpb.AttestationData{
+		Slot:            data.Slot,
+		CommitteeIndex:  data.CommitteeIndex,
+		BeaconBlockRoot: canonicalRoot(data.BeaconBlockRoot),
+		Source:          canonicalCheckpoint(data.Source),
+		Target:          canonicalCheckpoint(data.Target),
+	}
+}
+
+func canonicalCheckpoint(checkpoint *ethpb.Checkpoint) *ethpb.Checkpoint {
+	if checkpoint == nil {
+		checkpoint = &ethpb.Checkpoint{}
+	}
+	return &ethpb.Checkpoint{Epoch: checkpoint.Epoch, Root: canonicalRoot(checkpoint.Root)}
+}
+
+func canonicalRoot(root []byte) []byte {
+	if len(root) >= 32 {
+		return root
+	}
+	padded := make([]byte, 32)
+	copy(padded, root)
+	return padded
 }
 
 func isSurrounding(incomingAtt *ethpb.IndexedAttestation, prevAtt *ethpb.IndexedAttestation) bool {
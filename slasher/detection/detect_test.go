@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
 	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
 )
 
 func TestDetect_detectAttesterSlashings_Surround(t *testing.T) {
@@ -148,7 +150,7 @@ func TestDetect_detectAttesterSlashings_Surround(t *testing.T) {
 			ds := Service{
 				ctx:                ctx,
 				slasherDB:          db,
-				minMaxSpanDetector: attestations.NewSpanDetector(db),
+				minMaxSpanDetector: attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
 			}
 			if err := db.SaveIndexedAttestations(ctx, tt.savedAtts); err != nil {
 				t.Fatal(err)
@@ -302,7 +304,7 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 			ds := Service{
 				ctx:                ctx,
 				slasherDB:          db,
-				minMaxSpanDetector: attestations.NewSpanDetector(db),
+				minMaxSpanDetector: attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
 			}
 			if err := db.SaveIndexedAttestations(ctx, tt.savedAtts); err != nil {
 				t.Fatal(err)
@@ -328,7 +330,11 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 			for _, ss := range slashings {
 				slashingAtt1 := ss.Attestation_1
 				slashingAtt2 := ss.Attestation_2
-				if !isDoubleVote(slashingAtt1, slashingAtt2) {
+				doubleVote, err := isDoubleVote(slashingAtt1, slashingAtt2)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !doubleVote {
 					t.Fatalf(
 						"Expected slashing to be valid, received atts with target epoch %d and %d but not valid",
 						slashingAtt2.Data.Target.Epoch,
@@ -340,3 +346,190 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 		})
 	}
 }
+
+func TestDetect_detectAttesterSlashingsBatch(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                ctx,
+		slasherDB:          db,
+		minMaxSpanDetector: attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	savedAtts := []*ethpb.IndexedAttestation{
+		{
+			AttestingIndices: []uint64{3},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 9},
+				Target: &ethpb.Checkpoint{Epoch: 13},
+			},
+			Signature: []byte{1, 2},
+		},
+		{
+			AttestingIndices: []uint64{0, 1},
+			Data: &ethpb.AttestationData{
+				Source:          &ethpb.Checkpoint{Epoch: 0},
+				Target:          &ethpb.Checkpoint{Epoch: 2},
+				BeaconBlockRoot: []byte("good block root"),
+			},
+			Signature: []byte{1, 3},
+		},
+	}
+	if err := db.SaveIndexedAttestations(ctx, savedAtts); err != nil {
+		t.Fatal(err)
+	}
+	for _, att := range savedAtts {
+		if err := ds.minMaxSpanDetector.UpdateSpans(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	incomingAtts := []*ethpb.IndexedAttestation{
+		// Surrounds the first saved attestation.
+		{
+			AttestingIndices: []uint64{1, 3, 7},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 7},
+				Target: &ethpb.Checkpoint{Epoch: 14},
+			},
+		},
+		// Double votes with the second saved attestation for both its validators.
+		{
+			AttestingIndices: []uint64{0, 1},
+			Data: &ethpb.AttestationData{
+				Source:          &ethpb.Checkpoint{Epoch: 0},
+				Target:          &ethpb.Checkpoint{Epoch: 2},
+				BeaconBlockRoot: []byte("bad block root"),
+			},
+		},
+		// Not slashable.
+		{
+			AttestingIndices: []uint64{5},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 1},
+				Target: &ethpb.Checkpoint{Epoch: 2},
+			},
+		},
+	}
+	slashings, err := ds.DetectAttesterSlashingsBatch(ctx, incomingAtts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The double vote is reported for two validators but produces the same slashing.
+	if len(slashings) != 2 {
+		t.Fatalf("Unexpected amount of slashings found, received %d, expected %d", len(slashings), 2)
+	}
+	if !isSurrounding(slashings[0].Attestation_1, slashings[0].Attestation_2) {
+		t.Error("Expected the first slashing to be a surround vote")
+	}
+	if doubleVote, err := isDoubleVote(slashings[1].Attestation_1, slashings[1].Attestation_2); err != nil || !doubleVote {
+		t.Error("Expected the second slashing to be a double vote")
+	}
+	savedSlashings, err := db.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(savedSlashings) != len(slashings) {
+		t.Errorf("Wanted %d saved slashings, received %d", len(slashings), len(savedSlashings))
+	}
+
+	// An empty batch detects nothing.
+	slashings, err = ds.DetectAttesterSlashingsBatch(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 0 {
+		t.Errorf("Wanted no slashings for an empty batch, received %d", len(slashings))
+	}
+}
+
+func TestIsDoubleVote(t *testing.T) {
+	data := func(slot uint64, root []byte) *ethpb.AttestationData {
+		return &ethpb.AttestationData{
+			Slot:            slot,
+			BeaconBlockRoot: root,
+			Source:          &ethpb.Checkpoint{Epoch: 1},
+			Target:          &ethpb.Checkpoint{Epoch: 2},
+		}
+	}
+	tests := []struct {
+		name string
+		att1 *ethpb.IndexedAttestation
+		att2 *ethpb.IndexedAttestation
+		want bool
+	}{
+		{
+			name: "same data, different attesters",
+			att1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}, Data: data(1, []byte("a")), Signature: []byte{1}},
+			att2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}, Data: data(1, []byte("a")), Signature: []byte{2}},
+			want: false,
+		},
+		{
+			name: "same data, zero root encoded differently",
+			att1: &ethpb.IndexedAttestation{Data: data(1, nil)},
+			att2: &ethpb.IndexedAttestation{Data: data(1, make([]byte, 32))},
+			want: false,
+		},
+		{
+			name: "different data, same target",
+			att1: &ethpb.IndexedAttestation{Data: data(1, []byte("a"))},
+			att2: &ethpb.IndexedAttestation{Data: data(2, []byte("a"))},
+			want: true,
+		},
+		{
+			name: "different target",
+			att1: &ethpb.IndexedAttestation{Data: data(1, []byte("a"))},
+			att2: &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{Epoch: 3}}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isDoubleVote(tt.att1, tt.att2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("isDoubleVote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type mockProposerFetcher struct {
+	proposers map[uint64]uint64
+}
+
+func (m *mockProposerFetcher) ProposerIndex(_ context.Context, slot uint64) (uint64, error) {
+	return m.proposers[slot], nil
+}
+
+func TestDetect_DetectDoubleProposals_ResolvesProposer(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		slasherDB:         db,
+		proposalsDetector: proposals.NewProposeDetector(db),
+		proposerFetcher:   &mockProposerFetcher{proposers: map[uint64]uint64{9: 42}},
+	}
+	header := func(sig byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{Slot: 9, BodyRoot: []byte{sig}},
+			Signature: []byte{sig},
+		}
+	}
+	if _, err := ds.DetectDoubleProposals(ctx, header(1)); err != nil {
+		t.Fatal(err)
+	}
+	slashing, err := ds.DetectDoubleProposals(ctx, header(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slashing == nil {
+		t.Fatal("Wanted a double proposal to be detected")
+	}
+	if slashing.ProposerIndex != 42 {
+		t.Errorf("Wanted proposer index 42, received %d", slashing.ProposerIndex)
+	}
+}
@@ -0,0 +1,128 @@
+package detection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
+	"go.opencensus.io/trace"
+)
+
+// maxDryRunRequestSize is the largest attestation accepted by the dry run endpoint, an indexed
+// attestation of the largest committee is well below it.
+const maxDryRunRequestSize = 1 << 20
+
+// dryRunTimeout bounds the time spent detecting the slashings of a dry run request, the
+// candidate scans of a dry run are not bounded by the detection budget.
+const dryRunTimeout = 10 * time.Second
+
+// dryRunResponse is the JSON response of the dry run endpoint.
+type dryRunResponse struct {
+	Slashable         bool                      `json:"slashable"`
+	Slashings         []*notifications.Alert    `json:"slashings"`
+	AttesterSlashings []*ethpb.AttesterSlashing `json:"attester_slashings"`
+}
+
+// DryRunAttesterSlashings detects the attester slashings an attestation would cause the same
+// way DetectAttesterSlashings does, without saving the attestation, the slashings nor updating
+// the spans. All the candidate attestations are checked regardless of the detection budget.
+func (ds *Service) DryRunAttesterSlashings(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DryRunAttesterSlashings")
+	defer span.End()
+	results, err := ds.minMaxSpanDetector.DetectSlashingsForAttestation(ctx, att)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make(candidateCache)
+	var slashings []*ethpb.AttesterSlashing
+	for _, result := range results {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		slashing, err := ds.confirmDetectionResult(ctx, att, result, false /* inline */, candidates)
+		if err != nil {
+			return nil, err
+		}
+		if slashing != nil {
+			slashings = append(slashings, slashing)
+		}
+	}
+	return dedupeAttesterSlashings(slashings)
+}
+
+// DryRunHandler runs slashing detection on an indexed attestation posted as JSON or, with the
+// application/octet-stream content type, as SSZ, and responds with the detected slashings as
+// JSON. Nothing is persisted, which makes it suitable to test the slasher from scripts. The
+// detection stops when the request is canceled or after the dry run timeout.
+func (ds *Service) DryRunHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	att, err := decodeDryRunAttestation(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), dryRunTimeout)
+	defer cancel()
+	slashings, err := ds.DryRunAttesterSlashings(ctx, att)
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		http.Error(w, "Slashing detection dry run timed out or was canceled", http.StatusServiceUnavailable)
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Could not run slashing detection dry run")
+		http.Error(w, fmt.Sprintf("Could not detect attester slashings: %v", err), http.StatusInternalServerError)
+		return
+	}
+	res := &dryRunResponse{
+		Slashable:         len(slashings) > 0,
+		Slashings:         make([]*notifications.Alert, len(slashings)),
+		AttesterSlashings: slashings,
+	}
+	for i, slashing := range slashings {
+		res.Slashings[i] = notifications.AttesterSlashingAlert(notifications.Detection, slashing)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.WithError(err).Error("Could not write dry run response")
+	}
+}
+
+func decodeDryRunAttestation(w http.ResponseWriter, r *http.Request) (*ethpb.IndexedAttestation, error) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxDryRunRequestSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read request body")
+	}
+	att := &ethpb.IndexedAttestation{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/octet-stream") {
+		if err := ssz.Unmarshal(body, att); err != nil {
+			return nil, errors.Wrap(err, "could not decode SSZ attestation")
+		}
+	} else if err := json.Unmarshal(body, att); err != nil {
+		return nil, errors.Wrap(err, "could not decode JSON attestation")
+	}
+	if att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return nil, errors.New("attestation is missing its data, source or target")
+	}
+	if att.Data.Source.Epoch > att.Data.Target.Epoch {
+		return nil, errors.New("attestation source epoch is greater than its target epoch")
+	}
+	if maxIndices := params.BeaconConfig().MaxValidatorsPerCommittee; uint64(len(att.AttestingIndices)) > maxIndices {
+		return nil, fmt.Errorf("attestation has %d attesting indices, more than the max %d", len(att.AttestingIndices), maxIndices)
+	}
+	return att, nil
+}
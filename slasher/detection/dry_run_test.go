@@ -0,0 +1,131 @@
+package detection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+)
+
+func TestService_DryRunHandler(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                ctx,
+		slasherDB:          db,
+		minMaxSpanDetector: attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	saved := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 3},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	if err := db.SaveIndexedAttestation(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.minMaxSpanDetector.UpdateSpans(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	incoming := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	jsonBody, err := json.Marshal(incoming)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sszBody, err := ssz.Marshal(incoming)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tooManyIndices := &ethpb.IndexedAttestation{
+		AttestingIndices: make([]uint64, params.BeaconConfig().MaxValidatorsPerCommittee+1),
+		Data:             incoming.Data,
+	}
+	tooManyIndicesBody, err := json.Marshal(tooManyIndices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        []byte
+		wantCode    int
+	}{
+		{name: "json", method: http.MethodPost, contentType: "application/json", body: jsonBody, wantCode: http.StatusOK},
+		{name: "ssz", method: http.MethodPost, contentType: "application/octet-stream", body: sszBody, wantCode: http.StatusOK},
+		{name: "missing data", method: http.MethodPost, contentType: "application/json", body: []byte("{}"), wantCode: http.StatusBadRequest},
+		{name: "too many indices", method: http.MethodPost, contentType: "application/json", body: tooManyIndicesBody, wantCode: http.StatusBadRequest},
+		{name: "get", method: http.MethodGet, wantCode: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/detection/dry-run", bytes.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+			ds.DryRunHandler(rec, req)
+			if rec.Code != tt.wantCode {
+				t.Fatalf("Wanted status %d, received %d: %s", tt.wantCode, rec.Code, rec.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			res := &dryRunResponse{}
+			if err := json.NewDecoder(rec.Body).Decode(res); err != nil {
+				t.Fatal(err)
+			}
+			if !res.Slashable || len(res.Slashings) != 1 || len(res.AttesterSlashings) != 1 {
+				t.Fatalf("Wanted a single slashing, received %+v", res)
+			}
+			if got := res.Slashings[0].ValidatorIndices; len(got) != 1 || got[0] != 3 {
+				t.Errorf("Wanted validator 3 slashable, received %v", got)
+			}
+		})
+	}
+
+	// Nothing detected by the dry runs is persisted.
+	savedSlashings, err := db.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(savedSlashings) != 0 {
+		t.Errorf("Wanted no slashings saved, received %d", len(savedSlashings))
+	}
+	spanMap, err := db.EpochSpansMap(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spanMap[1].HasAttested {
+		t.Error("Wanted the spans of the dry run attestation not updated")
+	}
+
+	// Canceled requests stop the detection.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	req := httptest.NewRequest(http.MethodPost, "/detection/dry-run", bytes.NewReader(jsonBody)).WithContext(canceledCtx)
+	rec := httptest.NewRecorder()
+	ds.DryRunHandler(rec, req)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Wanted status %d for a canceled request, received %d", http.StatusServiceUnavailable, rec.Code)
+	}
+}
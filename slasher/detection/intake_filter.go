@@ -0,0 +1,124 @@
+package detection
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// intakeFilter drops incoming attestations the deployment isn't interested in before
+// detection runs on them: attestations without any attester of the configured validator set,
+// and attestations with a committee participation below the configured threshold. Filtering
+// reduces detection coverage, which is why the filter settings and counts are reported by the
+// status RPC.
+type intakeFilter struct {
+	// The counters are accessed atomically and kept first for 64-bit alignment.
+	filteredByValidators    uint64
+	filteredByParticipation uint64
+	validators              map[uint64]bool
+	minParticipation        float64
+	committeeFetcher        beaconclient.CommitteeFetcher
+}
+
+// IntakeFilterStatus reports the intake filter settings and the number of attestations it
+// dropped since the slasher started.
+type IntakeFilterStatus struct {
+	Enabled                 bool
+	ValidatorIndices        []uint64
+	MinParticipation        float64
+	FilteredByValidatorSet  uint64
+	FilteredByParticipation uint64
+}
+
+func newIntakeFilter(cfg *Config) *intakeFilter {
+	f := &intakeFilter{
+		minParticipation: cfg.MinCommitteeParticipation,
+		committeeFetcher: cfg.CommitteeFetcher,
+	}
+	if len(cfg.IntakeValidatorIndices) > 0 {
+		f.validators = make(map[uint64]bool, len(cfg.IntakeValidatorIndices))
+		for _, idx := range cfg.IntakeValidatorIndices {
+			f.validators[idx] = true
+		}
+	}
+	return f
+}
+
+func (f *intakeFilter) enabled() bool {
+	return f != nil && (len(f.validators) > 0 || f.minParticipation > 0)
+}
+
+// allows returns true if detection should run on the attestation.
+func (f *intakeFilter) allows(ctx context.Context, att *ethpb.IndexedAttestation) bool {
+	if !f.enabled() {
+		return true
+	}
+	ctx, span := trace.StartSpan(ctx, "detection.intakeFilter.allows")
+	defer span.End()
+	if len(f.validators) > 0 && !f.intersects(att.AttestingIndices) {
+		atomic.AddUint64(&f.filteredByValidators, 1)
+		attestationsFiltered.WithLabelValues("validator_set").Inc()
+		return false
+	}
+	if f.minParticipation > 0 && f.committeeFetcher != nil {
+		size, err := f.committeeFetcher.CommitteeSize(ctx, att.Data.Slot, att.Data.CommitteeIndex)
+		// Attestations are let through when the committee is unknown, a filter failure must
+		// not silently reduce coverage further.
+		if err != nil {
+			log.WithError(err).Debug("Could not get committee size, skipping participation filter")
+			return true
+		}
+		if size > 0 && float64(len(att.AttestingIndices))/float64(size) < f.minParticipation {
+			atomic.AddUint64(&f.filteredByParticipation, 1)
+			attestationsFiltered.WithLabelValues("participation").Inc()
+			return false
+		}
+	}
+	return true
+}
+
+func (f *intakeFilter) intersects(indices []uint64) bool {
+	for _, idx := range indices {
+		if f.validators[idx] {
+			return true
+		}
+	}
+	return false
+}
+
+// IntakeFilterStatus returns the settings and counts of the attestation intake filter.
+func (ds *Service) IntakeFilterStatus() *IntakeFilterStatus {
+	f := ds.intakeFilter
+	if !f.enabled() {
+		return &IntakeFilterStatus{}
+	}
+	indices := make([]uint64, 0, len(f.validators))
+	for idx := range f.validators {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return &IntakeFilterStatus{
+		Enabled:                 f.enabled(),
+		ValidatorIndices:        indices,
+		MinParticipation:        f.minParticipation,
+		FilteredByValidatorSet:  atomic.LoadUint64(&f.filteredByValidators),
+		FilteredByParticipation: atomic.LoadUint64(&f.filteredByParticipation),
+	}
+}
+
+func (f *intakeFilter) logSettings() {
+	if !f.enabled() {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"validators":       len(f.validators),
+		"minParticipation": f.minParticipation,
+	}).Warn("Attestation intake filter enabled, slashings of filtered attestations are not detected")
+}
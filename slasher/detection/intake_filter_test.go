@@ -0,0 +1,104 @@
+package detection
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+type mockCommitteeFetcher struct {
+	size uint64
+	err  error
+}
+
+func (m *mockCommitteeFetcher) CommitteeSize(_ context.Context, _ uint64, _ uint64) (uint64, error) {
+	return m.size, m.err
+}
+
+func TestIntakeFilter_Allows(t *testing.T) {
+	att := func(indices ...uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{AttestingIndices: indices, Data: &ethpb.AttestationData{}}
+	}
+	tests := []struct {
+		name    string
+		cfg     *Config
+		att     *ethpb.IndexedAttestation
+		allowed bool
+	}{
+		{
+			name:    "disabled",
+			cfg:     &Config{},
+			att:     att(1),
+			allowed: true,
+		},
+		{
+			name:    "attester in validator set",
+			cfg:     &Config{IntakeValidatorIndices: []uint64{3, 5}},
+			att:     att(1, 5),
+			allowed: true,
+		},
+		{
+			name:    "no attester in validator set",
+			cfg:     &Config{IntakeValidatorIndices: []uint64{3, 5}},
+			att:     att(1, 2),
+			allowed: false,
+		},
+		{
+			name:    "participation above threshold",
+			cfg:     &Config{MinCommitteeParticipation: 0.5, CommitteeFetcher: &mockCommitteeFetcher{size: 4}},
+			att:     att(1, 2),
+			allowed: true,
+		},
+		{
+			name:    "participation below threshold",
+			cfg:     &Config{MinCommitteeParticipation: 0.5, CommitteeFetcher: &mockCommitteeFetcher{size: 4}},
+			att:     att(1),
+			allowed: false,
+		},
+		{
+			name: "unknown committee",
+			cfg: &Config{
+				MinCommitteeParticipation: 0.5,
+				CommitteeFetcher:          &mockCommitteeFetcher{err: errors.New("unavailable")},
+			},
+			att:     att(1),
+			allowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIntakeFilter(tt.cfg)
+			if got := f.allows(context.Background(), tt.att); got != tt.allowed {
+				t.Errorf("Wanted allowed %v, received %v", tt.allowed, got)
+			}
+		})
+	}
+}
+
+func TestService_IntakeFilterStatus(t *testing.T) {
+	ds := &Service{intakeFilter: newIntakeFilter(&Config{
+		IntakeValidatorIndices:    []uint64{5, 3},
+		MinCommitteeParticipation: 0.5,
+		CommitteeFetcher:          &mockCommitteeFetcher{size: 4},
+	})}
+	ctx := context.Background()
+	ds.intakeFilter.allows(ctx, &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}, Data: &ethpb.AttestationData{}})
+	ds.intakeFilter.allows(ctx, &ethpb.IndexedAttestation{AttestingIndices: []uint64{3}, Data: &ethpb.AttestationData{}})
+
+	want := &IntakeFilterStatus{
+		Enabled:                 true,
+		ValidatorIndices:        []uint64{3, 5},
+		MinParticipation:        0.5,
+		FilteredByValidatorSet:  1,
+		FilteredByParticipation: 1,
+	}
+	if got := ds.IntakeFilterStatus(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted status %+v, received %+v", want, got)
+	}
+	if (&Service{}).IntakeFilterStatus().Enabled {
+		t.Error("Did not want the filter of a service without filter settings to be enabled")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -49,17 +50,7 @@ func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethp
 	for {
 		select {
 		case indexedAtt := <-ch:
-			slashings, err := ds.DetectAttesterSlashings(ctx, indexedAtt)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
-			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, indexedAtt); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
+			ds.processAttestation(ctx, indexedAtt)
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -69,3 +60,36 @@ func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethp
 		}
 	}
 }
+
+// processAttestation runs detection on a queued attestation, updating the spans when it isn't
+// slashable and submitting the slashings found otherwise, and returns whether the attestation
+// left the queue. An attestation failing detection stays queued, to be processed again on
+// restart, until it failed maxPendingAttestationAttempts times.
+func (ds *Service) processAttestation(ctx context.Context, indexedAtt *ethpb.IndexedAttestation) bool {
+	if ds.intakeFilter.allows(ctx, indexedAtt) {
+		slashings, err := ds.DetectAndUpdateSpans(ctx, indexedAtt)
+		if err != nil {
+			log.WithError(err).Error("Could not process attestation")
+			attempts, err := ds.slasherDB.RecordPendingAttestationFailure(ctx, indexedAtt)
+			if err != nil {
+				log.WithError(err).Error("Could not record failed attestation processing")
+				return false
+			}
+			if attempts < maxPendingAttestationAttempts {
+				return false
+			}
+			log.WithFields(logrus.Fields{
+				"targetEpoch": indexedAtt.Data.Target.Epoch,
+				"attempts":    attempts,
+			}).Warn("Dropping attestation failing detection repeatedly")
+		} else {
+			ds.submitAttesterSlashings(ctx, slashings)
+		}
+	}
+	if err := ds.slasherDB.DeletePendingAttestation(ctx, indexedAtt); err != nil {
+		log.WithError(err).Error("Could not remove processed attestation from the queue")
+		return false
+	}
+	ds.recordProcessedEpoch(indexedAtt.Data.Target.Epoch)
+	return true
+}
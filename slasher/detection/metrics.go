@@ -22,4 +22,53 @@ var (
 		Name: "surrounded_votes_detected_total",
 		Help: "The # of surrounded slashable events detected",
 	})
+	slashingsAlreadyOnChain = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slashings_already_on_chain_total",
+		Help: "The # of detected slashings saved as included because their validators were already slashed on chain",
+	})
+	detectionsDeferred = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "detections_deferred_total",
+		Help: "The # of detection results deferred to the deep check worker",
+	})
+	deepChecksProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "deep_checks_processed_total",
+		Help: "The # of deferred detection results processed by the deep check worker",
+	})
+	backfilledEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfilled_epoch",
+		Help: "The latest historical epoch slashing detection was backfilled for",
+	})
+	prunedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruned_epoch",
+		Help: "The epoch up to which the slasher DB was last pruned",
+	})
+	compactedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "compacted_epoch",
+		Help: "The epoch up to which the slasher DB was last compacted to slashings only",
+	})
+	attestationsFiltered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "attestations_filtered_total",
+		Help: "The # of incoming attestations dropped by the intake filter before detection",
+	}, []string{"reason"})
+	chainHeadEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_chain_head_epoch",
+		Help: "The head epoch of the beacon node last observed by the slasher",
+	})
+	processedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_processed_epoch",
+		Help: "The highest target epoch of the incoming attestations processed by slashing detection",
+	})
+	detectionEpochLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_detection_epoch_lag",
+		Help: "The # of epochs the processed epoch is behind the chain head epoch",
+	})
+	detectionQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "slasher_detection_queue_depth",
+		Help: "The # of items waiting in the detection queues, by queue",
+	}, []string{"queue"})
+	detectionLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "slasher_detection_latency_seconds",
+		Help:    "The time taken by slashing detection, by detection type",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5},
+	}, []string{"type"})
 )
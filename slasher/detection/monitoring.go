@@ -0,0 +1,98 @@
+package detection
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+// Detection types the detection latency is reported for.
+const (
+	spanCheckLatency      = "span_check"
+	spanUpdateLatency     = "span_update"
+	doubleVoteLatency     = "double_vote"
+	surroundVoteLatency   = "surround_vote"
+	doubleProposalLatency = "double_proposal"
+)
+
+// runMonitoring reports how far behind the chain head slashing detection is, along with the depth
+// of the detection queues, once per slot.
+func (ds *Service) runMonitoring(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ds.reportDetectionStatus(ctx)
+		case <-ctx.Done():
+			log.Debug("Context canceled, stopping monitoring routine")
+			return
+		}
+	}
+}
+
+// reportDetectionStatus updates the detection lag and queue depth metrics.
+func (ds *Service) reportDetectionStatus(ctx context.Context) {
+	if ds.chainFetcher != nil {
+		ds.reportDetectionLag(ctx)
+	}
+
+	detectionQueueDepth.WithLabelValues("attestations").Set(float64(len(ds.attsChan)))
+	detectionQueueDepth.WithLabelValues("blocks").Set(float64(len(ds.blocksChan)))
+	pending, err := ds.slasherDB.PendingAttestationsCount(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not count pending attestations")
+		return
+	}
+	detectionQueueDepth.WithLabelValues("pending_attestations").Set(float64(pending))
+	deferred, err := ds.slasherDB.DeferredDetectionsCount(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not count deferred detections")
+		return
+	}
+	detectionQueueDepth.WithLabelValues("deferred_detections").Set(float64(deferred))
+}
+
+// reportDetectionLag updates the number of epochs the processed epoch is behind the chain head.
+func (ds *Service) reportDetectionLag(ctx context.Context) {
+	head, err := ds.chainFetcher.ChainHead(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get chain head to report the detection lag")
+		return
+	}
+	processed := atomic.LoadUint64(&ds.processedEpoch)
+	var lag uint64
+	if head.HeadEpoch > processed {
+		lag = head.HeadEpoch - processed
+	}
+	chainHeadEpoch.Set(float64(head.HeadEpoch))
+	processedEpoch.Set(float64(processed))
+	detectionEpochLag.Set(float64(lag))
+}
+
+// recordProcessedEpoch records the target epoch of an attestation processed by detection, the
+// highest one is the processed epoch the lag is measured from.
+func (ds *Service) recordProcessedEpoch(epoch uint64) {
+	for {
+		processed := atomic.LoadUint64(&ds.processedEpoch)
+		if epoch <= processed || atomic.CompareAndSwapUint64(&ds.processedEpoch, processed, epoch) {
+			return
+		}
+	}
+}
+
+// observeDetectionLatency records the time elapsed since start for the input detection type.
+func observeDetectionLatency(detectionType string, start time.Time) {
+	detectionLatency.WithLabelValues(detectionType).Observe(time.Since(start).Seconds())
+}
+
+// resultLatencyType is the detection type of a span detection result.
+func resultLatencyType(result *types.DetectionResult) string {
+	if result.Kind == types.DoubleVote {
+		return doubleVoteLatency
+	}
+	return surroundVoteLatency
+}
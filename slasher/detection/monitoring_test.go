@@ -0,0 +1,48 @@
+package detection
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+)
+
+func TestService_RecordProcessedEpoch(t *testing.T) {
+	ds := &Service{}
+	var wg sync.WaitGroup
+	for _, epoch := range []uint64{3, 9, 1, 7} {
+		wg.Add(1)
+		go func(epoch uint64) {
+			defer wg.Done()
+			ds.recordProcessedEpoch(epoch)
+		}(epoch)
+	}
+	wg.Wait()
+	if processed := atomic.LoadUint64(&ds.processedEpoch); processed != 9 {
+		t.Errorf("Wanted processed epoch 9, received %d", processed)
+	}
+	ds.recordProcessedEpoch(4)
+	if processed := atomic.LoadUint64(&ds.processedEpoch); processed != 9 {
+		t.Errorf("Wanted processed epoch to stay 9, received %d", processed)
+	}
+}
+
+func TestService_ReportDetectionStatus(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ds := &Service{
+		slasherDB:    db,
+		chainFetcher: &mockChainFetcher{head: &ethpb.ChainHead{HeadEpoch: 10}},
+		attsChan:     make(chan *ethpb.IndexedAttestation, 1),
+		blocksChan:   make(chan *ethpb.SignedBeaconBlock, 1),
+	}
+	ds.recordProcessedEpoch(12)
+	// A processed epoch ahead of the last observed head doesn't underflow the lag.
+	ds.reportDetectionStatus(context.Background())
+
+	ds.chainFetcher = nil
+	ds.reportDetectionStatus(context.Background())
+}
@@ -0,0 +1,42 @@
+package detection
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
+)
+
+// Slashings are queued while their alerts are handed to the notifications service, so the
+// feeds the beacon client submits slashings from aren't blocked.
+const notificationQueueSize = 256
+
+// runNotifications reports every slashing sent over the attester and proposer slashing feeds,
+// whichever detection path found them, to the notifications service.
+func (ds *Service) runNotifications(ctx context.Context) {
+	attCh := make(chan *ethpb.AttesterSlashing, notificationQueueSize)
+	attSub := ds.attesterSlashingsFeed.Subscribe(attCh)
+	defer attSub.Unsubscribe()
+	propCh := make(chan *ethpb.ProposerSlashing, notificationQueueSize)
+	propSub := ds.proposerSlashingsFeed.Subscribe(propCh)
+	defer propSub.Unsubscribe()
+	for {
+		select {
+		case slashing := <-attCh:
+			detectionQueueDepth.WithLabelValues("notifications").Set(float64(len(attCh) + len(propCh)))
+			ds.notifications.Notify(notifications.AttesterSlashingAlert(notifications.Detection, slashing))
+		case slashing := <-propCh:
+			detectionQueueDepth.WithLabelValues("notifications").Set(float64(len(attCh) + len(propCh)))
+			ds.notifications.Notify(notifications.ProposerSlashingAlert(notifications.Detection, slashing))
+		case <-attSub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-propSub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-ctx.Done():
+			log.Debug("Context canceled, stopping notifications routine")
+			return
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package detection
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
+)
+
+func TestService_RunNotifications(t *testing.T) {
+	received := make(chan map[string]interface{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := make(map[string]interface{})
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		received <- payload
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ns := notifications.NewService(ctx, &notifications.Config{Sinks: []*notifications.SinkConfig{
+		{Sink: notifications.NewWebhookSink(srv.URL), Events: []notifications.Event{notifications.Detection}},
+	}})
+	ns.Start()
+	ds := NewDetectionService(ctx, &Config{
+		AttesterSlashingsFeed: new(event.Feed),
+		ProposerSlashingsFeed: new(event.Feed),
+		Notifications:         ns,
+	})
+	go ds.runNotifications(ctx)
+	// Wait for the notifications routine to subscribe to the feeds.
+	for ds.proposerSlashingsFeed.Send(&ethpb.ProposerSlashing{
+		ProposerIndex: 7,
+		Header_1:      &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 65}},
+		Header_2:      &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 65}},
+	}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	payload := <-received
+	if payload["event"] != "detection" || payload["type"] != "proposer" || payload["slot"] != float64(65) {
+		t.Errorf("Unexpected payload %v", payload)
+	}
+}
@@ -0,0 +1,60 @@
+package detection
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// replayPendingAttestations runs detection on the attestations queued but left unprocessed when the
+// slasher last stopped. Attestations already processed before the crash but not yet removed from
+// the queue are harmless to process again: span updates only ever lower min spans and raise max
+// spans, and an attestation is never a double vote of itself.
+// The queue is replayed in bounded batches, oldest target epochs first.
+const pendingAttestationsBatchSize = 256
+
+// Attestations are dropped from the queue once detection failed on them this many times.
+const maxPendingAttestationAttempts = 3
+
+func (ds *Service) replayPendingAttestations(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "detection.replayPendingAttestations")
+	defer span.End()
+	count, err := ds.slasherDB.PendingAttestationsCount(ctx)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+	log.WithField("count", count).Info("Replaying attestations left unprocessed on shutdown")
+	// Attestations failing detection again stay queued ahead of the next batch, skip over them.
+	replayed, kept := 0, 0
+	for {
+		atts, err := ds.slasherDB.PendingAttestations(ctx, kept, pendingAttestationsBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(atts) == 0 {
+			break
+		}
+		for _, att := range atts {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if !ds.processAttestation(ctx, att) {
+				kept++
+			}
+			replayed++
+		}
+	}
+	remaining, err := ds.slasherDB.PendingAttestationsCount(ctx)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"replayed":  replayed,
+		"remaining": remaining,
+	}).Info("Replayed unprocessed attestations")
+	return nil
+}
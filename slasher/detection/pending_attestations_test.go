@@ -0,0 +1,131 @@
+package detection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestService_ReplayPendingAttestations(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                   ctx,
+		slasherDB:             db,
+		minMaxSpanDetector:    attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+		attesterSlashingsFeed: new(event.Feed),
+	}
+	processed := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 3},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	surrounding := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 5},
+		},
+		Signature: []byte{3, 4},
+	}
+	atts := []*ethpb.IndexedAttestation{processed, surrounding}
+	if err := db.SaveIndexedAttestations(ctx, atts); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SavePendingAttestations(ctx, atts); err != nil {
+		t.Fatal(err)
+	}
+	// The first attestation was processed before the crash, but not removed from the queue.
+	if err := ds.minMaxSpanDetector.UpdateSpans(ctx, processed); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ds.replayPendingAttestations(ctx); err != nil {
+		t.Fatal(err)
+	}
+	pending, err := db.PendingAttestationsCount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending != 0 {
+		t.Errorf("Wanted the queue drained, %d attestations remain", pending)
+	}
+	// Replaying the processed attestation isn't a double vote, the surround vote is caught.
+	slashings, err := db.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 || !isSurrounding(slashings[0].Attestation_1, slashings[0].Attestation_2) {
+		t.Errorf("Wanted a single surround vote slashing, received %v", slashings)
+	}
+}
+
+type failingSpanDetector struct {
+	iface.SpanDetector
+}
+
+func (failingSpanDetector) DetectSlashingsForAttestation(
+	_ context.Context,
+	_ *ethpb.IndexedAttestation,
+) ([]*types.DetectionResult, error) {
+	return nil, errors.New("detection failed")
+}
+
+func TestService_ReplayPendingAttestations_DropsFailingAttestations(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                   ctx,
+		slasherDB:             db,
+		minMaxSpanDetector:    failingSpanDetector{},
+		attesterSlashingsFeed: new(event.Feed),
+	}
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 3},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	if err := db.SavePendingAttestations(ctx, []*ethpb.IndexedAttestation{att}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i <= maxPendingAttestationAttempts; i++ {
+		if err := ds.replayPendingAttestations(ctx); err != nil {
+			t.Fatal(err)
+		}
+		pending, err := db.PendingAttestationsCount(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i < maxPendingAttestationAttempts && pending != 1 {
+			t.Errorf("Wanted the attestation kept after %d failed attempts, %d attestations queued", i, pending)
+		}
+		// The epoch of an attestation is only processed once it left the queue.
+		if i < maxPendingAttestationAttempts && ds.processedEpoch != 0 {
+			t.Errorf("Wanted no processed epoch after %d failed attempts, received %d", i, ds.processedEpoch)
+		}
+		if i == maxPendingAttestationAttempts && pending != 0 {
+			t.Errorf("Wanted the attestation dropped after %d failed attempts, %d attestations queued", i, pending)
+		}
+	}
+	if ds.processedEpoch != 4 {
+		t.Errorf("Wanted processed epoch 4 once the attestation was dropped, received %d", ds.processedEpoch)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"go.opencensus.io/trace"
@@ -24,22 +23,26 @@ func NewProposeDetector(db db.Database) *ProposeDetector {
 	}
 }
 
-// DetectDoublePropose detects double proposals given a block by looking in the db.
+// DetectDoublePropose detects double proposals given a block by looking in the proposal history
+// of the proposer. Every distinct header of the same slot conflicts with the incoming one, whichever
+// fork it was proposed on, and whatever the order the headers are received in. The incoming header
+// is added to the proposal history. Block headers don't carry their proposer, so it is given by the
+// caller.
 func (dd *ProposeDetector) DetectDoublePropose(
 	ctx context.Context,
+	proposerIdx uint64,
 	incomingBlk *ethpb.SignedBeaconBlockHeader,
 ) (*ethpb.ProposerSlashing, error) {
 	ctx, span := trace.StartSpan(ctx, "detector.DetectDoublePropose")
 	defer span.End()
-	epoch := helpers.SlotToEpoch(incomingBlk.Header.Slot)
-	//TODO(#5119) remove constand and use input from block header.
-	//validatorIdx:=blk.Header.ProposerIndex
-	proposerIdx := uint64(0)
-	bha, err := dd.slasherDB.BlockHeaders(ctx, epoch, proposerIdx)
+	history, err := dd.slasherDB.ProposalHistoryForSlot(ctx, proposerIdx, incomingBlk.Header.Slot)
 	if err != nil {
 		return nil, err
 	}
-	for _, bh := range bha {
+	if err := dd.slasherDB.SaveProposalHistory(ctx, proposerIdx, incomingBlk); err != nil {
+		return nil, err
+	}
+	for _, bh := range history {
 		if bytes.Equal(bh.Signature, incomingBlk.Signature) {
 			continue
 		}
@@ -17,15 +17,20 @@ var _ = iface.ProposalsDetector(&ProposeDetector{})
 func TestProposalsDetector_DetectSlashingsForBlockHeaders(t *testing.T) {
 	type testStruct struct {
 		name        string
-		blk         *ethpb.SignedBeaconBlockHeader
+		blks        []*ethpb.SignedBeaconBlockHeader
 		incomingBlk *ethpb.SignedBeaconBlockHeader
 		slashing    *ethpb.ProposerSlashing
 	}
-	blk1epoch0, err := signedBlockHeader(startSlot(0), 0)
+	blk1slot0, err := signedBlockHeader(startSlot(0), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
-	blk2epoch0, err := signedBlockHeader(startSlot(0)+1, 0)
+	blk2slot0, err := signedBlockHeader(startSlot(0), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk2slot0.Header.ParentRoot = []byte("fork parent root")
+	blk1slot1, err := signedBlockHeader(startSlot(0)+1, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -33,28 +38,36 @@ func TestProposalsDetector_DetectSlashingsForBlockHeaders(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	//blk1epoch3, err := signedBlockHeader(startSlot(3), 0)
-	//if err != nil {
-	//	t.Fatal(err)
-	//}
 	tests := []testStruct{
 		{
 			name:        "same block sig dont slash",
-			blk:         blk1epoch0,
-			incomingBlk: blk1epoch0,
+			blks:        []*ethpb.SignedBeaconBlockHeader{blk1slot0},
+			incomingBlk: blk1slot0,
 			slashing:    nil,
 		},
 		{
 			name:        "block from different epoch dont slash",
-			blk:         blk1epoch0,
+			blks:        []*ethpb.SignedBeaconBlockHeader{blk1slot0},
 			incomingBlk: blk1epoch1,
 			slashing:    nil,
 		},
 		{
-			name:        "different sig from same epoch slash",
-			blk:         blk1epoch0,
-			incomingBlk: blk2epoch0,
-			slashing:    &ethpb.ProposerSlashing{ProposerIndex: 0, Header_1: blk2epoch0, Header_2: blk1epoch0},
+			name:        "block from different slot of the same epoch dont slash",
+			blks:        []*ethpb.SignedBeaconBlockHeader{blk1slot0},
+			incomingBlk: blk1slot1,
+			slashing:    nil,
+		},
+		{
+			name:        "block from same slot on a different fork slash",
+			blks:        []*ethpb.SignedBeaconBlockHeader{blk1slot0},
+			incomingBlk: blk2slot0,
+			slashing:    &ethpb.ProposerSlashing{ProposerIndex: 7, Header_1: blk2slot0, Header_2: blk1slot0},
+		},
+		{
+			name:        "block from same slot received after later blocks slash",
+			blks:        []*ethpb.SignedBeaconBlockHeader{blk1slot0, blk1slot1, blk1epoch1},
+			incomingBlk: blk2slot0,
+			slashing:    &ethpb.ProposerSlashing{ProposerIndex: 7, Header_1: blk2slot0, Header_2: blk1slot0},
 		},
 	}
 
@@ -68,11 +81,13 @@ func TestProposalsDetector_DetectSlashingsForBlockHeaders(t *testing.T) {
 				slasherDB: db,
 			}
 
-			if err := sd.slasherDB.SaveBlockHeader(ctx, 0, tt.blk); err != nil {
-				t.Fatal(err)
+			for _, blk := range tt.blks {
+				if _, err := sd.DetectDoublePropose(ctx, 7, blk); err != nil {
+					t.Fatal(err)
+				}
 			}
 
-			res, err := sd.DetectDoublePropose(ctx, tt.incomingBlk)
+			res, err := sd.DetectDoublePropose(ctx, 7, tt.incomingBlk)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -85,6 +100,34 @@ func TestProposalsDetector_DetectSlashingsForBlockHeaders(t *testing.T) {
 	}
 }
 
+func TestProposalsDetector_KeepsProposalHistory(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	sd := NewProposeDetector(db)
+
+	blk1, err := signedBlockHeader(startSlot(2), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk2, err := signedBlockHeader(startSlot(2), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, blk := range []*ethpb.SignedBeaconBlockHeader{blk1, blk2, blk1} {
+		if _, err := sd.DetectDoublePropose(ctx, 7, blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history, err := db.ProposalHistoryForSlot(ctx, 7, startSlot(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Errorf("Wanted 2 headers in the proposal history, received %d", len(history))
+	}
+}
+
 func signedBlockHeader(slot uint64, proposerIdx uint64) (*ethpb.SignedBeaconBlockHeader, error) {
 	sig, err := genRandomSig()
 	if err != nil {
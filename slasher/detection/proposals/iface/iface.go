@@ -8,5 +8,5 @@ import (
 
 // ProposalsDetector defines an interface for different implementations.
 type ProposalsDetector interface {
-	DetectDoublePropose(ctx context.Context, incomingBlk *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
+	DetectDoublePropose(ctx context.Context, proposerIdx uint64, incomingBlk *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
 }
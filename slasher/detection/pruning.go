@@ -0,0 +1,71 @@
+package detection
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// runPruning deletes the span maps, indexed attestations and block headers older than the
+// pruning epoch age from the slasher DB, every prune slasher storage period epochs. Span maps
+// older than the spans length are deleted as well, and history older than the compaction age
+// is compacted down to the detected slashings.
+func (ds *Service) runPruning(ctx context.Context) {
+	period := params.BeaconConfig().PruneSlasherStoragePeriod * params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	ticker := time.NewTicker(time.Duration(period) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := ds.pruneHistory(ctx); err != nil {
+				log.WithError(err).Error("Could not prune slasher DB")
+			}
+		case <-ctx.Done():
+			log.Debug("Context canceled, stopping pruning routine")
+			return
+		}
+	}
+}
+
+// pruneHistory prunes the slasher DB relative to the current chain head epoch of the beacon node.
+func (ds *Service) pruneHistory(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "detection.pruneHistory")
+	defer span.End()
+	head, err := ds.chainFetcher.ChainHead(ctx)
+	if err != nil {
+		return err
+	}
+	// Past the compaction age only the detected slashings and their evidence are retained.
+	// Compaction prunes the raw history itself, the history is traversed once from the lowest
+	// of the pruning and compaction ages.
+	switch {
+	case ds.compactAfterEpochs > 0:
+		age := ds.compactAfterEpochs
+		if ds.pruningEpochAge > 0 && ds.pruningEpochAge < age {
+			age = ds.pruningEpochAge
+		}
+		if err := ds.slasherDB.CompactHistory(ctx, head.HeadEpoch, age); err != nil {
+			return err
+		}
+		if head.HeadEpoch > age {
+			compactedEpoch.Set(float64(head.HeadEpoch - age))
+		}
+	case ds.pruningEpochAge > 0:
+		if err := ds.slasherDB.PruneHistory(ctx, head.HeadEpoch, ds.pruningEpochAge); err != nil {
+			return err
+		}
+	}
+	// Span maps are only read within the spans length of the head, older ones can go sooner.
+	if ds.spansLength > 0 && (ds.pruningEpochAge == 0 || ds.spansLength < ds.pruningEpochAge) {
+		if err := ds.slasherDB.PruneSpanMaps(ctx, head.HeadEpoch, ds.spansLength); err != nil {
+			return err
+		}
+	}
+	if ds.pruningEpochAge > 0 && head.HeadEpoch > ds.pruningEpochAge {
+		prunedEpoch.Set(float64(head.HeadEpoch - ds.pruningEpochAge))
+	}
+	log.WithField("headEpoch", head.HeadEpoch).Debug("Pruned slasher DB")
+	return nil
+}
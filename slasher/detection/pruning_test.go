@@ -0,0 +1,41 @@
+package detection
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestService_PruneHistory_SpansLengthWithoutPruningEpochAge(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := &Service{
+		ctx:          ctx,
+		slasherDB:    db,
+		chainFetcher: &mockChainFetcher{head: &ethpb.ChainHead{HeadEpoch: 10}},
+		spansLength:  4,
+	}
+	for epoch := uint64(1); epoch <= 10; epoch++ {
+		if err := db.SaveEpochSpansMap(ctx, epoch, map[uint64]types.Span{1: {MinSpan: 1, HasAttested: true}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Without a pruning epoch age, span maps outside of the spans length are still pruned.
+	if err := ds.pruneHistory(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for epoch := uint64(1); epoch <= 10; epoch++ {
+		spanMap, err := db.EpochSpansMap(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pruned := epoch <= 6; pruned != (len(spanMap) == 0) {
+			t.Errorf("Epoch %d: wanted span map pruned %v, got %d spans", epoch, pruned, len(spanMap))
+		}
+	}
+}
@@ -2,6 +2,7 @@ package detection
 
 import (
 	"context"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
 	proposerIface "github.com/prysmaticlabs/prysm/slasher/detection/proposals/iface"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -27,11 +29,24 @@ type Service struct {
 	attsChan              chan *ethpb.IndexedAttestation
 	notifier              beaconclient.Notifier
 	chainFetcher          beaconclient.ChainFetcher
-	beaconClient          *beaconclient.Service
+	historicalFetcher     beaconclient.HistoricalFetcher
 	attesterSlashingsFeed *event.Feed
 	proposerSlashingsFeed *event.Feed
 	minMaxSpanDetector    iface.SpanDetector
 	proposalsDetector     proposerIface.ProposalsDetector
+	detectionBudget       time.Duration
+	backfill              bool
+	backfillFromEpoch     uint64
+	pruningEpochAge       uint64
+	compactAfterEpochs    uint64
+	spansLength           uint64
+	spansSnapshotEpochs   uint64
+	notifications         *notifications.Service
+	intakeFilter          *intakeFilter
+	slashedFetcher        beaconclient.SlashedValidatorsFetcher
+	proposerFetcher       beaconclient.ProposerFetcher
+	processedEpoch        uint64 // Highest target epoch processed, accessed atomically.
+	validatorLocks        validatorLocks
 }
 
 // Config options for the detection service.
@@ -39,9 +54,37 @@ type Config struct {
 	Notifier              beaconclient.Notifier
 	SlasherDB             db.Database
 	ChainFetcher          beaconclient.ChainFetcher
-	BeaconClient          *beaconclient.Service
+	HistoricalFetcher     beaconclient.HistoricalFetcher
 	AttesterSlashingsFeed *event.Feed
 	ProposerSlashingsFeed *event.Feed
+	DetectionBudget       time.Duration
+	Backfill              bool
+	BackfillFromEpoch     uint64
+	PruningEpochAge       uint64
+	// CompactAfterEpochs is the age after which only detected slashings and their detection
+	// context are kept in the DB, 0 disables compaction.
+	CompactAfterEpochs uint64
+	// SpansLength is the number of epochs between the source and target of an attestation
+	// covered by surround vote detection.
+	SpansLength uint64
+	// Notifications is notified of the detected slashings, nil disables notifications.
+	Notifications *notifications.Service
+	// IntakeValidatorIndices restricts detection to attestations with at least one of
+	// these attesters, empty disables the restriction.
+	IntakeValidatorIndices []uint64
+	// MinCommitteeParticipation is the minimum share of its committee an attestation needs
+	// for detection to run on it, 0 disables the threshold.
+	MinCommitteeParticipation float64
+	CommitteeFetcher          beaconclient.CommitteeFetcher
+	// SlashedValidatorsFetcher checks whether the validators of detected slashings are already
+	// slashed on chain, those slashings are saved as included instead of active.
+	SlashedValidatorsFetcher beaconclient.SlashedValidatorsFetcher
+	// ProposerFetcher resolves the proposer of the blocks checked for double proposals, which
+	// block headers don't carry.
+	ProposerFetcher beaconclient.ProposerFetcher
+	// SpansSnapshotEpochs is the number of epochs between snapshots of the span maps cached by
+	// the slasher DB, 0 disables snapshots.
+	SpansSnapshotEpochs uint64
 }
 
 // NewDetectionService instantiation.
@@ -53,13 +96,24 @@ func NewDetectionService(ctx context.Context, cfg *Config) *Service {
 		notifier:              cfg.Notifier,
 		chainFetcher:          cfg.ChainFetcher,
 		slasherDB:             cfg.SlasherDB,
-		beaconClient:          cfg.BeaconClient,
+		historicalFetcher:     cfg.HistoricalFetcher,
 		blocksChan:            make(chan *ethpb.SignedBeaconBlock, 1),
 		attsChan:              make(chan *ethpb.IndexedAttestation, 1),
 		attesterSlashingsFeed: cfg.AttesterSlashingsFeed,
 		proposerSlashingsFeed: cfg.ProposerSlashingsFeed,
-		minMaxSpanDetector:    attestations.NewSpanDetector(cfg.SlasherDB),
+		minMaxSpanDetector:    attestations.NewSpanDetector(cfg.SlasherDB, cfg.SpansLength),
 		proposalsDetector:     proposals.NewProposeDetector(cfg.SlasherDB),
+		detectionBudget:       cfg.DetectionBudget,
+		backfill:              cfg.Backfill,
+		backfillFromEpoch:     cfg.BackfillFromEpoch,
+		pruningEpochAge:       cfg.PruningEpochAge,
+		compactAfterEpochs:    cfg.CompactAfterEpochs,
+		spansLength:           cfg.SpansLength,
+		spansSnapshotEpochs:   cfg.SpansSnapshotEpochs,
+		notifications:         cfg.Notifications,
+		intakeFilter:          newIntakeFilter(cfg),
+		slashedFetcher:        cfg.SlashedValidatorsFetcher,
+		proposerFetcher:       cfg.ProposerFetcher,
 	}
 }
 
@@ -85,63 +139,46 @@ func (ds *Service) Start() {
 	<-ch
 	sub.Unsubscribe()
 
-	// The detection service runs detection on all historical
-	// chain data since genesis.
-	// TODO(#5030): Re-enable after issue is resolved.
+	// The span maps of the DB may have been built with another spans length.
+	if err := ds.checkSpansLength(ds.ctx); err != nil {
+		log.WithError(err).Error("Could not check the spans length of the slasher DB")
+	}
 
 	// We subscribe to incoming blocks from the beacon node via
 	// our gRPC client to keep detecting slashable offenses.
+	ds.intakeFilter.logSettings()
 	go ds.detectIncomingBlocks(ds.ctx, ds.blocksChan)
 	go ds.detectIncomingAttestations(ds.ctx, ds.attsChan)
-}
-
-func (ds *Service) detectHistoricalChainData(ctx context.Context) {
-	ctx, span := trace.StartSpan(ctx, "detection.detectHistoricalChainData")
-	defer span.End()
-	// We fetch both the latest persisted chain head in our DB as well
-	// as the current chain head from the beacon node via gRPC.
-	latestStoredHead, err := ds.slasherDB.ChainHead(ctx)
-	if err != nil {
-		log.WithError(err).Fatal("Could not retrieve chain head from DB")
+	// Attestations received before a crash but never processed are replayed alongside the
+	// incoming ones.
+	go func() {
+		if err := ds.replayPendingAttestations(ds.ctx); err != nil {
+			log.WithError(err).Error("Could not replay unprocessed attestations")
+		}
+	}()
+	// Candidate scans deferred by the inline detection budget are run in the background.
+	go ds.runDeepChecks(ds.ctx)
+	// Offences committed before the slasher started are caught by running detection
+	// on the historical chain data.
+	if ds.backfill {
+		go ds.backfillHistoricalChainData(ds.ctx)
 	}
-	currentChainHead, err := ds.chainFetcher.ChainHead(ctx)
-	if err != nil {
-		log.WithError(err).Fatal("Cannot retrieve chain head from beacon node")
+	// Data older than the pruning epoch age, and span maps outside of the spans length, are
+	// deleted so the DB doesn't grow unbounded.
+	if ds.pruningEpochAge > 0 || ds.compactAfterEpochs > 0 || ds.spansLength > 0 {
+		go ds.runPruning(ds.ctx)
 	}
-	var latestStoredEpoch uint64
-	if latestStoredHead != nil {
-		latestStoredEpoch = latestStoredHead.HeadEpoch
+	// Span maps kept in memory are written to disk periodically so a crash loses at most a
+	// snapshot period of span updates.
+	if ds.spansSnapshotEpochs > 0 {
+		go ds.runSpanSnapshots(ds.ctx)
 	}
-
-	// We retrieve historical chain data from the last persisted chain head in the
-	// slasher DB up to the current beacon node's head epoch we retrieved via gRPC.
-	// If no data was persisted from previous sessions, we request data starting from
-	// the genesis epoch.
-	for epoch := latestStoredEpoch; epoch < currentChainHead.HeadEpoch; epoch++ {
-		indexedAtts, err := ds.beaconClient.RequestHistoricalAttestations(ctx, epoch)
-		if err != nil {
-			log.WithError(err).Errorf("Could not fetch attestations for epoch: %d", epoch)
-		}
-		log.Debugf(
-			"Running slashing detection on %d attestations in epoch %d...",
-			len(indexedAtts),
-			epoch,
-		)
-
-		for _, att := range indexedAtts {
-			slashings, err := ds.DetectAttesterSlashings(ctx, att)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
-		}
+	// Operators are pushed a notification for every detected slashing.
+	if ds.notifications.Subscribed(notifications.Detection) {
+		go ds.runNotifications(ds.ctx)
 	}
-
-	if err := ds.slasherDB.SaveChainHead(ctx, currentChainHead); err != nil {
-		log.WithError(err).Error("Could not persist chain head to disk")
-	}
-	log.Infof("Completed slashing detection on historical chain data up to epoch %d", currentChainHead.HeadEpoch)
+	// The detection lag and queue depths are reported as metrics.
+	go ds.runMonitoring(ds.ctx)
 }
 
 func (ds *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
@@ -150,6 +187,10 @@ func (ds *Service) submitAttesterSlashings(ctx context.Context, slashings []*eth
 	for i := 0; i < len(slashings); i++ {
 		slash := slashings[i]
 		if slash != nil && slash.Attestation_1 != nil && slash.Attestation_2 != nil {
+			// Slashings of validators already slashed on chain aren't submitted again.
+			if ds.isIncludedAttesterSlashing(ctx, slash) {
+				continue
+			}
 			slashableIndices := sliceutil.IntersectionUint64(slashings[i].Attestation_1.AttestingIndices, slashings[i].Attestation_2.AttestingIndices)
 			log.WithFields(logrus.Fields{
 				"sourceEpoch":  slash.Attestation_1.Data.Source.Epoch,
@@ -0,0 +1,109 @@
+package detection
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+)
+
+// saveAttesterSlashings saves detected attester slashings as active, except the slashings of
+// validators which are all already slashed on chain, saved as included instead so they are
+// neither reported as active nor submitted to the beacon node.
+func (ds *Service) saveAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "detection.saveAttesterSlashings")
+	defer span.End()
+	var indices []uint64
+	for _, slashing := range slashings {
+		indices = append(indices, slashableIndices(slashing)...)
+	}
+	slashed := ds.slashedOnChain(ctx, indices)
+	var active, included []*ethpb.AttesterSlashing
+	for _, slashing := range slashings {
+		if allSlashed(slashableIndices(slashing), slashed) {
+			included = append(included, slashing)
+		} else {
+			active = append(active, slashing)
+		}
+	}
+	if err := ds.slasherDB.SaveAttesterSlashings(ctx, status.Active, active); err != nil {
+		return err
+	}
+	if len(included) > 0 {
+		slashingsAlreadyOnChain.Add(float64(len(included)))
+		if err := ds.slasherDB.SaveAttesterSlashings(ctx, status.Included, included); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markProposerSlashingOnChain saves a detected proposer slashing as included if the proposer
+// is already slashed on chain.
+func (ds *Service) markProposerSlashingOnChain(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "detection.markProposerSlashingOnChain")
+	defer span.End()
+	if !ds.slashedOnChain(ctx, []uint64{slashing.ProposerIndex})[slashing.ProposerIndex] {
+		return nil
+	}
+	slashingsAlreadyOnChain.Inc()
+	return ds.slasherDB.SaveProposerSlashing(ctx, status.Included, slashing)
+}
+
+// slashedOnChain returns which of the input validators are already slashed on chain. Failing to
+// check with the beacon node is logged and no validator is reported slashed, so slashings are
+// still submitted.
+func (ds *Service) slashedOnChain(ctx context.Context, indices []uint64) map[uint64]bool {
+	if ds.slashedFetcher == nil || len(indices) == 0 {
+		return nil
+	}
+	slashed, err := ds.slashedFetcher.SlashedValidators(ctx, sliceutil.SetUint64(indices))
+	if err != nil {
+		log.WithError(err).Warn("Could not check whether validators are already slashed on chain")
+		return nil
+	}
+	return slashed
+}
+
+// isIncludedAttesterSlashing returns true if an attester slashing is stored as included, it
+// doesn't need to be submitted to the beacon node.
+func (ds *Service) isIncludedAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) bool {
+	found, st, err := ds.slasherDB.HasAttesterSlashing(ctx, slashing)
+	if err != nil {
+		log.WithError(err).Error("Could not check attester slashing status")
+		return false
+	}
+	return found && st == status.Included
+}
+
+// isIncludedProposerSlashing returns true if a proposer slashing is stored as included, it
+// doesn't need to be submitted to the beacon node.
+func (ds *Service) isIncludedProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) bool {
+	found, st, err := ds.slasherDB.HasProposerSlashing(ctx, slashing)
+	if err != nil {
+		log.WithError(err).Error("Could not check proposer slashing status")
+		return false
+	}
+	return found && st == status.Included
+}
+
+func slashableIndices(slashing *ethpb.AttesterSlashing) []uint64 {
+	if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		return nil
+	}
+	return sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+}
+
+func allSlashed(indices []uint64, slashed map[uint64]bool) bool {
+	if len(indices) == 0 {
+		return false
+	}
+	for _, idx := range indices {
+		if !slashed[idx] {
+			return false
+		}
+	}
+	return true
+}
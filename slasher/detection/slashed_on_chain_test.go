@@ -0,0 +1,85 @@
+package detection
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+)
+
+type mockSlashedFetcher struct {
+	slashed map[uint64]bool
+}
+
+func (m *mockSlashedFetcher) SlashedValidators(_ context.Context, _ []uint64) (map[uint64]bool, error) {
+	return m.slashed, nil
+}
+
+func TestDetect_SlashingsAlreadyOnChainSavedAsIncluded(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                   ctx,
+		slasherDB:             db,
+		minMaxSpanDetector:    attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+		attesterSlashingsFeed: new(event.Feed),
+		slashedFetcher:        &mockSlashedFetcher{slashed: map[uint64]bool{3: true}},
+	}
+	saved := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 3},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+	if err := db.SaveIndexedAttestation(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.minMaxSpanDetector.UpdateSpans(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	incoming := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+		Signature: []byte{1, 2},
+	}
+
+	slashings, err := ds.DetectAttesterSlashings(ctx, incoming)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Wanted 1 slashing detected, received %d", len(slashings))
+	}
+	active, err := db.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		t.Fatal(err)
+	}
+	included, err := db.AttesterSlashings(ctx, status.Included)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 0 || len(included) != 1 {
+		t.Errorf("Wanted the slashing saved as included, received %d active and %d included", len(active), len(included))
+	}
+
+	ch := make(chan *ethpb.AttesterSlashing, 1)
+	sub := ds.attesterSlashingsFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	ds.submitAttesterSlashings(ctx, slashings)
+	select {
+	case <-ch:
+		t.Error("Wanted the slashing of a validator slashed on chain not submitted")
+	default:
+	}
+}
@@ -0,0 +1,27 @@
+package detection
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// runSpanSnapshots writes the span maps cached by the slasher DB to disk every spans snapshot
+// epochs.
+func (ds *Service) runSpanSnapshots(ctx context.Context) {
+	period := ds.spansSnapshotEpochs * params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	ticker := time.NewTicker(time.Duration(period) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := ds.minMaxSpanDetector.SnapshotSpans(ctx); err != nil {
+				log.WithError(err).Error("Could not snapshot span maps")
+			}
+		case <-ctx.Done():
+			log.Debug("Context canceled, stopping span snapshots routine")
+			return
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package detection
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// checkSpansLength compares the spans length the span maps of the DB were built with to the
+// configured one, and records the configured one.
+func (ds *Service) checkSpansLength(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "detection.checkSpansLength")
+	defer span.End()
+	if ds.spansLength == 0 {
+		return nil
+	}
+	stored, err := ds.slasherDB.SpansLength(ctx)
+	if err != nil {
+		return err
+	}
+	if stored == ds.spansLength {
+		return nil
+	}
+	fields := logrus.Fields{
+		"previousSpansLength": stored,
+		"spansLength":         ds.spansLength,
+	}
+	if stored > ds.spansLength {
+		log.WithFields(fields).Info("Spans length decreased, span maps outside of the new window will be pruned")
+	} else if stored != 0 {
+		// Min spans were only recorded within the previous window, surround votes spanning more
+		// epochs are only caught for attestations received from now on.
+		log.WithFields(fields).Warn("Spans length increased, surround votes longer than the previous spans length are only detected for new attestations")
+	}
+	return ds.slasherDB.SaveSpansLength(ctx, ds.spansLength)
+}
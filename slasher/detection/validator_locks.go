@@ -0,0 +1,102 @@
+package detection
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// validatorLockStripes is the number of locks the validator indices are striped over.
+const validatorLockStripes = 256
+
+// validatorLocks serializes the detection of attestations sharing validators. Validators are
+// striped over a fixed set of locks, two attestations contend when any of their validators
+// share a stripe. Aggregates touching most of the stripes take the global lock instead of
+// acquiring the stripes one by one. The zero value is ready to use.
+type validatorLocks struct {
+	global  sync.RWMutex
+	stripes [validatorLockStripes]sync.Mutex
+}
+
+// This locks the stripes of the input validator indices and returns the function unlocking
+// them. Stripes are locked in increasing order so concurrent callers can't deadlock. Holding the
+// global lock for writing excludes the holders of any stripe, which hold it for reading.
+func (l *validatorLocks) lock(indices []uint64) func() {
+	seen := make(map[int]bool, len(indices))
+	ids := make([]int, 0, len(indices))
+	for _, idx := range indices {
+		id := int(idx % validatorLockStripes)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > validatorLockStripes/2 {
+		l.global.Lock()
+		return l.global.Unlock
+	}
+	sort.Ints(ids)
+	l.global.RLock()
+	for _, id := range ids {
+		l.stripes[id].Lock()
+	}
+	return func() {
+		for i := len(ids) - 1; i >= 0; i-- {
+			l.stripes[ids[i]].Unlock()
+		}
+		l.global.RUnlock()
+	}
+}
+
+// DetectAndUpdateSpans detects the slashings of an attestation and, when it isn't slashable,
+// updates the spans of its validators. The spans read by detection and the update are done
+// under the locks of the attesting validators: two attestations of a validator processed
+// concurrently would otherwise both be checked against spans missing the other one, and an
+// offence between them would go undetected.
+func (ds *Service) DetectAndUpdateSpans(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	return ds.detectAndUpdateSpans(ctx, att, true /* bounded */)
+}
+
+// DetectAndUpdateSpansUnbounded is DetectAndUpdateSpans running every candidate scan inline
+// regardless of the detection budget. Callers answering whether an attestation is slashable,
+// such as the slashing protection RPC, must use it: a deferred scan would report a slashable
+// attestation as safe.
+func (ds *Service) DetectAndUpdateSpansUnbounded(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	return ds.detectAndUpdateSpans(ctx, att, false /* bounded */)
+}
+
+func (ds *Service) detectAndUpdateSpans(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	bounded bool,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DetectAndUpdateSpans")
+	defer span.End()
+	unlock := ds.validatorLocks.lock(att.AttestingIndices)
+	defer unlock()
+
+	slashings, err := ds.detectAttesterSlashings(ctx, att, bounded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect attester slashings")
+	}
+	if len(slashings) > 0 {
+		return slashings, nil
+	}
+	start := time.Now()
+	if err := ds.minMaxSpanDetector.UpdateSpans(ctx, att); err != nil {
+		return nil, errors.Wrap(err, "could not update spans")
+	}
+	observeDetectionLatency(spanUpdateLatency, start)
+	return nil, nil
+}
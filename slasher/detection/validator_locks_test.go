@@ -0,0 +1,115 @@
+package detection
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+)
+
+func TestDetectAndUpdateSpans_ConcurrentSurroundVotes(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := &Service{
+		ctx:                ctx,
+		slasherDB:          db,
+		minMaxSpanDetector: attestations.NewSpanDetector(db, params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+
+	// Every validator casts a surrounding and a surrounded vote which are processed concurrently.
+	// Whichever is processed second has to be checked against the spans of the first one.
+	const numValidators = 64
+	var pairs [][2]*ethpb.IndexedAttestation
+	for i := uint64(0); i < numValidators; i++ {
+		pair := [2]*ethpb.IndexedAttestation{
+			{
+				AttestingIndices: []uint64{i},
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 2},
+					Target: &ethpb.Checkpoint{Epoch: 5},
+				},
+				Signature: []byte{1},
+			},
+			{
+				AttestingIndices: []uint64{i},
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 1},
+					Target: &ethpb.Checkpoint{Epoch: 6},
+				},
+				Signature: []byte{2},
+			},
+		}
+		if err := db.SaveIndexedAttestations(ctx, pair[:]); err != nil {
+			t.Fatal(err)
+		}
+		pairs = append(pairs, pair)
+	}
+
+	var lock sync.Mutex
+	detected := make(map[uint64]int)
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i, pair := range pairs {
+		for _, att := range pair {
+			wg.Add(1)
+			go func(validatorIdx uint64, att *ethpb.IndexedAttestation) {
+				defer wg.Done()
+				<-start
+				slashings, err := ds.DetectAndUpdateSpans(ctx, att)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				lock.Lock()
+				detected[validatorIdx] += len(slashings)
+				lock.Unlock()
+			}(uint64(i), att)
+		}
+	}
+	close(start)
+	wg.Wait()
+
+	for i := uint64(0); i < numValidators; i++ {
+		if detected[i] != 1 {
+			t.Errorf("Expected 1 slashing for validator %d, received %d", i, detected[i])
+		}
+	}
+}
+
+func TestValidatorLocks_SharedStripes(t *testing.T) {
+	locks := &validatorLocks{}
+	// Indices of the same stripe are locked once.
+	unlock := locks.lock([]uint64{1, 1 + validatorLockStripes, 2})
+	unlock()
+	// The stripes were all released.
+	unlock = locks.lock([]uint64{1, 2})
+	unlock()
+}
+
+func TestValidatorLocks_GlobalLock(t *testing.T) {
+	locks := &validatorLocks{}
+	aggregate := make([]uint64, validatorLockStripes)
+	for i := range aggregate {
+		aggregate[i] = uint64(i)
+	}
+	unlock := locks.lock(aggregate)
+	// An attestation of a single validator waits for the aggregate holding the global lock.
+	locked := make(chan struct{})
+	go func() {
+		locks.lock([]uint64{3})()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("Expected the stripes to wait for the global lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+}
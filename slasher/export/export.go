@@ -0,0 +1,235 @@
+// Package export writes slasher detection data to CSV files partitioned by epoch so that it
+// can be loaded into data warehouses for offline analysis.
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "export")
+
+const (
+	indexedAttestationsFile = "indexed_attestations.csv"
+	attesterSlashingsFile   = "attester_slashings.csv"
+	proposerSlashingsFile   = "proposer_slashings.csv"
+)
+
+var (
+	indexedAttestationsHeader = []string{
+		"slot", "committee_index", "beacon_block_root", "source_epoch", "target_epoch", "attesting_indices", "signature",
+	}
+	attesterSlashingsHeader = []string{
+		"status", "slashable_indices", "attestation_1_source_epoch", "attestation_1_target_epoch",
+		"attestation_1_block_root", "attestation_2_source_epoch", "attestation_2_target_epoch", "attestation_2_block_root",
+	}
+	proposerSlashingsHeader = []string{
+		"status", "proposer_index", "slot", "header_1_body_root", "header_2_body_root",
+	}
+	slashingStatuses = []types.SlashingStatus{types.Active, types.Included, types.Reverted}
+)
+
+// Exporter writes indexed attestations and detected slashings from the slasher DB into
+// one directory per epoch, named epoch=<epoch>, under the output directory.
+type Exporter struct {
+	slasherDB db.ReadOnlyDatabase
+	outputDir string
+}
+
+// NewExporter returns an exporter reading from the given DB and writing below outputDir.
+func NewExporter(slasherDB db.ReadOnlyDatabase, outputDir string) *Exporter {
+	return &Exporter{
+		slasherDB: slasherDB,
+		outputDir: outputDir,
+	}
+}
+
+// ExportRange exports every epoch between fromEpoch and toEpoch, inclusive. Epochs
+// without any data do not get a partition directory.
+func (e *Exporter) ExportRange(ctx context.Context, fromEpoch uint64, toEpoch uint64) error {
+	if fromEpoch > toEpoch {
+		return fmt.Errorf("from epoch %d is greater than to epoch %d", fromEpoch, toEpoch)
+	}
+	attSlashings, err := e.attesterSlashingsByEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	propSlashings, err := e.proposerSlashingsByEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		atts, err := e.slasherDB.IndexedAttestationsForTarget(ctx, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not get indexed attestations for epoch %d", epoch)
+		}
+		if err := e.exportEpoch(epoch, atts, attSlashings[epoch], propSlashings[epoch]); err != nil {
+			return errors.Wrapf(err, "could not export epoch %d", epoch)
+		}
+		// Guard against overflow when exporting up to the max epoch.
+		if epoch == toEpoch {
+			break
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) exportEpoch(
+	epoch uint64,
+	atts []*ethpb.IndexedAttestation,
+	attSlashings [][]string,
+	propSlashings [][]string,
+) error {
+	if len(atts) == 0 && len(attSlashings) == 0 && len(propSlashings) == 0 {
+		return nil
+	}
+	dir := filepath.Join(e.outputDir, fmt.Sprintf("epoch=%d", epoch))
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	attRecords := make([][]string, len(atts))
+	for i, att := range atts {
+		attRecords[i] = indexedAttestationRecord(att)
+	}
+	if err := writeCSV(filepath.Join(dir, indexedAttestationsFile), indexedAttestationsHeader, attRecords); err != nil {
+		return err
+	}
+	if err := writeCSV(filepath.Join(dir, attesterSlashingsFile), attesterSlashingsHeader, attSlashings); err != nil {
+		return err
+	}
+	if err := writeCSV(filepath.Join(dir, proposerSlashingsFile), proposerSlashingsHeader, propSlashings); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":             epoch,
+		"attestations":      len(atts),
+		"attesterSlashings": len(attSlashings),
+		"proposerSlashings": len(propSlashings),
+	}).Info("Exported epoch")
+	return nil
+}
+
+// attesterSlashingsByEpoch groups the attester slashing records of every status by the
+// target epoch of the second attestation.
+func (e *Exporter) attesterSlashingsByEpoch(ctx context.Context) (map[uint64][][]string, error) {
+	records := make(map[uint64][][]string)
+	for _, status := range slashingStatuses {
+		slashings, err := e.slasherDB.AttesterSlashings(ctx, status)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get %s attester slashings", status)
+		}
+		for _, slashing := range slashings {
+			att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+			if att1 == nil || att1.Data == nil || att2 == nil || att2.Data == nil {
+				continue
+			}
+			epoch := att2.Data.Target.Epoch
+			records[epoch] = append(records[epoch], []string{
+				status.String(),
+				joinUint64(sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)),
+				strconv.FormatUint(att1.Data.Source.Epoch, 10),
+				strconv.FormatUint(att1.Data.Target.Epoch, 10),
+				fmt.Sprintf("%#x", att1.Data.BeaconBlockRoot),
+				strconv.FormatUint(att2.Data.Source.Epoch, 10),
+				strconv.FormatUint(att2.Data.Target.Epoch, 10),
+				fmt.Sprintf("%#x", att2.Data.BeaconBlockRoot),
+			})
+		}
+	}
+	return records, nil
+}
+
+// proposerSlashingsByEpoch groups the proposer slashing records of every status by the
+// epoch of the slashed proposal.
+func (e *Exporter) proposerSlashingsByEpoch(ctx context.Context) (map[uint64][][]string, error) {
+	records := make(map[uint64][][]string)
+	for _, status := range slashingStatuses {
+		slashings, err := e.slasherDB.ProposalSlashingsByStatus(ctx, status)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get %s proposer slashings", status)
+		}
+		for _, slashing := range slashings {
+			h1, h2 := slashing.Header_1, slashing.Header_2
+			if h1 == nil || h1.Header == nil || h2 == nil || h2.Header == nil {
+				continue
+			}
+			epoch := h1.Header.Slot / params.BeaconConfig().SlotsPerEpoch
+			records[epoch] = append(records[epoch], []string{
+				status.String(),
+				strconv.FormatUint(slashing.ProposerIndex, 10),
+				strconv.FormatUint(h1.Header.Slot, 10),
+				fmt.Sprintf("%#x", h1.Header.BodyRoot),
+				fmt.Sprintf("%#x", h2.Header.BodyRoot),
+			})
+		}
+	}
+	return records, nil
+}
+
+func indexedAttestationRecord(att *ethpb.IndexedAttestation) []string {
+	data := att.Data
+	if data == nil {
+		data = &ethpb.AttestationData{}
+	}
+	var source, target uint64
+	if data.Source != nil {
+		source = data.Source.Epoch
+	}
+	if data.Target != nil {
+		target = data.Target.Epoch
+	}
+	return []string{
+		strconv.FormatUint(data.Slot, 10),
+		strconv.FormatUint(data.CommitteeIndex, 10),
+		fmt.Sprintf("%#x", data.BeaconBlockRoot),
+		strconv.FormatUint(source, 10),
+		strconv.FormatUint(target, 10),
+		joinUint64(att.AttestingIndices),
+		fmt.Sprintf("%#x", att.Signature),
+	}
+}
+
+func writeCSV(path string, header []string, records [][]string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	w := csv.NewWriter(f)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+	return w.Error()
+}
+
+// joinUint64 joins validator indices with spaces, keeping the whole list in a single CSV column.
+func joinUint64(indices []uint64) string {
+	strs := make([]string, len(indices))
+	for i, idx := range indices {
+		strs[i] = strconv.FormatUint(idx, 10)
+	}
+	return strings.Join(strs, " ")
+}
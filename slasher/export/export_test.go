@@ -0,0 +1,97 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+func TestExportRange_PartitionsByEpoch(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+
+	att1 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		},
+		Signature: []byte{1, 2},
+	}
+	att2 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{2, 3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+			Slot:   1,
+		},
+		Signature: []byte{3, 4},
+	}
+	if err := db.SaveIndexedAttestations(ctx, []*ethpb.IndexedAttestation{att1, att2}); err != nil {
+		t.Fatal(err)
+	}
+	slashing := &ethpb.AttesterSlashing{Attestation_1: att1, Attestation_2: att2}
+	if err := db.SaveAttesterSlashing(ctx, types.Active, slashing); err != nil {
+		t.Fatal(err)
+	}
+
+	outputDir, err := ioutil.TempDir("", "slasher-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(outputDir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := NewExporter(db, outputDir).ExportRange(ctx, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(outputDir, "epoch=0")); !os.IsNotExist(err) {
+		t.Error("Expected no partition for an epoch without data")
+	}
+	attRecords := readCSV(t, filepath.Join(outputDir, "epoch=1", indexedAttestationsFile))
+	if len(attRecords) != 3 {
+		t.Errorf("Wanted header and 2 attestation rows, got %d rows", len(attRecords))
+	}
+	slashingRecords := readCSV(t, filepath.Join(outputDir, "epoch=1", attesterSlashingsFile))
+	if len(slashingRecords) != 2 {
+		t.Fatalf("Wanted header and 1 slashing row, got %d rows", len(slashingRecords))
+	}
+	if slashingRecords[1][0] != types.SlashingStatus(types.Active).String() || slashingRecords[1][1] != "2" {
+		t.Errorf("Unexpected slashing record %v", slashingRecords[1])
+	}
+}
+
+func TestExportRange_InvalidRange(t *testing.T) {
+	if err := NewExporter(nil, "").ExportRange(context.Background(), 2, 1); err == nil {
+		t.Error("Expected error for invalid epoch range")
+	}
+}
+
+func readCSV(t *testing.T, path string) [][]string {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return records
+}
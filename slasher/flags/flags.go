@@ -1,6 +1,9 @@
 package flags
 
 import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"gopkg.in/urfave/cli.v2"
 )
 
@@ -21,6 +24,18 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// RPCAuthTokensFileFlag defines the file of the tokens allowed to call the slasher endpoints.
+	RPCAuthTokensFileFlag = &cli.StringFlag{
+		Name: "rpc-auth-tokens-file",
+		Usage: "File of the tokens allowed to call the slasher gRPC and HTTP endpoints, one token and its scope, " +
+			"read or admin, per line. Callers send the token as an \"Authorization: Bearer <token>\" header. " +
+			"If not set, any caller is allowed",
+	}
+	// MonitoringTLSFlag serves the monitoring HTTP endpoints over TLS.
+	MonitoringTLSFlag = &cli.BoolFlag{
+		Name:  "monitoring-tls",
+		Usage: "Serve the monitoring HTTP endpoints, including /slashings and /detection/dry-run, over TLS with the tls-cert and tls-key",
+	}
 	// BeaconCertFlag defines a flag for the beacon api certificate.
 	BeaconCertFlag = &cli.StringFlag{
 		Name:  "beacon-tls-cert",
@@ -28,18 +43,158 @@ var (
 	}
 	// BeaconRPCProviderFlag defines a flag for the beacon host ip or address.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. Multiple comma-separated endpoints can be given, " +
+			"the first reachable one in order is used and the slasher fails over to the next ones " +
+			"when it becomes unreachable",
 		Value: "localhost:4000",
 	}
+	// StreamIndexedAttestationsFlag receives attestations indexed by the beacon node.
+	StreamIndexedAttestationsFlag = &cli.BoolFlag{
+		Name: "stream-indexed-attestations",
+		Usage: "Receive the indexed attestations stream of the beacon node instead of the unindexed one. By default " +
+			"attesting indices are resolved by the slasher from the beacon committees, requested from the beacon node " +
+			"on demand and cached per epoch",
+	}
 	// UseSpanCacheFlag enables the slasher to use span cache.
 	UseSpanCacheFlag = &cli.BoolFlag{
 		Name:  "span-map-cache",
 		Usage: "Enable span map cache",
 	}
+	// InMemorySpansFlag enables the slasher to keep the span maps of every epoch in memory.
+	InMemorySpansFlag = &cli.BoolFlag{
+		Name: "in-memory-spans",
+		Usage: "Keep the span maps of every epoch in memory for maximum detection throughput. Span maps are " +
+			"written to disk every --spans-snapshot-epochs epochs and on shutdown, a crash loses the span updates " +
+			"since the last snapshot",
+	}
+	// InMemorySpansMaxMBFlag bounds the memory taken by the span maps kept in memory.
+	InMemorySpansMaxMBFlag = &cli.Uint64Flag{
+		Name: "in-memory-spans-max-mb",
+		Usage: "Estimated memory in megabytes the span maps of --in-memory-spans may take, the least recently " +
+			"used epochs are written to disk and dropped from memory past it. 0 disables the bound",
+		Value: 4096,
+	}
+	// SpansSnapshotEpochsFlag defines how often the span maps kept in memory are written to disk.
+	SpansSnapshotEpochsFlag = &cli.Uint64Flag{
+		Name:  "spans-snapshot-epochs",
+		Usage: "Number of epochs between snapshots of the span maps of --in-memory-spans to disk. 0 disables snapshots",
+		Value: 4,
+	}
 	// RebuildSpanMapsFlag iterate through all indexed attestations in db and update all validators span maps from scratch.
 	RebuildSpanMapsFlag = &cli.BoolFlag{
 		Name:  "rebuild-span-maps",
 		Usage: "Rebuild span maps from indexed attestations in db",
 	}
+	// DetectionBudgetFlag defines how long candidate scans may run inline per attestation.
+	DetectionBudgetFlag = &cli.DurationFlag{
+		Name:  "detection-budget",
+		Usage: "Time budget for confirming slashings of an incoming attestation inline, remaining checks are deferred to a background worker. 0 disables deferral",
+		Value: 250 * time.Millisecond,
+	}
+	// BackfillFlag enables running slashing detection on historical chain data on startup.
+	BackfillFlag = &cli.BoolFlag{
+		Name:  "backfill",
+		Usage: "Run slashing detection on the historical blocks and attestations of the beacon node on startup",
+	}
+	// BackfillFromEpochFlag defines the epoch historical slashing detection starts from.
+	BackfillFromEpochFlag = &cli.Uint64Flag{
+		Name:  "backfill-from-epoch",
+		Usage: "Epoch to start the backfill from, epochs already backfilled by a previous run are skipped",
+	}
+	// PruningEpochAgeFlag defines how many epochs of history the slasher DB keeps.
+	PruningEpochAgeFlag = &cli.Uint64Flag{
+		Name:  "pruning-epoch-age",
+		Usage: "Number of epochs of span maps, indexed attestations and block headers kept in the DB, older data is pruned. 0 disables pruning",
+		Value: params.BeaconConfig().WeakSubjectivityPeriod,
+	}
+	// CompactAfterEpochsFlag defines after how many epochs the slasher DB history is compacted.
+	CompactAfterEpochsFlag = &cli.Uint64Flag{
+		Name: "compact-after-epochs",
+		Usage: "Number of epochs after which raw attestations, span maps and block headers are deleted while " +
+			"detected slashings and their detection context are kept. Offences older than this can no longer " +
+			"be detected. 0 disables compaction",
+	}
+	// SpansLengthFlag defines the number of epochs covered by the min-max span detection.
+	SpansLengthFlag = &cli.Uint64Flag{
+		Name: "spans-length",
+		Usage: "Number of epochs between the source and target of an attestation covered by surround vote detection, " +
+			"lower values reduce the disk and memory used by span maps. Must be between 1 and 65535",
+		Value: params.BeaconConfig().WeakSubjectivityPeriod,
+	}
+	// WebhookURLFlag specifies the HTTP endpoints that slashing events are posted to.
+	WebhookURLFlag = &cli.StringSliceFlag{
+		Name: "slashing-webhook",
+		Usage: "URL to POST a JSON notification to for every slashing event of --slashing-webhook-events, including " +
+			"the event, the slashable validator indices and epochs. Can be specified multiple times.",
+	}
+	// WebhookEventsFlag specifies the slashing events posted to the generic webhooks.
+	WebhookEventsFlag = &cli.StringSliceFlag{
+		Name: "slashing-webhook-events",
+		Usage: "Slashing events posted to the --slashing-webhook URLs: detection, submission or inclusion. " +
+			"Can be specified multiple times or comma separated, defaults to detection.",
+	}
+	// SlackWebhookURLFlag specifies the Slack incoming webhook that slashing events are posted to.
+	SlackWebhookURLFlag = &cli.StringFlag{
+		Name:  "slack-webhook",
+		Usage: "Slack incoming webhook URL to post a message to for every slashing event of --slack-webhook-events",
+	}
+	// SlackEventsFlag specifies the slashing events posted to Slack.
+	SlackEventsFlag = &cli.StringSliceFlag{
+		Name: "slack-webhook-events",
+		Usage: "Slashing events posted to the --slack-webhook URL: detection, submission or inclusion. " +
+			"Can be specified multiple times or comma separated, defaults to detection.",
+	}
+	// PagerDutyRoutingKeyFlag specifies the PagerDuty integration key alerts are triggered with.
+	PagerDutyRoutingKeyFlag = &cli.StringFlag{
+		Name: "pagerduty-routing-key",
+		Usage: "PagerDuty Events API v2 routing key to send the slashing events of --pagerduty-events to. Detections " +
+			"and submissions trigger an alert for the slashing, its inclusion resolves it",
+	}
+	// PagerDutyEventsFlag specifies the slashing events sent to PagerDuty.
+	PagerDutyEventsFlag = &cli.StringSliceFlag{
+		Name: "pagerduty-events",
+		Usage: "Slashing events sent to PagerDuty: detection, submission or inclusion. " +
+			"Can be specified multiple times or comma separated, defaults to detection.",
+	}
+	// IntakeValidatorIndicesFlag restricts detection to the attestations of a validator set.
+	IntakeValidatorIndicesFlag = &cli.StringSliceFlag{
+		Name: "intake-validator-indices",
+		Usage: "Only run detection on attestations with at least one of these validator indices as attester, " +
+			"slashings of other validators are not detected. Can be specified multiple times or comma separated.",
+	}
+	// MinCommitteeParticipationFlag defines the committee participation attestations need for detection.
+	MinCommitteeParticipationFlag = &cli.Float64Flag{
+		Name: "min-committee-participation",
+		Usage: "Only run detection on attestations attested by at least this share of their committee, between 0 and 1, " +
+			"slashings in other attestations are not detected. 0 disables the threshold",
+	}
+	// DBFileFlag defines the file the db export and import subcommands write to and read from.
+	DBFileFlag = &cli.StringFlag{
+		Name:  "file",
+		Usage: "Path of the portable slasher DB file",
+		Value: "slasher-db.jsonl.gz",
+	}
+	// GenesisRootFlag defines the genesis namespace the export subcommands read from.
+	GenesisRootFlag = &cli.StringFlag{
+		Name: "genesis-root",
+		Usage: "Hex encoded genesis block root of the network to export the data of, the slasher keeps the data of each network it ran against separately. " +
+			"Defaults to the network the slasher last ran against",
+	}
+	// ExportOutputDirFlag defines the directory the export subcommand writes its epoch partitions to.
+	ExportOutputDirFlag = &cli.StringFlag{
+		Name:  "output-dir",
+		Usage: "Directory to write the exported CSV files to, one epoch=<epoch> subdirectory per epoch",
+		Value: "slasher-export",
+	}
+	// ExportFromEpochFlag defines the first target epoch included in an export.
+	ExportFromEpochFlag = &cli.Uint64Flag{
+		Name:  "from-epoch",
+		Usage: "First epoch (inclusive) of the exported range",
+	}
+	// ExportToEpochFlag defines the last target epoch included in an export.
+	ExportToEpochFlag = &cli.Uint64Flag{
+		Name:  "to-epoch",
+		Usage: "Last epoch (inclusive) of the exported range",
+	}
 )
@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"path"
 	"runtime"
+	"strings"
 
 	joonix "github.com/joonix/log"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/dump"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/prysmaticlabs/prysm/slasher/export"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/node"
 	"github.com/sirupsen/logrus"
@@ -34,6 +43,99 @@ func startSlasher(ctx *cli.Context) error {
 	return nil
 }
 
+func exportDetectionData(ctx *cli.Context) error {
+	if !ctx.IsSet(flags.ExportToEpochFlag.Name) {
+		return fmt.Errorf("%s is required", flags.ExportToEpochFlag.Name)
+	}
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.SlasherDBName)
+	d, err := db.NewDB(dbPath, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher DB")
+		}
+	}()
+	if err := useExportedGenesisNamespace(ctx, d, dbPath); err != nil {
+		return err
+	}
+	exporter := export.NewExporter(d, ctx.String(flags.ExportOutputDirFlag.Name))
+	return exporter.ExportRange(
+		context.Background(),
+		ctx.Uint64(flags.ExportFromEpochFlag.Name),
+		ctx.Uint64(flags.ExportToEpochFlag.Name),
+	)
+}
+
+func exportSlasherDB(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.SlasherDBName)
+	d, err := db.NewDB(dbPath, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher DB")
+		}
+	}()
+	if err := useExportedGenesisNamespace(ctx, d, dbPath); err != nil {
+		return err
+	}
+	f, err := os.Create(ctx.String(flags.DBFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if _, err := dump.Export(context.Background(), d, f); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close export file")
+		}
+		return err
+	}
+	return f.Close()
+}
+
+// useExportedGenesisNamespace switches the DB to the genesis namespace requested with
+// --genesis-root. Without the flag, the namespace the slasher last ran against is exported.
+func useExportedGenesisNamespace(ctx *cli.Context, d db.Database, dbPath string) error {
+	if !ctx.IsSet(flags.GenesisRootFlag.Name) {
+		return nil
+	}
+	genesisRoot, err := hex.DecodeString(strings.TrimPrefix(ctx.String(flags.GenesisRootFlag.Name), "0x"))
+	if err != nil {
+		return errors.Wrap(err, "could not decode genesis root")
+	}
+	// Switching to a namespace which doesn't exist would adopt the unnamespaced data.
+	if _, err := os.Stat(kv.GenesisNamespacePath(dbPath, genesisRoot)); err != nil {
+		return errors.Wrapf(err, "no data for genesis root %#x", genesisRoot)
+	}
+	return d.UseGenesisNamespace(context.Background(), genesisRoot)
+}
+
+func importSlasherDB(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.SlasherDBName)
+	d, err := db.NewDB(dbPath, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher DB")
+		}
+	}()
+	f, err := os.Open(ctx.String(flags.DBFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close import file")
+		}
+	}()
+	_, err = dump.Import(context.Background(), d, f)
+	return err
+}
+
 var appFlags = []cli.Flag{
 	cmd.VerbosityFlag,
 	cmd.DataDirFlag,
@@ -54,9 +156,30 @@ var appFlags = []cli.Flag{
 	debug.CPUProfileFlag,
 	debug.TraceFlag,
 	flags.RPCPort,
+	flags.CertFlag,
 	flags.KeyFlag,
+	flags.RPCAuthTokensFileFlag,
+	flags.MonitoringTLSFlag,
+	flags.StreamIndexedAttestationsFlag,
 	flags.UseSpanCacheFlag,
+	flags.InMemorySpansFlag,
+	flags.InMemorySpansMaxMBFlag,
+	flags.SpansSnapshotEpochsFlag,
 	flags.RebuildSpanMapsFlag,
+	flags.DetectionBudgetFlag,
+	flags.BackfillFlag,
+	flags.BackfillFromEpochFlag,
+	flags.PruningEpochAgeFlag,
+	flags.CompactAfterEpochsFlag,
+	flags.SpansLengthFlag,
+	flags.WebhookURLFlag,
+	flags.WebhookEventsFlag,
+	flags.SlackWebhookURLFlag,
+	flags.SlackEventsFlag,
+	flags.PagerDutyRoutingKeyFlag,
+	flags.PagerDutyEventsFlag,
+	flags.IntakeValidatorIndicesFlag,
+	flags.MinCommitteeParticipationFlag,
 	flags.BeaconCertFlag,
 	flags.BeaconRPCProviderFlag,
 }
@@ -68,6 +191,45 @@ func main() {
 	app.Version = version.GetVersion()
 	app.Flags = appFlags
 	app.Action = startSlasher
+	app.Commands = []*cli.Command{
+		{
+			Name:  "export",
+			Usage: "exports indexed attestations and detected slashings to CSV files partitioned by epoch",
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.ExportOutputDirFlag,
+				flags.ExportFromEpochFlag,
+				flags.ExportToEpochFlag,
+				flags.GenesisRootFlag,
+			},
+			Action: exportDetectionData,
+		},
+		{
+			Name:  "db",
+			Usage: "manages the slasher DB",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "export",
+					Usage: "exports the span maps, indexed attestations and detected slashings to a portable file",
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.DBFileFlag,
+						flags.GenesisRootFlag,
+					},
+					Action: exportSlasherDB,
+				},
+				{
+					Name:  "import",
+					Usage: "imports a file written by db export into the slasher DB",
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.DBFileFlag,
+					},
+					Action: importSlasherDB,
+				},
+			},
+		},
+	}
 	app.Before = func(ctx *cli.Context) error {
 		format := ctx.String(cmd.LogFormat.Name)
 		switch format {
@@ -84,7 +246,7 @@ func main() {
 			logrus.SetFormatter(joonix.NewFormatter())
 			break
 		case "json":
-			logrus.SetFormatter(&logrus.JSONFormatter{})
+			logrus.SetFormatter(logutil.NewJSONFormatter())
 			break
 		default:
 			return fmt.Errorf("unknown log format %s", format)
@@ -3,23 +3,29 @@ package node
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 	"os/signal"
 	"path"
+	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/db/kv"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/prysmaticlabs/prysm/slasher/notifications"
 	"github.com/prysmaticlabs/prysm/slasher/rpc"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/urfave/cli.v2"
@@ -27,7 +33,8 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const slasherDBName = "slasherdata"
+// SlasherDBName is the name of the slasher database directory inside the data directory.
+const SlasherDBName = "slasherdata"
 
 // SlasherNode defines a struct that handles the services running a slashing detector
 // for eth2. It handles the lifecycle of the entire system and registers
@@ -40,6 +47,7 @@ type SlasherNode struct {
 	attesterSlashingsFeed *event.Feed
 	stop                  chan struct{} // Channel to wait for termination notifications.
 	db                    db.Database
+	auth                  *rpc.Authenticator
 }
 
 // NewSlasherNode creates a new node instance, sets up configuration options,
@@ -63,11 +71,19 @@ func NewSlasherNode(ctx *cli.Context) (*SlasherNode, error) {
 		services:              registry,
 		stop:                  make(chan struct{}),
 	}
-	if err := slasher.registerPrometheusService(ctx); err != nil {
+	if err := slasher.startDB(ctx); err != nil {
 		return nil, err
 	}
 
-	if err := slasher.startDB(ctx); err != nil {
+	if tokensFile := ctx.String(flags.RPCAuthTokensFileFlag.Name); tokensFile != "" {
+		auth, err := rpc.LoadAuthTokens(tokensFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load RPC auth tokens")
+		}
+		slasher.auth = auth
+	}
+
+	if err := slasher.registerNotificationsService(ctx); err != nil {
 		return nil, err
 	}
 
@@ -75,7 +91,7 @@ func NewSlasherNode(ctx *cli.Context) (*SlasherNode, error) {
 		return nil, err
 	}
 
-	if err := slasher.registerDetectionService(); err != nil {
+	if err := slasher.registerDetectionService(ctx); err != nil {
 		return nil, err
 	}
 
@@ -83,6 +99,10 @@ func NewSlasherNode(ctx *cli.Context) (*SlasherNode, error) {
 		return nil, err
 	}
 
+	if err := slasher.registerPrometheusService(ctx); err != nil {
+		return nil, err
+	}
+
 	return slasher, nil
 }
 
@@ -128,10 +148,30 @@ func (s *SlasherNode) Close() {
 }
 
 func (s *SlasherNode) registerPrometheusService(ctx *cli.Context) error {
+	var detectionService *detection.Service
+	if err := s.services.FetchService(&detectionService); err != nil {
+		return err
+	}
+	dryRunHandler := detectionService.DryRunHandler
+	slashingsHandler := db.SlashingsHandler(s.db)
+	if s.auth != nil {
+		dryRunHandler = s.auth.HTTPHandler(rpc.ReadScope, dryRunHandler)
+		slashingsHandler = s.auth.HTTPHandler(rpc.ReadScope, slashingsHandler)
+	}
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.Int64(cmd.MonitoringPortFlag.Name)),
 		s.services,
+		prometheus.Handler{Path: "/detection/dry-run", Handler: dryRunHandler},
+		prometheus.Handler{Path: "/slashings", Handler: slashingsHandler},
 	)
+	if ctx.Bool(flags.MonitoringTLSFlag.Name) {
+		cert := ctx.String(flags.CertFlag.Name)
+		key := ctx.String(flags.KeyFlag.Name)
+		if cert == "" || key == "" {
+			return errors.New("monitoring TLS requires both the tls-cert and tls-key flags")
+		}
+		service.EnableTLS(cert, key)
+	}
 	logrus.AddHook(prometheus.NewLogrusCollector())
 	return s.services.RegisterService(service)
 }
@@ -140,8 +180,12 @@ func (s *SlasherNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.String(cmd.DataDirFlag.Name)
 	clearDB := ctx.Bool(cmd.ClearDB.Name)
 	forceClearDB := ctx.Bool(cmd.ForceClearDB.Name)
-	dbPath := path.Join(baseDir, slasherDBName)
-	cfg := &kv.Config{SpanCacheEnabled: ctx.Bool(flags.UseSpanCacheFlag.Name)}
+	dbPath := path.Join(baseDir, SlasherDBName)
+	cfg := &kv.Config{
+		SpanCacheEnabled:      ctx.Bool(flags.UseSpanCacheFlag.Name),
+		InMemorySpans:         ctx.Bool(flags.InMemorySpansFlag.Name),
+		InMemorySpansMaxBytes: ctx.Uint64(flags.InMemorySpansMaxMBFlag.Name) * 1024 * 1024,
+	}
 	d, err := db.NewDB(dbPath, cfg)
 	if err != nil {
 		return err
@@ -171,35 +215,125 @@ func (s *SlasherNode) startDB(ctx *cli.Context) error {
 	return nil
 }
 
+func (s *SlasherNode) registerNotificationsService(ctx *cli.Context) error {
+	var sinks []*notifications.SinkConfig
+	addSinks := func(eventsFlag string, newSinks ...notifications.Sink) error {
+		if len(newSinks) == 0 {
+			return nil
+		}
+		events, err := notifications.ParseEvents(ctx.StringSlice(eventsFlag))
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s value", eventsFlag)
+		}
+		for _, sink := range newSinks {
+			sinks = append(sinks, &notifications.SinkConfig{Sink: sink, Events: events})
+		}
+		return nil
+	}
+	var webhooks []notifications.Sink
+	for _, url := range ctx.StringSlice(flags.WebhookURLFlag.Name) {
+		webhooks = append(webhooks, notifications.NewWebhookSink(url))
+	}
+	if err := addSinks(flags.WebhookEventsFlag.Name, webhooks...); err != nil {
+		return err
+	}
+	if url := ctx.String(flags.SlackWebhookURLFlag.Name); url != "" {
+		if err := addSinks(flags.SlackEventsFlag.Name, notifications.NewSlackSink(url)); err != nil {
+			return err
+		}
+	}
+	if routingKey := ctx.String(flags.PagerDutyRoutingKeyFlag.Name); routingKey != "" {
+		if err := addSinks(flags.PagerDutyEventsFlag.Name, notifications.NewPagerDutySink(routingKey)); err != nil {
+			return err
+		}
+	}
+	ns := notifications.NewService(context.Background(), &notifications.Config{Sinks: sinks})
+	return s.services.RegisterService(ns)
+}
+
 func (s *SlasherNode) registerBeaconClientService(ctx *cli.Context) error {
+	var ns *notifications.Service
+	if err := s.services.FetchService(&ns); err != nil {
+		return err
+	}
+
 	beaconCert := ctx.String(flags.BeaconCertFlag.Name)
 	beaconProvider := ctx.String(flags.BeaconRPCProviderFlag.Name)
 	if beaconProvider == "" {
 		beaconProvider = flags.BeaconRPCProviderFlag.Value
 	}
+	var beaconProviders []string
+	for _, provider := range sliceutil.SplitCommaSeparated([]string{beaconProvider}) {
+		if provider = strings.TrimSpace(provider); provider != "" {
+			beaconProviders = append(beaconProviders, provider)
+		}
+	}
+	if len(beaconProviders) == 0 {
+		return errors.Errorf("no endpoint in %s value %q", flags.BeaconRPCProviderFlag.Name, beaconProvider)
+	}
 
 	bs := beaconclient.NewBeaconClientService(context.Background(), &beaconclient.Config{
-		BeaconCert:            beaconCert,
-		SlasherDB:             s.db,
-		BeaconProvider:        beaconProvider,
-		AttesterSlashingsFeed: s.attesterSlashingsFeed,
-		ProposerSlashingsFeed: s.proposerSlashingsFeed,
+		BeaconCert:                beaconCert,
+		SlasherDB:                 s.db,
+		BeaconProviders:           beaconProviders,
+		AttesterSlashingsFeed:     s.attesterSlashingsFeed,
+		ProposerSlashingsFeed:     s.proposerSlashingsFeed,
+		Notifications:             ns,
+		StreamIndexedAttestations: ctx.Bool(flags.StreamIndexedAttestationsFlag.Name),
 	})
 	return s.services.RegisterService(bs)
 }
 
-func (s *SlasherNode) registerDetectionService() error {
+func (s *SlasherNode) registerDetectionService(ctx *cli.Context) error {
 	var bs *beaconclient.Service
 	if err := s.services.FetchService(&bs); err != nil {
 		panic(err)
 	}
+	var ns *notifications.Service
+	if err := s.services.FetchService(&ns); err != nil {
+		return err
+	}
+	var intakeIndices []uint64
+	for _, idx := range sliceutil.SplitCommaSeparated(ctx.StringSlice(flags.IntakeValidatorIndicesFlag.Name)) {
+		i, err := strconv.ParseUint(strings.TrimSpace(idx), 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s value %q", flags.IntakeValidatorIndicesFlag.Name, idx)
+		}
+		intakeIndices = append(intakeIndices, i)
+	}
+	minParticipation := ctx.Float64(flags.MinCommitteeParticipationFlag.Name)
+	if minParticipation < 0 || minParticipation > 1 {
+		return fmt.Errorf("%s must be between 0 and 1, received %f", flags.MinCommitteeParticipationFlag.Name, minParticipation)
+	}
+	spansLength := ctx.Uint64(flags.SpansLengthFlag.Name)
+	if spansLength == 0 || spansLength > math.MaxUint16 {
+		return fmt.Errorf("%s must be between 1 and %d, received %d", flags.SpansLengthFlag.Name, math.MaxUint16, spansLength)
+	}
+	// Snapshots are only needed when span maps aren't written to disk as they are updated.
+	var spansSnapshotEpochs uint64
+	if ctx.Bool(flags.InMemorySpansFlag.Name) {
+		spansSnapshotEpochs = ctx.Uint64(flags.SpansSnapshotEpochsFlag.Name)
+	}
 	ds := detection.NewDetectionService(context.Background(), &detection.Config{
-		Notifier:              bs,
-		SlasherDB:             s.db,
-		BeaconClient:          bs,
-		ChainFetcher:          bs,
-		AttesterSlashingsFeed: s.attesterSlashingsFeed,
-		ProposerSlashingsFeed: s.proposerSlashingsFeed,
+		Notifier:                  bs,
+		SlasherDB:                 s.db,
+		HistoricalFetcher:         bs,
+		ChainFetcher:              bs,
+		AttesterSlashingsFeed:     s.attesterSlashingsFeed,
+		ProposerSlashingsFeed:     s.proposerSlashingsFeed,
+		DetectionBudget:           ctx.Duration(flags.DetectionBudgetFlag.Name),
+		Backfill:                  ctx.Bool(flags.BackfillFlag.Name),
+		BackfillFromEpoch:         ctx.Uint64(flags.BackfillFromEpochFlag.Name),
+		PruningEpochAge:           ctx.Uint64(flags.PruningEpochAgeFlag.Name),
+		CompactAfterEpochs:        ctx.Uint64(flags.CompactAfterEpochsFlag.Name),
+		SpansLength:               spansLength,
+		Notifications:             ns,
+		IntakeValidatorIndices:    intakeIndices,
+		MinCommitteeParticipation: minParticipation,
+		CommitteeFetcher:          bs,
+		SlashedValidatorsFetcher:  bs,
+		ProposerFetcher:           bs,
+		SpansSnapshotEpochs:       spansSnapshotEpochs,
 	})
 	return s.services.RegisterService(ds)
 }
@@ -219,6 +353,7 @@ func (s *SlasherNode) registerRPCService(ctx *cli.Context) error {
 		KeyFlag:   key,
 		Detector:  detectionService,
 		SlasherDB: s.db,
+		Auth:      s.auth,
 	})
 
 	return s.services.RegisterService(rpcService)
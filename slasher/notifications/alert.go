@@ -0,0 +1,119 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// Event is a stage of the lifecycle of a slashing operators can be notified of.
+type Event string
+
+const (
+	// Detection is the event of a slashing being detected by the slasher.
+	Detection Event = "detection"
+	// Submission is the event of a detected slashing being submitted to the beacon node.
+	Submission Event = "submission"
+	// Inclusion is the event of a slashing being seen included in a beacon block.
+	Inclusion Event = "inclusion"
+)
+
+// ParseEvents parses event names, an empty list defaults to the detection event.
+func ParseEvents(names []string) ([]Event, error) {
+	if len(names) == 0 {
+		return []Event{Detection}, nil
+	}
+	events := make([]Event, 0, len(names))
+	for _, name := range sliceutil.SplitCommaSeparated(names) {
+		switch e := Event(strings.TrimSpace(name)); e {
+		case Detection, Submission, Inclusion:
+			events = append(events, e)
+		default:
+			return nil, fmt.Errorf("unknown notification event %q, must be one of %s, %s or %s", name, Detection, Submission, Inclusion)
+		}
+	}
+	return events, nil
+}
+
+// Alert is the payload of the generic webhooks, it is also attached to the PagerDuty
+// notifications. The epoch is the target epoch of attester slashings and the epoch of the
+// proposals of proposer slashings, the slot and source epoch are only set for the latter and
+// the former respectively.
+type Alert struct {
+	Event            Event    `json:"event"`
+	Type             string   `json:"type"`
+	ValidatorIndices []uint64 `json:"validator_indices"`
+	Epoch            uint64   `json:"epoch"`
+	Slot             *uint64  `json:"slot,omitempty"`
+	SourceEpoch      *uint64  `json:"source_epoch,omitempty"`
+	SurroundVote     bool     `json:"surround_vote,omitempty"`
+}
+
+// AttesterSlashingAlert returns the alert of the event for an attester slashing. The attestation
+// with the lowest source and target epochs comes first, so the alerts of a slashing are the same
+// whichever order its attestations are in.
+func AttesterSlashingAlert(event Event, slashing *ethpb.AttesterSlashing) *Alert {
+	att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+	if att2.Data.Source.Epoch < att1.Data.Source.Epoch ||
+		att2.Data.Source.Epoch == att1.Data.Source.Epoch && att2.Data.Target.Epoch < att1.Data.Target.Epoch {
+		att1, att2 = att2, att1
+	}
+	sourceEpoch := att1.Data.Source.Epoch
+	return &Alert{
+		Event:            event,
+		Type:             "attester",
+		ValidatorIndices: sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices),
+		Epoch:            att1.Data.Target.Epoch,
+		SourceEpoch:      &sourceEpoch,
+		SurroundVote:     isSurrounding(att1, att2),
+	}
+}
+
+// ProposerSlashingAlert returns the alert of the event for a proposer slashing.
+func ProposerSlashingAlert(event Event, slashing *ethpb.ProposerSlashing) *Alert {
+	slot := slashing.Header_1.Header.Slot
+	return &Alert{
+		Event:            event,
+		Type:             "proposer",
+		ValidatorIndices: []uint64{slashing.ProposerIndex},
+		Epoch:            helpers.SlotToEpoch(slot),
+		Slot:             &slot,
+	}
+}
+
+func (a *Alert) summary() string {
+	verb := map[Event]string{
+		Detection:  "detected",
+		Submission: "submitted to the beacon node",
+		Inclusion:  "included in a block",
+	}[a.Event]
+	if a.Slot != nil {
+		return fmt.Sprintf("Proposer slashing %s for validators %v at slot %d", verb, a.ValidatorIndices, *a.Slot)
+	}
+	return fmt.Sprintf(
+		"Attester slashing %s for validators %v, source epoch %d, target epoch %d",
+		verb,
+		a.ValidatorIndices,
+		*a.SourceEpoch,
+		a.Epoch,
+	)
+}
+
+// This identifies the slashing of the alert across its events, so the alerts of a slashing are
+// grouped in the same PagerDuty incident.
+func (a *Alert) slashingKey() string {
+	if a.Slot != nil {
+		return fmt.Sprintf("%s-%v-%d", a.Type, a.ValidatorIndices, *a.Slot)
+	}
+	return fmt.Sprintf("%s-%v-%d-%d", a.Type, a.ValidatorIndices, *a.SourceEpoch, a.Epoch)
+}
+
+// This returns true if either attestation surrounds the vote of the other, the attestations of a
+// slashing included in a block may come in either order.
+func isSurrounding(att1 *ethpb.IndexedAttestation, att2 *ethpb.IndexedAttestation) bool {
+	return att1.Data.Source.Epoch < att2.Data.Source.Epoch && att2.Data.Target.Epoch < att1.Data.Target.Epoch ||
+		att2.Data.Source.Epoch < att1.Data.Source.Epoch && att1.Data.Target.Epoch < att2.Data.Target.Epoch
+}
@@ -0,0 +1,57 @@
+package notifications
+
+import (
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func TestParseEvents(t *testing.T) {
+	events, err := ParseEvents(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(events, []Event{Detection}) {
+		t.Errorf("Wanted the detection event by default, received %v", events)
+	}
+	events, err = ParseEvents([]string{"submission,inclusion", "detection"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(events, []Event{Submission, Inclusion, Detection}) {
+		t.Errorf("Unexpected events %v", events)
+	}
+	if _, err := ParseEvents([]string{"proposal"}); err == nil {
+		t.Error("Expected error parsing an unknown event")
+	}
+}
+
+func TestAttesterSlashingAlert_AttestationOrder(t *testing.T) {
+	att1 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2, 3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 4},
+		},
+	}
+	att2 := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{2, 3, 4},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 3},
+		},
+	}
+	detected := AttesterSlashingAlert(Detection, &ethpb.AttesterSlashing{Attestation_1: att1, Attestation_2: att2})
+	included := AttesterSlashingAlert(Inclusion, &ethpb.AttesterSlashing{Attestation_1: att2, Attestation_2: att1})
+	if *detected.SourceEpoch != 1 || detected.Epoch != 4 || !detected.SurroundVote {
+		t.Errorf("Unexpected alert %v", detected)
+	}
+	if detected.slashingKey() != included.slashingKey() {
+		t.Errorf("Wanted the same slashing key for both attestation orders, received %s and %s",
+			detected.slashingKey(), included.slashingKey())
+	}
+	if included.summary() != "Attester slashing included in a block for validators [2 3], source epoch 1, target epoch 4" {
+		t.Errorf("Unexpected summary %s", included.summary())
+	}
+}
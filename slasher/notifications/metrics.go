@@ -0,0 +1,25 @@
+package notifications
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	notificationsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "slashing_notifications_sent_total",
+		Help: "The # of slashing alerts successfully delivered, per sink and event",
+	}, []string{"sink", "event"})
+	notificationsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "slashing_notifications_failed_total",
+		Help: "The # of slashing alerts which could not be delivered, per sink and event",
+	}, []string{"sink", "event"})
+	alertsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "slashing_alerts_dropped_total",
+		Help: "The # of slashing alerts dropped because the notification queue was full, per event",
+	}, []string{"event"})
+	alertQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slashing_alert_queue_depth",
+		Help: "The # of slashing alerts waiting to be delivered",
+	})
+)
@@ -0,0 +1,157 @@
+/*
+Package notifications defines a service delivering alerts about the slashings handled by the
+slasher, from their detection to their inclusion in a block, to the sinks operators configured
+for each event: Slack, PagerDuty or generic JSON webhooks.
+*/
+package notifications
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "notifications")
+
+// Alerts are queued while sinks are being notified, so a slow endpoint doesn't block the
+// services reporting slashing events.
+const alertQueueSize = 256
+
+// SinkConfig is a sink with the events it is notified of.
+type SinkConfig struct {
+	Sink   Sink
+	Events []Event
+}
+
+// Config options for the notifications service.
+type Config struct {
+	Sinks []*SinkConfig
+}
+
+type subscription struct {
+	sink   Sink
+	events map[Event]bool
+}
+
+// Service delivers the alerts of slashing events to the sinks subscribed to them. A nil service
+// drops every alert, so services can report events whether notifications are configured or not.
+type Service struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	subscriptions []*subscription
+	alerts        chan *Alert
+}
+
+// NewService instantiation.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	subscriptions := make([]*subscription, 0, len(cfg.Sinks))
+	for _, c := range cfg.Sinks {
+		events := make(map[Event]bool, len(c.Events))
+		for _, e := range c.Events {
+			events[e] = true
+		}
+		subscriptions = append(subscriptions, &subscription{sink: c.Sink, events: events})
+	}
+	return &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		subscriptions: subscriptions,
+		alerts:        make(chan *Alert, alertQueueSize),
+	}
+}
+
+// Start the notifications service.
+func (s *Service) Start() {
+	for _, sub := range s.subscriptions {
+		events := make([]Event, 0, len(sub.events))
+		for e := range sub.events {
+			events = append(events, e)
+		}
+		log.WithFields(logrus.Fields{
+			"sink":   sub.sink.Name(),
+			"events": events,
+		}).Info("Notifying sink of slashing events")
+	}
+	go s.run(s.ctx)
+}
+
+// Stop the notifications service.
+func (s *Service) Stop() error {
+	s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status returns an error if there exists an error in the notifications service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Subscribed returns true if a sink is notified of the event.
+func (s *Service) Subscribed(event Event) bool {
+	if s == nil {
+		return false
+	}
+	for _, sub := range s.subscriptions {
+		if sub.events[event] {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify queues the alert for delivery to the sinks subscribed to its event. The alert is dropped
+// if the queue is full.
+func (s *Service) Notify(alert *Alert) {
+	if !s.Subscribed(alert.Event) {
+		return
+	}
+	select {
+	case s.alerts <- alert:
+		alertQueueDepth.Set(float64(len(s.alerts)))
+	default:
+		alertsDropped.WithLabelValues(string(alert.Event)).Inc()
+		log.WithField("event", alert.Event).Warn("Notification queue full, dropping slashing alert")
+	}
+}
+
+func (s *Service) run(ctx context.Context) {
+	for {
+		select {
+		case alert := <-s.alerts:
+			alertQueueDepth.Set(float64(len(s.alerts)))
+			s.deliver(ctx, alert)
+		case <-ctx.Done():
+			log.Debug("Context canceled, stopping notifications routine")
+			return
+		}
+	}
+}
+
+// deliver sends the alert to every sink subscribed to its event. A failing sink is logged and
+// doesn't prevent the others from being notified.
+func (s *Service) deliver(ctx context.Context, alert *Alert) {
+	ctx, span := trace.StartSpan(ctx, "notifications.deliver")
+	defer span.End()
+	for _, sub := range s.subscriptions {
+		if !sub.events[alert.Event] {
+			continue
+		}
+		if err := sub.sink.Send(ctx, alert); err != nil {
+			notificationsFailed.WithLabelValues(sub.sink.Name(), string(alert.Event)).Inc()
+			log.WithError(err).WithFields(logrus.Fields{
+				"sink":  sub.sink.Name(),
+				"event": alert.Event,
+			}).Error("Could not deliver slashing alert")
+			continue
+		}
+		notificationsSent.WithLabelValues(sub.sink.Name(), string(alert.Event)).Inc()
+		log.WithFields(logrus.Fields{
+			"sink":  sub.sink.Name(),
+			"event": alert.Event,
+			"type":  alert.Type,
+		}).Debug("Delivered slashing alert")
+	}
+}
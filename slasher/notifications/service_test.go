@@ -0,0 +1,92 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func TestService_DeliversSubscribedEvents(t *testing.T) {
+	received := make(chan map[string]interface{}, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := make(map[string]interface{})
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		received <- payload
+	}))
+	defer srv.Close()
+
+	s := NewService(context.Background(), &Config{Sinks: []*SinkConfig{
+		{Sink: NewWebhookSink(srv.URL), Events: []Event{Detection, Inclusion}},
+	}})
+	s.Start()
+	defer func() {
+		if err := s.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if s.Subscribed(Submission) {
+		t.Error("Expected no sink subscribed to submissions")
+	}
+
+	slashing := &ethpb.ProposerSlashing{
+		ProposerIndex: 7,
+		Header_1:      &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 65}},
+		Header_2:      &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 65}},
+	}
+	s.Notify(ProposerSlashingAlert(Detection, slashing))
+	s.Notify(ProposerSlashingAlert(Submission, slashing))
+	s.Notify(ProposerSlashingAlert(Inclusion, slashing))
+
+	for _, event := range []Event{Detection, Inclusion} {
+		payload := <-received
+		want := map[string]interface{}{
+			"event":             string(event),
+			"type":              "proposer",
+			"validator_indices": []interface{}{float64(7)},
+			"epoch":             float64(2),
+			"slot":              float64(65),
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("Wanted payload %v, received %v", want, payload)
+		}
+	}
+}
+
+func TestService_NilDropsAlerts(t *testing.T) {
+	var s *Service
+	if s.Subscribed(Detection) {
+		t.Error("Expected a nil service not to be subscribed")
+	}
+	slot := uint64(1)
+	s.Notify(&Alert{Event: Detection, Type: "proposer", Slot: &slot})
+}
+
+func TestSinks_Formats(t *testing.T) {
+	slot := uint64(65)
+	alert := &Alert{Event: Detection, Type: "proposer", ValidatorIndices: []uint64{7}, Epoch: 2, Slot: &slot}
+
+	slack := NewSlackSink("").(*webhook).encode(alert)
+	if !reflect.DeepEqual(slack, map[string]string{"text": "Proposer slashing detected for validators [7] at slot 65"}) {
+		t.Errorf("Unexpected Slack message %v", slack)
+	}
+
+	pagerDuty := NewPagerDutySink("key")
+	triggered := pagerDuty.(*webhook).encode(alert).(map[string]interface{})
+	included := *alert
+	included.Event = Inclusion
+	resolved := pagerDuty.(*webhook).encode(&included).(map[string]interface{})
+	if triggered["event_action"] != "trigger" || resolved["event_action"] != "resolve" {
+		t.Errorf("Wanted the detection to trigger and the inclusion to resolve, received %v and %v",
+			triggered["event_action"], resolved["event_action"])
+	}
+	if triggered["dedup_key"] != resolved["dedup_key"] {
+		t.Error("Wanted the same dedup key for the events of a slashing")
+	}
+}
@@ -0,0 +1,124 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+const (
+	pagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+	// webhookTimeout is the maximum time spent delivering a single webhook.
+	webhookTimeout = 10 * time.Second
+)
+
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+// Sink is a destination alerts are delivered to.
+type Sink interface {
+	// Name identifies the kind of sink in logs and metrics.
+	Name() string
+	// Send delivers the alert, returning an error if it could not be delivered.
+	Send(ctx context.Context, alert *Alert) error
+}
+
+// webhook is an HTTP endpoint notified with a POST request of a JSON payload for every alert. The
+// URL isn't logged as Slack and some generic webhook URLs embed a secret token.
+type webhook struct {
+	name   string
+	url    string
+	client *http.Client
+	encode func(alert *Alert) interface{}
+}
+
+// NewWebhookSink returns a sink posting the alerts as is in JSON to the URL.
+func NewWebhookSink(url string) Sink {
+	return &webhook{
+		name:   "generic",
+		url:    url,
+		client: webhookClient,
+		encode: func(alert *Alert) interface{} {
+			return alert
+		},
+	}
+}
+
+// NewSlackSink returns a sink posting a message summarizing the alerts to a Slack incoming
+// webhook URL.
+func NewSlackSink(url string) Sink {
+	return &webhook{
+		name:   "slack",
+		url:    url,
+		client: webhookClient,
+		encode: func(alert *Alert) interface{} {
+			return map[string]string{"text": alert.summary()}
+		},
+	}
+}
+
+// NewPagerDutySink returns a sink sending the alerts to the PagerDuty Events API v2 with the
+// routing key. Detections and submissions trigger an incident for the slashing, which is
+// resolved once the slashing is included in a block.
+func NewPagerDutySink(routingKey string) Sink {
+	return &webhook{
+		name:   "pagerduty",
+		url:    pagerDutyURL,
+		client: webhookClient,
+		encode: func(alert *Alert) interface{} {
+			action := "trigger"
+			if alert.Event == Inclusion {
+				action = "resolve"
+			}
+			dedupKey := hashutil.Hash([]byte(alert.slashingKey()))
+			return map[string]interface{}{
+				"routing_key":  routingKey,
+				"event_action": action,
+				"dedup_key":    fmt.Sprintf("%x", dedupKey),
+				"payload": map[string]interface{}{
+					"summary":        alert.summary(),
+					"source":         "prysm-slasher",
+					"severity":       "critical",
+					"custom_details": alert,
+				},
+			}
+		},
+	}
+}
+
+// Name of the webhook kind.
+func (w *webhook) Name() string {
+	return w.name
+}
+
+// Send posts the encoded alert to the webhook URL.
+func (w *webhook) Send(ctx context.Context, alert *Alert) error {
+	body, err := json.Marshal(w.encode(alert))
+	if err != nil {
+		return errors.Wrap(err, "could not encode webhook payload")
+	}
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create webhook request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close webhook response body")
+		}
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
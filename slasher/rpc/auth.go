@@ -0,0 +1,196 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Scope of the calls an auth token allows.
+type Scope int
+
+const (
+	// ReadScope allows querying the slasher.
+	ReadScope Scope = iota
+	// AdminScope allows every call, including the ones submitting data to the slasher DB.
+	AdminScope
+)
+
+// String returns the name of the scope as written in the tokens file.
+func (s Scope) String() string {
+	switch s {
+	case ReadScope:
+		return "read"
+	case AdminScope:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// The scope required by each slasher RPC method. Methods not listed, such as the reflection
+// service, require the admin scope.
+var methodScopes = map[string]Scope{
+	"/ethereum.slashing.Slasher/IsSlashableAttestation": AdminScope,
+	"/ethereum.slashing.Slasher/IsSlashableBlock":       AdminScope,
+	"/ethereum.slashing.Slasher/ValidatorEpochSpan":     ReadScope,
+	"/ethereum.slashing.Slasher/HighestAttestation":     ReadScope,
+	"/ethereum.slashing.Slasher/SlasherStatus":          ReadScope,
+}
+
+var (
+	errMissingToken      = errors.New("missing auth token")
+	errInvalidToken      = errors.New("invalid auth token")
+	errInsufficientScope = errors.New("auth token scope is insufficient")
+)
+
+// Authenticator checks the bearer tokens of the callers of the slasher endpoints against the
+// tokens loaded from the tokens file.
+type Authenticator struct {
+	tokens map[string]Scope
+}
+
+// LoadAuthTokens reads the tokens file, which lists one token and its scope, read or admin,
+// separated by whitespace per line. Empty lines and lines starting with # are ignored.
+func LoadAuthTokens(path string) (*Authenticator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open auth tokens file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close auth tokens file")
+		}
+	}()
+
+	a := &Authenticator{tokens: make(map[string]Scope)}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d of auth tokens file: wanted a token and a scope", line)
+		}
+		var scope Scope
+		switch fields[1] {
+		case ReadScope.String():
+			scope = ReadScope
+		case AdminScope.String():
+			scope = AdminScope
+		default:
+			return nil, errors.Errorf("line %d of auth tokens file: unknown scope %q", line, fields[1])
+		}
+		a.tokens[fields[0]] = scope
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read auth tokens file")
+	}
+	if len(a.tokens) == 0 {
+		return nil, errors.New("auth tokens file has no tokens")
+	}
+	return a, nil
+}
+
+// This returns nil if the token is known and its scope covers the required scope. Every token
+// is compared in constant time so the response time doesn't leak the known tokens.
+func (a *Authenticator) authorize(token string, required Scope) error {
+	if token == "" {
+		return errMissingToken
+	}
+	found := false
+	var scope Scope
+	for t, s := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			found = true
+			scope = s
+		}
+	}
+	if !found {
+		return errInvalidToken
+	}
+	if scope < required {
+		return errInsufficientScope
+	}
+	return nil
+}
+
+// This checks the token of the gRPC call metadata against the scope required by the method.
+func (a *Authenticator) authorizeCall(ctx context.Context, method string) error {
+	required, ok := methodScopes[method]
+	if !ok {
+		required = AdminScope
+	}
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = bearerToken(values[0])
+		}
+	}
+	if err := a.authorize(token, required); err != nil {
+		authFailures.WithLabelValues(err.Error()).Inc()
+		if err == errInsufficientScope {
+			return status.Errorf(codes.PermissionDenied, "Could not call %s: %v", method, err)
+		}
+		return status.Errorf(codes.Unauthenticated, "Could not call %s: %v", method, err)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor rejects the unary calls without a token allowed to call the method.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorizeCall(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the streams without a token allowed to call the method.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorizeCall(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// HTTPHandler wraps an HTTP handler so it is only served to requests with an Authorization
+// header bearing a token of the required scope.
+func (a *Authenticator) HTTPHandler(required Scope, handler func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := a.authorize(bearerToken(r.Header.Get("Authorization")), required); err != nil {
+			authFailures.WithLabelValues(err.Error()).Inc()
+			if err == errInsufficientScope {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// This returns the token of a "Bearer <token>" authorization value.
+func bearerToken(value string) string {
+	const prefix = "Bearer "
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(value[len(prefix):])
+}
@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func writeTokensFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "slasher-auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "tokens")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoadAuthTokens(t *testing.T) {
+	path, cleanup := writeTokensFile(t, "# Slasher tokens.\nreader read\n\noperator admin\n")
+	defer cleanup()
+
+	auth, err := LoadAuthTokens(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auth.tokens) != 2 || auth.tokens["reader"] != ReadScope || auth.tokens["operator"] != AdminScope {
+		t.Errorf("Unexpected tokens %v", auth.tokens)
+	}
+}
+
+func TestLoadAuthTokens_Invalid(t *testing.T) {
+	tests := []struct {
+		content string
+		wantErr string
+	}{
+		{content: "reader\n", wantErr: "wanted a token and a scope"},
+		{content: "reader write\n", wantErr: "unknown scope"},
+		{content: "# No tokens.\n", wantErr: "has no tokens"},
+	}
+	for _, tt := range tests {
+		path, cleanup := writeTokensFile(t, tt.content)
+		_, err := LoadAuthTokens(path)
+		cleanup()
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Wanted error containing %q, received %v", tt.wantErr, err)
+		}
+	}
+}
+
+func TestAuthenticator_UnaryServerInterceptor(t *testing.T) {
+	auth := &Authenticator{tokens: map[string]Scope{"reader": ReadScope, "operator": AdminScope}}
+	interceptor := auth.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		want   codes.Code
+	}{
+		{name: "read scope reads", token: "reader", method: "/ethereum.slashing.Slasher/SlasherStatus", want: codes.OK},
+		{name: "admin scope reads", token: "operator", method: "/ethereum.slashing.Slasher/HighestAttestation", want: codes.OK},
+		{name: "admin scope submits", token: "operator", method: "/ethereum.slashing.Slasher/IsSlashableBlock", want: codes.OK},
+		{name: "read scope submits", token: "reader", method: "/ethereum.slashing.Slasher/IsSlashableAttestation", want: codes.PermissionDenied},
+		{name: "unknown method", token: "reader", method: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", want: codes.PermissionDenied},
+		{name: "invalid token", token: "intruder", method: "/ethereum.slashing.Slasher/SlasherStatus", want: codes.Unauthenticated},
+		{name: "missing token", method: "/ethereum.slashing.Slasher/SlasherStatus", want: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.want {
+				t.Errorf("Wanted code %v, received %v", tt.want, code)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_HTTPHandler(t *testing.T) {
+	auth := &Authenticator{tokens: map[string]Scope{"reader": ReadScope, "operator": AdminScope}}
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	tests := []struct {
+		header   string
+		required Scope
+		want     int
+	}{
+		{header: "Bearer reader", required: ReadScope, want: http.StatusOK},
+		{header: "bearer operator", required: AdminScope, want: http.StatusOK},
+		{header: "Bearer reader", required: AdminScope, want: http.StatusForbidden},
+		{header: "Bearer intruder", required: ReadScope, want: http.StatusUnauthorized},
+		{header: "reader", required: ReadScope, want: http.StatusUnauthorized},
+		{required: ReadScope, want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/slashings", nil)
+		if tt.header != "" {
+			req.Header.Set("Authorization", tt.header)
+		}
+		rec := httptest.NewRecorder()
+		auth.HTTPHandler(tt.required, handler)(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("Authorization %q: wanted status %d, received %d", tt.header, tt.want, rec.Code)
+		}
+	}
+}
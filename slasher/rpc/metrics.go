@@ -0,0 +1,13 @@
+package rpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	authFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "slasher_rpc_auth_failures_total",
+		Help: "The # of slasher RPC and HTTP requests rejected by token authentication",
+	}, []string{"reason"})
+)
@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// attesterSlashingProof summarizes the slashing condition an attester slashing satisfies along with
+// the hash tree roots of the attestation data and the epochs the condition is checked on.
+func attesterSlashingProof(slashing *ethpb.AttesterSlashing) (*slashpb.SlashingProof, error) {
+	att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+	if att1 == nil || att2 == nil || att1.Data == nil || att2.Data == nil ||
+		att1.Data.Source == nil || att1.Data.Target == nil || att2.Data.Source == nil || att2.Data.Target == nil {
+		return nil, errors.New("attester slashing is missing attestation data")
+	}
+	root1, err := ssz.HashTreeRoot(att1.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation data")
+	}
+	root2, err := ssz.HashTreeRoot(att2.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation data")
+	}
+	proof := &slashpb.SlashingProof{
+		Root_1:           root1[:],
+		Root_2:           root2[:],
+		SourceEpoch_1:    att1.Data.Source.Epoch,
+		TargetEpoch_1:    att1.Data.Target.Epoch,
+		SourceEpoch_2:    att2.Data.Source.Epoch,
+		TargetEpoch_2:    att2.Data.Target.Epoch,
+		ValidatorIndices: sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices),
+	}
+	switch {
+	case root1 != root2 && proof.TargetEpoch_1 == proof.TargetEpoch_2:
+		proof.Condition = slashpb.SlashingProof_DoubleVote
+	case proof.SourceEpoch_1 < proof.SourceEpoch_2 && proof.TargetEpoch_2 < proof.TargetEpoch_1:
+		proof.Condition = slashpb.SlashingProof_SurroundingVote
+	case proof.SourceEpoch_2 < proof.SourceEpoch_1 && proof.TargetEpoch_1 < proof.TargetEpoch_2:
+		proof.Condition = slashpb.SlashingProof_SurroundedVote
+	}
+	return proof, nil
+}
+
+// proposerSlashingProof summarizes the slashing condition a proposer slashing satisfies along with
+// the hash tree roots of both block headers.
+func proposerSlashingProof(slashing *ethpb.ProposerSlashing) (*slashpb.SlashingProof, error) {
+	if slashing.Header_1 == nil || slashing.Header_2 == nil ||
+		slashing.Header_1.Header == nil || slashing.Header_2.Header == nil {
+		return nil, errors.New("proposer slashing is missing block headers")
+	}
+	header1, header2 := slashing.Header_1.Header, slashing.Header_2.Header
+	root1, err := ssz.HashTreeRoot(header1)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block header")
+	}
+	root2, err := ssz.HashTreeRoot(header2)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block header")
+	}
+	proof := &slashpb.SlashingProof{
+		Root_1:           root1[:],
+		Root_2:           root2[:],
+		ValidatorIndices: []uint64{slashing.ProposerIndex},
+		Slot:             header1.Slot,
+	}
+	if root1 != root2 && header1.Slot == header2.Slot {
+		proof.Condition = slashpb.SlashingProof_DoubleProposal
+	}
+	return proof, nil
+}
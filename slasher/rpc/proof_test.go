@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+)
+
+func TestAttesterSlashingProof(t *testing.T) {
+	att := func(indices []uint64, source uint64, target uint64, slot uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		slashing *ethpb.AttesterSlashing
+		want     slashpb.SlashingProof_Condition
+	}{
+		{
+			name:     "double vote",
+			slashing: &ethpb.AttesterSlashing{Attestation_1: att([]uint64{1, 2}, 1, 4, 1), Attestation_2: att([]uint64{2}, 2, 4, 2)},
+			want:     slashpb.SlashingProof_DoubleVote,
+		},
+		{
+			name:     "surrounding vote",
+			slashing: &ethpb.AttesterSlashing{Attestation_1: att([]uint64{2}, 1, 5, 1), Attestation_2: att([]uint64{2}, 2, 4, 2)},
+			want:     slashpb.SlashingProof_SurroundingVote,
+		},
+		{
+			name:     "surrounded vote",
+			slashing: &ethpb.AttesterSlashing{Attestation_1: att([]uint64{2}, 2, 4, 1), Attestation_2: att([]uint64{2}, 1, 5, 2)},
+			want:     slashpb.SlashingProof_SurroundedVote,
+		},
+		{
+			name:     "same vote",
+			slashing: &ethpb.AttesterSlashing{Attestation_1: att([]uint64{2}, 2, 4, 1), Attestation_2: att([]uint64{2}, 2, 4, 1)},
+			want:     slashpb.SlashingProof_UnknownCondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, err := attesterSlashingProof(tt.slashing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if proof.Condition != tt.want {
+				t.Errorf("Wanted condition %s, received %s", tt.want, proof.Condition)
+			}
+			if len(proof.ValidatorIndices) != 1 || proof.ValidatorIndices[0] != 2 {
+				t.Errorf("Wanted validator 2 slashable, received %v", proof.ValidatorIndices)
+			}
+			if proof.TargetEpoch_1 != tt.slashing.Attestation_1.Data.Target.Epoch ||
+				proof.SourceEpoch_2 != tt.slashing.Attestation_2.Data.Source.Epoch {
+				t.Errorf("Unexpected proof epochs %v", proof)
+			}
+		})
+	}
+
+	if _, err := attesterSlashingProof(&ethpb.AttesterSlashing{Attestation_1: att(nil, 1, 2, 1)}); err == nil {
+		t.Error("Wanted an error for a slashing missing an attestation")
+	}
+}
+
+func TestProposerSlashingProof(t *testing.T) {
+	header := func(slot uint64, stateRoot byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  bytes.Repeat([]byte{stateRoot}, 32),
+				BodyRoot:   make([]byte, 32),
+			},
+		}
+	}
+	proof, err := proposerSlashingProof(&ethpb.ProposerSlashing{ProposerIndex: 5, Header_1: header(3, 1), Header_2: header(3, 2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Condition != slashpb.SlashingProof_DoubleProposal || proof.Slot != 3 {
+		t.Errorf("Wanted a double proposal at slot 3, received %v", proof)
+	}
+	if len(proof.ValidatorIndices) != 1 || proof.ValidatorIndices[0] != 5 {
+		t.Errorf("Wanted proposer 5 slashable, received %v", proof.ValidatorIndices)
+	}
+	if bytes.Equal(proof.Root_1, proof.Root_2) {
+		t.Error("Wanted different header roots")
+	}
+}
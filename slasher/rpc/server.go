@@ -3,7 +3,6 @@ package rpc
 import (
 	"context"
 
-	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/slasher/db"
@@ -23,7 +22,7 @@ type Server struct {
 }
 
 // IsSlashableAttestation returns an attester slashing if the attestation submitted
-// is a slashable vote.
+// is a slashable vote, along with a proof of the slashing condition it satisfies.
 func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.IndexedAttestation) (*slashpb.AttesterSlashingResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "detection.IsSlashableAttestation")
 	defer span.End()
@@ -32,22 +31,107 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 		log.WithError(err).Error("Could not save indexed attestation")
 		return nil, status.Errorf(codes.Internal, "Could not save indexed attestation: %v: %v", req, err)
 	}
-	slashings, err := ss.detector.DetectAttesterSlashings(ctx, req)
+	slashings, err := ss.detector.DetectAndUpdateSpansUnbounded(ctx, req)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not detect attester slashings for attestation: %v: %v", req, err)
 	}
 	if len(slashings) < 1 {
-		if err := ss.detector.UpdateSpans(ctx, req); err != nil {
-			log.WithError(err).Error("Could not update spans")
+		return &slashpb.AttesterSlashingResponse{}, nil
+	}
+	// All slashings found for a single attestation are detected against the same chain head.
+	detectionCtx, err := ss.slasherDB.AttesterSlashingContext(ctx, slashings[0])
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve detection context")
+	}
+	proofs := make([]*slashpb.SlashingProof, len(slashings))
+	for i, slashing := range slashings {
+		proofs[i], err = attesterSlashingProof(slashing)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not build attester slashing proof: %v", err)
 		}
 	}
 	return &slashpb.AttesterSlashingResponse{
 		AttesterSlashing: slashings,
+		DetectionContext: detectionCtx,
+		Proofs:           proofs,
 	}, nil
 }
 
 // IsSlashableBlock returns an proposer slashing if the block submitted
-// is a double proposal.
+// is a double proposal, along with a proof of the slashing condition it satisfies.
 func (ss *Server) IsSlashableBlock(ctx context.Context, req *ethpb.SignedBeaconBlockHeader) (*slashpb.ProposerSlashingResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "detection.IsSlashableBlock")
+	defer span.End()
+	if req == nil || req.Header == nil {
+		return nil, status.Error(codes.InvalidArgument, "Block header cannot be nil")
+	}
+	slashing, err := ss.detector.DetectDoubleProposals(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not detect proposer slashing for block: %v: %v", req, err)
+	}
+	if slashing == nil {
+		return &slashpb.ProposerSlashingResponse{}, nil
+	}
+	detectionCtx, err := ss.slasherDB.ProposerSlashingContext(ctx, slashing)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve detection context")
+	}
+	proof, err := proposerSlashingProof(slashing)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build proposer slashing proof: %v", err)
+	}
+	return &slashpb.ProposerSlashingResponse{
+		ProposerSlashing: []*ethpb.ProposerSlashing{slashing},
+		DetectionContext: detectionCtx,
+		Proofs:           []*slashpb.SlashingProof{proof},
+	}, nil
+}
+
+// HighestAttestation returns the highest source and target epochs of the attestations
+// the slasher has seen for a validator.
+func (ss *Server) HighestAttestation(ctx context.Context, req *slashpb.HighestAttestationRequest) (*slashpb.HighestAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.HighestAttestation")
+	defer span.End()
+	highest, err := ss.slasherDB.HighestAttestation(ctx, req.ValidatorIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve highest attestation of validator %d: %v", req.ValidatorIndex, err)
+	}
+	if highest == nil {
+		return nil, status.Errorf(codes.NotFound, "No attestation found for validator %d", req.ValidatorIndex)
+	}
+	return highest, nil
+}
+
+// SlasherStatus returns the status of the slasher. The attestation intake filter settings
+// are reported so operators can tell when detection doesn't cover every attestation.
+func (ss *Server) SlasherStatus(ctx context.Context, _ *slashpb.SlasherStatusRequest) (*slashpb.SlasherStatusResponse, error) {
+	filter := ss.detector.IntakeFilterStatus()
+	return &slashpb.SlasherStatusResponse{
+		IntakeFilter: &slashpb.IntakeFilterStatus{
+			Enabled:                   filter.Enabled,
+			ValidatorIndices:          filter.ValidatorIndices,
+			MinCommitteeParticipation: filter.MinParticipation,
+			FilteredByValidatorSet:    filter.FilteredByValidatorSet,
+			FilteredByParticipation:   filter.FilteredByParticipation,
+		},
+	}, nil
+}
+
+// ValidatorEpochSpan returns the min-max span values stored for a validator at an epoch,
+// along with the signature bytes used to look up the attestation targeting that epoch.
+func (ss *Server) ValidatorEpochSpan(ctx context.Context, req *slashpb.ValidatorEpochSpanRequest) (*slashpb.ValidatorEpochSpan, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.ValidatorEpochSpan")
+	defer span.End()
+	epochSpan, err := ss.slasherDB.EpochSpanByValidatorIndex(ctx, req.ValidatorIndex, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve span for validator %d at epoch %d: %v", req.ValidatorIndex, req.Epoch, err)
+	}
+	return &slashpb.ValidatorEpochSpan{
+		ValidatorIndex: req.ValidatorIndex,
+		Epoch:          req.Epoch,
+		MinSpan:        uint32(epochSpan.MinSpan),
+		MaxSpan:        uint32(epochSpan.MaxSpan),
+		SigBytes:       epochSpan.SigBytes[:],
+		HasAttested:    epochSpan.HasAttested,
+	}, nil
 }
@@ -1,10 +1,12 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 )
@@ -50,4 +52,119 @@ func Test_DetectionFlow(t *testing.T) {
 	if len(slashing.AttesterSlashing) != 1 {
 		t.Fatalf("only one slashing should have been found. got: %v", len(slashing.AttesterSlashing))
 	}
+	if len(slashing.Proofs) != 1 || slashing.Proofs[0].Condition != slashpb.SlashingProof_DoubleVote {
+		t.Errorf("Wanted a double vote proof, received %v", slashing.Proofs)
+	}
+}
+
+func TestServer_ValidatorEpochSpan(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := detection.NewDetectionService(ctx, &detection.Config{SlasherDB: db})
+	server := Server{ctx: ctx, detector: ds, slasherDB: db}
+
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 5},
+		},
+		Signature: []byte{1, 2},
+	}
+	if _, err := server.IsSlashableAttestation(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+
+	targetSpan, err := server.ValidatorEpochSpan(ctx, &slashpb.ValidatorEpochSpanRequest{ValidatorIndex: 3, Epoch: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !targetSpan.HasAttested {
+		t.Error("Expected the validator to have attested for its target epoch")
+	}
+	if !bytes.Equal(targetSpan.SigBytes, []byte{1, 2}) {
+		t.Errorf("Wanted sig bytes %#x, received %#x", []byte{1, 2}, targetSpan.SigBytes)
+	}
+
+	// Epochs between the source and target have a max span to the target.
+	midSpan, err := server.ValidatorEpochSpan(ctx, &slashpb.ValidatorEpochSpanRequest{ValidatorIndex: 3, Epoch: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if midSpan.MaxSpan != 2 {
+		t.Errorf("Wanted max span %d, received %d", 2, midSpan.MaxSpan)
+	}
+	if midSpan.HasAttested {
+		t.Error("Did not expect the validator to have attested for epoch 3")
+	}
+}
+
+func TestServer_HighestAttestation(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := detection.NewDetectionService(ctx, &detection.Config{SlasherDB: db})
+	server := Server{ctx: ctx, detector: ds, slasherDB: db}
+
+	if _, err := server.HighestAttestation(ctx, &slashpb.HighestAttestationRequest{ValidatorIndex: 3}); err == nil {
+		t.Error("Expected an error for a validator without attestations")
+	}
+
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 2},
+			Target: &ethpb.Checkpoint{Epoch: 5},
+		},
+		Signature: []byte{1, 2},
+	}
+	if _, err := server.IsSlashableAttestation(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+	highest, err := server.HighestAttestation(ctx, &slashpb.HighestAttestationRequest{ValidatorIndex: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if highest.HighestSourceEpoch != 2 || highest.HighestTargetEpoch != 5 {
+		t.Errorf("Wanted highest source 2 and target 5, received %d and %d", highest.HighestSourceEpoch, highest.HighestTargetEpoch)
+	}
+}
+
+func TestServer_IsSlashableBlock(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	defer testDB.TeardownSlasherDB(t, db)
+	ctx := context.Background()
+	ds := detection.NewDetectionService(ctx, &detection.Config{SlasherDB: db})
+	server := Server{ctx: ctx, detector: ds, slasherDB: db}
+
+	if _, err := server.IsSlashableBlock(ctx, &ethpb.SignedBeaconBlockHeader{}); err == nil {
+		t.Error("Expected an error for a nil block header")
+	}
+	res, err := server.IsSlashableBlock(ctx, &ethpb.SignedBeaconBlockHeader{
+		Header:    &ethpb.BeaconBlockHeader{Slot: 1},
+		Signature: []byte{1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ProposerSlashing) != 0 {
+		t.Errorf("Did not expect proposer slashings, received %d", len(res.ProposerSlashing))
+	}
+}
+
+func TestServer_SlasherStatus(t *testing.T) {
+	ctx := context.Background()
+	ds := detection.NewDetectionService(ctx, &detection.Config{IntakeValidatorIndices: []uint64{3}})
+	server := Server{ctx: ctx, detector: ds}
+	res, err := server.SlasherStatus(ctx, &slashpb.SlasherStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IntakeFilter.Enabled {
+		t.Error("Expected the intake filter to be reported as enabled")
+	}
+	if len(res.IntakeFilter.ValidatorIndices) != 1 || res.IntakeFilter.ValidatorIndices[0] != 3 {
+		t.Errorf("Wanted filtered validator set [3], received %v", res.IntakeFilter.ValidatorIndices)
+	}
 }
@@ -34,6 +34,7 @@ type Service struct {
 	withCert        string
 	withKey         string
 	credentialError error
+	auth            *Authenticator
 }
 
 // Config options for the slasher node RPC server.
@@ -44,6 +45,8 @@ type Config struct {
 	KeyFlag   string
 	Detector  *detection.Service
 	SlasherDB db.Database
+	// Auth rejects the calls without an allowed token, nil allows any caller.
+	Auth *Authenticator
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -55,8 +58,11 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		cancel:    cancel,
 		host:      cfg.Host,
 		port:      cfg.Port,
+		withCert:  cfg.CertFlag,
+		withKey:   cfg.KeyFlag,
 		detector:  cfg.Detector,
 		slasherDB: cfg.SlasherDB,
+		auth:      cfg.Auth,
 	}
 }
 
@@ -70,22 +76,28 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("address", address).Info("RPC-API listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_opentracing.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_opentracing.UnaryServerInterceptor(),
+	}
+	if s.auth != nil {
+		streamInterceptors = append(streamInterceptors, s.auth.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, s.auth.UnaryServerInterceptor())
+	}
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_opentracing.StreamServerInterceptor(),
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_opentracing.UnaryServerInterceptor(),
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	// TODO(#791): Utilize a certificate for secure connections
@@ -95,10 +107,14 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Errorf("Could not load TLS keys: %s", err)
 			s.credentialError = err
+		} else {
+			opts = append(opts, grpc.Creds(creds))
 		}
-		opts = append(opts, grpc.Creds(creds))
 	} else {
 		log.Warn("You are using an insecure gRPC connection! Provide a certificate and key to connect securely")
+		if s.auth != nil {
+			log.Warn("RPC auth tokens are sent in clear text over an insecure gRPC connection")
+		}
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
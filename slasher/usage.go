@@ -72,10 +72,31 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "slasher",
 		Flags: []cli.Flag{
 			flags.BeaconCertFlag,
+			flags.CertFlag,
 			flags.KeyFlag,
+			flags.RPCAuthTokensFileFlag,
+			flags.MonitoringTLSFlag,
 			flags.RPCPort,
+			flags.StreamIndexedAttestationsFlag,
 			flags.UseSpanCacheFlag,
+			flags.InMemorySpansFlag,
+			flags.InMemorySpansMaxMBFlag,
+			flags.SpansSnapshotEpochsFlag,
 			flags.RebuildSpanMapsFlag,
+			flags.DetectionBudgetFlag,
+			flags.BackfillFlag,
+			flags.BackfillFromEpochFlag,
+			flags.PruningEpochAgeFlag,
+			flags.CompactAfterEpochsFlag,
+			flags.SpansLengthFlag,
+			flags.WebhookURLFlag,
+			flags.WebhookEventsFlag,
+			flags.SlackWebhookURLFlag,
+			flags.SlackEventsFlag,
+			flags.PagerDutyRoutingKeyFlag,
+			flags.PagerDutyEventsFlag,
+			flags.IntakeValidatorIndicesFlag,
+			flags.MinCommitteeParticipationFlag,
 			flags.BeaconRPCProviderFlag,
 		},
 	},
@@ -20,7 +20,6 @@ import (
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	_ "go.uber.org/automaxprocs"
 	"gopkg.in/urfave/cli.v2"
-	"gopkg.in/urfave/cli.v2/altsrc"
 )
 
 var log = logrus.WithField("prefix", "main")
@@ -147,10 +146,8 @@ contract in order to activate the validator client`,
 	app.Flags = appFlags
 
 	app.Before = func(ctx *cli.Context) error {
-		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
-			if err := altsrc.InitInputSourceWithContext(appFlags, altsrc.NewYamlSourceFromFlagFunc(cmd.ConfigFileFlag.Name))(ctx); err != nil {
-				return err
-			}
+		if err := cmd.LoadFlagsFromConfigFile(ctx, appFlags); err != nil {
+			return err
 		}
 
 		format := ctx.String(cmd.LogFormat.Name)